@@ -46,10 +46,10 @@ func Init(ctx context.Context) error {
 	auth.New(v1Group, database, logger, environment, s3Service)
 
 	// Initialize Integrations Module (coordina core, WhatsApp, Shopify, etc.)
-	integrations.New(v1Group, database, logger, environment, rabbitMQ)
+	integrationCore := integrations.New(v1Group, database, logger, environment, rabbitMQ, redisClient)
 
 	// Initialize Order Module
-	modules.New(v1Group, database, logger, environment, rabbitMQ, redisClient)
+	modules.New(v1Group, database, logger, environment, rabbitMQ, redisClient, integrationCore, s3Service)
 
 	LogStartupInfo(ctx, logger, environment)
 
@@ -3,6 +3,7 @@ package integrations
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify"
 	"github.com/secamc93/probability/back/central/services/integrations/test"
 	whatsapp "github.com/secamc93/probability/back/central/services/integrations/whatsApp"
@@ -10,14 +11,18 @@ import (
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
 	"github.com/secamc93/probability/back/central/shared/rabbitmq"
+	"github.com/secamc93/probability/back/central/shared/redis"
 )
 
 // New inicializa todos los servicios de integraciones
 // Este bundle coordina la inicialización de todos los módulos de integraciones
-// (core, WhatsApp, Shopify, etc.) sin exponer dependencias externas
-func New(router *gin.RouterGroup, db db.IDatabase, logger log.ILogger, config env.IConfig, rabbitMQ rabbitmq.IQueue) {
+// (core, scheduler de sincronización, WhatsApp, Shopify, etc.) sin exponer dependencias externas.
+// Retorna el core de integraciones para los módulos que consumen datos de las integraciones (ej: catálogo)
+func New(router *gin.RouterGroup, db db.IDatabase, logger log.ILogger, config env.IConfig, rabbitMQ rabbitmq.IQueue, redisClient redis.IRedis) core.IIntegrationCore {
 
-	integrationCore := core.New(router, db, logger, config)
+	integrationCore := core.New(router, db, logger, config, rabbitMQ)
+
+	syncScheduler := scheduler.New(router, db, logger, redisClient)
 
 	whatsappBundle := whatsapp.New(config, logger)
 
@@ -25,7 +30,9 @@ func New(router *gin.RouterGroup, db db.IDatabase, logger log.ILogger, config en
 
 	integrationCore.RegisterTester("whatsap", whatsappBundle)
 
-	shopify.New(router, db, logger, config, integrationCore)
+	shopify.New(router, db, logger, config, integrationCore, syncScheduler, redisClient)
+
+	test.New(router, logger, rabbitMQ, syncScheduler)
 
-	test.New(router, logger, rabbitMQ)
+	return integrationCore
 }
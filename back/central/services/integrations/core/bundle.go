@@ -1,16 +1,30 @@
 package core
 
 import (
+	"context"
+	"strconv"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/app/usecaseintegrations"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/app/usecaseintegrationtype"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrations"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrationtype"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/scheduler"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/secondary/encryption"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/secondary/queue"
 	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/secondary/repository"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/rabbitmq"
+)
+
+// Valores por defecto del monitoreo de salud de integraciones
+const (
+	defaultHealthCheckIntervalMinutes = 15
+	defaultHealthFailureThreshold     = 3
 )
 
 // New inicializa el módulo core de integraciones y retorna la interfaz pública
@@ -19,17 +33,30 @@ func New(
 	db db.IDatabase,
 	logger log.ILogger,
 	config env.IConfig,
+	rabbitMQ rabbitmq.IQueue,
 ) IIntegrationCore {
 	// 1. Inicializar Servicio de Encriptación
 	encryptionService := encryption.New(config, logger)
 
-	// 2. Inicializar Repositorio
+	// 2. Inicializar Repositorio y publicador de eventos de salud
 	repo := repository.New(db, logger, encryptionService)
+	healthPublisher := queue.New(rabbitMQ, logger)
 
 	// 3. Inicializar Casos de Uso
-	IntegrationUseCase := usecaseintegrations.New(repo, encryptionService, logger)
+	healthCheckInterval := time.Duration(envInt(config, "INTEGRATION_HEALTH_CHECK_INTERVAL_MINUTES", defaultHealthCheckIntervalMinutes)) * time.Minute
+	healthPolicy := domain.HealthCheckPolicy{
+		FailureThreshold:    envInt(config, "INTEGRATION_HEALTH_FAILURE_THRESHOLD", defaultHealthFailureThreshold),
+		DeactivateThreshold: envInt(config, "INTEGRATION_HEALTH_DEACTIVATE_THRESHOLD", 0),
+		CheckInterval:       healthCheckInterval,
+	}
+	IntegrationUseCase := usecaseintegrations.New(repo, encryptionService, healthPublisher, healthPolicy, logger)
 	integrationTypeUseCase := usecaseintegrationtype.New(repo, logger)
 
+	// 3.1. Reportar schemas guardados antes de que se validaran al crear/actualizar el tipo
+	if invalid, err := integrationTypeUseCase.CheckStoredSchemas(context.Background()); err == nil && invalid > 0 {
+		logger.Warn(context.Background()).Int("invalid_types", invalid).Msg("Hay tipos de integración con schemas inválidos, sus integraciones no se pueden guardar hasta corregirlos")
+	}
+
 	// 4. Inicializar Handlers
 	handlerIntegrations := handlerintegrations.New(IntegrationUseCase, logger)
 	handlerIntegrationType := handlerintegrationtype.New(integrationTypeUseCase, logger)
@@ -38,6 +65,26 @@ func New(
 	handlerIntegrations.RegisterRoutes(router, logger)
 	handlerIntegrationType.RegisterRoutes(router, logger)
 
-	// 6. Crear y retornar interfaz pública
+	// 6. Iniciar scheduler de chequeos de salud (los testers se registran después,
+	// pero la primera ronda ocurre recién al cumplirse el intervalo)
+	if healthCheckInterval > 0 {
+		healthScheduler := scheduler.New(IntegrationUseCase, healthCheckInterval, logger)
+		healthScheduler.Start(context.Background())
+	}
+
+	// 7. Crear y retornar interfaz pública
 	return NewIntegrationCore(IntegrationUseCase)
 }
+
+// envInt lee una variable de entorno numérica con valor por defecto
+func envInt(config env.IConfig, key string, defaultValue int) int {
+	value := config.Get(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return defaultValue
+	}
+	return parsed
+}
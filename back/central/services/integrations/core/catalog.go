@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	CATÁLOGO - Importación de productos desde integraciones
+//
+// ───────────────────────────────────────────
+
+// ICatalogSource define la interfaz que implementa cada integración capaz de exponer su catálogo
+// de productos (Shopify, Mercado Libre, ...). El catálogo se recorre por páginas.
+type ICatalogSource interface {
+	// FetchCatalogPage obtiene una página del catálogo de la integración. cursor vacío = primera página.
+	FetchCatalogPage(ctx context.Context, integration *IntegrationWithCredentials, cursor string) (*CatalogPage, error)
+}
+
+// CatalogPage es una página del catálogo de una integración
+type CatalogPage struct {
+	Products   []CatalogProduct
+	NextCursor string // Vacío si es la última página
+}
+
+// CatalogProduct es un producto del catálogo externo en formato unificado.
+// Si el producto tiene una sola variante sin opciones, Variants viene vacío y el SKU,
+// precio y stock del producto son los de esa variante.
+type CatalogProduct struct {
+	ExternalID     string
+	SKU            string
+	Name           string
+	Description    string
+	Brand          string
+	Category       string
+	Tags           []string
+	Status         string // "active" | "draft" | "archived"
+	Price          float64
+	CompareAtPrice *float64
+	Currency       string
+	StockQuantity  int
+	TrackInventory bool
+	Weight         *float64
+	WeightUnit     string
+	ImageURLs      []string
+	OptionAxes     []string
+	Variants       []CatalogVariant
+}
+
+// CatalogVariant es una variante del catálogo externo
+type CatalogVariant struct {
+	ExternalID     string
+	SKU            string
+	Title          string
+	Barcode        string
+	Options        map[string]string
+	Position       int
+	Price          *float64
+	CompareAtPrice *float64
+	StockQuantity  int
+	Weight         *float64
+}
+
+// catalogSourceRegistry mantiene las fuentes de catálogo por tipo de integración
+type catalogSourceRegistry struct {
+	sources map[string]ICatalogSource
+	mu      sync.RWMutex
+}
+
+func newCatalogSourceRegistry() *catalogSourceRegistry {
+	return &catalogSourceRegistry{
+		sources: make(map[string]ICatalogSource),
+	}
+}
+
+// RegisterCatalogSource registra la fuente de catálogo de un tipo de integración
+func (ic *integrationCore) RegisterCatalogSource(integrationType string, source ICatalogSource) error {
+	if integrationType == "" {
+		return domain.ErrTesterTypeEmpty
+	}
+	if source == nil {
+		return fmt.Errorf("la fuente de catálogo no puede ser nil")
+	}
+
+	ic.catalogSources.mu.Lock()
+	defer ic.catalogSources.mu.Unlock()
+
+	ic.catalogSources.sources[integrationType] = source
+	return nil
+}
+
+// GetCatalogSource obtiene la fuente de catálogo registrada para un tipo de integración
+func (ic *integrationCore) GetCatalogSource(integrationType string) (ICatalogSource, error) {
+	ic.catalogSources.mu.RLock()
+	defer ic.catalogSources.mu.RUnlock()
+
+	source, exists := ic.catalogSources.sources[integrationType]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCatalogSourceNotFound, integrationType)
+	}
+
+	return source, nil
+}
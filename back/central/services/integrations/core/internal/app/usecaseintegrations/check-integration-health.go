@@ -0,0 +1,170 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// healthCheckTimeout limita la duración de cada tester durante un chequeo de salud
+const healthCheckTimeout = 30 * time.Second
+
+// CheckIntegrationHealth ejecuta el tester registrado de una integración, guarda el resultado
+// en el historial y actualiza su estado de salud (degradada, recuperada o desactivada)
+func (uc *IntegrationUseCase) CheckIntegrationHealth(ctx context.Context, id uint, trigger string) (*domain.IntegrationHealthCheck, error) {
+	ctx = log.WithFunctionCtx(ctx, "CheckIntegrationHealth")
+
+	integration, err := uc.repo.GetIntegrationByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationNotFound, err)
+	}
+
+	return uc.checkHealth(ctx, integration, trigger)
+}
+
+// checkHealth ejecuta el chequeo sobre una integración ya cargada
+func (uc *IntegrationUseCase) checkHealth(ctx context.Context, integration *domain.Integration, trigger string) (*domain.IntegrationHealthCheck, error) {
+	integrationTypeCode := ""
+	if integration.IntegrationType != nil {
+		integrationTypeCode = integration.IntegrationType.Code
+	} else {
+		integrationType, err := uc.repo.GetIntegrationTypeByID(ctx, integration.IntegrationTypeID)
+		if err != nil {
+			return nil, fmt.Errorf("error al obtener tipo de integración: %w", err)
+		}
+		integrationTypeCode = integrationType.Code
+	}
+
+	tester, err := uc.testerReg.GetTester(integrationTypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	testErr := uc.runHealthTester(ctx, integration, tester)
+
+	check := &domain.IntegrationHealthCheck{
+		IntegrationID: integration.ID,
+		Status:        domain.ClassifyHealthCheckError(testErr),
+		LatencyMs:     time.Since(startedAt).Milliseconds(),
+		Trigger:       trigger,
+		CheckedAt:     startedAt,
+	}
+	if testErr != nil {
+		check.ErrorMessage = testErr.Error()
+	}
+
+	if err := uc.repo.CreateHealthCheck(ctx, check); err != nil {
+		return nil, err
+	}
+
+	transition, err := uc.repo.RecordIntegrationHealth(ctx, integration.ID, check.Status == domain.HealthCheckStatusOK, uc.healthPolicy.DegradedThreshold(), check.CheckedAt)
+	if err != nil {
+		return nil, err
+	}
+	previousStatus, healthStatus, failures := transition.PreviousStatus, transition.Status, transition.ConsecutiveFailures
+
+	uc.log.Info(ctx).
+		Uint("integration_id", integration.ID).
+		Str("type_code", integrationTypeCode).
+		Str("check_status", check.Status).
+		Int64("latency_ms", check.LatencyMs).
+		Str("health_status", healthStatus).
+		Int("consecutive_failures", failures).
+		Msg("Chequeo de salud de integración completado")
+
+	if healthStatus == domain.IntegrationHealthDegraded && previousStatus != domain.IntegrationHealthDegraded {
+		uc.publishHealthEvent(ctx, domain.IntegrationHealthEventDegraded, integration, integrationTypeCode, check, failures)
+	} else if healthStatus == domain.IntegrationHealthHealthy && previousStatus == domain.IntegrationHealthDegraded {
+		uc.publishHealthEvent(ctx, domain.IntegrationHealthEventRecovered, integration, integrationTypeCode, check, failures)
+	}
+
+	// Una integración ya inactiva (p. ej. chequeo manual) no se vuelve a desactivar ni se notifica otra vez
+	if integration.IsActive && check.Status != domain.HealthCheckStatusOK && uc.healthPolicy.ShouldDeactivate(failures) {
+		if err := uc.DeactivateIntegration(ctx, integration.ID); err != nil {
+			uc.log.Error(ctx).
+				Err(err).
+				Uint("integration_id", integration.ID).
+				Int("consecutive_failures", failures).
+				Msg("Error al desactivar automáticamente integración con fallos consecutivos")
+		} else {
+			uc.log.Warn(ctx).
+				Uint("integration_id", integration.ID).
+				Int("consecutive_failures", failures).
+				Msg("Integración desactivada automáticamente por fallos consecutivos")
+			uc.publishHealthEvent(ctx, domain.IntegrationHealthEventDeactivated, integration, integrationTypeCode, check, failures)
+		}
+	}
+
+	return check, nil
+}
+
+// runHealthTester prepara config/credenciales y ejecuta el tester con timeout
+func (uc *IntegrationUseCase) runHealthTester(ctx context.Context, integration *domain.Integration, tester ITestIntegration) error {
+	var credentials domain.DecryptedCredentials
+	if len(integration.Credentials) > 0 {
+		encryptedBytes, err := decodeEncryptedCredentials([]byte(integration.Credentials))
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
+		}
+		decrypted, err := uc.encryption.DecryptCredentials(ctx, encryptedBytes)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
+		}
+		credentials = decrypted
+	}
+
+	configMap := map[string]interface{}{}
+	if len(integration.Config) > 0 {
+		if err := json.Unmarshal(integration.Config, &configMap); err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrIntegrationConfigDeserialize, err)
+		}
+	}
+	// El chequeo periódico solo valida credenciales: sin número de prueba el tester de
+	// WhatsApp no envía mensajes reales
+	delete(configMap, "test_phone_number")
+
+	testCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	return tester.TestConnection(testCtx, configMap, credentials)
+}
+
+// publishHealthEvent notifica al business un cambio de salud (los errores solo se registran)
+func (uc *IntegrationUseCase) publishHealthEvent(
+	ctx context.Context,
+	eventType string,
+	integration *domain.Integration,
+	integrationTypeCode string,
+	check *domain.IntegrationHealthCheck,
+	failures int,
+) {
+	if uc.healthPublisher == nil {
+		return
+	}
+
+	event := &domain.IntegrationHealthEvent{
+		Type:                eventType,
+		IntegrationID:       integration.ID,
+		IntegrationName:     integration.Name,
+		IntegrationCode:     integration.Code,
+		IntegrationType:     integrationTypeCode,
+		BusinessID:          integration.BusinessID,
+		CheckStatus:         check.Status,
+		ConsecutiveFailures: failures,
+		ErrorMessage:        check.ErrorMessage,
+		Timestamp:           check.CheckedAt,
+	}
+
+	if err := uc.healthPublisher.PublishHealthEvent(ctx, event); err != nil {
+		uc.log.Error(ctx).
+			Err(err).
+			Uint("integration_id", integration.ID).
+			Str("event_type", eventType).
+			Msg("Error al publicar evento de salud de integración")
+	}
+}
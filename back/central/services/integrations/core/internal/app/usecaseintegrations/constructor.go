@@ -13,6 +13,7 @@ type IIntegrationUseCase interface {
 	GetIntegrationByID(ctx context.Context, id uint) (*domain.Integration, error)
 	GetIntegrationByIDWithCredentials(ctx context.Context, id uint) (*domain.IntegrationWithCredentials, error)
 	GetIntegrationByType(ctx context.Context, integrationTypeCode string, businessID *uint) (*domain.IntegrationWithCredentials, error)
+	ListActiveIntegrationsByType(ctx context.Context, integrationTypeCode string, businessID *uint) ([]*domain.IntegrationWithCredentials, error)
 	DeleteIntegration(ctx context.Context, id uint) error
 	ListIntegrations(ctx context.Context, filters domain.IntegrationFilters) ([]*domain.Integration, int64, error)
 	TestIntegration(ctx context.Context, id uint) error
@@ -20,22 +21,35 @@ type IIntegrationUseCase interface {
 	ActivateIntegration(ctx context.Context, id uint) error
 	DeactivateIntegration(ctx context.Context, id uint) error
 	SetAsDefault(ctx context.Context, id uint) error
+	CheckIntegrationHealth(ctx context.Context, id uint, trigger string) (*domain.IntegrationHealthCheck, error)
+	RunHealthChecks(ctx context.Context) error
+	ListHealthChecks(ctx context.Context, integrationID uint, businessID uint, limit int) ([]*domain.IntegrationHealthCheck, error)
 }
 
 type IntegrationUseCase struct {
-	repo       domain.IRepository
-	encryption domain.IEncryptionService
-	testerReg  *IntegrationTesterRegistry
-	log        log.ILogger
+	repo            domain.IRepository
+	encryption      domain.IEncryptionService
+	testerReg       *IntegrationTesterRegistry
+	healthPublisher domain.IHealthEventPublisher
+	healthPolicy    domain.HealthCheckPolicy
+	log             log.ILogger
 }
 
 // New crea una nueva instancia del caso de uso de integraciones
-func New(repo domain.IRepository, encryption domain.IEncryptionService, logger log.ILogger) IIntegrationUseCase {
+func New(
+	repo domain.IRepository,
+	encryption domain.IEncryptionService,
+	healthPublisher domain.IHealthEventPublisher,
+	healthPolicy domain.HealthCheckPolicy,
+	logger log.ILogger,
+) IIntegrationUseCase {
 	return &IntegrationUseCase{
-		repo:       repo,
-		encryption: encryption,
-		testerReg:  NewIntegrationTesterRegistry(),
-		log:        logger,
+		repo:            repo,
+		encryption:      encryption,
+		testerReg:       NewIntegrationTesterRegistry(),
+		healthPublisher: healthPublisher,
+		healthPolicy:    healthPolicy,
+		log:             logger,
 	}
 }
 
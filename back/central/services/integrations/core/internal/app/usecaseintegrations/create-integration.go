@@ -52,6 +52,23 @@ func (uc *IntegrationUseCase) CreateIntegration(ctx context.Context, dto domain.
 		return nil, fmt.Errorf("error al obtener tipo de integración: %w", err)
 	}
 
+	// Deserializar Config a map para la validación y el tester
+	configMap := map[string]interface{}{}
+	if len(dto.Config) > 0 {
+		if err := json.Unmarshal(dto.Config, &configMap); err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationConfigDeserialize, err)
+		}
+	}
+	credentialsMap := dto.Credentials
+	if credentialsMap == nil {
+		credentialsMap = map[string]interface{}{}
+	}
+
+	// Validar config y credenciales contra los schemas del tipo de integración
+	if err := uc.validateAgainstSchemas(ctx, integrationType, configMap, credentialsMap); err != nil {
+		return nil, err
+	}
+
 	// VALIDAR CONEXIÓN ANTES DE GUARDAR
 	// Obtener tester registrado para este tipo
 	tester, err := uc.testerReg.GetTester(integrationType.Code)
@@ -64,14 +81,6 @@ func (uc *IntegrationUseCase) CreateIntegration(ctx context.Context, dto domain.
 			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationTestFailed, err)
 		}
 	} else {
-		// Deserializar Config a map para el tester
-		var configMap map[string]interface{}
-		if len(dto.Config) > 0 {
-			if err := json.Unmarshal(dto.Config, &configMap); err != nil {
-				return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationConfigDeserialize, err)
-			}
-		}
-
 		// Testear conexión con el tester específico
 		if err := tester.TestConnection(ctx, configMap, dto.Credentials); err != nil {
 			uc.log.Error(ctx).
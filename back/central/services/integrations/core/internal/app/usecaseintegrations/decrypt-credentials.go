@@ -0,0 +1,36 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+)
+
+// withDecryptedCredentials envuelve una integración junto con sus credenciales desencriptadas
+func (uc *IntegrationUseCase) withDecryptedCredentials(ctx context.Context, integration *domain.Integration) (*domain.IntegrationWithCredentials, error) {
+	var decryptedCredentials domain.DecryptedCredentials
+	if len(integration.Credentials) > 0 {
+		// Las credenciales están codificadas en base64 dentro de un JSON
+		encryptedBytes, err := decodeEncryptedCredentials([]byte(integration.Credentials))
+		if err != nil {
+			uc.log.Error(ctx).Err(err).
+				Uint("id", integration.ID).
+				Msg("Error al decodificar credenciales desde base64")
+			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
+		}
+		decrypted, err := uc.encryption.DecryptCredentials(ctx, encryptedBytes)
+		if err != nil {
+			uc.log.Error(ctx).Err(err).
+				Uint("id", integration.ID).
+				Msg("Error al desencriptar credenciales")
+			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
+		}
+		decryptedCredentials = decrypted
+	}
+
+	return &domain.IntegrationWithCredentials{
+		Integration:          *integration,
+		DecryptedCredentials: decryptedCredentials,
+	}, nil
+}
@@ -57,29 +57,5 @@ func (uc *IntegrationUseCase) GetIntegrationByType(ctx context.Context, integrat
 		return nil, err
 	}
 
-	// Desencriptar credenciales
-	var decryptedCredentials domain.DecryptedCredentials
-	if len(integration.Credentials) > 0 {
-		// Las credenciales están codificadas en base64 dentro de un JSON
-		encryptedBytes, err := decodeEncryptedCredentials([]byte(integration.Credentials))
-		if err != nil {
-			uc.log.Error(ctx).Err(err).
-				Uint("id", integration.ID).
-				Msg("Error al decodificar credenciales desde base64")
-			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
-		}
-		decrypted, err := uc.encryption.DecryptCredentials(ctx, encryptedBytes)
-		if err != nil {
-			uc.log.Error(ctx).Err(err).
-				Uint("id", integration.ID).
-				Msg("Error al desencriptar credenciales")
-			return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationCredentialsDecrypt, err)
-		}
-		decryptedCredentials = decrypted
-	}
-
-	return &domain.IntegrationWithCredentials{
-		Integration:          *integration,
-		DecryptedCredentials: decryptedCredentials,
-	}, nil
+	return uc.withDecryptedCredentials(ctx, integration)
 }
@@ -0,0 +1,44 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// ListActiveIntegrationsByType lista todas las integraciones activas de un tipo con credenciales desencriptadas.
+// Si businessID es nil se listan las integraciones de todos los negocios.
+// Las integraciones cuyas credenciales no se pueden desencriptar se omiten para no bloquear al resto.
+func (uc *IntegrationUseCase) ListActiveIntegrationsByType(ctx context.Context, integrationTypeCode string, businessID *uint) ([]*domain.IntegrationWithCredentials, error) {
+	ctx = log.WithFunctionCtx(ctx, "ListActiveIntegrationsByType")
+
+	integrationType, err := uc.repo.GetIntegrationTypeByCode(ctx, integrationTypeCode)
+	if err != nil {
+		uc.log.Error(ctx).Err(err).
+			Str("type_code", integrationTypeCode).
+			Msg("Error al obtener tipo de integración por código")
+		return nil, fmt.Errorf("%w '%s': %w", domain.ErrIntegrationTypeNotFound, integrationTypeCode, err)
+	}
+
+	integrations, err := uc.repo.ListActiveIntegrationsByIntegrationTypeID(ctx, integrationType.ID, businessID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.IntegrationWithCredentials, 0, len(integrations))
+	for _, integration := range integrations {
+		withCredentials, err := uc.withDecryptedCredentials(ctx, integration)
+		if err != nil {
+			uc.log.Warn(ctx).Err(err).
+				Uint("id", integration.ID).
+				Str("type_code", integrationTypeCode).
+				Msg("Integración omitida por error al desencriptar credenciales")
+			continue
+		}
+		result = append(result, withCredentials)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// ListHealthChecks obtiene el historial de chequeos de salud de una integración. Con businessID distinto
+// de 0 solo se permiten integraciones de ese business (las de otros business se reportan como inexistentes)
+func (uc *IntegrationUseCase) ListHealthChecks(ctx context.Context, integrationID uint, businessID uint, limit int) ([]*domain.IntegrationHealthCheck, error) {
+	ctx = log.WithFunctionCtx(ctx, "ListHealthChecks")
+
+	integration, err := uc.repo.GetIntegrationByID(ctx, integrationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationNotFound, err)
+	}
+	if businessID != 0 && (integration.BusinessID == nil || *integration.BusinessID != businessID) {
+		return nil, fmt.Errorf("%w: id %d", domain.ErrIntegrationNotFound, integrationID)
+	}
+
+	return uc.repo.ListHealthChecks(ctx, integrationID, limit)
+}
@@ -0,0 +1,74 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// RunHealthChecks ejecuta el chequeo de salud de todas las integraciones activas que tengan tester registrado.
+// Cada integración se reclama antes del chequeo para que, con varias réplicas, se chequee una sola vez por intervalo.
+func (uc *IntegrationUseCase) RunHealthChecks(ctx context.Context) error {
+	ctx = log.WithFunctionCtx(ctx, "RunHealthChecks")
+
+	integrations, err := uc.repo.ListActiveIntegrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	// La ventana de reclamo es la mitad del intervalo: tolera el desfase entre los tickers de las réplicas
+	claimWindow := uc.healthPolicy.CheckInterval / 2
+
+	checked, failed, skipped := 0, 0, 0
+	for _, integration := range integrations {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if integration.IntegrationType == nil || !uc.testerReg.IsRegistered(integration.IntegrationType.Code) {
+			skipped++
+			continue
+		}
+
+		claimed, err := uc.repo.ClaimHealthCheck(ctx, integration.ID, time.Now(), claimWindow)
+		if err != nil {
+			uc.log.Error(ctx).
+				Err(err).
+				Uint("integration_id", integration.ID).
+				Msg("Error al reclamar chequeo de salud de integración")
+			failed++
+			continue
+		}
+		if !claimed {
+			// Otra réplica ya chequeó la integración en este intervalo
+			skipped++
+			continue
+		}
+
+		check, err := uc.checkHealth(ctx, integration, domain.HealthCheckTriggerScheduler)
+		if err != nil {
+			uc.log.Error(ctx).
+				Err(err).
+				Uint("integration_id", integration.ID).
+				Msg("Error al ejecutar chequeo de salud de integración")
+			failed++
+			continue
+		}
+
+		checked++
+		if check.Status != domain.HealthCheckStatusOK {
+			failed++
+		}
+	}
+
+	uc.log.Info(ctx).
+		Int("total", len(integrations)).
+		Int("checked", checked).
+		Int("failed", failed).
+		Int("skipped", skipped).
+		Msg("Ronda de chequeos de salud de integraciones finalizada")
+
+	return nil
+}
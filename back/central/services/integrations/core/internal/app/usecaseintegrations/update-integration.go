@@ -47,6 +47,13 @@ func (uc *IntegrationUseCase) UpdateIntegration(ctx context.Context, id uint, dt
 			}
 		}
 	}
+	// Validar config y/o credenciales nuevas contra los schemas del tipo de integración
+	if dto.Config != nil || dto.Credentials != nil {
+		if err := uc.validateUpdateAgainstSchemas(ctx, existing, dto); err != nil {
+			return nil, err
+		}
+	}
+
 	if dto.Config != nil {
 		existing.Config = *dto.Config
 	}
@@ -78,3 +85,38 @@ func (uc *IntegrationUseCase) UpdateIntegration(ctx context.Context, id uint, dt
 
 	return existing, nil
 }
+
+// validateUpdateAgainstSchemas valida solo las partes (config/credenciales) que cambian en la actualización
+func (uc *IntegrationUseCase) validateUpdateAgainstSchemas(ctx context.Context, existing *domain.Integration, dto domain.UpdateIntegrationDTO) error {
+	integrationType := existing.IntegrationType
+	if integrationType == nil {
+		var err error
+		integrationType, err = uc.repo.GetIntegrationTypeByID(ctx, existing.IntegrationTypeID)
+		if err != nil {
+			return fmt.Errorf("error al obtener tipo de integración: %w", err)
+		}
+	}
+
+	var configMap map[string]interface{}
+	if dto.Config != nil {
+		configMap = map[string]interface{}{}
+		if len(*dto.Config) > 0 {
+			if err := json.Unmarshal(*dto.Config, &configMap); err != nil {
+				return fmt.Errorf("%w: %w", domain.ErrIntegrationConfigDeserialize, err)
+			}
+		}
+		if configMap == nil {
+			configMap = map[string]interface{}{}
+		}
+	}
+
+	var credentialsMap map[string]interface{}
+	if dto.Credentials != nil {
+		credentialsMap = *dto.Credentials
+		if credentialsMap == nil {
+			credentialsMap = map[string]interface{}{}
+		}
+	}
+
+	return uc.validateAgainstSchemas(ctx, integrationType, configMap, credentialsMap)
+}
@@ -0,0 +1,47 @@
+package usecaseintegrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+)
+
+// validateAgainstSchemas valida config y credenciales contra los schemas del tipo de integración.
+// Un map nil indica que esa parte no se está modificando y no se valida.
+func (uc *IntegrationUseCase) validateAgainstSchemas(
+	ctx context.Context,
+	integrationType *domain.IntegrationType,
+	config map[string]interface{},
+	credentials map[string]interface{},
+) error {
+	var fieldErrors []domain.FieldError
+
+	if config != nil {
+		configSchema, err := domain.ParseIntegrationSchema(integrationType.ConfigSchema)
+		if err != nil {
+			uc.log.Error(ctx).Err(err).Str("type_code", integrationType.Code).Msg("ConfigSchema inválido en tipo de integración")
+			return fmt.Errorf("config_schema de '%s': %w", integrationType.Code, err)
+		}
+		fieldErrors = append(fieldErrors, configSchema.Validate(domain.FormSectionConfig, config)...)
+	}
+
+	if credentials != nil {
+		credentialsSchema, err := domain.ParseIntegrationSchema(integrationType.CredentialsSchema)
+		if err != nil {
+			uc.log.Error(ctx).Err(err).Str("type_code", integrationType.Code).Msg("CredentialsSchema inválido en tipo de integración")
+			return fmt.Errorf("credentials_schema de '%s': %w", integrationType.Code, err)
+		}
+		fieldErrors = append(fieldErrors, credentialsSchema.Validate(domain.FormSectionCredentials, credentials)...)
+	}
+
+	if len(fieldErrors) > 0 {
+		uc.log.Warn(ctx).
+			Str("type_code", integrationType.Code).
+			Int("errors", len(fieldErrors)).
+			Msg("Configuración de integración no cumple el schema del tipo")
+		return &domain.SchemaValidationError{Fields: fieldErrors}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package usecaseintegrationtype
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// CheckStoredSchemas valida los schemas guardados de todos los tipos de integración. Los schemas
+// creados antes de la validación al guardar pueden ser inválidos: se reportan en el log al iniciar
+// para corregirlos, porque mientras tanto no se pueden crear ni actualizar integraciones de ese tipo.
+// Retorna cuántos tipos tienen schemas inválidos.
+func (uc *integrationTypeUseCase) CheckStoredSchemas(ctx context.Context) (int, error) {
+	ctx = log.WithFunctionCtx(ctx, "CheckStoredSchemas")
+
+	integrationTypes, err := uc.repo.ListIntegrationTypes(ctx)
+	if err != nil {
+		uc.log.Error(ctx).Err(err).Msg("Error al listar tipos de integración para validar sus schemas")
+		return 0, err
+	}
+
+	invalid := 0
+	for _, integrationType := range integrationTypes {
+		if err := validateSchemas(integrationType.ConfigSchema, integrationType.CredentialsSchema); err != nil {
+			invalid++
+			uc.log.Error(ctx).Err(err).
+				Uint("id", integrationType.ID).
+				Str("type_code", integrationType.Code).
+				Msg("Schema guardado inválido en tipo de integración")
+		}
+	}
+
+	return invalid, nil
+}
@@ -15,6 +15,8 @@ type IIntegrationTypeUseCase interface {
 	DeleteIntegrationType(ctx context.Context, id uint) error
 	ListIntegrationTypes(ctx context.Context) ([]*domain.IntegrationType, error)
 	ListActiveIntegrationTypes(ctx context.Context) ([]*domain.IntegrationType, error)
+	GetIntegrationTypeForm(ctx context.Context, id uint) (*domain.IntegrationTypeForm, error)
+	CheckStoredSchemas(ctx context.Context) (int, error)
 }
 
 type integrationTypeUseCase struct {
@@ -57,6 +57,12 @@ func (uc *integrationTypeUseCase) CreateIntegrationType(ctx context.Context, dto
 		return nil, fmt.Errorf("%w: %s", domain.ErrIntegrationTypeNameExists, dto.Name)
 	}
 
+	// Validar que los schemas sean JSON Schema válidos
+	if err := validateSchemas(dto.ConfigSchema, dto.CredentialsSchema); err != nil {
+		uc.log.Warn(ctx).Err(err).Str("name", dto.Name).Msg("Schemas inválidos al crear tipo de integración")
+		return nil, err
+	}
+
 	// Generar código automáticamente si no se proporciona
 	code := dto.Code
 	if code == "" {
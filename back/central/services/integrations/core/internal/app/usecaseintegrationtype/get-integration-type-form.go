@@ -0,0 +1,74 @@
+package usecaseintegrationtype
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	"gorm.io/datatypes"
+)
+
+// GetIntegrationTypeForm construye la definición de formulario de un tipo de integración
+// a partir de su ConfigSchema y CredentialsSchema
+func (uc *integrationTypeUseCase) GetIntegrationTypeForm(ctx context.Context, id uint) (*domain.IntegrationTypeForm, error) {
+	ctx = log.WithFunctionCtx(ctx, "GetIntegrationTypeForm")
+
+	integrationType, err := uc.repo.GetIntegrationTypeByID(ctx, id)
+	if err != nil {
+		uc.log.Error(ctx).Err(err).Uint("id", id).Msg("Error al obtener tipo de integración para formulario")
+		return nil, fmt.Errorf("%w: %w", domain.ErrIntegrationTypeNotFound, err)
+	}
+
+	configSchema, err := domain.ParseIntegrationSchema(integrationType.ConfigSchema)
+	if err != nil {
+		uc.log.Error(ctx).Err(err).Uint("id", id).Msg("ConfigSchema inválido al construir formulario")
+		return nil, fmt.Errorf("config_schema: %w", err)
+	}
+
+	credentialsSchema, err := domain.ParseIntegrationSchema(integrationType.CredentialsSchema)
+	if err != nil {
+		uc.log.Error(ctx).Err(err).Uint("id", id).Msg("CredentialsSchema inválido al construir formulario")
+		return nil, fmt.Errorf("credentials_schema: %w", err)
+	}
+
+	return &domain.IntegrationTypeForm{
+		IntegrationTypeID: integrationType.ID,
+		Code:              integrationType.Code,
+		Name:              integrationType.Name,
+		Description:       integrationType.Description,
+		Icon:              integrationType.Icon,
+		SetupInstructions: integrationType.SetupInstructions,
+		Sections: []domain.FormSection{
+			{
+				Key:    domain.FormSectionConfig,
+				Title:  sectionTitle(configSchema, "Configuración"),
+				Fields: configSchema.FormFields(false),
+			},
+			{
+				Key:    domain.FormSectionCredentials,
+				Title:  sectionTitle(credentialsSchema, "Credenciales"),
+				Fields: credentialsSchema.FormFields(true),
+			},
+		},
+	}, nil
+}
+
+// sectionTitle retorna el título definido en el schema o el título por defecto
+func sectionTitle(schema *domain.IntegrationSchema, defaultTitle string) string {
+	if schema != nil && schema.Title != "" {
+		return schema.Title
+	}
+	return defaultTitle
+}
+
+// validateSchemas verifica que los schemas de config y credenciales se puedan interpretar
+func validateSchemas(configSchema, credentialsSchema datatypes.JSON) error {
+	if _, err := domain.ParseIntegrationSchema(configSchema); err != nil {
+		return fmt.Errorf("config_schema: %w", err)
+	}
+	if _, err := domain.ParseIntegrationSchema(credentialsSchema); err != nil {
+		return fmt.Errorf("credentials_schema: %w", err)
+	}
+	return nil
+}
@@ -67,6 +67,12 @@ func (uc *integrationTypeUseCase) UpdateIntegrationType(ctx context.Context, id
 	if dto.CredentialsSchema != nil {
 		existing.CredentialsSchema = *dto.CredentialsSchema
 	}
+	if dto.ConfigSchema != nil || dto.CredentialsSchema != nil {
+		if err := validateSchemas(existing.ConfigSchema, existing.CredentialsSchema); err != nil {
+			uc.log.Warn(ctx).Err(err).Uint("id", id).Msg("Schemas inválidos al actualizar tipo de integración")
+			return nil, err
+		}
+	}
 
 	if err := uc.repo.UpdateIntegrationType(ctx, id, existing); err != nil {
 		uc.log.Error(ctx).Err(err).
@@ -24,22 +24,25 @@ type IntegrationType struct {
 
 // Integration representa una integración del sistema
 type Integration struct {
-	ID                uint             `json:"id"`
-	Name              string           `json:"name"`
-	Code              string           `json:"code"`
-	IntegrationTypeID uint             `json:"integration_type_id"`        // Relación con IntegrationType
-	IntegrationType   *IntegrationType `json:"integration_type,omitempty"` // Relación cargada
-	Category          string           `json:"category"`                   // "internal" | "external" (redundante pero útil para queries)
-	BusinessID        *uint            `json:"business_id"`                // NULL = global (como WhatsApp)
-	IsActive          bool             `json:"is_active"`
-	IsDefault         bool             `json:"is_default"`
-	Config            datatypes.JSON   `json:"config"` // Configuración en JSON (no sensible)
-	Credentials       datatypes.JSON   `json:"-"`      // Credenciales encriptadas (no se expone)
-	Description       string           `json:"description"`
-	CreatedByID       uint             `json:"created_by_id"`
-	UpdatedByID       *uint            `json:"updated_by_id"`
-	CreatedAt         time.Time        `json:"created_at"`
-	UpdatedAt         time.Time        `json:"updated_at"`
+	ID                  uint             `json:"id"`
+	Name                string           `json:"name"`
+	Code                string           `json:"code"`
+	IntegrationTypeID   uint             `json:"integration_type_id"`        // Relación con IntegrationType
+	IntegrationType     *IntegrationType `json:"integration_type,omitempty"` // Relación cargada
+	Category            string           `json:"category"`                   // "internal" | "external" (redundante pero útil para queries)
+	BusinessID          *uint            `json:"business_id"`                // NULL = global (como WhatsApp)
+	IsActive            bool             `json:"is_active"`
+	IsDefault           bool             `json:"is_default"`
+	HealthStatus        string           `json:"health_status"` // "unknown" | "healthy" | "degraded"
+	LastHealthCheckAt   *time.Time       `json:"last_health_check_at"`
+	ConsecutiveFailures int              `json:"consecutive_failures"`
+	Config              datatypes.JSON   `json:"config"` // Configuración en JSON (no sensible)
+	Credentials         datatypes.JSON   `json:"-"`      // Credenciales encriptadas (no se expone)
+	Description         string           `json:"description"`
+	CreatedByID         uint             `json:"created_by_id"`
+	UpdatedByID         *uint            `json:"updated_by_id"`
+	CreatedAt           time.Time        `json:"created_at"`
+	UpdatedAt           time.Time        `json:"updated_at"`
 }
 
 // DecryptedCredentials representa las credenciales desencriptadas (solo en memoria)
@@ -47,3 +50,35 @@ type DecryptedCredentials map[string]interface{}
 
 // IntegrationConfig representa la configuración de una integración (estructura flexible)
 type IntegrationConfig map[string]interface{}
+
+// IntegrationHealthCheck representa el resultado de un chequeo de salud de una integración
+type IntegrationHealthCheck struct {
+	ID            uint      `json:"id"`
+	IntegrationID uint      `json:"integration_id"`
+	Status        string    `json:"status"` // "ok" | "auth_failed" | "unreachable" | "error"
+	LatencyMs     int64     `json:"latency_ms"`
+	ErrorMessage  string    `json:"error_message"`
+	Trigger       string    `json:"trigger"` // "scheduler" | "manual"
+	CheckedAt     time.Time `json:"checked_at"`
+}
+
+// IntegrationHealthEvent representa un cambio en la salud de una integración que se notifica al business
+type IntegrationHealthEvent struct {
+	Type                string    `json:"type"` // "integration.degraded" | "integration.recovered" | "integration.deactivated"
+	IntegrationID       uint      `json:"integration_id"`
+	IntegrationName     string    `json:"integration_name"`
+	IntegrationCode     string    `json:"integration_code"`
+	IntegrationType     string    `json:"integration_type"`
+	BusinessID          *uint     `json:"business_id,omitempty"`
+	CheckStatus         string    `json:"check_status"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	ErrorMessage        string    `json:"error_message,omitempty"`
+	Timestamp           time.Time `json:"timestamp"`
+}
+
+// HealthCheckPolicy define cuándo una integración se considera degradada o se desactiva
+type HealthCheckPolicy struct {
+	FailureThreshold    int           // Fallos consecutivos para marcar la integración como degradada
+	DeactivateThreshold int           // Fallos consecutivos para desactivarla automáticamente (0 = nunca)
+	CheckInterval       time.Duration // Intervalo del chequeo programado (cada integración se chequea una vez por intervalo entre réplicas)
+}
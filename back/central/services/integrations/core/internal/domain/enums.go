@@ -13,6 +13,34 @@ const (
 	IntegrationCategoryExternal = "external" // Integraciones externas con clientes
 )
 
+// Estado de salud de una integración (resultado acumulado de los chequeos)
+const (
+	IntegrationHealthUnknown  = "unknown"
+	IntegrationHealthHealthy  = "healthy"
+	IntegrationHealthDegraded = "degraded"
+)
+
+// Resultado de un chequeo de salud individual
+const (
+	HealthCheckStatusOK          = "ok"
+	HealthCheckStatusAuthFailed  = "auth_failed"
+	HealthCheckStatusUnreachable = "unreachable"
+	HealthCheckStatusError       = "error"
+)
+
+// Origen de un chequeo de salud
+const (
+	HealthCheckTriggerScheduler = "scheduler"
+	HealthCheckTriggerManual    = "manual"
+)
+
+// Tipos de eventos de salud publicados al business
+const (
+	IntegrationHealthEventDegraded    = "integration.degraded"
+	IntegrationHealthEventRecovered   = "integration.recovered"
+	IntegrationHealthEventDeactivated = "integration.deactivated"
+)
+
 // IsValidType valida si un tipo de integración es válido
 func IsValidType(integrationType string) bool {
 	validTypes := []string{
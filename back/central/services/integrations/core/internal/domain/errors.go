@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	// Errores de validación de integración
@@ -20,10 +23,16 @@ var (
 	ErrIntegrationConfigSerialize      = errors.New("error al serializar configuración")
 	ErrIntegrationTestFailed           = errors.New("test de conexión falló")
 	ErrIntegrationAccessTokenNotFound  = errors.New("access_token no encontrado o inválido en las credenciales")
+	ErrIntegrationSchemaValidation     = errors.New("la configuración o las credenciales no cumplen el schema del tipo de integración")
+	ErrIntegrationAuthFailed           = errors.New("las credenciales de la integración fueron rechazadas por el proveedor")
+	ErrIntegrationUnreachable          = errors.New("no fue posible contactar al proveedor de la integración")
+	ErrCatalogSourceNotFound           = errors.New("el tipo de integración no soporta la importación de catálogo")
+	ErrRefundWriterNotFound            = errors.New("el tipo de integración no soporta el write-back de reembolsos")
 
 	// Errores de validación de tipo de integración
-	ErrIntegrationTypeNameRequired = errors.New("el nombre del tipo de integración es obligatorio")
-	ErrIntegrationTypeCodeRequired = errors.New("el código del tipo de integración es obligatorio")
+	ErrIntegrationTypeNameRequired  = errors.New("el nombre del tipo de integración es obligatorio")
+	ErrIntegrationTypeCodeRequired  = errors.New("el código del tipo de integración es obligatorio")
+	ErrIntegrationTypeSchemaInvalid = errors.New("el schema del tipo de integración no es un JSON Schema válido")
 
 	// Errores de negocio de tipo de integración
 	ErrIntegrationTypeNameExists      = errors.New("el nombre del tipo de integración ya está en uso")
@@ -35,3 +44,22 @@ var (
 	ErrTesterNil           = errors.New("tester no puede ser nil")
 	ErrTesterNotRegistered = errors.New("tester no registrado para tipo")
 )
+
+// SchemaValidationError agrupa los errores por campo encontrados al validar
+// la configuración y credenciales de una integración contra su IntegrationType
+type SchemaValidationError struct {
+	Fields []FieldError
+}
+
+func (e *SchemaValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		messages[i] = f.Field + ": " + f.Message
+	}
+	return ErrIntegrationSchemaValidation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+// Unwrap permite usar errors.Is(err, ErrIntegrationSchemaValidation)
+func (e *SchemaValidationError) Unwrap() error {
+	return ErrIntegrationSchemaValidation
+}
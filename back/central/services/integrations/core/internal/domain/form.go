@@ -0,0 +1,120 @@
+package domain
+
+// Secciones del formulario de configuración de una integración
+const (
+	FormSectionConfig      = "config"
+	FormSectionCredentials = "credentials"
+)
+
+// IntegrationTypeForm representa la definición de formulario que el frontend usa para
+// renderizar la configuración de un tipo de integración
+type IntegrationTypeForm struct {
+	IntegrationTypeID uint
+	Code              string
+	Name              string
+	Description       string
+	Icon              string
+	SetupInstructions string
+	Sections          []FormSection
+}
+
+// FormSection agrupa los campos de una parte del formulario (config o credentials)
+type FormSection struct {
+	Key    string
+	Title  string
+	Fields []FormField
+}
+
+// FormField representa un campo del formulario derivado del JSON Schema
+type FormField struct {
+	Name         string
+	Label        string
+	Type         string
+	InputType    string
+	Format       string
+	Required     bool
+	Secret       bool
+	Placeholder  string
+	Description  string
+	HelpText     string
+	HelpLink     string
+	ErrorMessage string
+	Default      interface{}
+	Options      []SchemaOption
+	Pattern      string
+	MinLength    *int
+	MaxLength    *int
+	Minimum      *float64
+	Maximum      *float64
+	Order        int
+}
+
+// FormFields construye los campos del formulario a partir del schema.
+// Si secret es true todos los campos se marcan como sensibles (credenciales).
+func (s *IntegrationSchema) FormFields(secret bool) []FormField {
+	if s == nil {
+		return []FormField{}
+	}
+
+	fields := make([]FormField, 0, len(s.Properties))
+	for _, name := range s.sortedPropertyNames() {
+		prop := s.Properties[name]
+
+		fieldType := prop.Type
+		if fieldType == "" {
+			fieldType = SchemaTypeString
+		}
+
+		fields = append(fields, FormField{
+			Name:         name,
+			Label:        prop.DisplayLabel(name),
+			Type:         fieldType,
+			InputType:    prop.inputType(secret),
+			Format:       prop.Format,
+			Required:     prop.IsRequired,
+			Secret:       secret || prop.WriteOnly || prop.Format == "password",
+			Placeholder:  prop.Placeholder,
+			Description:  prop.Description,
+			HelpText:     prop.HelpText,
+			HelpLink:     prop.HelpLink,
+			ErrorMessage: prop.ErrorMessage,
+			Default:      prop.Default,
+			Options:      prop.Options(),
+			Pattern:      prop.Pattern,
+			MinLength:    prop.MinLength,
+			MaxLength:    prop.MaxLength,
+			Minimum:      prop.minimum(),
+			Maximum:      prop.maximum(),
+			Order:        prop.Order,
+		})
+	}
+	return fields
+}
+
+// inputType determina el tipo de input HTML sugerido para el campo
+func (p *SchemaProperty) inputType(secret bool) string {
+	if p.InputType != "" {
+		return p.InputType
+	}
+	if len(p.Enum) > 0 {
+		return "select"
+	}
+	if secret || p.WriteOnly || p.Format == "password" {
+		return "password"
+	}
+
+	switch p.Type {
+	case SchemaTypeNumber, SchemaTypeInteger:
+		return "number"
+	case SchemaTypeBoolean:
+		return "checkbox"
+	}
+
+	switch p.Format {
+	case "email":
+		return "email"
+	case "uri", "url":
+		return "url"
+	}
+	return "text"
+}
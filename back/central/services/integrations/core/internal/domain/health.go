@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// ClassifyHealthCheckError traduce el error de un tester al estado de chequeo de salud.
+// Los testers pueden envolver ErrIntegrationAuthFailed o ErrIntegrationUnreachable para
+// clasificar explícitamente; los errores de red y timeouts se consideran "unreachable".
+func ClassifyHealthCheckError(err error) string {
+	if err == nil {
+		return HealthCheckStatusOK
+	}
+
+	if errors.Is(err, ErrIntegrationAuthFailed) ||
+		errors.Is(err, ErrIntegrationAccessTokenNotFound) ||
+		errors.Is(err, ErrIntegrationCredentialsDecrypt) {
+		return HealthCheckStatusAuthFailed
+	}
+
+	if errors.Is(err, ErrIntegrationUnreachable) || errors.Is(err, context.DeadlineExceeded) {
+		return HealthCheckStatusUnreachable
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return HealthCheckStatusUnreachable
+	}
+
+	return HealthCheckStatusError
+}
+
+// HealthTransition es el estado de salud de una integración antes y después de registrar un chequeo
+type HealthTransition struct {
+	PreviousStatus      string
+	Status              string
+	ConsecutiveFailures int
+}
+
+// DegradedThreshold retorna los fallos consecutivos con los que la integración queda degradada (mínimo 1)
+func (p HealthCheckPolicy) DegradedThreshold() int {
+	if p.FailureThreshold < 1 {
+		return 1
+	}
+	return p.FailureThreshold
+}
+
+// ShouldDeactivate indica si la integración debe desactivarse por fallos consecutivos
+func (p HealthCheckPolicy) ShouldDeactivate(failures int) bool {
+	return p.DeactivateThreshold > 0 && failures >= p.DeactivateThreshold
+}
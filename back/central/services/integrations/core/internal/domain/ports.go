@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // IRepository define la interfaz unificada del repositorio de integraciones y tipos de integración
 type IRepository interface {
@@ -14,8 +17,16 @@ type IRepository interface {
 	GetActiveIntegrationByIntegrationTypeID(ctx context.Context, integrationTypeID uint, businessID *uint) (*Integration, error)
 	ListIntegrationsByBusiness(ctx context.Context, businessID uint) ([]*Integration, error)
 	ListIntegrationsByIntegrationTypeID(ctx context.Context, integrationTypeID uint) ([]*Integration, error)
+	ListActiveIntegrationsByIntegrationTypeID(ctx context.Context, integrationTypeID uint, businessID *uint) ([]*Integration, error)
 	SetIntegrationAsDefault(ctx context.Context, id uint) error
 	ExistsIntegrationByCode(ctx context.Context, code string, businessID *uint) (bool, error)
+	ListActiveIntegrations(ctx context.Context) ([]*Integration, error)
+	RecordIntegrationHealth(ctx context.Context, id uint, checkOK bool, degradedThreshold int, checkedAt time.Time) (*HealthTransition, error)
+	ClaimHealthCheck(ctx context.Context, id uint, claimedAt time.Time, minInterval time.Duration) (bool, error)
+
+	// Métodos de IntegrationHealthChecks
+	CreateHealthCheck(ctx context.Context, check *IntegrationHealthCheck) error
+	ListHealthChecks(ctx context.Context, integrationID uint, limit int) ([]*IntegrationHealthCheck, error)
 
 	// Métodos de IntegrationTypes
 	CreateIntegrationType(ctx context.Context, integrationType *IntegrationType) error
@@ -43,6 +54,11 @@ type IEncryptionService interface {
 	DecryptValue(ctx context.Context, encryptedValue string) (string, error)
 }
 
+// IHealthEventPublisher publica los cambios de salud de las integraciones para notificar al business
+type IHealthEventPublisher interface {
+	PublishHealthEvent(ctx context.Context, event *IntegrationHealthEvent) error
+}
+
 // IIntegrationTypeUseCase define la interfaz del caso de uso de tipos de integración
 
 // IIntegrationUseCase define la interfaz del caso de uso de integraciones
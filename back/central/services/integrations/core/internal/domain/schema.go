@@ -0,0 +1,487 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"gorm.io/datatypes"
+)
+
+// Tipos de JSON Schema soportados en ConfigSchema y CredentialsSchema
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeString  = "string"
+	SchemaTypeNumber  = "number"
+	SchemaTypeInteger = "integer"
+	SchemaTypeBoolean = "boolean"
+	SchemaTypeArray   = "array"
+)
+
+// patternCache guarda los patrones ya compilados: los schemas se parsean en cada validación y
+// los patrones se repiten entre tipos de integración
+var patternCache sync.Map // string -> *regexp.Regexp
+
+// compilePattern compila el patrón una sola vez por proceso
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := patternCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patternCache.Store(pattern, re)
+	return re, nil
+}
+
+// IntegrationSchema representa un JSON Schema (subconjunto de draft-07) que describe
+// los campos de configuración o credenciales de un tipo de integración.
+//
+// Además de las palabras clave estándar (type, properties, required, enum, pattern,
+// minLength, maxLength, minimum, maximum, format) acepta las extensiones que usa el
+// frontend para renderizar formularios (label, placeholder, order, help_text, ...).
+// También acepta el formato legado {"required_fields": [...], "optional_fields": [...]}.
+type IntegrationSchema struct {
+	Type                 string                     `json:"type,omitempty"`
+	Title                string                     `json:"title,omitempty"`
+	Description          string                     `json:"description,omitempty"`
+	Properties           map[string]*SchemaProperty `json:"properties,omitempty"`
+	Required             []string                   `json:"required,omitempty"`
+	AdditionalProperties *bool                      `json:"additionalProperties,omitempty"`
+
+	// Formato legado (se convierte a properties/required al parsear)
+	RequiredFields []string `json:"required_fields,omitempty"`
+	OptionalFields []string `json:"optional_fields,omitempty"`
+}
+
+// SchemaProperty representa la definición de un campo dentro del schema
+type SchemaProperty struct {
+	Type        string        `json:"type,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Format      string        `json:"format,omitempty"` // "email" | "uri" | "url" | "hostname" | "password"
+	Pattern     string        `json:"pattern,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"` // Valores planos o {"value": ..., "label": ...}
+	Default     interface{}   `json:"default,omitempty"`
+	MinLength   *int          `json:"minLength,omitempty"`
+	MaxLength   *int          `json:"maxLength,omitempty"`
+	Minimum     *float64      `json:"minimum,omitempty"`
+	Maximum     *float64      `json:"maximum,omitempty"`
+	WriteOnly   bool          `json:"writeOnly,omitempty"`
+
+	// Campos anidados (type = "object") e items (type = "array")
+	Properties     map[string]*SchemaProperty `json:"properties,omitempty"`
+	RequiredFields []string                   `json:"-"`
+	Items          *SchemaProperty            `json:"items,omitempty"`
+
+	// Extensiones de UI (compatibles con DynamicField del frontend)
+	IsRequired   bool     `json:"-"`
+	Label        string   `json:"label,omitempty"`
+	Placeholder  string   `json:"placeholder,omitempty"`
+	HelpText     string   `json:"help_text,omitempty"`
+	HelpLink     string   `json:"help_link,omitempty"`
+	InputType    string   `json:"input_type,omitempty"`
+	ErrorMessage string   `json:"error_message,omitempty"`
+	Order        int      `json:"order,omitempty"`
+	Min          *float64 `json:"min,omitempty"` // Alias de minimum usado por el frontend
+	Max          *float64 `json:"max,omitempty"` // Alias de maximum usado por el frontend
+}
+
+// UnmarshalJSON permite que "required" sea un booleano (extensión del frontend)
+// o un arreglo de campos requeridos (JSON Schema estándar para objetos anidados)
+func (p *SchemaProperty) UnmarshalJSON(data []byte) error {
+	type alias SchemaProperty
+	aux := struct {
+		*alias
+		Required json.RawMessage `json:"required,omitempty"`
+	}{alias: (*alias)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.Required) == 0 {
+		return nil
+	}
+
+	var required bool
+	if err := json.Unmarshal(aux.Required, &required); err == nil {
+		p.IsRequired = required
+		return nil
+	}
+
+	var requiredFields []string
+	if err := json.Unmarshal(aux.Required, &requiredFields); err != nil {
+		return fmt.Errorf("'required' debe ser booleano o arreglo de strings: %w", err)
+	}
+	p.RequiredFields = requiredFields
+	return nil
+}
+
+// SchemaOption representa una opción de un campo enumerado
+type SchemaOption struct {
+	Value interface{}
+	Label string
+}
+
+// FieldError representa un error de validación asociado a un campo específico
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ParseIntegrationSchema interpreta un schema almacenado en la base de datos.
+// Retorna nil si el schema está vacío (sin restricciones).
+func ParseIntegrationSchema(raw datatypes.JSON) (*IntegrationSchema, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" || trimmed == "{}" {
+		return nil, nil
+	}
+
+	var schema IntegrationSchema
+	if err := json.Unmarshal([]byte(trimmed), &schema); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrIntegrationTypeSchemaInvalid, err)
+	}
+
+	if schema.Type != "" && schema.Type != SchemaTypeObject {
+		return nil, fmt.Errorf("%w: el tipo raíz debe ser 'object', se recibió '%s'", ErrIntegrationTypeSchemaInvalid, schema.Type)
+	}
+	schema.Type = SchemaTypeObject
+
+	if schema.Properties == nil {
+		schema.Properties = make(map[string]*SchemaProperty)
+	}
+
+	// Convertir formato legado a properties/required
+	for _, name := range schema.RequiredFields {
+		if _, exists := schema.Properties[name]; !exists {
+			schema.Properties[name] = &SchemaProperty{Type: SchemaTypeString}
+		}
+		schema.Required = appendUnique(schema.Required, name)
+	}
+	for _, name := range schema.OptionalFields {
+		if _, exists := schema.Properties[name]; !exists {
+			schema.Properties[name] = &SchemaProperty{Type: SchemaTypeString}
+		}
+	}
+	schema.RequiredFields = nil
+	schema.OptionalFields = nil
+
+	// Unificar "required: true" de cada propiedad con el arreglo required
+	for name, prop := range schema.Properties {
+		if prop == nil {
+			return nil, fmt.Errorf("%w: la propiedad '%s' no tiene definición", ErrIntegrationTypeSchemaInvalid, name)
+		}
+		if err := prop.check(name); err != nil {
+			return nil, err
+		}
+		if prop.IsRequired {
+			schema.Required = appendUnique(schema.Required, name)
+		}
+	}
+	for _, name := range schema.Required {
+		if prop, exists := schema.Properties[name]; exists {
+			prop.IsRequired = true
+		}
+	}
+
+	return &schema, nil
+}
+
+// check valida que la definición de la propiedad sea coherente
+func (p *SchemaProperty) check(path string) error {
+	switch p.Type {
+	case "", SchemaTypeString, SchemaTypeNumber, SchemaTypeInteger, SchemaTypeBoolean, SchemaTypeObject, SchemaTypeArray:
+	default:
+		return fmt.Errorf("%w: tipo '%s' no soportado en '%s'", ErrIntegrationTypeSchemaInvalid, p.Type, path)
+	}
+
+	if p.Pattern != "" {
+		if _, err := compilePattern(p.Pattern); err != nil {
+			return fmt.Errorf("%w: patrón inválido en '%s': %w", ErrIntegrationTypeSchemaInvalid, path, err)
+		}
+	}
+
+	for name, child := range p.Properties {
+		if child == nil {
+			return fmt.Errorf("%w: la propiedad '%s.%s' no tiene definición", ErrIntegrationTypeSchemaInvalid, path, name)
+		}
+		if err := child.check(path + "." + name); err != nil {
+			return err
+		}
+	}
+	for _, name := range p.RequiredFields {
+		if child, exists := p.Properties[name]; exists {
+			child.IsRequired = true
+		}
+	}
+
+	if p.Items != nil {
+		return p.Items.check(path + "[]")
+	}
+	return nil
+}
+
+// Validate valida los datos contra el schema y retorna los errores por campo.
+// El prefijo se antepone al nombre de cada campo (ej: "config", "credentials").
+func (s *IntegrationSchema) Validate(prefix string, data map[string]interface{}) []FieldError {
+	if s == nil {
+		return nil
+	}
+
+	var errs []FieldError
+
+	for _, name := range s.sortedPropertyNames() {
+		prop := s.Properties[name]
+		value, present := data[name]
+		path := joinFieldPath(prefix, name)
+
+		if !present || isEmptyValue(value) {
+			if prop.IsRequired {
+				errs = append(errs, FieldError{Field: path, Message: prop.message("es obligatorio")})
+			}
+			continue
+		}
+
+		errs = append(errs, prop.validate(path, value)...)
+	}
+
+	if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+		extra := make([]string, 0)
+		for name := range data {
+			if _, defined := s.Properties[name]; !defined {
+				extra = append(extra, name)
+			}
+		}
+		sort.Strings(extra)
+		for _, name := range extra {
+			errs = append(errs, FieldError{Field: joinFieldPath(prefix, name), Message: "campo no permitido por el schema"})
+		}
+	}
+
+	return errs
+}
+
+// validate valida un valor presente contra la definición de la propiedad
+func (p *SchemaProperty) validate(path string, value interface{}) []FieldError {
+	switch p.Type {
+	case SchemaTypeString:
+		str, ok := value.(string)
+		if !ok {
+			return []FieldError{{Field: path, Message: p.message("debe ser un texto")}}
+		}
+		return p.validateString(path, str)
+
+	case SchemaTypeNumber, SchemaTypeInteger:
+		num, ok := value.(float64)
+		if !ok {
+			return []FieldError{{Field: path, Message: p.message("debe ser un número")}}
+		}
+		if p.Type == SchemaTypeInteger && num != math.Trunc(num) {
+			return []FieldError{{Field: path, Message: p.message("debe ser un número entero")}}
+		}
+		return p.validateNumber(path, num)
+
+	case SchemaTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return []FieldError{{Field: path, Message: p.message("debe ser verdadero o falso")}}
+		}
+
+	case SchemaTypeObject:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return []FieldError{{Field: path, Message: p.message("debe ser un objeto")}}
+		}
+		nested := &IntegrationSchema{Properties: p.Properties, Required: p.RequiredFields}
+		return nested.Validate(path, obj)
+
+	case SchemaTypeArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			return []FieldError{{Field: path, Message: p.message("debe ser una lista")}}
+		}
+		if p.Items == nil {
+			return nil
+		}
+		var errs []FieldError
+		for i, item := range items {
+			errs = append(errs, p.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+		}
+		return errs
+	}
+
+	if len(p.Enum) > 0 && !p.allowsValue(value) {
+		return []FieldError{{Field: path, Message: p.message("tiene un valor no permitido")}}
+	}
+
+	return nil
+}
+
+// validateString aplica las restricciones de texto
+func (p *SchemaProperty) validateString(path, value string) []FieldError {
+	length := utf8.RuneCountInString(value)
+	if p.MinLength != nil && length < *p.MinLength {
+		return []FieldError{{Field: path, Message: p.message(fmt.Sprintf("debe tener al menos %d caracteres", *p.MinLength))}}
+	}
+	if p.MaxLength != nil && length > *p.MaxLength {
+		return []FieldError{{Field: path, Message: p.message(fmt.Sprintf("debe tener máximo %d caracteres", *p.MaxLength))}}
+	}
+	if p.Pattern != "" {
+		if re, err := compilePattern(p.Pattern); err == nil && !re.MatchString(value) {
+			return []FieldError{{Field: path, Message: p.message("no tiene un formato válido")}}
+		}
+	}
+
+	switch p.Format {
+	case "email":
+		if _, err := mail.ParseAddress(value); err != nil {
+			return []FieldError{{Field: path, Message: p.message("debe ser un correo electrónico válido")}}
+		}
+	case "uri", "url":
+		if u, err := url.ParseRequestURI(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return []FieldError{{Field: path, Message: p.message("debe ser una URL válida")}}
+		}
+	case "hostname":
+		if strings.ContainsAny(value, " /:") {
+			return []FieldError{{Field: path, Message: p.message("debe ser un nombre de host válido")}}
+		}
+	}
+
+	if len(p.Enum) > 0 && !p.allowsValue(value) {
+		return []FieldError{{Field: path, Message: p.message("tiene un valor no permitido")}}
+	}
+	return nil
+}
+
+// validateNumber aplica las restricciones numéricas
+func (p *SchemaProperty) validateNumber(path string, value float64) []FieldError {
+	if minimum := p.minimum(); minimum != nil && value < *minimum {
+		return []FieldError{{Field: path, Message: p.message(fmt.Sprintf("debe ser mayor o igual a %v", *minimum))}}
+	}
+	if maximum := p.maximum(); maximum != nil && value > *maximum {
+		return []FieldError{{Field: path, Message: p.message(fmt.Sprintf("debe ser menor o igual a %v", *maximum))}}
+	}
+	if len(p.Enum) > 0 && !p.allowsValue(value) {
+		return []FieldError{{Field: path, Message: p.message("tiene un valor no permitido")}}
+	}
+	return nil
+}
+
+// Options retorna las opciones del enum normalizadas a valor/etiqueta
+func (p *SchemaProperty) Options() []SchemaOption {
+	options := make([]SchemaOption, 0, len(p.Enum))
+	for _, raw := range p.Enum {
+		if obj, ok := raw.(map[string]interface{}); ok {
+			label, _ := obj["label"].(string)
+			if label == "" {
+				label = fmt.Sprintf("%v", obj["value"])
+			}
+			options = append(options, SchemaOption{Value: obj["value"], Label: label})
+			continue
+		}
+		options = append(options, SchemaOption{Value: raw, Label: fmt.Sprintf("%v", raw)})
+	}
+	return options
+}
+
+// allowsValue verifica si el valor está dentro del enum
+func (p *SchemaProperty) allowsValue(value interface{}) bool {
+	for _, option := range p.Options() {
+		if fmt.Sprintf("%v", option.Value) == fmt.Sprintf("%v", value) {
+			return true
+		}
+	}
+	return false
+}
+
+// DisplayLabel retorna la etiqueta a mostrar para el campo
+func (p *SchemaProperty) DisplayLabel(name string) string {
+	if p.Label != "" {
+		return p.Label
+	}
+	if p.Title != "" {
+		return p.Title
+	}
+	return name
+}
+
+// message retorna el mensaje personalizado del schema o el mensaje por defecto
+func (p *SchemaProperty) message(defaultMsg string) string {
+	if p.ErrorMessage != "" {
+		return p.ErrorMessage
+	}
+	return defaultMsg
+}
+
+func (p *SchemaProperty) minimum() *float64 {
+	if p.Minimum != nil {
+		return p.Minimum
+	}
+	return p.Min
+}
+
+func (p *SchemaProperty) maximum() *float64 {
+	if p.Maximum != nil {
+		return p.Maximum
+	}
+	return p.Max
+}
+
+// sortedPropertyNames retorna los nombres de las propiedades ordenados por "order" y luego por nombre
+func (s *IntegrationSchema) sortedPropertyNames() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		oi, oj := orderOf(s.Properties[names[i]]), orderOf(s.Properties[names[j]])
+		if oi != oj {
+			return oi < oj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// orderOf retorna el orden del campo (los campos sin orden van al final)
+func orderOf(p *SchemaProperty) int {
+	if p.Order == 0 {
+		return math.MaxInt32
+	}
+	return p.Order
+}
+
+// isEmptyValue determina si un valor se considera ausente para la validación de requeridos
+func isEmptyValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str) == ""
+	}
+	return false
+}
+
+func joinFieldPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"gorm.io/datatypes"
+)
+
+func TestParseIntegrationSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		nilOK    bool
+		wantErr  bool
+		required []string
+		props    []string
+	}{
+		{name: "vacío", raw: "", nilOK: true},
+		{name: "null", raw: "null", nilOK: true},
+		{name: "objeto vacío", raw: "{}", nilOK: true},
+		{name: "JSON inválido", raw: "{", wantErr: true},
+		{name: "raíz no objeto", raw: `{"type":"string"}`, wantErr: true},
+		{name: "tipo no soportado", raw: `{"properties":{"a":{"type":"date"}}}`, wantErr: true},
+		{name: "patrón inválido", raw: `{"properties":{"a":{"type":"string","pattern":"("}}}`, wantErr: true},
+		{name: "propiedad sin definición", raw: `{"properties":{"a":null}}`, wantErr: true},
+		{name: "required no booleano ni arreglo", raw: `{"properties":{"a":{"type":"string","required":"si"}}}`, wantErr: true},
+		{
+			name:     "formato legado",
+			raw:      `{"required_fields":["api_key"],"optional_fields":["store"]}`,
+			required: []string{"api_key"},
+			props:    []string{"api_key", "store"},
+		},
+		{
+			name:     "required booleano por propiedad",
+			raw:      `{"properties":{"token":{"type":"string","required":true},"note":{"type":"string"}}}`,
+			required: []string{"token"},
+			props:    []string{"note", "token"},
+		},
+		{
+			name:     "required estándar sin duplicados",
+			raw:      `{"type":"object","required":["token"],"properties":{"token":{"type":"string","required":true}}}`,
+			required: []string{"token"},
+			props:    []string{"token"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ParseIntegrationSchema(datatypes.JSON(tt.raw))
+			if tt.wantErr {
+				if !errors.Is(err, ErrIntegrationTypeSchemaInvalid) {
+					t.Fatalf("err = %v, want ErrIntegrationTypeSchemaInvalid", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.nilOK {
+				if schema != nil {
+					t.Fatalf("schema = %+v, want nil", schema)
+				}
+				return
+			}
+			if !reflect.DeepEqual(schema.Required, tt.required) {
+				t.Errorf("Required = %v, want %v", schema.Required, tt.required)
+			}
+			if got := schema.sortedPropertyNames(); !reflect.DeepEqual(got, tt.props) {
+				t.Errorf("properties = %v, want %v", got, tt.props)
+			}
+			for _, name := range tt.required {
+				if !schema.Properties[name].IsRequired {
+					t.Errorf("property %q should be required", name)
+				}
+			}
+		})
+	}
+}
+
+func TestIntegrationSchemaValidate(t *testing.T) {
+	const raw = `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"store": {"type": "string", "required": true, "pattern": "^[a-z0-9-]+$", "minLength": 3, "maxLength": 20},
+			"email": {"type": "string", "format": "email"},
+			"webhook": {"type": "string", "format": "url"},
+			"host": {"type": "string", "format": "hostname"},
+			"mode": {"type": "string", "enum": [{"value": "live", "label": "Producción"}, "test"]},
+			"retries": {"type": "integer", "minimum": 0, "max": 5},
+			"rate": {"type": "number", "min": 0.5},
+			"active": {"type": "boolean"},
+			"address": {"type": "object", "required": ["city"], "properties": {"city": {"type": "string"}}},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 3}},
+			"secret": {"type": "string", "minLength": 8, "error_message": "clave muy corta"}
+		}
+	}`
+	schema, err := ParseIntegrationSchema(datatypes.JSON(raw))
+	if err != nil {
+		t.Fatalf("ParseIntegrationSchema: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		want []FieldError
+	}{
+		{name: "válido", data: map[string]interface{}{
+			"store": "mi-tienda", "email": "a@b.co", "webhook": "https://x.co/hook", "host": "x.co",
+			"mode": "live", "retries": float64(3), "rate": 1.5, "active": true,
+			"address": map[string]interface{}{"city": "Bogotá"}, "tags": []interface{}{"a", "bc"},
+		}},
+		{name: "requerido ausente", data: map[string]interface{}{},
+			want: []FieldError{{Field: "config.store", Message: "es obligatorio"}}},
+		{name: "requerido en blanco", data: map[string]interface{}{"store": "  "},
+			want: []FieldError{{Field: "config.store", Message: "es obligatorio"}}},
+		{name: "texto con tipo incorrecto", data: map[string]interface{}{"store": float64(1)},
+			want: []FieldError{{Field: "config.store", Message: "debe ser un texto"}}},
+		{name: "patrón", data: map[string]interface{}{"store": "Mi Tienda"},
+			want: []FieldError{{Field: "config.store", Message: "no tiene un formato válido"}}},
+		{name: "longitud mínima", data: map[string]interface{}{"store": "ab"},
+			want: []FieldError{{Field: "config.store", Message: "debe tener al menos 3 caracteres"}}},
+		{name: "longitud máxima en caracteres", data: map[string]interface{}{"store": "ñññ", "tags": []interface{}{"ñandú"}},
+			want: []FieldError{{Field: "config.store", Message: "no tiene un formato válido"}, {Field: "config.tags[0]", Message: "debe tener máximo 3 caracteres"}}},
+		{name: "formatos", data: map[string]interface{}{"store": "tienda", "email": "no-es-correo", "webhook": "/relativa", "host": "x.co/path"},
+			want: []FieldError{
+				{Field: "config.email", Message: "debe ser un correo electrónico válido"},
+				{Field: "config.host", Message: "debe ser un nombre de host válido"},
+				{Field: "config.webhook", Message: "debe ser una URL válida"},
+			}},
+		{name: "enum", data: map[string]interface{}{"store": "tienda", "mode": "sandbox"},
+			want: []FieldError{{Field: "config.mode", Message: "tiene un valor no permitido"}}},
+		{name: "entero", data: map[string]interface{}{"store": "tienda", "retries": 1.5},
+			want: []FieldError{{Field: "config.retries", Message: "debe ser un número entero"}}},
+		{name: "rango con alias del frontend", data: map[string]interface{}{"store": "tienda", "retries": float64(6), "rate": 0.1},
+			want: []FieldError{
+				{Field: "config.rate", Message: "debe ser mayor o igual a 0.5"},
+				{Field: "config.retries", Message: "debe ser menor o igual a 5"},
+			}},
+		{name: "booleano", data: map[string]interface{}{"store": "tienda", "active": "true"},
+			want: []FieldError{{Field: "config.active", Message: "debe ser verdadero o falso"}}},
+		{name: "objeto anidado", data: map[string]interface{}{"store": "tienda", "address": map[string]interface{}{}},
+			want: []FieldError{{Field: "config.address.city", Message: "es obligatorio"}}},
+		{name: "lista con tipo incorrecto", data: map[string]interface{}{"store": "tienda", "tags": "a,b"},
+			want: []FieldError{{Field: "config.tags", Message: "debe ser una lista"}}},
+		{name: "mensaje personalizado", data: map[string]interface{}{"store": "tienda", "secret": "123"},
+			want: []FieldError{{Field: "config.secret", Message: "clave muy corta"}}},
+		{name: "campos no permitidos", data: map[string]interface{}{"store": "tienda", "zeta": 1, "alfa": 2},
+			want: []FieldError{
+				{Field: "config.alfa", Message: "campo no permitido por el schema"},
+				{Field: "config.zeta", Message: "campo no permitido por el schema"},
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := schema.Validate(FormSectionConfig, tt.data)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNilSchemaValidate(t *testing.T) {
+	var schema *IntegrationSchema
+	if errs := schema.Validate(FormSectionConfig, map[string]interface{}{"any": "value"}); errs != nil {
+		t.Errorf("Validate() = %v, want nil", errs)
+	}
+}
+
+func TestCompilePatternCachesRegexp(t *testing.T) {
+	first, err := compilePattern("^[0-9]+$")
+	if err != nil {
+		t.Fatalf("compilePattern: %v", err)
+	}
+	second, err := compilePattern("^[0-9]+$")
+	if err != nil {
+		t.Fatalf("compilePattern: %v", err)
+	}
+	if first != second {
+		t.Error("compilePattern should return the cached regexp")
+	}
+	if _, err := compilePattern("("); err == nil {
+		t.Error("compilePattern should fail on an invalid pattern")
+	}
+}
@@ -65,6 +65,17 @@ func (h *IntegrationHandler) CreateIntegrationHandler(c *gin.Context) {
 	dto := mapper.ToCreateIntegrationDTO(req, userID)
 	integration, err := h.usecase.CreateIntegration(c.Request.Context(), dto)
 	if err != nil {
+		var validationErr *domain.SchemaValidationError
+		if errors.As(err, &validationErr) {
+			h.logger.Warn().
+				Err(err).
+				Uint("user_id", userID).
+				Int("fields", len(validationErr.Fields)).
+				Msg("Configuración de integración rechazada por el schema del tipo")
+			c.JSON(http.StatusBadRequest, mapper.ToValidationErrorResponse(validationErr))
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
 		errorMsg := "Error al crear integración"
 
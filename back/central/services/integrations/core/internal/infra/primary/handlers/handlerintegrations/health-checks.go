@@ -0,0 +1,173 @@
+package handlerintegrations
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrations/mapper"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrations/response"
+)
+
+// CheckIntegrationHealthHandler ejecuta un chequeo de salud inmediato de una integración
+//
+//	@Summary		Ejecutar chequeo de salud
+//	@Description	Ejecuta el tester registrado de la integración, guarda el resultado en el historial y actualiza su estado de salud
+//	@Tags			Integrations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID de la integración"
+//	@Success		200	{object}	response.IntegrationHealthCheckSuccessResponse
+//	@Failure		400	{object}	map[string]interface{}
+//	@Failure		401	{object}	map[string]interface{}
+//	@Failure		403	{object}	map[string]interface{}
+//	@Failure		404	{object}	map[string]interface{}
+//	@Failure		500	{object}	map[string]interface{}
+//	@Router			/integrations/{id}/health-checks [post]
+func (h *IntegrationHandler) CheckIntegrationHealthHandler(c *gin.Context) {
+	// Solo super admins pueden ejecutar chequeos manuales
+	if !middleware.IsSuperAdmin(c) {
+		h.logger.Error().Str("endpoint", "/integrations/:id/health-checks").Str("method", "POST").Msg("Intento de ejecutar chequeo de salud sin permisos de super admin")
+		c.JSON(http.StatusForbidden, response.IntegrationErrorResponse{
+			Success: false,
+			Message: "Solo los super usuarios pueden ejecutar chequeos de salud",
+			Error:   "permisos insuficientes",
+		})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		h.logger.Error().Err(err).Str("id", idStr).Str("endpoint", "/integrations/:id/health-checks").Str("method", "POST").Msg("ID de integración inválido al ejecutar chequeo de salud")
+		c.JSON(http.StatusBadRequest, response.IntegrationErrorResponse{
+			Success: false,
+			Message: "ID inválido",
+			Error:   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	check, err := h.usecase.CheckIntegrationHealth(c.Request.Context(), uint(id), domain.HealthCheckTriggerManual)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMsg := "Error al ejecutar chequeo de salud de la integración"
+
+		if errors.Is(err, domain.ErrIntegrationNotFound) {
+			statusCode = http.StatusNotFound
+			errorMsg = "La integración especificada no existe"
+		} else if errors.Is(err, domain.ErrTesterNotRegistered) {
+			statusCode = http.StatusBadRequest
+			errorMsg = "El tipo de integración no tiene un tester registrado"
+		}
+
+		h.logger.Error().
+			Err(err).
+			Uint64("integration_id", id).
+			Int("status_code", statusCode).
+			Msg("Error al ejecutar chequeo de salud en el usecase")
+		c.JSON(statusCode, response.IntegrationErrorResponse{
+			Success: false,
+			Message: errorMsg,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.IntegrationHealthCheckSuccessResponse{
+		Success: true,
+		Message: "Chequeo de salud ejecutado",
+		Data:    mapper.ToHealthCheckResponse(check),
+	})
+}
+
+// ListHealthChecksHandler obtiene el historial de chequeos de salud de una integración
+//
+//	@Summary		Historial de salud de integración
+//	@Description	Lista los chequeos de salud más recientes (ok, auth_failed, unreachable, error) con su latencia
+//	@Tags			Integrations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"ID de la integración"
+//	@Param			limit	query		int	false	"Cantidad máxima de registros (por defecto 50, máximo 200)"
+//	@Success		200		{object}	response.IntegrationHealthCheckListResponse
+//	@Failure		400		{object}	map[string]interface{}
+//	@Failure		401		{object}	map[string]interface{}
+//	@Failure		404		{object}	map[string]interface{}
+//	@Failure		500		{object}	map[string]interface{}
+//	@Router			/integrations/{id}/health-checks [get]
+func (h *IntegrationHandler) ListHealthChecksHandler(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		h.logger.Error().Err(err).Str("id", idStr).Str("endpoint", "/integrations/:id/health-checks").Str("method", "GET").Msg("ID de integración inválido al listar chequeos de salud")
+		c.JSON(http.StatusBadRequest, response.IntegrationErrorResponse{
+			Success: false,
+			Message: "ID inválido",
+			Error:   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.IntegrationErrorResponse{
+				Success: false,
+				Message: "limit inválido",
+				Error:   "El limit debe ser un número válido",
+			})
+			return
+		}
+	}
+
+	// Los usuarios de un business solo ven el historial de sus propias integraciones
+	var businessID uint
+	if !middleware.IsSuperAdmin(c) {
+		var ok bool
+		businessID, ok = middleware.GetBusinessID(c)
+		if !ok || businessID == 0 {
+			c.JSON(http.StatusUnauthorized, response.IntegrationErrorResponse{
+				Success: false,
+				Message: "Business no identificado",
+				Error:   "No se pudo obtener el business del token",
+			})
+			return
+		}
+	}
+
+	checks, err := h.usecase.ListHealthChecks(c.Request.Context(), uint(id), businessID, limit)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMsg := "Error al obtener historial de salud de la integración"
+
+		if errors.Is(err, domain.ErrIntegrationNotFound) {
+			statusCode = http.StatusNotFound
+			errorMsg = "La integración especificada no existe"
+		}
+
+		h.logger.Error().
+			Err(err).
+			Uint64("integration_id", id).
+			Int("status_code", statusCode).
+			Msg("Error al listar chequeos de salud en el usecase")
+		c.JSON(statusCode, response.IntegrationErrorResponse{
+			Success: false,
+			Message: errorMsg,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.IntegrationHealthCheckListResponse{
+		Success: true,
+		Message: "Historial de salud obtenido exitosamente",
+		Data:    mapper.ToHealthCheckListResponse(checks),
+	})
+}
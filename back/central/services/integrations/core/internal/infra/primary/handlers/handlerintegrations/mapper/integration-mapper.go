@@ -81,20 +81,23 @@ func ToIntegrationResponse(integration *domain.Integration) response.Integration
 	}
 
 	resp := response.IntegrationResponse{
-		ID:                integration.ID,
-		Name:              integration.Name,
-		Code:              integration.Code,
-		IntegrationTypeID: integration.IntegrationTypeID,
-		Category:          integration.Category,
-		BusinessID:        integration.BusinessID,
-		IsActive:          integration.IsActive,
-		IsDefault:         integration.IsDefault,
-		Config:            config,
-		Description:       integration.Description,
-		CreatedByID:       integration.CreatedByID,
-		UpdatedByID:       integration.UpdatedByID,
-		CreatedAt:         integration.CreatedAt,
-		UpdatedAt:         integration.UpdatedAt,
+		ID:                  integration.ID,
+		Name:                integration.Name,
+		Code:                integration.Code,
+		IntegrationTypeID:   integration.IntegrationTypeID,
+		Category:            integration.Category,
+		BusinessID:          integration.BusinessID,
+		IsActive:            integration.IsActive,
+		IsDefault:           integration.IsDefault,
+		HealthStatus:        integration.HealthStatus,
+		LastHealthCheckAt:   integration.LastHealthCheckAt,
+		ConsecutiveFailures: integration.ConsecutiveFailures,
+		Config:              config,
+		Description:         integration.Description,
+		CreatedByID:         integration.CreatedByID,
+		UpdatedByID:         integration.UpdatedByID,
+		CreatedAt:           integration.CreatedAt,
+		UpdatedAt:           integration.UpdatedAt,
 	}
 
 	// Incluir información del tipo de integración si está cargado
@@ -145,3 +148,40 @@ func ToIntegrationFilters(req request.GetIntegrationsRequest) domain.Integration
 		Search:              req.Search,
 	}
 }
+
+// ToValidationErrorResponse convierte un domain.SchemaValidationError en la respuesta con errores por campo
+func ToValidationErrorResponse(validationErr *domain.SchemaValidationError) response.IntegrationValidationErrorResponse {
+	fields := make([]response.IntegrationFieldError, len(validationErr.Fields))
+	for i, f := range validationErr.Fields {
+		fields[i] = response.IntegrationFieldError{Field: f.Field, Message: f.Message}
+	}
+
+	return response.IntegrationValidationErrorResponse{
+		Success: false,
+		Message: "La configuración no cumple el schema del tipo de integración",
+		Error:   validationErr.Error(),
+		Fields:  fields,
+	}
+}
+
+// ToHealthCheckResponse convierte domain.IntegrationHealthCheck a IntegrationHealthCheckResponse
+func ToHealthCheckResponse(check *domain.IntegrationHealthCheck) response.IntegrationHealthCheckResponse {
+	return response.IntegrationHealthCheckResponse{
+		ID:            check.ID,
+		IntegrationID: check.IntegrationID,
+		Status:        check.Status,
+		LatencyMs:     check.LatencyMs,
+		ErrorMessage:  check.ErrorMessage,
+		Trigger:       check.Trigger,
+		CheckedAt:     check.CheckedAt,
+	}
+}
+
+// ToHealthCheckListResponse convierte una lista de chequeos de salud a respuestas
+func ToHealthCheckListResponse(checks []*domain.IntegrationHealthCheck) []response.IntegrationHealthCheckResponse {
+	result := make([]response.IntegrationHealthCheckResponse, len(checks))
+	for i, check := range checks {
+		result[i] = ToHealthCheckResponse(check)
+	}
+	return result
+}
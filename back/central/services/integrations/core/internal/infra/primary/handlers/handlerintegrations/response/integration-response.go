@@ -11,22 +11,25 @@ type IntegrationTypeInfo struct {
 
 // IntegrationResponse representa la respuesta de una integración (sin credenciales)
 type IntegrationResponse struct {
-	ID                uint                   `json:"id" example:"1"`
-	Name              string                 `json:"name" example:"WhatsApp Principal"`
-	Code              string                 `json:"code" example:"whatsapp_platform"`
-	IntegrationTypeID uint                   `json:"integration_type_id" example:"1"`
-	IntegrationType   *IntegrationTypeInfo   `json:"integration_type,omitempty"` // Información del tipo si está cargado
-	Category          string                 `json:"category" example:"internal"`
-	BusinessID        *uint                  `json:"business_id" example:"16"`
-	IsActive          bool                   `json:"is_active" example:"true"`
-	IsDefault         bool                   `json:"is_default" example:"true"`
-	Config            map[string]interface{} `json:"config"`
-	Credentials       map[string]interface{} `json:"credentials,omitempty"` // Solo se incluye cuando se solicita para edición
-	Description       string                 `json:"description" example:"Integración principal de WhatsApp"`
-	CreatedByID       uint                   `json:"created_by_id" example:"1"`
-	UpdatedByID       *uint                  `json:"updated_by_id"`
-	CreatedAt         time.Time              `json:"created_at" example:"2024-01-15T10:30:00Z"`
-	UpdatedAt         time.Time              `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+	ID                  uint                   `json:"id" example:"1"`
+	Name                string                 `json:"name" example:"WhatsApp Principal"`
+	Code                string                 `json:"code" example:"whatsapp_platform"`
+	IntegrationTypeID   uint                   `json:"integration_type_id" example:"1"`
+	IntegrationType     *IntegrationTypeInfo   `json:"integration_type,omitempty"` // Información del tipo si está cargado
+	Category            string                 `json:"category" example:"internal"`
+	BusinessID          *uint                  `json:"business_id" example:"16"`
+	IsActive            bool                   `json:"is_active" example:"true"`
+	IsDefault           bool                   `json:"is_default" example:"true"`
+	HealthStatus        string                 `json:"health_status" example:"healthy"`
+	LastHealthCheckAt   *time.Time             `json:"last_health_check_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	ConsecutiveFailures int                    `json:"consecutive_failures" example:"0"`
+	Config              map[string]interface{} `json:"config"`
+	Credentials         map[string]interface{} `json:"credentials,omitempty"` // Solo se incluye cuando se solicita para edición
+	Description         string                 `json:"description" example:"Integración principal de WhatsApp"`
+	CreatedByID         uint                   `json:"created_by_id" example:"1"`
+	UpdatedByID         *uint                  `json:"updated_by_id"`
+	CreatedAt           time.Time              `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt           time.Time              `json:"updated_at" example:"2024-01-15T10:30:00Z"`
 }
 
 // IntegrationListResponse representa la respuesta de lista de integraciones
@@ -67,3 +70,48 @@ type IntegrationMessageResponse struct {
 	Success bool   `json:"success" example:"true"`
 	Message string `json:"message" example:"Operación realizada exitosamente"`
 }
+
+// IntegrationFieldError representa un error de validación de un campo contra el schema del tipo
+type IntegrationFieldError struct {
+	Field   string `json:"field" example:"config.store_name"`
+	Message string `json:"message" example:"el campo es requerido"`
+}
+
+// IntegrationValidationErrorResponse representa la respuesta cuando config/credenciales no cumplen el schema
+//
+//	@Description	Respuesta de error con el detalle por campo de la validación contra el schema
+type IntegrationValidationErrorResponse struct {
+	Success bool                    `json:"success" example:"false"`
+	Message string                  `json:"message" example:"La configuración no cumple el schema del tipo de integración"`
+	Error   string                  `json:"error,omitempty" example:"Detalles del error"`
+	Fields  []IntegrationFieldError `json:"fields"`
+}
+
+// IntegrationHealthCheckResponse representa un chequeo de salud de una integración
+type IntegrationHealthCheckResponse struct {
+	ID            uint      `json:"id" example:"1"`
+	IntegrationID uint      `json:"integration_id" example:"3"`
+	Status        string    `json:"status" example:"auth_failed"`
+	LatencyMs     int64     `json:"latency_ms" example:"412"`
+	ErrorMessage  string    `json:"error_message,omitempty" example:"shopify api returned status: 401"`
+	Trigger       string    `json:"trigger" example:"scheduler"`
+	CheckedAt     time.Time `json:"checked_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// IntegrationHealthCheckSuccessResponse representa la respuesta de un chequeo de salud ejecutado
+//
+//	@Description	Resultado del chequeo de salud ejecutado manualmente
+type IntegrationHealthCheckSuccessResponse struct {
+	Success bool                           `json:"success" example:"true"`
+	Message string                         `json:"message" example:"Chequeo de salud ejecutado"`
+	Data    IntegrationHealthCheckResponse `json:"data"`
+}
+
+// IntegrationHealthCheckListResponse representa el historial de chequeos de salud
+//
+//	@Description	Historial de chequeos de salud de una integración
+type IntegrationHealthCheckListResponse struct {
+	Success bool                             `json:"success" example:"true"`
+	Message string                           `json:"message" example:"Historial de salud obtenido exitosamente"`
+	Data    []IntegrationHealthCheckResponse `json:"data"`
+}
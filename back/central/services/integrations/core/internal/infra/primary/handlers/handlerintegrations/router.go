@@ -19,6 +19,8 @@ type IIntegrationHandler interface {
 	ActivateIntegrationHandler(c *gin.Context)
 	DeactivateIntegrationHandler(c *gin.Context)
 	SetAsDefaultHandler(c *gin.Context)
+	CheckIntegrationHealthHandler(c *gin.Context)
+	ListHealthChecksHandler(c *gin.Context)
 	RegisterRoutes(router *gin.RouterGroup, handler IIntegrationHandler, logger log.ILogger)
 }
 
@@ -40,5 +42,9 @@ func (h *IntegrationHandler) RegisterRoutes(router *gin.RouterGroup, logger log.
 		integrationsGroup.PUT("/:id/activate", middleware.JWT(), h.ActivateIntegrationHandler)
 		integrationsGroup.PUT("/:id/deactivate", middleware.JWT(), h.DeactivateIntegrationHandler)
 		integrationsGroup.PUT("/:id/set-default", middleware.JWT(), h.SetAsDefaultHandler)
+
+		// Monitoreo de salud
+		integrationsGroup.GET("/:id/health-checks", middleware.JWT(), h.ListHealthChecksHandler)
+		integrationsGroup.POST("/:id/health-checks", middleware.JWT(), h.CheckIntegrationHealthHandler)
 	}
 }
@@ -77,6 +77,17 @@ func (h *IntegrationHandler) UpdateIntegrationHandler(c *gin.Context) {
 	dto := mapper.ToUpdateIntegrationDTO(req, userID)
 	integration, err := h.usecase.UpdateIntegration(c.Request.Context(), uint(id), dto)
 	if err != nil {
+		var validationErr *domain.SchemaValidationError
+		if errors.As(err, &validationErr) {
+			h.logger.Warn().
+				Err(err).
+				Uint("user_id", userID).
+				Int("fields", len(validationErr.Fields)).
+				Msg("Configuración de integración rechazada por el schema del tipo")
+			c.JSON(http.StatusBadRequest, mapper.ToValidationErrorResponse(validationErr))
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
 		errorMsg := "Error al actualizar integración"
 
@@ -0,0 +1,75 @@
+package handlerintegrationtype
+
+// IntegrationTypeHandler está definido en integration-type-handler-constructor.go
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrationtype/mapper"
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/infra/primary/handlers/handlerintegrationtype/response"
+)
+
+// GetIntegrationTypeFormHandler obtiene la definición de formulario de un tipo de integración
+//
+//	@Summary		Obtener formulario de tipo de integración
+//	@Description	Retorna los campos de configuración y credenciales (derivados del JSON Schema) para renderizar el formulario de configuración
+//	@Tags			IntegrationTypes
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID del tipo de integración"	example(1)
+//	@Success		200	{object}	response.IntegrationTypeFormDetailResponse
+//	@Failure		400	{object}	map[string]interface{}
+//	@Failure		401	{object}	map[string]interface{}
+//	@Failure		404	{object}	map[string]interface{}
+//	@Failure		500	{object}	map[string]interface{}
+//	@Router			/integration-types/{id}/form [get]
+func (h *IntegrationTypeHandler) GetIntegrationTypeFormHandler(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		h.logger.Error().Err(err).Str("id", idStr).Str("endpoint", "/integration-types/:id/form").Str("method", "GET").Msg("ID de tipo de integración inválido al intentar obtener formulario")
+		c.JSON(http.StatusBadRequest, response.IntegrationErrorResponse{
+			Success: false,
+			Message: "ID inválido",
+			Error:   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	form, err := h.usecase.GetIntegrationTypeForm(c.Request.Context(), uint(id))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMsg := "Error interno del servidor al obtener el formulario del tipo de integración"
+
+		if errors.Is(err, domain.ErrIntegrationTypeNotFound) {
+			statusCode = http.StatusNotFound
+			errorMsg = "Tipo de integración no encontrado"
+		} else if errors.Is(err, domain.ErrIntegrationTypeSchemaInvalid) {
+			statusCode = http.StatusUnprocessableEntity
+			errorMsg = "El tipo de integración tiene un schema inválido"
+		}
+
+		h.logger.Error().
+			Err(err).
+			Uint64("integration_type_id", id).
+			Int("status_code", statusCode).
+			Msg("Error al obtener formulario de tipo de integración en el usecase")
+		c.JSON(statusCode, response.IntegrationErrorResponse{
+			Success: false,
+			Message: errorMsg,
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.IntegrationTypeFormDetailResponse{
+		Success: true,
+		Message: "Formulario del tipo de integración obtenido exitosamente",
+		Data:    mapper.ToIntegrationTypeFormResponse(form),
+	})
+}
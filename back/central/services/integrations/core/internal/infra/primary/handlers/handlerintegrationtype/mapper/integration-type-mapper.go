@@ -35,6 +35,10 @@ func ToCreateIntegrationTypeDTO(req request.CreateIntegrationTypeRequest) domain
 		configBytes, _ := json.Marshal(req.ConfigSchema)
 		configSchema = configBytes
 	}
+	if req.CredentialsSchema != nil {
+		credentialsBytes, _ := json.Marshal(req.CredentialsSchema)
+		credentialsSchema = credentialsBytes
+	}
 
 	return domain.CreateIntegrationTypeDTO{
 		Name:              req.Name,
@@ -75,6 +79,64 @@ func ToUpdateIntegrationTypeDTO(req request.UpdateIntegrationTypeRequest) domain
 		configJSON := datatypes.JSON(configBytes)
 		dto.ConfigSchema = &configJSON
 	}
+	if req.CredentialsSchema != nil {
+		credentialsBytes, _ := json.Marshal(*req.CredentialsSchema)
+		credentialsJSON := datatypes.JSON(credentialsBytes)
+		dto.CredentialsSchema = &credentialsJSON
+	}
 
 	return dto
 }
+
+// ToIntegrationTypeFormResponse convierte domain.IntegrationTypeForm a IntegrationTypeFormResponse
+func ToIntegrationTypeFormResponse(form *domain.IntegrationTypeForm) response.IntegrationTypeFormResponse {
+	sections := make([]response.IntegrationTypeFormSection, len(form.Sections))
+	for i, section := range form.Sections {
+		fields := make([]response.IntegrationTypeFormField, len(section.Fields))
+		for j, f := range section.Fields {
+			var options []response.IntegrationTypeFormOption
+			for _, opt := range f.Options {
+				options = append(options, response.IntegrationTypeFormOption{Value: opt.Value, Label: opt.Label})
+			}
+
+			fields[j] = response.IntegrationTypeFormField{
+				Name:         f.Name,
+				Label:        f.Label,
+				Type:         f.Type,
+				InputType:    f.InputType,
+				Format:       f.Format,
+				Required:     f.Required,
+				Secret:       f.Secret,
+				Placeholder:  f.Placeholder,
+				Description:  f.Description,
+				HelpText:     f.HelpText,
+				HelpLink:     f.HelpLink,
+				ErrorMessage: f.ErrorMessage,
+				Default:      f.Default,
+				Options:      options,
+				Pattern:      f.Pattern,
+				MinLength:    f.MinLength,
+				MaxLength:    f.MaxLength,
+				Minimum:      f.Minimum,
+				Maximum:      f.Maximum,
+				Order:        f.Order,
+			}
+		}
+
+		sections[i] = response.IntegrationTypeFormSection{
+			Key:    section.Key,
+			Title:  section.Title,
+			Fields: fields,
+		}
+	}
+
+	return response.IntegrationTypeFormResponse{
+		IntegrationTypeID: form.IntegrationTypeID,
+		Code:              form.Code,
+		Name:              form.Name,
+		Description:       form.Description,
+		Icon:              form.Icon,
+		SetupInstructions: form.SetupInstructions,
+		Sections:          sections,
+	}
+}
@@ -8,7 +8,8 @@ type CreateIntegrationTypeRequest struct {
 	Icon              string                 `json:"icon" example:"whatsapp-icon"`
 	Category          string                 `json:"category" binding:"required" example:"internal"`
 	IsActive          bool                   `json:"is_active" example:"true"`
-	ConfigSchema      map[string]interface{} `json:"credentials_schema"` // JSON schema para credenciales
+	ConfigSchema      map[string]interface{} `json:"config_schema"`      // JSON Schema para campos de configuración
+	CredentialsSchema map[string]interface{} `json:"credentials_schema"` // JSON Schema para credenciales
 }
 
 // UpdateIntegrationTypeRequest representa la solicitud para actualizar un tipo de integración
@@ -19,5 +20,6 @@ type UpdateIntegrationTypeRequest struct {
 	Icon              *string                 `json:"icon" example:"whatsapp-icon"`
 	Category          *string                 `json:"category" example:"internal"`
 	IsActive          *bool                   `json:"is_active" example:"true"`
-	ConfigSchema      *map[string]interface{} `json:"credentials_schema"`
+	ConfigSchema      *map[string]interface{} `json:"config_schema"`
+	CredentialsSchema *map[string]interface{} `json:"credentials_schema"`
 }
@@ -48,3 +48,58 @@ type IntegrationMessageResponse struct {
 	Success bool   `json:"success" example:"true"`
 	Message string `json:"message" example:"Operación realizada exitosamente"`
 }
+
+// IntegrationTypeFormOption representa una opción de un campo de selección
+type IntegrationTypeFormOption struct {
+	Value interface{} `json:"value"`
+	Label string      `json:"label" example:"2024-01"`
+}
+
+// IntegrationTypeFormField representa un campo del formulario de configuración
+type IntegrationTypeFormField struct {
+	Name         string                      `json:"name" example:"store_name"`
+	Label        string                      `json:"label" example:"Nombre de la tienda"`
+	Type         string                      `json:"type" example:"string"`
+	InputType    string                      `json:"input_type" example:"text"`
+	Format       string                      `json:"format,omitempty" example:"hostname"`
+	Required     bool                        `json:"required" example:"true"`
+	Secret       bool                        `json:"secret" example:"false"`
+	Placeholder  string                      `json:"placeholder,omitempty" example:"mi-tienda"`
+	Description  string                      `json:"description,omitempty"`
+	HelpText     string                      `json:"help_text,omitempty"`
+	HelpLink     string                      `json:"help_link,omitempty"`
+	ErrorMessage string                      `json:"error_message,omitempty"`
+	Default      interface{}                 `json:"default,omitempty"`
+	Options      []IntegrationTypeFormOption `json:"options,omitempty"`
+	Pattern      string                      `json:"pattern,omitempty"`
+	MinLength    *int                        `json:"min_length,omitempty"`
+	MaxLength    *int                        `json:"max_length,omitempty"`
+	Minimum      *float64                    `json:"minimum,omitempty"`
+	Maximum      *float64                    `json:"maximum,omitempty"`
+	Order        int                         `json:"order" example:"1"`
+}
+
+// IntegrationTypeFormSection representa una sección del formulario (config o credentials)
+type IntegrationTypeFormSection struct {
+	Key    string                     `json:"key" example:"config"`
+	Title  string                     `json:"title" example:"Configuración"`
+	Fields []IntegrationTypeFormField `json:"fields"`
+}
+
+// IntegrationTypeFormResponse representa el formulario de configuración de un tipo de integración
+type IntegrationTypeFormResponse struct {
+	IntegrationTypeID uint                         `json:"integration_type_id" example:"2"`
+	Code              string                       `json:"code" example:"shopify"`
+	Name              string                       `json:"name" example:"Shopify"`
+	Description       string                       `json:"description"`
+	Icon              string                       `json:"icon" example:"shopify-icon"`
+	SetupInstructions string                       `json:"setup_instructions"`
+	Sections          []IntegrationTypeFormSection `json:"sections"`
+}
+
+// IntegrationTypeFormDetailResponse representa la respuesta del formulario de un tipo de integración
+type IntegrationTypeFormDetailResponse struct {
+	Success bool                        `json:"success" example:"true"`
+	Message string                      `json:"message" example:"Formulario del tipo de integración obtenido exitosamente"`
+	Data    IntegrationTypeFormResponse `json:"data"`
+}
@@ -69,6 +69,9 @@ func (h *IntegrationTypeHandler) UpdateIntegrationTypeHandler(c *gin.Context) {
 		} else if errors.Is(err, domain.ErrIntegrationTypeNameExists) {
 			statusCode = http.StatusConflict
 			errorMsg = "Ya existe otro tipo de integración con el nombre proporcionado"
+		} else if errors.Is(err, domain.ErrIntegrationTypeSchemaInvalid) {
+			statusCode = http.StatusBadRequest
+			errorMsg = "El config_schema o credentials_schema no es un JSON Schema válido"
 		}
 
 		h.logger.Error().
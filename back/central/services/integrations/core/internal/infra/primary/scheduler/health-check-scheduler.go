@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/app/usecaseintegrations"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// HealthCheckScheduler ejecuta periódicamente los chequeos de salud de las integraciones activas
+type HealthCheckScheduler struct {
+	usecase  usecaseintegrations.IIntegrationUseCase
+	interval time.Duration
+	logger   log.ILogger
+}
+
+// New crea el scheduler de chequeos de salud
+func New(usecase usecaseintegrations.IIntegrationUseCase, interval time.Duration, logger log.ILogger) *HealthCheckScheduler {
+	return &HealthCheckScheduler{
+		usecase:  usecase,
+		interval: interval,
+		logger:   logger.WithModule("integrations-health"),
+	}
+}
+
+// Start inicia el ciclo de chequeos en background hasta que el contexto se cancele
+func (s *HealthCheckScheduler) Start(ctx context.Context) {
+	s.logger.Info(ctx).
+		Str("interval", s.interval.String()).
+		Msg("Scheduler de chequeos de salud de integraciones iniciado")
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.run(ctx)
+			case <-ctx.Done():
+				s.logger.Info(ctx).Msg("Context cancelado, deteniendo scheduler de chequeos de salud")
+				return
+			}
+		}
+	}()
+}
+
+// run ejecuta una ronda de chequeos protegiendo el scheduler de panics en los testers
+func (s *HealthCheckScheduler) run(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error(ctx).
+				Interface("panic", r).
+				Msg("Panic durante la ronda de chequeos de salud de integraciones")
+		}
+	}()
+
+	if err := s.usecase.RunHealthChecks(ctx); err != nil {
+		s.logger.Error(ctx).
+			Err(err).
+			Msg("Error al ejecutar la ronda de chequeos de salud de integraciones")
+	}
+}
@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/rabbitmq"
+)
+
+const (
+	IntegrationHealthQueueName = "probability.integrations.health"
+)
+
+type healthEventPublisher struct {
+	queue  rabbitmq.IQueue
+	logger log.ILogger
+}
+
+// New crea el publicador de eventos de salud. Si RabbitMQ no está disponible
+// los eventos solo se registran en el log.
+func New(queue rabbitmq.IQueue, logger log.ILogger) domain.IHealthEventPublisher {
+	return &healthEventPublisher{
+		queue:  queue,
+		logger: logger,
+	}
+}
+
+// PublishHealthEvent publica un cambio de salud de una integración
+func (p *healthEventPublisher) PublishHealthEvent(ctx context.Context, event *domain.IntegrationHealthEvent) error {
+	if p.queue == nil {
+		p.logger.Warn(ctx).
+			Str("event_type", event.Type).
+			Uint("integration_id", event.IntegrationID).
+			Str("check_status", event.CheckStatus).
+			Msg("RabbitMQ no disponible, evento de salud solo registrado en log")
+		return nil
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("event_type", event.Type).
+			Uint("integration_id", event.IntegrationID).
+			Msg("Error al serializar evento de salud de integración")
+		return fmt.Errorf("error al serializar evento de salud: %w", err)
+	}
+
+	if err := p.queue.Publish(ctx, IntegrationHealthQueueName, eventJSON); err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("queue", IntegrationHealthQueueName).
+			Str("event_type", event.Type).
+			Uint("integration_id", event.IntegrationID).
+			Msg("Error al publicar evento de salud de integración")
+		return fmt.Errorf("error al publicar evento de salud: %w", err)
+	}
+
+	p.logger.Info(ctx).
+		Str("queue", IntegrationHealthQueueName).
+		Str("event_type", event.Type).
+		Uint("integration_id", event.IntegrationID).
+		Msg("Evento de salud de integración publicado")
+
+	return nil
+}
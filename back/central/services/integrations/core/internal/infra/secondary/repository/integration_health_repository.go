@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ListActiveIntegrations lista todas las integraciones activas con su tipo cargado
+func (r *Repository) ListActiveIntegrations(ctx context.Context) ([]*domain.Integration, error) {
+	var integrationModels []models.Integration
+	if err := r.db.Conn(ctx).Preload("IntegrationType").Where("is_active = ?", true).Order("id ASC").Find(&integrationModels).Error; err != nil {
+		r.log.Error(ctx).Err(err).Msg("Error al listar integraciones activas")
+		return nil, fmt.Errorf("error al listar integraciones activas: %w", err)
+	}
+
+	integrations := make([]*domain.Integration, len(integrationModels))
+	for i, model := range integrationModels {
+		integrations[i] = r.toDomain(&model)
+	}
+
+	return integrations, nil
+}
+
+// RecordIntegrationHealth registra el resultado de un chequeo en una sola sentencia: el contador de fallos
+// se incrementa en SQL para que dos chequeos simultáneos (manual y programado) no pierdan fallos. Retorna
+// el estado anterior, el nuevo estado y el contador resultante.
+func (r *Repository) RecordIntegrationHealth(ctx context.Context, id uint, checkOK bool, degradedThreshold int, checkedAt time.Time) (*domain.HealthTransition, error) {
+	var row struct {
+		PreviousStatus      string
+		HealthStatus        string
+		ConsecutiveFailures int
+	}
+	result := r.db.Conn(ctx).Raw(`
+		UPDATE integrations AS i SET
+			consecutive_failures = CASE WHEN @ok THEN 0 ELSE i.consecutive_failures + 1 END,
+			health_status = CASE
+				WHEN @ok THEN @healthy
+				WHEN i.consecutive_failures + 1 >= @threshold THEN @degraded
+				ELSE COALESCE(NULLIF(i.health_status, ''), @unknown)
+			END,
+			last_health_check_at = @checked_at
+		FROM (SELECT id, health_status FROM integrations WHERE id = @id FOR UPDATE) AS prev
+		WHERE i.id = prev.id
+		RETURNING COALESCE(prev.health_status, '') AS previous_status, i.health_status, i.consecutive_failures`,
+		map[string]interface{}{
+			"ok":         checkOK,
+			"healthy":    domain.IntegrationHealthHealthy,
+			"degraded":   domain.IntegrationHealthDegraded,
+			"unknown":    domain.IntegrationHealthUnknown,
+			"threshold":  degradedThreshold,
+			"checked_at": checkedAt,
+			"id":         id,
+		},
+	).Scan(&row)
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("id", id).Msg("Error al actualizar salud de integración")
+		return nil, fmt.Errorf("error al actualizar salud de integración: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrIntegrationNotFound
+	}
+
+	return &domain.HealthTransition{
+		PreviousStatus:      row.PreviousStatus,
+		Status:              row.HealthStatus,
+		ConsecutiveFailures: row.ConsecutiveFailures,
+	}, nil
+}
+
+// ClaimHealthCheck reclama el chequeo programado de una integración activa marcando last_health_check_at,
+// solo si el último chequeo es anterior a minInterval. Con varias réplicas ejecutando el scheduler,
+// únicamente la que actualiza la fila ejecuta el chequeo.
+func (r *Repository) ClaimHealthCheck(ctx context.Context, id uint, claimedAt time.Time, minInterval time.Duration) (bool, error) {
+	result := r.db.Conn(ctx).Model(&models.Integration{}).
+		Where("id = ? AND is_active = ?", id, true).
+		Where("last_health_check_at IS NULL OR last_health_check_at < ?", claimedAt.Add(-minInterval)).
+		Update("last_health_check_at", claimedAt)
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("id", id).Msg("Error al reclamar chequeo de salud de integración")
+		return false, fmt.Errorf("error al reclamar chequeo de salud de integración: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// CreateHealthCheck registra el resultado de un chequeo de salud
+func (r *Repository) CreateHealthCheck(ctx context.Context, check *domain.IntegrationHealthCheck) error {
+	model := models.IntegrationHealthCheck{
+		IntegrationID: check.IntegrationID,
+		Status:        check.Status,
+		LatencyMs:     check.LatencyMs,
+		ErrorMessage:  truncate(check.ErrorMessage, 1000),
+		Trigger:       check.Trigger,
+		CheckedAt:     check.CheckedAt,
+	}
+
+	if err := r.db.Conn(ctx).Create(&model).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_id", check.IntegrationID).Msg("Error al registrar chequeo de salud")
+		return fmt.Errorf("error al registrar chequeo de salud: %w", err)
+	}
+
+	check.ID = model.ID
+	return nil
+}
+
+// ListHealthChecks lista los chequeos de salud más recientes de una integración
+func (r *Repository) ListHealthChecks(ctx context.Context, integrationID uint, limit int) ([]*domain.IntegrationHealthCheck, error) {
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	var checkModels []models.IntegrationHealthCheck
+	if err := r.db.Conn(ctx).
+		Where("integration_id = ?", integrationID).
+		Order("checked_at DESC").
+		Limit(limit).
+		Find(&checkModels).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_id", integrationID).Msg("Error al listar chequeos de salud")
+		return nil, fmt.Errorf("error al listar chequeos de salud: %w", err)
+	}
+
+	checks := make([]*domain.IntegrationHealthCheck, len(checkModels))
+	for i, m := range checkModels {
+		checks[i] = &domain.IntegrationHealthCheck{
+			ID:            m.ID,
+			IntegrationID: m.IntegrationID,
+			Status:        m.Status,
+			LatencyMs:     m.LatencyMs,
+			ErrorMessage:  m.ErrorMessage,
+			Trigger:       m.Trigger,
+			CheckedAt:     m.CheckedAt,
+		}
+	}
+
+	return checks, nil
+}
+
+// truncate recorta un texto al tamaño máximo de la columna
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+	return value[:max]
+}
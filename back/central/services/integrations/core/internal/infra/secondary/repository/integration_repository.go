@@ -55,10 +55,11 @@ func (r *Repository) CreateIntegration(ctx context.Context, integration *domain.
 // UpdateIntegration actualiza una integración existente
 func (r *Repository) UpdateIntegration(ctx context.Context, id uint, integration *domain.Integration) error {
 	// Encriptar credenciales si se están actualizando
+	// (si ya vienen encriptadas desde la BD, p. ej. al activar/desactivar, se guardan tal cual)
 	if len(integration.Credentials) > 0 {
 		var credentialsMap map[string]interface{}
 		credentialsBytes := []byte(integration.Credentials)
-		if err := json.Unmarshal(credentialsBytes, &credentialsMap); err == nil {
+		if err := json.Unmarshal(credentialsBytes, &credentialsMap); err == nil && !isEncryptedCredentials(credentialsMap) {
 			encrypted, err := r.encryptionService.EncryptCredentials(ctx, credentialsMap)
 			if err != nil {
 				r.log.Error(ctx).Err(err).Msg("Error al encriptar credenciales")
@@ -237,7 +238,7 @@ func (r *Repository) GetActiveIntegrationByIntegrationTypeID(ctx context.Context
 
 	if err := query.First(&model).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("integración activa de tipo con ID %d no encontrada", integrationTypeID)
+			return nil, fmt.Errorf("%w: no hay integración activa del tipo con ID %d", domain.ErrIntegrationNotFound, integrationTypeID)
 		}
 		r.log.Error(ctx).Err(err).Uint("integration_type_id", integrationTypeID).Msg("Error al obtener integración activa por tipo")
 		return nil, fmt.Errorf("error al obtener integración activa por tipo: %w", err)
@@ -278,6 +279,29 @@ func (r *Repository) ListIntegrationsByIntegrationTypeID(ctx context.Context, in
 	return integrations, nil
 }
 
+// ListActiveIntegrationsByIntegrationTypeID lista las integraciones activas de un tipo (businessID nil = todos los negocios)
+func (r *Repository) ListActiveIntegrationsByIntegrationTypeID(ctx context.Context, integrationTypeID uint, businessID *uint) ([]*domain.Integration, error) {
+	var integrationModels []models.Integration
+	query := r.db.Conn(ctx).Preload("IntegrationType").
+		Where("integration_type_id = ? AND is_active = ?", integrationTypeID, true)
+
+	if businessID != nil {
+		query = query.Where("business_id = ?", *businessID)
+	}
+
+	if err := query.Order("id ASC").Find(&integrationModels).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_type_id", integrationTypeID).Msg("Error al listar integraciones activas por tipo")
+		return nil, fmt.Errorf("error al listar integraciones activas por tipo: %w", err)
+	}
+
+	integrations := make([]*domain.Integration, len(integrationModels))
+	for i, model := range integrationModels {
+		integrations[i] = r.toDomain(&model)
+	}
+
+	return integrations, nil
+}
+
 // SetIntegrationAsDefault marca una integración como default
 func (r *Repository) SetIntegrationAsDefault(ctx context.Context, id uint) error {
 	// Primero obtener la integración para saber su tipo y business_id
@@ -328,6 +352,15 @@ func (r *Repository) ExistsIntegrationByCode(ctx context.Context, code string, b
 	return count > 0, nil
 }
 
+// isEncryptedCredentials indica si las credenciales ya tienen el formato encriptado {"encrypted": "<base64>"}
+func isEncryptedCredentials(credentials map[string]interface{}) bool {
+	if len(credentials) != 1 {
+		return false
+	}
+	_, ok := credentials["encrypted"].(string)
+	return ok
+}
+
 // toModel convierte domain.Integration a models.Integration
 func (r *Repository) toModel(integration *domain.Integration) *models.Integration {
 	model := &models.Integration{
@@ -343,11 +376,16 @@ func (r *Repository) toModel(integration *domain.Integration) *models.Integratio
 		BusinessID:        integration.BusinessID,
 		IsActive:          integration.IsActive,
 		IsDefault:         integration.IsDefault,
+		HealthStatus:      integration.HealthStatus,
+		LastHealthCheckAt: integration.LastHealthCheckAt,
 		Config:            integration.Config,
 		Credentials:       integration.Credentials,
 		Description:       integration.Description,
 		CreatedByID:       integration.CreatedByID,
 	}
+	if model.HealthStatus == "" {
+		model.HealthStatus = domain.IntegrationHealthUnknown
+	}
 	if integration.UpdatedByID != nil {
 		model.UpdatedByID = integration.UpdatedByID
 	}
@@ -363,21 +401,24 @@ func (r *Repository) toDomain(model *models.Integration) *domain.Integration {
 	}
 
 	integration := &domain.Integration{
-		ID:                model.ID,
-		Name:              model.Name,
-		Code:              model.Code,
-		IntegrationTypeID: model.IntegrationTypeID,
-		Category:          model.Category,
-		BusinessID:        businessID,
-		IsActive:          model.IsActive,
-		IsDefault:         model.IsDefault,
-		Config:            model.Config,
-		Credentials:       model.Credentials, // Mantener encriptado
-		Description:       model.Description,
-		CreatedByID:       model.CreatedByID,
-		UpdatedByID:       updatedByID,
-		CreatedAt:         model.CreatedAt,
-		UpdatedAt:         model.UpdatedAt,
+		ID:                  model.ID,
+		Name:                model.Name,
+		Code:                model.Code,
+		IntegrationTypeID:   model.IntegrationTypeID,
+		Category:            model.Category,
+		BusinessID:          businessID,
+		IsActive:            model.IsActive,
+		IsDefault:           model.IsDefault,
+		HealthStatus:        model.HealthStatus,
+		LastHealthCheckAt:   model.LastHealthCheckAt,
+		ConsecutiveFailures: model.ConsecutiveFailures,
+		Config:              model.Config,
+		Credentials:         model.Credentials, // Mantener encriptado
+		Description:         model.Description,
+		CreatedByID:         model.CreatedByID,
+		UpdatedByID:         updatedByID,
+		CreatedAt:           model.CreatedAt,
+		UpdatedAt:           model.UpdatedAt,
 	}
 
 	// Cargar IntegrationType si está disponible en el modelo
@@ -23,6 +23,17 @@ const (
 	IntegrationTypeMercadoLibre = "mercado_libre"
 )
 
+// Errores públicos: los testers pueden envolver los de salud para clasificar el resultado de los chequeos,
+// y los consumidores pueden comparar con errors.Is los de búsqueda
+var (
+	ErrIntegrationNotFound     = domain.ErrIntegrationNotFound
+	ErrIntegrationTypeNotFound = domain.ErrIntegrationTypeNotFound
+	ErrIntegrationAuthFailed   = domain.ErrIntegrationAuthFailed
+	ErrIntegrationUnreachable  = domain.ErrIntegrationUnreachable
+	ErrCatalogSourceNotFound   = domain.ErrCatalogSourceNotFound
+	ErrRefundWriterNotFound    = domain.ErrRefundWriterNotFound
+)
+
 // IntegrationWithCredentials representa una integración con credenciales desencriptadas
 // Este es un tipo público que envuelve el tipo interno
 type IntegrationWithCredentials = domain.IntegrationWithCredentials
@@ -32,6 +43,13 @@ type IIntegrationCore interface {
 	// GetIntegrationByType obtiene una integración con credenciales desencriptadas (para uso interno)
 	GetIntegrationByType(ctx context.Context, integrationType string, businessID *uint) (*IntegrationWithCredentials, error)
 
+	// GetIntegrationByID obtiene una integración específica con credenciales desencriptadas (para uso interno)
+	GetIntegrationByID(ctx context.Context, id uint) (*IntegrationWithCredentials, error)
+
+	// ListActiveIntegrationsByType lista todas las integraciones activas de un tipo con credenciales desencriptadas.
+	// Si businessID es nil se incluyen las de todos los negocios.
+	ListActiveIntegrationsByType(ctx context.Context, integrationType string, businessID *uint) ([]*IntegrationWithCredentials, error)
+
 	// GetIntegrationConfig obtiene solo la configuración de una integración (sin credenciales)
 	GetIntegrationConfig(ctx context.Context, integrationType string, businessID *uint) (map[string]interface{}, error)
 
@@ -40,17 +58,33 @@ type IIntegrationCore interface {
 
 	// RegisterTester registra un tester para un tipo de integración
 	RegisterTester(integrationType string, tester ITestIntegration) error
+
+	// RegisterCatalogSource registra la fuente de catálogo de un tipo de integración
+	RegisterCatalogSource(integrationType string, source ICatalogSource) error
+
+	// GetCatalogSource obtiene la fuente de catálogo registrada para un tipo de integración
+	GetCatalogSource(integrationType string) (ICatalogSource, error)
+
+	// RegisterRefundWriter registra el escritor de reembolsos de un tipo de integración
+	RegisterRefundWriter(integrationType string, writer IRefundWriter) error
+
+	// GetRefundWriter obtiene el escritor de reembolsos registrado para un tipo de integración
+	GetRefundWriter(integrationType string) (IRefundWriter, error)
 }
 
 // integrationCore implementa IIntegrationCore
 type integrationCore struct {
-	useCase usecaseintegrations.IIntegrationUseCase
+	useCase        usecaseintegrations.IIntegrationUseCase
+	catalogSources *catalogSourceRegistry
+	refundWriters  *refundWriterRegistry
 }
 
 // NewIntegrationCore crea una nueva instancia de IIntegrationCore
 func NewIntegrationCore(useCase usecaseintegrations.IIntegrationUseCase) IIntegrationCore {
 	return &integrationCore{
-		useCase: useCase,
+		useCase:        useCase,
+		catalogSources: newCatalogSourceRegistry(),
+		refundWriters:  newRefundWriterRegistry(),
 	}
 }
 
@@ -59,6 +93,16 @@ func (ic *integrationCore) GetIntegrationByType(ctx context.Context, integration
 	return ic.useCase.GetIntegrationByType(ctx, integrationType, businessID)
 }
 
+// GetIntegrationByID obtiene una integración por ID con credenciales desencriptadas
+func (ic *integrationCore) GetIntegrationByID(ctx context.Context, id uint) (*domain.IntegrationWithCredentials, error) {
+	return ic.useCase.GetIntegrationByIDWithCredentials(ctx, id)
+}
+
+// ListActiveIntegrationsByType lista las integraciones activas de un tipo con credenciales desencriptadas
+func (ic *integrationCore) ListActiveIntegrationsByType(ctx context.Context, integrationType string, businessID *uint) ([]*domain.IntegrationWithCredentials, error) {
+	return ic.useCase.ListActiveIntegrationsByType(ctx, integrationType, businessID)
+}
+
 // GetIntegrationConfig obtiene solo la configuración (sin credenciales)
 func (ic *integrationCore) GetIntegrationConfig(ctx context.Context, integrationType string, businessID *uint) (map[string]interface{}, error) {
 	integration, err := ic.useCase.GetIntegrationByType(ctx, integrationType, businessID)
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	REEMBOLSOS - Write-back de reembolsos a la plataforma de origen
+//
+// ───────────────────────────────────────────
+
+// IRefundWriter define la interfaz que implementa cada integración capaz de registrar en la
+// plataforma de origen los reembolsos hechos en Probability (Shopify, ...)
+type IRefundWriter interface {
+	// PushRefund registra el reembolso en la orden de la plataforma y retorna el ID del reembolso creado
+	PushRefund(ctx context.Context, integration *IntegrationWithCredentials, refund *RefundWriteBack) (*RefundWriteBackResult, error)
+}
+
+// RefundWriteBack es un reembolso a registrar en la plataforma de origen
+type RefundWriteBack struct {
+	ExternalOrderID string
+	Amount          float64
+	Currency        string
+	Reason          string
+	Note            string
+	Lines           []RefundWriteBackLine // Vacío = reembolso solo de dinero, sin líneas
+}
+
+// RefundWriteBackLine es una línea de la orden incluida en el reembolso
+type RefundWriteBackLine struct {
+	ExternalVariantID string // ID de la variante en la plataforma (si se conoce)
+	SKU               string
+	Quantity          int
+}
+
+// RefundWriteBackResult es el resultado del write-back del reembolso
+type RefundWriteBackResult struct {
+	ExternalRefundID string
+}
+
+// refundWriterRegistry mantiene los escritores de reembolsos por tipo de integración
+type refundWriterRegistry struct {
+	writers map[string]IRefundWriter
+	mu      sync.RWMutex
+}
+
+func newRefundWriterRegistry() *refundWriterRegistry {
+	return &refundWriterRegistry{
+		writers: make(map[string]IRefundWriter),
+	}
+}
+
+// RegisterRefundWriter registra el escritor de reembolsos de un tipo de integración
+func (ic *integrationCore) RegisterRefundWriter(integrationType string, writer IRefundWriter) error {
+	if integrationType == "" {
+		return domain.ErrTesterTypeEmpty
+	}
+	if writer == nil {
+		return fmt.Errorf("el escritor de reembolsos no puede ser nil")
+	}
+
+	ic.refundWriters.mu.Lock()
+	defer ic.refundWriters.mu.Unlock()
+
+	ic.refundWriters.writers[integrationType] = writer
+	return nil
+}
+
+// GetRefundWriter obtiene el escritor de reembolsos registrado para un tipo de integración
+func (ic *integrationCore) GetRefundWriter(integrationType string) (IRefundWriter, error) {
+	ic.refundWriters.mu.RLock()
+	defer ic.refundWriters.mu.RUnlock()
+
+	writer, exists := ic.refundWriters.writers[integrationType]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrRefundWriterNotFound, integrationType)
+	}
+
+	return writer, nil
+}
@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/app/usecasesync"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/worker"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/secondary/lock"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/secondary/repository"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// New inicializa el subsistema de sincronización programada de integraciones.
+// Las integraciones registran sus jobs con RegisterJob; el worker arranca de inmediato
+// pero solo ejecuta programaciones de tipos que tengan job registrado.
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, redisClient redis.IRedis) ISyncScheduler {
+	moduleLogger := logger.WithModule("integrations-sync")
+
+	// 1. Init Secondary Adapters
+	repo := repository.New(database, moduleLogger)
+	if redisClient == nil {
+		moduleLogger.Warn().Msg("Redis no disponible, el lock de sincronización solo será local a esta réplica")
+	}
+	syncLock := lock.New(redisClient, moduleLogger)
+
+	// 2. Init Use Cases
+	syncUseCase := usecasesync.New(repo, syncLock, moduleLogger)
+
+	// 3. Init Handlers and Routes
+	h := handlers.New(syncUseCase, moduleLogger)
+	h.RegisterRoutes(router)
+
+	// 4. Start Worker
+	syncWorker := worker.New(syncUseCase, moduleLogger)
+	syncWorker.Start(context.Background())
+
+	return NewSyncScheduler(syncUseCase)
+}
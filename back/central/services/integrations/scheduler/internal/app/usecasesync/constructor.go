@@ -0,0 +1,39 @@
+package usecasesync
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// lockTTL es la duración máxima de una sincronización; al vencer, el lock se libera solo
+const lockTTL = time.Hour
+
+type ISyncUseCase interface {
+	RegisterJob(integrationType string, job domain.ISyncJob) error
+	UpsertSchedule(ctx context.Context, dto domain.UpsertSyncScheduleDTO) (*domain.SyncSchedule, error)
+	GetSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error)
+	PauseSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error)
+	ResumeSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error)
+	TriggerSync(ctx context.Context, integrationID uint) error
+	RunDueSchedules(ctx context.Context) error
+}
+
+type SyncUseCase struct {
+	repo     domain.IRepository
+	lock     domain.ISyncLock
+	registry *SyncJobRegistry
+	log      log.ILogger
+}
+
+// New crea una nueva instancia del caso de uso de sincronización programada
+func New(repo domain.IRepository, lock domain.ISyncLock, logger log.ILogger) ISyncUseCase {
+	return &SyncUseCase{
+		repo:     repo,
+		lock:     lock,
+		registry: NewSyncJobRegistry(),
+		log:      logger,
+	}
+}
@@ -0,0 +1,15 @@
+package usecasesync
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// GetSchedule obtiene la programación de sincronización de una integración
+func (uc *SyncUseCase) GetSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error) {
+	ctx = log.WithFunctionCtx(ctx, "GetSchedule")
+
+	return uc.repo.GetScheduleByIntegrationID(ctx, integrationID)
+}
@@ -0,0 +1,64 @@
+package usecasesync
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+)
+
+// SyncJobRegistry mantiene un registro de jobs de sincronización por tipo de integración
+type SyncJobRegistry struct {
+	jobs map[string]domain.ISyncJob
+	mu   sync.RWMutex
+}
+
+// NewSyncJobRegistry crea una nueva instancia del registry
+func NewSyncJobRegistry() *SyncJobRegistry {
+	return &SyncJobRegistry{
+		jobs: make(map[string]domain.ISyncJob),
+	}
+}
+
+// Register registra un job para un tipo de integración
+func (r *SyncJobRegistry) Register(integrationType string, job domain.ISyncJob) error {
+	if integrationType == "" {
+		return domain.ErrSyncJobTypeEmpty
+	}
+	if job == nil {
+		return domain.ErrSyncJobNil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.jobs[integrationType] = job
+	return nil
+}
+
+// GetJob obtiene el job registrado para un tipo de integración
+func (r *SyncJobRegistry) GetJob(integrationType string) (domain.ISyncJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, exists := r.jobs[integrationType]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrSyncJobNotRegistered, integrationType)
+	}
+
+	return job, nil
+}
+
+// IsRegistered verifica si hay un job registrado para un tipo
+func (r *SyncJobRegistry) IsRegistered(integrationType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.jobs[integrationType]
+	return exists
+}
+
+// RegisterJob registra el job de sincronización de un tipo de integración
+func (uc *SyncUseCase) RegisterJob(integrationType string, job domain.ISyncJob) error {
+	return uc.registry.Register(integrationType, job)
+}
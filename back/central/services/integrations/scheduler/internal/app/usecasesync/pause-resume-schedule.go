@@ -0,0 +1,49 @@
+package usecasesync
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// PauseSchedule pausa la sincronización programada de una integración
+func (uc *SyncUseCase) PauseSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error) {
+	ctx = log.WithFunctionCtx(ctx, "PauseSchedule")
+
+	if err := uc.repo.SetPaused(ctx, integrationID, true, nil); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx).Uint("integration_id", integrationID).Msg("Sincronización programada pausada")
+
+	return uc.repo.GetScheduleByIntegrationID(ctx, integrationID)
+}
+
+// ResumeSchedule reanuda la sincronización programada y recalcula la siguiente ejecución
+func (uc *SyncUseCase) ResumeSchedule(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error) {
+	ctx = log.WithFunctionCtx(ctx, "ResumeSchedule")
+
+	schedule, err := uc.repo.GetScheduleByIntegrationID(ctx, integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	cron, err := domain.ParseCronSpec(schedule.CronSpec)
+	if err != nil {
+		return nil, err
+	}
+	next := cron.Next(time.Now())
+
+	if err := uc.repo.SetPaused(ctx, integrationID, false, &next); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx).
+		Uint("integration_id", integrationID).
+		Time("next_run_at", next).
+		Msg("Sincronización programada reanudada")
+
+	return uc.repo.GetScheduleByIntegrationID(ctx, integrationID)
+}
@@ -0,0 +1,163 @@
+package usecasesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// TriggerSync inicia inmediatamente la sincronización de una integración (en background)
+func (uc *SyncUseCase) TriggerSync(ctx context.Context, integrationID uint) error {
+	ctx = log.WithFunctionCtx(ctx, "TriggerSync")
+
+	schedule, err := uc.repo.GetScheduleByIntegrationID(ctx, integrationID)
+	if err != nil {
+		return err
+	}
+	if schedule.Integration == nil || !schedule.Integration.IsActive {
+		return fmt.Errorf("%w: id %d", domain.ErrSyncIntegrationInactive, integrationID)
+	}
+
+	// La ejecución manual no altera la próxima ejecución programada
+	return uc.startRun(context.WithoutCancel(ctx), schedule, domain.SyncTriggerManual, nil)
+}
+
+// RunDueSchedules inicia todas las sincronizaciones cuya ejecución programada ya venció.
+// Las que no pueden iniciarse (sincronización en curso, sin job registrado) se omiten hasta
+// su siguiente ejecución para no reintentarlas en cada tick.
+func (uc *SyncUseCase) RunDueSchedules(ctx context.Context) error {
+	ctx = log.WithFunctionCtx(ctx, "RunDueSchedules")
+
+	now := time.Now()
+	schedules, err := uc.repo.ListDueSchedules(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		cron, err := domain.ParseCronSpec(schedule.CronSpec)
+		if err != nil {
+			uc.log.Error(ctx).Err(err).Uint("integration_id", schedule.IntegrationID).Msg("Expresión cron inválida en programación de sincronización")
+			continue
+		}
+		next := cron.Next(now)
+
+		if err := uc.startRun(ctx, schedule, domain.SyncTriggerScheduler, &next); err != nil {
+			if errors.Is(err, domain.ErrSyncRunAlreadyClaimed) {
+				continue
+			}
+			uc.log.Warn(ctx).
+				Err(err).
+				Uint("integration_id", schedule.IntegrationID).
+				Time("next_run_at", next).
+				Msg("Sincronización programada omitida hasta la siguiente ejecución")
+
+			if err := uc.repo.AdvanceNextRun(ctx, schedule.IntegrationID, next); err != nil {
+				uc.log.Error(ctx).Err(err).Uint("integration_id", schedule.IntegrationID).Msg("Error al avanzar programación omitida")
+			}
+		}
+	}
+
+	return nil
+}
+
+// startRun toma el lock distribuido, marca el inicio y ejecuta el job en una goroutine. Las ejecuciones
+// programadas (nextRunAt != nil) se reclaman contra el next_run_at listado antes de ejecutar el job.
+func (uc *SyncUseCase) startRun(ctx context.Context, schedule *domain.SyncSchedule, trigger string, nextRunAt *time.Time) error {
+	if schedule.Integration == nil {
+		return fmt.Errorf("%w: id %d", domain.ErrSyncIntegrationNotFound, schedule.IntegrationID)
+	}
+
+	job, err := uc.registry.GetJob(schedule.Integration.IntegrationTypeCode)
+	if err != nil {
+		return err
+	}
+
+	lockKey := fmt.Sprintf("probability:integrations:sync:%d", schedule.IntegrationID)
+	token, acquired, err := uc.lock.Acquire(ctx, lockKey, lockTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return fmt.Errorf("%w: id %d", domain.ErrSyncAlreadyRunning, schedule.IntegrationID)
+	}
+
+	var expectedNextRunAt *time.Time
+	if nextRunAt != nil {
+		expectedNextRunAt = schedule.NextRunAt
+	}
+
+	startedAt := time.Now()
+	claimed, err := uc.repo.MarkRunStarted(ctx, schedule.IntegrationID, trigger, startedAt, expectedNextRunAt, nextRunAt)
+	if err != nil {
+		_ = uc.lock.Release(ctx, lockKey, token)
+		return err
+	}
+	if !claimed {
+		_ = uc.lock.Release(ctx, lockKey, token)
+		return fmt.Errorf("%w: id %d", domain.ErrSyncRunAlreadyClaimed, schedule.IntegrationID)
+	}
+
+	req := domain.SyncRequest{
+		IntegrationID:   schedule.IntegrationID,
+		IntegrationType: schedule.Integration.IntegrationTypeCode,
+		BusinessID:      schedule.Integration.BusinessID,
+		Cursor:          schedule.Cursor,
+		Trigger:         trigger,
+		StartedAt:       startedAt,
+	}
+
+	go uc.executeJob(ctx, job, req, lockKey, token)
+
+	return nil
+}
+
+// executeJob ejecuta el job, persiste el resultado y libera el lock
+func (uc *SyncUseCase) executeJob(ctx context.Context, job domain.ISyncJob, req domain.SyncRequest, lockKey, token string) {
+	outcome := domain.SyncRunOutcome{Trigger: req.Trigger}
+
+	defer func() {
+		if r := recover(); r != nil {
+			outcome.Status = domain.SyncStatusFailed
+			outcome.Error = fmt.Sprintf("panic: %v", r)
+		}
+		outcome.FinishedAt = time.Now()
+		outcome.DurationMs = outcome.FinishedAt.Sub(req.StartedAt).Milliseconds()
+
+		if err := uc.repo.SaveRunOutcome(ctx, req.IntegrationID, outcome); err != nil {
+			uc.log.Error(ctx).Err(err).Uint("integration_id", req.IntegrationID).Msg("Error al guardar resultado de sincronización")
+		}
+		if err := uc.lock.Release(ctx, lockKey, token); err != nil {
+			uc.log.Error(ctx).Err(err).Uint("integration_id", req.IntegrationID).Msg("Error al liberar lock de sincronización")
+		}
+
+		uc.log.Info(ctx).
+			Uint("integration_id", req.IntegrationID).
+			Str("integration_type", req.IntegrationType).
+			Str("trigger", req.Trigger).
+			Str("status", outcome.Status).
+			Int("items_processed", outcome.ItemsProcessed).
+			Int64("duration_ms", outcome.DurationMs).
+			Msg("Sincronización de integración finalizada")
+	}()
+
+	jobCtx, cancel := context.WithTimeout(ctx, lockTTL)
+	defer cancel()
+
+	result, err := job.Sync(jobCtx, req)
+	if err != nil {
+		outcome.Status = domain.SyncStatusFailed
+		outcome.Error = err.Error()
+		return
+	}
+
+	outcome.Status = domain.SyncStatusSuccess
+	if result != nil {
+		outcome.Cursor = result.Cursor
+		outcome.ItemsProcessed = result.ItemsProcessed
+	}
+}
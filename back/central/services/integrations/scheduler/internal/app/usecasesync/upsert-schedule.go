@@ -0,0 +1,58 @@
+package usecasesync
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UpsertSchedule crea o actualiza la expresión cron de sincronización de una integración
+func (uc *SyncUseCase) UpsertSchedule(ctx context.Context, dto domain.UpsertSyncScheduleDTO) (*domain.SyncSchedule, error) {
+	ctx = log.WithFunctionCtx(ctx, "UpsertSchedule")
+
+	cron, err := domain.ParseCronSpec(dto.CronSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := uc.repo.GetIntegration(ctx, dto.IntegrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.registry.GetJob(integration.IntegrationTypeCode); err != nil {
+		return nil, err
+	}
+
+	paused := false
+	if existing, err := uc.repo.GetScheduleByIntegrationID(ctx, dto.IntegrationID); err == nil {
+		paused = existing.IsPaused
+	}
+	if dto.IsPaused != nil {
+		paused = *dto.IsPaused
+	}
+
+	schedule := &domain.SyncSchedule{
+		IntegrationID: dto.IntegrationID,
+		CronSpec:      cron.String(),
+		IsPaused:      paused,
+	}
+	if !paused {
+		next := cron.Next(time.Now())
+		schedule.NextRunAt = &next
+	}
+
+	if err := uc.repo.UpsertSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	uc.log.Info(ctx).
+		Uint("integration_id", dto.IntegrationID).
+		Str("cron_spec", schedule.CronSpec).
+		Bool("paused", paused).
+		Msg("Programación de sincronización guardada")
+
+	return uc.repo.GetScheduleByIntegrationID(ctx, dto.IntegrationID)
+}
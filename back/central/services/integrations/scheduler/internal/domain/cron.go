@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule representa una expresión cron estándar de 5 campos
+// (minuto hora día-del-mes mes día-de-la-semana) o un descriptor @every/@hourly/@daily
+type CronSchedule struct {
+	spec     string
+	every    time.Duration
+	minutes  map[int]bool
+	hours    map[int]bool
+	days     map[int]bool
+	months   map[int]bool
+	weekdays map[int]bool
+	anyDay   bool
+	anyWDay  bool
+}
+
+// cronDescriptors equivalencias de los descriptores soportados
+var cronDescriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// ParseCronSpec valida y parsea una expresión cron
+// Ejemplos: "*/15 * * * *", "0 */2 * * *", "30 6 * * 1-5", "@hourly", "@every 10m"
+func ParseCronSpec(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("%w: expresión vacía", ErrInvalidCronSpec)
+	}
+
+	if strings.HasPrefix(spec, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCronSpec, err.Error())
+		}
+		if every < time.Minute {
+			return nil, fmt.Errorf("%w: el intervalo mínimo es 1m", ErrInvalidCronSpec)
+		}
+		return &CronSchedule{spec: spec, every: every}, nil
+	}
+
+	expr := spec
+	if descriptor, ok := cronDescriptors[spec]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: se esperaban 5 campos y se recibieron %d", ErrInvalidCronSpec, len(fields))
+	}
+
+	schedule := &CronSchedule{spec: spec}
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("%w: minuto: %s", ErrInvalidCronSpec, err.Error())
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("%w: hora: %s", ErrInvalidCronSpec, err.Error())
+	}
+	if schedule.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("%w: día del mes: %s", ErrInvalidCronSpec, err.Error())
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("%w: mes: %s", ErrInvalidCronSpec, err.Error())
+	}
+	if schedule.weekdays, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("%w: día de la semana: %s", ErrInvalidCronSpec, err.Error())
+	}
+	// 7 también representa domingo
+	if schedule.weekdays[7] {
+		schedule.weekdays[0] = true
+	}
+	schedule.anyDay = fields[2] == "*"
+	schedule.anyWDay = fields[4] == "*"
+
+	return schedule, nil
+}
+
+// String retorna la expresión original
+func (s *CronSchedule) String() string {
+	return s.spec
+}
+
+// Next retorna la siguiente ejecución estrictamente posterior a from (con precisión de minuto)
+func (s *CronSchedule) Next(from time.Time) time.Time {
+	if s.every > 0 {
+		return from.Add(s.every).Truncate(time.Minute)
+	}
+
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	// Máximo 5 años de búsqueda para evitar bucles con expresiones imposibles (p. ej. 31 de febrero)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return limit
+}
+
+// matchesDay aplica la semántica cron: si ambos campos de día están restringidos basta con que coincida uno
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dayMatch := s.days[t.Day()]
+	weekdayMatch := s.weekdays[int(t.Weekday())]
+	if s.anyDay || s.anyWDay {
+		return dayMatch && weekdayMatch
+	}
+	return dayMatch || weekdayMatch
+}
+
+// parseCronField parsea un campo cron con soporte para *, listas (a,b), rangos (a-b) y pasos (*/n, a-b/n)
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			n, err := strconv.Atoi(part[idx+1:])
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("paso inválido en '%s'", part)
+			}
+			step = n
+			part = part[:idx]
+		}
+
+		start, end := min, max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			a, errA := strconv.Atoi(bounds[0])
+			b, errB := strconv.Atoi(bounds[1])
+			if errA != nil || errB != nil || a > b {
+				return nil, fmt.Errorf("rango inválido '%s'", part)
+			}
+			start, end = a, b
+		default:
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("valor inválido '%s'", part)
+			}
+			start, end = n, n
+			if step > 1 {
+				end = max
+			}
+		}
+
+		if start < min || end > max {
+			return nil, fmt.Errorf("valor fuera de rango [%d-%d] en '%s'", min, max, field)
+		}
+		for v := start; v <= end; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// Estados de la última ejecución de una sincronización
+const (
+	SyncStatusRunning = "running"
+	SyncStatusSuccess = "success"
+	SyncStatusFailed  = "failed"
+)
+
+// Origen de una ejecución de sincronización
+const (
+	SyncTriggerScheduler = "scheduler"
+	SyncTriggerManual    = "manual"
+)
+
+// SyncSchedule representa la programación de sincronización de una instancia de integración
+type SyncSchedule struct {
+	ID                 uint
+	IntegrationID      uint
+	CronSpec           string
+	IsPaused           bool
+	NextRunAt          *time.Time
+	LastRunAt          *time.Time
+	LastFinishedAt     *time.Time
+	LastStatus         string
+	LastError          string
+	LastTrigger        string
+	LastItemsProcessed int
+	LastDurationMs     int64
+	Cursor             string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// Datos de la integración asociada (solo lectura)
+	Integration *SyncIntegration
+}
+
+// SyncIntegration contiene los datos de la integración necesarios para ejecutar su sincronización
+type SyncIntegration struct {
+	ID                  uint
+	Name                string
+	Code                string
+	IntegrationTypeCode string
+	BusinessID          *uint
+	IsActive            bool
+}
+
+// SyncRequest es lo que recibe un job al ejecutarse
+type SyncRequest struct {
+	IntegrationID   uint
+	IntegrationType string
+	BusinessID      *uint
+	Cursor          string // Cursor persistido de la ejecución exitosa anterior ("" en la primera)
+	Trigger         string // "scheduler" | "manual"
+	StartedAt       time.Time
+}
+
+// SyncResult es lo que retorna un job al terminar
+type SyncResult struct {
+	Cursor         string // Nuevo cursor a persistir (si es vacío se conserva el anterior)
+	ItemsProcessed int
+}
+
+// UpsertSyncScheduleDTO datos para crear o actualizar la programación de una integración
+type UpsertSyncScheduleDTO struct {
+	IntegrationID uint
+	CronSpec      string
+	IsPaused      *bool
+}
+
+// SyncRunOutcome resultado de una ejecución para persistir
+type SyncRunOutcome struct {
+	Status         string
+	Error          string
+	Trigger        string
+	ItemsProcessed int
+	DurationMs     int64
+	Cursor         string
+	FinishedAt     time.Time
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidCronSpec         = errors.New("expresión cron inválida")
+	ErrSyncScheduleNotFound    = errors.New("la integración no tiene programación de sincronización")
+	ErrSyncIntegrationNotFound = errors.New("integración no encontrada")
+	ErrSyncIntegrationInactive = errors.New("la integración no está activa")
+	ErrSyncJobNotRegistered    = errors.New("no hay job de sincronización registrado para el tipo de integración")
+	ErrSyncJobTypeEmpty        = errors.New("tipo de integración no puede estar vacío")
+	ErrSyncJobNil              = errors.New("job de sincronización no puede ser nil")
+	ErrSyncAlreadyRunning      = errors.New("ya hay una sincronización en curso para la integración")
+	ErrSyncRunAlreadyClaimed   = errors.New("otra réplica ya inició la ejecución programada")
+	ErrSyncLockUnavailable     = errors.New("no fue posible obtener el lock distribuido de sincronización")
+)
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ISyncJob es implementado por cada integración que sabe sincronizar una instancia
+type ISyncJob interface {
+	Sync(ctx context.Context, req SyncRequest) (*SyncResult, error)
+}
+
+// IRepository define la persistencia de las programaciones de sincronización
+type IRepository interface {
+	GetIntegration(ctx context.Context, integrationID uint) (*SyncIntegration, error)
+	GetScheduleByIntegrationID(ctx context.Context, integrationID uint) (*SyncSchedule, error)
+	UpsertSchedule(ctx context.Context, schedule *SyncSchedule) error
+	SetPaused(ctx context.Context, integrationID uint, paused bool, nextRunAt *time.Time) error
+	ListDueSchedules(ctx context.Context, now time.Time) ([]*SyncSchedule, error)
+	AdvanceNextRun(ctx context.Context, integrationID uint, nextRunAt time.Time) error
+	// MarkRunStarted registra el inicio de una ejecución. Con expectedNextRunAt solo lo registra si la
+	// próxima ejecución sigue siendo esa (reclama la ejecución programada) y retorna false si ya cambió.
+	MarkRunStarted(ctx context.Context, integrationID uint, trigger string, startedAt time.Time, expectedNextRunAt, nextRunAt *time.Time) (bool, error)
+	SaveRunOutcome(ctx context.Context, integrationID uint, outcome SyncRunOutcome) error
+}
+
+// ISyncLock define un lock distribuido para que solo una réplica ejecute la sincronización de una integración
+type ISyncLock interface {
+	// Acquire intenta tomar el lock; retorna el token para liberarlo y false si ya está tomado
+	Acquire(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+	Release(ctx context.Context, key string, token string) error
+}
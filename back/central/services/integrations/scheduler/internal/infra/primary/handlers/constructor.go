@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/app/usecasesync"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+type SyncScheduleHandler struct {
+	usecase usecasesync.ISyncUseCase
+	logger  log.ILogger
+}
+
+// New crea una nueva instancia del handler de programaciones de sincronización
+func New(usecase usecasesync.ISyncUseCase, logger log.ILogger) *SyncScheduleHandler {
+	return &SyncScheduleHandler{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
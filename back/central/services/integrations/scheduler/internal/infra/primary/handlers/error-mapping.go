@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// statusFromError traduce los errores de dominio a código HTTP y mensaje
+func statusFromError(err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrSyncIntegrationNotFound):
+		return http.StatusNotFound, "La integración especificada no existe"
+	case errors.Is(err, domain.ErrSyncScheduleNotFound):
+		return http.StatusNotFound, "La integración no tiene programación de sincronización"
+	case errors.Is(err, domain.ErrInvalidCronSpec):
+		return http.StatusBadRequest, "La expresión cron no es válida"
+	case errors.Is(err, domain.ErrSyncJobNotRegistered):
+		return http.StatusBadRequest, "El tipo de integración no soporta sincronización programada"
+	case errors.Is(err, domain.ErrSyncIntegrationInactive):
+		return http.StatusBadRequest, "La integración no está activa"
+	case errors.Is(err, domain.ErrSyncAlreadyRunning):
+		return http.StatusConflict, "Ya hay una sincronización en curso para la integración"
+	case errors.Is(err, domain.ErrSyncLockUnavailable):
+		return http.StatusServiceUnavailable, "No fue posible coordinar la sincronización, intente más tarde"
+	default:
+		return http.StatusInternalServerError, "Error al procesar la programación de sincronización"
+	}
+}
+
+// parseIntegrationID obtiene el ID de integración de la ruta; responde 400 si es inválido
+func (h *SyncScheduleHandler) parseIntegrationID(c *gin.Context) (uint, bool) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		h.logger.Error().Err(err).Str("id", idStr).Str("path", c.FullPath()).Msg("ID de integración inválido")
+		c.JSON(http.StatusBadRequest, response.SyncErrorResponse{
+			Success: false,
+			Message: "ID inválido",
+			Error:   "El ID debe ser un número válido",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// requireSuperAdmin responde 403 si el usuario no es super admin
+func (h *SyncScheduleHandler) requireSuperAdmin(c *gin.Context) bool {
+	if middleware.IsSuperAdmin(c) {
+		return true
+	}
+	h.logger.Error().Str("path", c.FullPath()).Str("method", c.Request.Method).Msg("Intento de modificar sincronización sin permisos de super admin")
+	c.JSON(http.StatusForbidden, response.SyncErrorResponse{
+		Success: false,
+		Message: "Solo los super usuarios pueden administrar la sincronización de integraciones",
+		Error:   "permisos insuficientes",
+	})
+	return false
+}
+
+// respondError registra y responde un error del usecase
+func (h *SyncScheduleHandler) respondError(c *gin.Context, integrationID uint, err error, logMsg string) {
+	statusCode, errorMsg := statusFromError(err)
+	h.logger.Error().
+		Err(err).
+		Uint("integration_id", integrationID).
+		Int("status_code", statusCode).
+		Msg(logMsg)
+	c.JSON(statusCode, response.SyncErrorResponse{
+		Success: false,
+		Message: errorMsg,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/mapper"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// GetScheduleHandler obtiene la programación de sincronización de una integración
+//
+//	@Summary		Obtener programación de sincronización
+//	@Description	Retorna la expresión cron, el estado de pausa, la próxima ejecución y el resultado y cursor de la última ejecución
+//	@Tags			IntegrationSync
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID de la integración"
+//	@Success		200	{object}	response.SyncScheduleSuccessResponse
+//	@Failure		400	{object}	response.SyncErrorResponse
+//	@Failure		401	{object}	response.SyncErrorResponse
+//	@Failure		404	{object}	response.SyncErrorResponse
+//	@Failure		500	{object}	response.SyncErrorResponse
+//	@Router			/integrations/{id}/sync-schedule [get]
+func (h *SyncScheduleHandler) GetScheduleHandler(c *gin.Context) {
+	id, ok := h.parseIntegrationID(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.usecase.GetSchedule(c.Request.Context(), id)
+	if err != nil {
+		h.respondError(c, id, err, "Error al obtener programación de sincronización")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SyncScheduleSuccessResponse{
+		Success: true,
+		Message: "Programación de sincronización obtenida exitosamente",
+		Data:    mapper.ToSyncScheduleResponse(schedule),
+	})
+}
@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/request"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// ToUpsertSyncScheduleDTO convierte UpsertSyncScheduleRequest a UpsertSyncScheduleDTO
+func ToUpsertSyncScheduleDTO(integrationID uint, req request.UpsertSyncScheduleRequest) domain.UpsertSyncScheduleDTO {
+	return domain.UpsertSyncScheduleDTO{
+		IntegrationID: integrationID,
+		CronSpec:      req.CronSpec,
+		IsPaused:      req.IsPaused,
+	}
+}
+
+// ToSyncScheduleResponse convierte domain.SyncSchedule a SyncScheduleResponse
+func ToSyncScheduleResponse(schedule *domain.SyncSchedule) response.SyncScheduleResponse {
+	resp := response.SyncScheduleResponse{
+		ID:                 schedule.ID,
+		IntegrationID:      schedule.IntegrationID,
+		CronSpec:           schedule.CronSpec,
+		IsPaused:           schedule.IsPaused,
+		NextRunAt:          schedule.NextRunAt,
+		LastRunAt:          schedule.LastRunAt,
+		LastFinishedAt:     schedule.LastFinishedAt,
+		LastStatus:         schedule.LastStatus,
+		LastError:          schedule.LastError,
+		LastTrigger:        schedule.LastTrigger,
+		LastItemsProcessed: schedule.LastItemsProcessed,
+		LastDurationMs:     schedule.LastDurationMs,
+		Cursor:             schedule.Cursor,
+		CreatedAt:          schedule.CreatedAt,
+		UpdatedAt:          schedule.UpdatedAt,
+	}
+	if schedule.Integration != nil {
+		resp.IntegrationName = schedule.Integration.Name
+		resp.IntegrationType = schedule.Integration.IntegrationTypeCode
+	}
+	return resp
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/mapper"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// PauseScheduleHandler pausa la sincronización programada de una integración
+//
+//	@Summary		Pausar sincronización
+//	@Description	Pausa la sincronización programada; las ejecuciones manuales siguen disponibles
+//	@Tags			IntegrationSync
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID de la integración"
+//	@Success		200	{object}	response.SyncScheduleSuccessResponse
+//	@Failure		400	{object}	response.SyncErrorResponse
+//	@Failure		401	{object}	response.SyncErrorResponse
+//	@Failure		403	{object}	response.SyncErrorResponse
+//	@Failure		404	{object}	response.SyncErrorResponse
+//	@Failure		500	{object}	response.SyncErrorResponse
+//	@Router			/integrations/{id}/sync-schedule/pause [post]
+func (h *SyncScheduleHandler) PauseScheduleHandler(c *gin.Context) {
+	if !h.requireSuperAdmin(c) {
+		return
+	}
+
+	id, ok := h.parseIntegrationID(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.usecase.PauseSchedule(c.Request.Context(), id)
+	if err != nil {
+		h.respondError(c, id, err, "Error al pausar sincronización")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SyncScheduleSuccessResponse{
+		Success: true,
+		Message: "Sincronización pausada exitosamente",
+		Data:    mapper.ToSyncScheduleResponse(schedule),
+	})
+}
+
+// ResumeScheduleHandler reanuda la sincronización programada de una integración
+//
+//	@Summary		Reanudar sincronización
+//	@Description	Reanuda la sincronización programada y recalcula la próxima ejecución
+//	@Tags			IntegrationSync
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID de la integración"
+//	@Success		200	{object}	response.SyncScheduleSuccessResponse
+//	@Failure		400	{object}	response.SyncErrorResponse
+//	@Failure		401	{object}	response.SyncErrorResponse
+//	@Failure		403	{object}	response.SyncErrorResponse
+//	@Failure		404	{object}	response.SyncErrorResponse
+//	@Failure		500	{object}	response.SyncErrorResponse
+//	@Router			/integrations/{id}/sync-schedule/resume [post]
+func (h *SyncScheduleHandler) ResumeScheduleHandler(c *gin.Context) {
+	if !h.requireSuperAdmin(c) {
+		return
+	}
+
+	id, ok := h.parseIntegrationID(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.usecase.ResumeSchedule(c.Request.Context(), id)
+	if err != nil {
+		h.respondError(c, id, err, "Error al reanudar sincronización")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SyncScheduleSuccessResponse{
+		Success: true,
+		Message: "Sincronización reanudada exitosamente",
+		Data:    mapper.ToSyncScheduleResponse(schedule),
+	})
+}
@@ -0,0 +1,7 @@
+package request
+
+// UpsertSyncScheduleRequest representa la solicitud para crear/actualizar la programación de sincronización
+type UpsertSyncScheduleRequest struct {
+	CronSpec string `json:"cron_spec" binding:"required" example:"*/15 * * * *"` // Cron de 5 campos, @hourly, @daily o @every 30m
+	IsPaused *bool  `json:"is_paused,omitempty" example:"false"`
+}
@@ -0,0 +1,44 @@
+package response
+
+import "time"
+
+// SyncScheduleResponse representa la programación de sincronización de una integración
+type SyncScheduleResponse struct {
+	ID                 uint       `json:"id" example:"1"`
+	IntegrationID      uint       `json:"integration_id" example:"3"`
+	IntegrationName    string     `json:"integration_name,omitempty" example:"Shopify Tienda Norte"`
+	IntegrationType    string     `json:"integration_type,omitempty" example:"shopify"`
+	CronSpec           string     `json:"cron_spec" example:"*/15 * * * *"`
+	IsPaused           bool       `json:"is_paused" example:"false"`
+	NextRunAt          *time.Time `json:"next_run_at,omitempty" example:"2024-01-15T10:45:00Z"`
+	LastRunAt          *time.Time `json:"last_run_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	LastFinishedAt     *time.Time `json:"last_finished_at,omitempty" example:"2024-01-15T10:30:12Z"`
+	LastStatus         string     `json:"last_status,omitempty" example:"success"`
+	LastError          string     `json:"last_error,omitempty"`
+	LastTrigger        string     `json:"last_trigger,omitempty" example:"scheduler"`
+	LastItemsProcessed int        `json:"last_items_processed" example:"42"`
+	LastDurationMs     int64      `json:"last_duration_ms" example:"12034"`
+	Cursor             string     `json:"cursor,omitempty" example:"2024-01-15T10:30:00Z"`
+	CreatedAt          time.Time  `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt          time.Time  `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// SyncScheduleSuccessResponse representa la respuesta exitosa con una programación
+type SyncScheduleSuccessResponse struct {
+	Success bool                 `json:"success" example:"true"`
+	Message string               `json:"message" example:"Programación de sincronización obtenida exitosamente"`
+	Data    SyncScheduleResponse `json:"data"`
+}
+
+// SyncMessageResponse representa la respuesta de mensaje
+type SyncMessageResponse struct {
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message" example:"Sincronización iniciada"`
+}
+
+// SyncErrorResponse representa la respuesta de error
+type SyncErrorResponse struct {
+	Success bool   `json:"success" example:"false"`
+	Message string `json:"message" example:"Error al procesar la solicitud"`
+	Error   string `json:"error,omitempty" example:"Detalles del error"`
+}
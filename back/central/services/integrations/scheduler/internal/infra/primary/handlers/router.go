@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+)
+
+// RegisterRoutes registra las rutas de programación de sincronización por integración
+func (h *SyncScheduleHandler) RegisterRoutes(router *gin.RouterGroup) {
+	syncGroup := router.Group("/integrations/:id/sync-schedule")
+	{
+		syncGroup.GET("", middleware.JWT(), h.GetScheduleHandler)
+		syncGroup.PUT("", middleware.JWT(), h.UpsertScheduleHandler)
+		syncGroup.POST("/pause", middleware.JWT(), h.PauseScheduleHandler)
+		syncGroup.POST("/resume", middleware.JWT(), h.ResumeScheduleHandler)
+		syncGroup.POST("/trigger", middleware.JWT(), h.TriggerSyncHandler)
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// TriggerSyncHandler inicia inmediatamente la sincronización de una integración
+//
+//	@Summary		Ejecutar sincronización
+//	@Description	Inicia la sincronización en background; responde 409 si ya hay una en curso en alguna réplica
+//	@Tags			IntegrationSync
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"ID de la integración"
+//	@Success		202	{object}	response.SyncMessageResponse
+//	@Failure		400	{object}	response.SyncErrorResponse
+//	@Failure		401	{object}	response.SyncErrorResponse
+//	@Failure		403	{object}	response.SyncErrorResponse
+//	@Failure		404	{object}	response.SyncErrorResponse
+//	@Failure		409	{object}	response.SyncErrorResponse
+//	@Failure		500	{object}	response.SyncErrorResponse
+//	@Router			/integrations/{id}/sync-schedule/trigger [post]
+func (h *SyncScheduleHandler) TriggerSyncHandler(c *gin.Context) {
+	if !h.requireSuperAdmin(c) {
+		return
+	}
+
+	id, ok := h.parseIntegrationID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.TriggerSync(c.Request.Context(), id); err != nil {
+		h.respondError(c, id, err, "Error al iniciar sincronización manual")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response.SyncMessageResponse{
+		Success: true,
+		Message: "Sincronización iniciada",
+	})
+}
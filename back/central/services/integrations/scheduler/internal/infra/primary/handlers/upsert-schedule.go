@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/mapper"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/request"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/infra/primary/handlers/response"
+)
+
+// UpsertScheduleHandler crea o actualiza la programación de sincronización de una integración
+//
+//	@Summary		Programar sincronización
+//	@Description	Define la expresión cron con la que se sincroniza la integración (cron de 5 campos, @hourly, @daily o @every 30m)
+//	@Tags			IntegrationSync
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int									true	"ID de la integración"
+//	@Param			request	body		request.UpsertSyncScheduleRequest	true	"Programación"
+//	@Success		200		{object}	response.SyncScheduleSuccessResponse
+//	@Failure		400		{object}	response.SyncErrorResponse
+//	@Failure		401		{object}	response.SyncErrorResponse
+//	@Failure		403		{object}	response.SyncErrorResponse
+//	@Failure		404		{object}	response.SyncErrorResponse
+//	@Failure		500		{object}	response.SyncErrorResponse
+//	@Router			/integrations/{id}/sync-schedule [put]
+func (h *SyncScheduleHandler) UpsertScheduleHandler(c *gin.Context) {
+	if !h.requireSuperAdmin(c) {
+		return
+	}
+
+	id, ok := h.parseIntegrationID(c)
+	if !ok {
+		return
+	}
+
+	var req request.UpsertSyncScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error().Err(err).Uint("integration_id", id).Msg("Error al validar datos de programación de sincronización")
+		c.JSON(http.StatusBadRequest, response.SyncErrorResponse{
+			Success: false,
+			Message: "Datos de entrada inválidos",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	schedule, err := h.usecase.UpsertSchedule(c.Request.Context(), mapper.ToUpsertSyncScheduleDTO(id, req))
+	if err != nil {
+		h.respondError(c, id, err, "Error al guardar programación de sincronización")
+		return
+	}
+
+	c.JSON(http.StatusOK, response.SyncScheduleSuccessResponse{
+		Success: true,
+		Message: "Programación de sincronización guardada exitosamente",
+		Data:    mapper.ToSyncScheduleResponse(schedule),
+	})
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/app/usecasesync"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// tickInterval cada cuánto se revisan las programaciones vencidas (la precisión del cron es de minutos)
+const tickInterval = 30 * time.Second
+
+// SyncWorker revisa periódicamente las programaciones vencidas e inicia sus sincronizaciones
+type SyncWorker struct {
+	usecase usecasesync.ISyncUseCase
+	logger  log.ILogger
+}
+
+// New crea el worker de sincronizaciones programadas
+func New(usecase usecasesync.ISyncUseCase, logger log.ILogger) *SyncWorker {
+	return &SyncWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *SyncWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de sincronizaciones programadas iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := w.usecase.RunDueSchedules(ctx); err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al ejecutar sincronizaciones programadas")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de sincronizaciones")
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,113 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// releaseScript libera el lock solo si el token coincide (evita liberar un lock tomado por otra réplica)
+var releaseScript = goredis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type redisLock struct {
+	redisClient redisclient.IRedis
+	logger      log.ILogger
+
+	// Fallback en memoria cuando Redis no está configurado (una sola réplica)
+	mu    sync.Mutex
+	local map[string]localEntry
+}
+
+type localEntry struct {
+	token     string
+	expiresAt time.Time
+}
+
+// New crea el lock distribuido basado en Redis (SET NX PX)
+func New(redisClient redisclient.IRedis, logger log.ILogger) domain.ISyncLock {
+	return &redisLock{
+		redisClient: redisClient,
+		logger:      logger,
+		local:       make(map[string]localEntry),
+	}
+}
+
+// Acquire intenta tomar el lock con SET NX y TTL
+func (l *redisLock) Acquire(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", false, err
+	}
+
+	if l.redisClient == nil {
+		return l.acquireLocal(key, token, ttl)
+	}
+
+	client := l.redisClient.Client(ctx)
+	if client == nil {
+		return "", false, fmt.Errorf("%w: cliente redis no disponible", domain.ErrSyncLockUnavailable)
+	}
+
+	ok, err := client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		l.logger.Error(ctx).Err(err).Str("key", key).Msg("Error al adquirir lock de sincronización en Redis")
+		return "", false, fmt.Errorf("%w: %w", domain.ErrSyncLockUnavailable, err)
+	}
+
+	return token, ok, nil
+}
+
+// Release libera el lock si sigue perteneciendo al token
+func (l *redisLock) Release(ctx context.Context, key string, token string) error {
+	if l.redisClient == nil {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if entry, ok := l.local[key]; ok && entry.token == token {
+			delete(l.local, key)
+		}
+		return nil
+	}
+
+	client := l.redisClient.Client(ctx)
+	if client == nil {
+		return fmt.Errorf("%w: cliente redis no disponible", domain.ErrSyncLockUnavailable)
+	}
+
+	if err := releaseScript.Run(ctx, client, []string{key}, token).Err(); err != nil {
+		l.logger.Error(ctx).Err(err).Str("key", key).Msg("Error al liberar lock de sincronización en Redis")
+		return err
+	}
+	return nil
+}
+
+func (l *redisLock) acquireLocal(key, token string, ttl time.Duration) (string, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if entry, ok := l.local[key]; ok && time.Now().Before(entry.expiresAt) {
+		return "", false, nil
+	}
+	l.local[key] = localEntry{token: token, expiresAt: time.Now().Add(ttl)}
+	return token, true, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error al generar token de lock: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
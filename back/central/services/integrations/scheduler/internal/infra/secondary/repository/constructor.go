@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+type Repository struct {
+	db  db.IDatabase
+	log log.ILogger
+}
+
+// New crea una nueva instancia del repositorio de programaciones de sincronización
+func New(database db.IDatabase, logger log.ILogger) domain.IRepository {
+	return &Repository{
+		db:  database,
+		log: logger,
+	}
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// GetIntegration obtiene los datos de la integración necesarios para sincronizarla
+func (r *Repository) GetIntegration(ctx context.Context, integrationID uint) (*domain.SyncIntegration, error) {
+	var model models.Integration
+	if err := r.db.Conn(ctx).Preload("IntegrationType").First(&model, integrationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: id %d", domain.ErrSyncIntegrationNotFound, integrationID)
+		}
+		r.log.Error(ctx).Err(err).Uint("integration_id", integrationID).Msg("Error al obtener integración")
+		return nil, fmt.Errorf("error al obtener integración: %w", err)
+	}
+
+	return toSyncIntegration(&model), nil
+}
+
+// GetScheduleByIntegrationID obtiene la programación de una integración
+func (r *Repository) GetScheduleByIntegrationID(ctx context.Context, integrationID uint) (*domain.SyncSchedule, error) {
+	var model models.IntegrationSyncSchedule
+	if err := r.db.Conn(ctx).
+		Preload("Integration.IntegrationType").
+		Where("integration_id = ?", integrationID).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: integración %d", domain.ErrSyncScheduleNotFound, integrationID)
+		}
+		r.log.Error(ctx).Err(err).Uint("integration_id", integrationID).Msg("Error al obtener programación de sincronización")
+		return nil, fmt.Errorf("error al obtener programación de sincronización: %w", err)
+	}
+
+	return toDomain(&model), nil
+}
+
+// UpsertSchedule crea o actualiza la programación de una integración
+func (r *Repository) UpsertSchedule(ctx context.Context, schedule *domain.SyncSchedule) error {
+	var model models.IntegrationSyncSchedule
+	err := r.db.Conn(ctx).Where("integration_id = ?", schedule.IntegrationID).First(&model).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		r.log.Error(ctx).Err(err).Uint("integration_id", schedule.IntegrationID).Msg("Error al buscar programación de sincronización")
+		return fmt.Errorf("error al buscar programación de sincronización: %w", err)
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		model = models.IntegrationSyncSchedule{
+			IntegrationID: schedule.IntegrationID,
+			CronSpec:      schedule.CronSpec,
+			IsPaused:      schedule.IsPaused,
+			NextRunAt:     schedule.NextRunAt,
+		}
+		if err := r.db.Conn(ctx).Create(&model).Error; err != nil {
+			r.log.Error(ctx).Err(err).Uint("integration_id", schedule.IntegrationID).Msg("Error al crear programación de sincronización")
+			return fmt.Errorf("error al crear programación de sincronización: %w", err)
+		}
+		schedule.ID = model.ID
+		schedule.CreatedAt = model.CreatedAt
+		schedule.UpdatedAt = model.UpdatedAt
+		return nil
+	}
+
+	updateFields := map[string]interface{}{
+		"cron_spec":   schedule.CronSpec,
+		"is_paused":   schedule.IsPaused,
+		"next_run_at": schedule.NextRunAt,
+	}
+	if err := r.db.Conn(ctx).Model(&models.IntegrationSyncSchedule{}).Where("id = ?", model.ID).Updates(updateFields).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_id", schedule.IntegrationID).Msg("Error al actualizar programación de sincronización")
+		return fmt.Errorf("error al actualizar programación de sincronización: %w", err)
+	}
+
+	schedule.ID = model.ID
+	schedule.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// SetPaused pausa o reanuda una programación
+func (r *Repository) SetPaused(ctx context.Context, integrationID uint, paused bool, nextRunAt *time.Time) error {
+	result := r.db.Conn(ctx).Model(&models.IntegrationSyncSchedule{}).
+		Where("integration_id = ?", integrationID).
+		Updates(map[string]interface{}{
+			"is_paused":   paused,
+			"next_run_at": nextRunAt,
+		})
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("integration_id", integrationID).Msg("Error al cambiar pausa de programación de sincronización")
+		return fmt.Errorf("error al cambiar pausa de programación: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: integración %d", domain.ErrSyncScheduleNotFound, integrationID)
+	}
+	return nil
+}
+
+// ListDueSchedules lista las programaciones no pausadas de integraciones activas cuya ejecución ya venció
+func (r *Repository) ListDueSchedules(ctx context.Context, now time.Time) ([]*domain.SyncSchedule, error) {
+	var scheduleModels []models.IntegrationSyncSchedule
+	if err := r.db.Conn(ctx).
+		Preload("Integration.IntegrationType").
+		Joins("JOIN integrations ON integrations.id = integration_sync_schedules.integration_id AND integrations.deleted_at IS NULL").
+		Where("integration_sync_schedules.is_paused = ?", false).
+		Where("integrations.is_active = ?", true).
+		Where("integration_sync_schedules.next_run_at IS NOT NULL AND integration_sync_schedules.next_run_at <= ?", now).
+		Order("integration_sync_schedules.next_run_at ASC").
+		Find(&scheduleModels).Error; err != nil {
+		r.log.Error(ctx).Err(err).Msg("Error al listar programaciones de sincronización vencidas")
+		return nil, fmt.Errorf("error al listar programaciones vencidas: %w", err)
+	}
+
+	schedules := make([]*domain.SyncSchedule, len(scheduleModels))
+	for i := range scheduleModels {
+		schedules[i] = toDomain(&scheduleModels[i])
+	}
+	return schedules, nil
+}
+
+// AdvanceNextRun mueve la próxima ejecución de una programación omitida sin registrar una ejecución.
+// Solo avanza (nunca retrocede) por si otra réplica ya la movió al iniciar la sincronización.
+func (r *Repository) AdvanceNextRun(ctx context.Context, integrationID uint, nextRunAt time.Time) error {
+	if err := r.db.Conn(ctx).Model(&models.IntegrationSyncSchedule{}).
+		Where("integration_id = ? AND (next_run_at IS NULL OR next_run_at < ?)", integrationID, nextRunAt).
+		Update("next_run_at", nextRunAt).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_id", integrationID).Msg("Error al avanzar próxima ejecución de sincronización")
+		return fmt.Errorf("error al avanzar próxima ejecución de sincronización: %w", err)
+	}
+	return nil
+}
+
+// MarkRunStarted registra el inicio de una ejecución y la siguiente fecha programada. Con
+// expectedNextRunAt es una actualización condicional: una réplica con la lista de programaciones
+// vencidas desactualizada no vuelve a ejecutar lo que otra ya inició.
+func (r *Repository) MarkRunStarted(ctx context.Context, integrationID uint, trigger string, startedAt time.Time, expectedNextRunAt, nextRunAt *time.Time) (bool, error) {
+	updateFields := map[string]interface{}{
+		"last_run_at":  startedAt,
+		"last_status":  domain.SyncStatusRunning,
+		"last_trigger": trigger,
+		"last_error":   "",
+	}
+	if nextRunAt != nil {
+		updateFields["next_run_at"] = *nextRunAt
+	}
+
+	query := r.db.Conn(ctx).Model(&models.IntegrationSyncSchedule{}).
+		Where("integration_id = ?", integrationID)
+	if expectedNextRunAt != nil {
+		query = query.Where("next_run_at = ?", *expectedNextRunAt)
+	}
+	result := query.Updates(updateFields)
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("integration_id", integrationID).Msg("Error al marcar inicio de sincronización")
+		return false, fmt.Errorf("error al marcar inicio de sincronización: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SaveRunOutcome persiste el resultado y el cursor de una ejecución
+func (r *Repository) SaveRunOutcome(ctx context.Context, integrationID uint, outcome domain.SyncRunOutcome) error {
+	updateFields := map[string]interface{}{
+		"last_status":          outcome.Status,
+		"last_error":           truncate(outcome.Error, 1000),
+		"last_trigger":         outcome.Trigger,
+		"last_items_processed": outcome.ItemsProcessed,
+		"last_duration_ms":     outcome.DurationMs,
+		"last_finished_at":     outcome.FinishedAt,
+	}
+	if outcome.Cursor != "" {
+		updateFields["cursor"] = truncate(outcome.Cursor, 500)
+	}
+
+	if err := r.db.Conn(ctx).Model(&models.IntegrationSyncSchedule{}).
+		Where("integration_id = ?", integrationID).
+		Updates(updateFields).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("integration_id", integrationID).Msg("Error al guardar resultado de sincronización")
+		return fmt.Errorf("error al guardar resultado de sincronización: %w", err)
+	}
+	return nil
+}
+
+// toDomain convierte models.IntegrationSyncSchedule a domain.SyncSchedule
+func toDomain(model *models.IntegrationSyncSchedule) *domain.SyncSchedule {
+	schedule := &domain.SyncSchedule{
+		ID:                 model.ID,
+		IntegrationID:      model.IntegrationID,
+		CronSpec:           model.CronSpec,
+		IsPaused:           model.IsPaused,
+		NextRunAt:          model.NextRunAt,
+		LastRunAt:          model.LastRunAt,
+		LastFinishedAt:     model.LastFinishedAt,
+		LastStatus:         model.LastStatus,
+		LastError:          model.LastError,
+		LastTrigger:        model.LastTrigger,
+		LastItemsProcessed: model.LastItemsProcessed,
+		LastDurationMs:     model.LastDurationMs,
+		Cursor:             model.Cursor,
+		CreatedAt:          model.CreatedAt,
+		UpdatedAt:          model.UpdatedAt,
+	}
+	if model.Integration.ID != 0 {
+		schedule.Integration = toSyncIntegration(&model.Integration)
+	}
+	return schedule
+}
+
+// toSyncIntegration convierte models.Integration a domain.SyncIntegration
+func toSyncIntegration(model *models.Integration) *domain.SyncIntegration {
+	return &domain.SyncIntegration{
+		ID:                  model.ID,
+		Name:                model.Name,
+		Code:                model.Code,
+		IntegrationTypeCode: model.IntegrationType.Code,
+		BusinessID:          model.BusinessID,
+		IsActive:            model.IsActive,
+	}
+}
+
+// truncate recorta un texto al tamaño máximo de la columna (en caracteres, sin partir caracteres UTF-8)
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/app/usecasesync"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler/internal/domain"
+)
+
+// ISyncJob es la interfaz que cada integración implementa para sincronizar una instancia
+type ISyncJob = domain.ISyncJob
+
+// SyncRequest contiene la instancia a sincronizar y el cursor de la ejecución anterior
+type SyncRequest = domain.SyncRequest
+
+// SyncResult contiene el nuevo cursor y la cantidad de elementos procesados
+type SyncResult = domain.SyncResult
+
+// Constantes públicas del origen de una ejecución
+const (
+	SyncTriggerScheduler = domain.SyncTriggerScheduler
+	SyncTriggerManual    = domain.SyncTriggerManual
+)
+
+// ISyncScheduler es la interfaz pública del subsistema de sincronización programada
+type ISyncScheduler interface {
+	// RegisterJob registra el job de sincronización para un tipo de integración (p. ej. "shopify")
+	RegisterJob(integrationType string, job ISyncJob) error
+}
+
+// syncScheduler implementa ISyncScheduler
+type syncScheduler struct {
+	useCase usecasesync.ISyncUseCase
+}
+
+// NewSyncScheduler crea una nueva instancia de ISyncScheduler
+func NewSyncScheduler(useCase usecasesync.ISyncUseCase) ISyncScheduler {
+	return &syncScheduler{
+		useCase: useCase,
+	}
+}
+
+// RegisterJob registra el job de sincronización para un tipo de integración
+func (s *syncScheduler) RegisterJob(integrationType string, job ISyncJob) error {
+	return s.useCase.RegisterJob(integrationType, job)
+}
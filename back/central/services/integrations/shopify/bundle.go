@@ -1,19 +1,27 @@
 package shopify
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/app/usecases"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/primary/events"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/primary/syncjob"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/primary/worker"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/secondary/client"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/secondary/publisher"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/secondary/queue"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/secondary/repository"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/infra/secondary/tester"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
 	"github.com/secamc93/probability/back/central/shared/rabbitmq"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
 )
 
 func New(
@@ -22,26 +30,62 @@ func New(
 	logger log.ILogger,
 	config env.IConfig,
 	coreIntegration core.IIntegrationCore,
+	syncScheduler scheduler.ISyncScheduler,
+	redisClient redisclient.IRedis,
 ) {
 	// 1. Init Secondary Adapters
 	shopifyClient := client.New()
+	fulfillmentRepo := repository.New(db, logger)
 
 	// Init RabbitMQ connection
+	var orderPublisher domain.OrderPublisher
 	rabbitMQ, err := rabbitmq.New(logger, config)
 	if err != nil {
 		logger.Error().
 			Err(err).
 			Msg("Failed to connect to RabbitMQ, using log publisher as fallback")
 		// Fallback to log publisher if RabbitMQ fails
-		orderPublisher := publisher.New(logger)
-		initializeModule(router, shopifyClient, orderPublisher, coreIntegration, logger)
-		return
+		orderPublisher = publisher.New(logger)
+	} else {
+		// Use RabbitMQ publisher
+		orderPublisher = queue.New(rabbitMQ, logger)
+	}
+
+	// Fulfillment write-back: envíos y cancelaciones de Probability hacia la tienda de origen
+	fulfillmentUseCase := usecases.NewFulfillmentWritebackUseCase(coreIntegration, shopifyClient, fulfillmentRepo, logger)
+	startFulfillmentWriteback(fulfillmentUseCase, redisClient, config, logger)
+
+	initializeModule(router, shopifyClient, orderPublisher, coreIntegration, syncScheduler, fulfillmentUseCase, logger)
+}
+
+// startFulfillmentWriteback inicia el consumo de eventos de órdenes/envíos y el worker de reintentos
+func startFulfillmentWriteback(
+	fulfillmentUseCase *usecases.FulfillmentWritebackUseCase,
+	redisClient redisclient.IRedis,
+	config env.IConfig,
+	logger log.ILogger,
+) {
+	ctx := context.Background()
+
+	orderChannel := config.Get("REDIS_ORDER_EVENTS_CHANNEL")
+	if orderChannel == "" {
+		orderChannel = "probability:orders:events"
+	}
+	shipmentChannel := config.Get("REDIS_SHIPMENT_EVENTS_CHANNEL")
+	if shipmentChannel == "" {
+		shipmentChannel = "probability:shipments:events"
 	}
 
-	// Use RabbitMQ publisher
-	orderPublisher := queue.New(rabbitMQ, logger)
+	if redisClient != nil {
+		subscriber := events.New(redisClient, fulfillmentUseCase, logger, orderChannel, shipmentChannel)
+		if err := subscriber.Start(ctx); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Msg("Failed to start shopify fulfillment subscriber, write-back only via retries")
+		}
+	}
 
-	initializeModule(router, shopifyClient, orderPublisher, coreIntegration, logger)
+	worker.New(fulfillmentUseCase, logger).Start(ctx)
 }
 
 func initializeModule(
@@ -49,20 +93,38 @@ func initializeModule(
 	shopifyClient domain.ShopifyClient,
 	orderPublisher domain.OrderPublisher,
 	coreIntegration core.IIntegrationCore,
+	syncScheduler scheduler.ISyncScheduler,
+	fulfillmentUseCase *usecases.FulfillmentWritebackUseCase,
 	logger log.ILogger,
 ) {
 	// 2. Register Tester with Core
 	shopifyTester := tester.New(shopifyClient)
-	if err := coreIntegration.RegisterTester("shopify", shopifyTester); err != nil {
+	if err := coreIntegration.RegisterTester(core.IntegrationTypeShopify, shopifyTester); err != nil {
 		logger.Error().Msg("Failed to register shopify tester: " + err.Error())
 	}
 
+	// 2.1. Register Catalog Source with Core (importación de catálogo en el módulo de productos)
+	if err := coreIntegration.RegisterCatalogSource(core.IntegrationTypeShopify, usecases.NewCatalogSourceUseCase(shopifyClient)); err != nil {
+		logger.Error().Msg("Failed to register shopify catalog source: " + err.Error())
+	}
+
+	// 2.2. Register Refund Writer with Core (write-back de reembolsos del módulo de pagos)
+	if err := coreIntegration.RegisterRefundWriter(core.IntegrationTypeShopify, usecases.NewRefundWriterUseCase(shopifyClient)); err != nil {
+		logger.Error().Msg("Failed to register shopify refund writer: " + err.Error())
+	}
+
 	// 3. Init Use Cases
 	syncUseCase := usecases.New(coreIntegration, shopifyClient, orderPublisher)
+	webhookUseCase := usecases.NewProcessWebhookUseCase(coreIntegration, syncUseCase)
+
+	// 4. Register Sync Job with Scheduler (sincronización programada por instancia)
+	if err := syncScheduler.RegisterJob(core.IntegrationTypeShopify, syncjob.New(syncUseCase)); err != nil {
+		logger.Error().Msg("Failed to register shopify sync job: " + err.Error())
+	}
 
-	// 4. Init Handlers
-	h := handlers.New(syncUseCase)
+	// 5. Init Handlers
+	h := handlers.New(syncUseCase, webhookUseCase, fulfillmentUseCase)
 
-	// 5. Register Routes
+	// 6. Register Routes
 	h.RegisterRoutes(router)
 }
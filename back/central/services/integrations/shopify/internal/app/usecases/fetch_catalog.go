@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+// CatalogSourceUseCase expone el catálogo de una tienda Shopify al core de integraciones
+// para que el módulo de productos lo importe
+type CatalogSourceUseCase struct {
+	shopifyClient domain.ShopifyClient
+}
+
+// NewCatalogSourceUseCase crea la fuente de catálogo de Shopify
+func NewCatalogSourceUseCase(shopifyClient domain.ShopifyClient) *CatalogSourceUseCase {
+	return &CatalogSourceUseCase{shopifyClient: shopifyClient}
+}
+
+// FetchCatalogPage obtiene una página de productos de la tienda y la mapea al formato unificado
+func (uc *CatalogSourceUseCase) FetchCatalogPage(ctx context.Context, integration *core.IntegrationWithCredentials, cursor string) (*core.CatalogPage, error) {
+	if integration.IntegrationType == nil || integration.IntegrationType.Code != core.IntegrationTypeShopify {
+		return nil, fmt.Errorf("%w: integration %d", domain.ErrNotShopifyIntegration, integration.ID)
+	}
+
+	storeName, accessToken, err := storeCredentials(integration)
+	if err != nil {
+		return nil, err
+	}
+
+	products, nextCursor, err := uc.shopifyClient.FetchProducts(ctx, storeName, accessToken, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch products: %w", err)
+	}
+
+	page := &core.CatalogPage{
+		Products:   make([]core.CatalogProduct, 0, len(products)),
+		NextCursor: nextCursor,
+	}
+	for _, product := range products {
+		page.Products = append(page.Products, mapCatalogProduct(product))
+	}
+	return page, nil
+}
+
+// mapCatalogProduct convierte un producto de Shopify al formato unificado.
+// Shopify no tiene SKU a nivel de producto: si el producto tiene una sola variante sin opciones
+// se usa el SKU de esa variante; si tiene variantes, el producto padre se identifica por su handle.
+func mapCatalogProduct(product domain.ShopifyProduct) core.CatalogProduct {
+	result := core.CatalogProduct{
+		ExternalID:  strconv.FormatInt(product.ID, 10),
+		Name:        product.Title,
+		Description: product.BodyHTML,
+		Brand:       product.Vendor,
+		Category:    product.ProductType,
+		Tags:        splitTags(product.Tags),
+		Status:      product.Status,
+	}
+
+	images := append([]domain.ShopifyImage(nil), product.Images...)
+	sort.SliceStable(images, func(i, j int) bool { return images[i].Position < images[j].Position })
+	for _, image := range images {
+		if image.Src != "" {
+			result.ImageURLs = append(result.ImageURLs, image.Src)
+		}
+	}
+
+	if len(product.Variants) == 0 {
+		result.SKU = product.Handle
+		return result
+	}
+
+	first := product.Variants[0]
+	result.Price = parsePrice(first.Price)
+	result.CompareAtPrice = parseOptionalPrice(first.CompareAtPrice)
+	if first.Weight > 0 {
+		weight := first.Weight
+		result.Weight = &weight
+		result.WeightUnit = first.WeightUnit
+	}
+	for _, variant := range product.Variants {
+		result.StockQuantity += variant.InventoryQuantity
+		if variant.InventoryManagement != nil && *variant.InventoryManagement == domain.ShopifyInventoryManaged {
+			result.TrackInventory = true
+		}
+	}
+
+	if !hasRealOptions(product) {
+		result.SKU = first.SKU
+		return result
+	}
+
+	result.SKU = product.Handle
+	for _, option := range product.Options {
+		result.OptionAxes = append(result.OptionAxes, option.Name)
+	}
+	for _, variant := range product.Variants {
+		result.Variants = append(result.Variants, mapCatalogVariant(variant, result.OptionAxes))
+	}
+	return result
+}
+
+// mapCatalogVariant convierte una variante de Shopify; option1..3 corresponden a los ejes en orden
+func mapCatalogVariant(variant domain.ShopifyVariant, axes []string) core.CatalogVariant {
+	result := core.CatalogVariant{
+		ExternalID:     strconv.FormatInt(variant.ID, 10),
+		SKU:            variant.SKU,
+		Title:          variant.Title,
+		Position:       variant.Position,
+		CompareAtPrice: parseOptionalPrice(variant.CompareAtPrice),
+		StockQuantity:  variant.InventoryQuantity,
+		Options:        make(map[string]string, len(axes)),
+	}
+	if variant.Barcode != nil {
+		result.Barcode = *variant.Barcode
+	}
+	price := parsePrice(variant.Price)
+	result.Price = &price
+	if variant.Weight > 0 {
+		weight := variant.Weight
+		result.Weight = &weight
+	}
+
+	values := []*string{variant.Option1, variant.Option2, variant.Option3}
+	for i, axis := range axes {
+		if i < len(values) && values[i] != nil {
+			result.Options[axis] = *values[i]
+		}
+	}
+	return result
+}
+
+// hasRealOptions indica si el producto tiene variantes reales (no solo la opción "Default Title")
+func hasRealOptions(product domain.ShopifyProduct) bool {
+	if len(product.Variants) > 1 {
+		return true
+	}
+	return len(product.Options) > 0 && !(len(product.Options) == 1 && product.Options[0].Name == domain.ShopifyDefaultOptionName)
+}
+
+func splitTags(tags string) []string {
+	var result []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func parsePrice(value string) float64 {
+	price, _ := strconv.ParseFloat(value, 64)
+	return price
+}
+
+func parseOptionalPrice(value *string) *float64 {
+	if value == nil || *value == "" {
+		return nil
+	}
+	price, err := strconv.ParseFloat(*value, 64)
+	if err != nil {
+		return nil
+	}
+	return &price
+}
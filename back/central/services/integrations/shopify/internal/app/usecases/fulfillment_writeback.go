@@ -0,0 +1,370 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+const (
+	// fulfillmentLease evita que el worker tome un write-back que se está procesando en línea
+	fulfillmentLease = 5 * time.Minute
+	// notifyCustomerConfigKey es la clave de config de la integración que activa la notificación de Shopify al cliente
+	notifyCustomerConfigKey = "notify_customer_on_fulfillment"
+)
+
+// FulfillmentWritebackUseCase refleja en la tienda Shopify de origen los envíos y cancelaciones registrados en Probability
+type FulfillmentWritebackUseCase struct {
+	coreIntegration core.IIntegrationCore
+	shopifyClient   domain.ShopifyClient
+	repo            domain.IFulfillmentRepository
+	log             log.ILogger
+}
+
+func NewFulfillmentWritebackUseCase(
+	coreIntegration core.IIntegrationCore,
+	shopifyClient domain.ShopifyClient,
+	repo domain.IFulfillmentRepository,
+	logger log.ILogger,
+) *FulfillmentWritebackUseCase {
+	return &FulfillmentWritebackUseCase{
+		coreIntegration: coreIntegration,
+		shopifyClient:   shopifyClient,
+		repo:            repo,
+		log:             logger,
+	}
+}
+
+// HandleShipmentEvent crea o actualiza el fulfillment de Shopify cuando un envío tiene tracking,
+// y lo cancela cuando el envío se cancela o elimina
+func (uc *FulfillmentWritebackUseCase) HandleShipmentEvent(ctx context.Context, event *domain.ShipmentEvent) error {
+	ctx = log.WithFunctionCtx(ctx, "HandleShipmentEvent")
+
+	order, err := uc.repo.GetOrderReference(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+	if order.IntegrationType != core.IntegrationTypeShopify {
+		return nil
+	}
+
+	key := domain.ShipmentSyncKey(event.ShipmentID)
+	existing, err := uc.repo.GetFulfillmentSyncByKey(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	cancelled := event.Type == domain.ShipmentEventDeleted || event.Data.CurrentStatus == domain.ShipmentStatusCancelled
+	if cancelled {
+		// Solo hay algo que cancelar si antes se escribió un fulfillment
+		if existing == nil || existing.Action == domain.FulfillmentActionCancelFulfillment {
+			return nil
+		}
+		existing.Action = domain.FulfillmentActionCancelFulfillment
+		return uc.schedule(ctx, existing)
+	}
+
+	tracking := domain.TrackingInfo{
+		Number:  stringValue(event.Data.TrackingNumber),
+		URL:     stringValue(event.Data.TrackingURL),
+		Company: stringValue(event.Data.Carrier),
+	}
+	if tracking.Number == "" {
+		return nil
+	}
+
+	if existing != nil && existing.Action == domain.FulfillmentActionFulfill && existing.Status == domain.FulfillmentSyncSynced &&
+		existing.TrackingNumber == tracking.Number && existing.TrackingURL == tracking.URL && existing.Carrier == tracking.Company {
+		return nil
+	}
+
+	sync := existing
+	if sync == nil {
+		shipmentID := event.ShipmentID
+		sync = &domain.FulfillmentSync{
+			SyncKey:         key,
+			BusinessID:      order.BusinessID,
+			IntegrationID:   order.IntegrationID,
+			OrderID:         order.ID,
+			ExternalOrderID: order.ExternalID,
+			ShipmentID:      &shipmentID,
+		}
+	}
+	sync.Action = domain.FulfillmentActionFulfill
+	sync.TrackingNumber = tracking.Number
+	sync.TrackingURL = tracking.URL
+	sync.Carrier = tracking.Company
+
+	return uc.schedule(ctx, sync)
+}
+
+// HandleOrderEvent cancela la orden en Shopify cuando se cancela en Probability
+func (uc *FulfillmentWritebackUseCase) HandleOrderEvent(ctx context.Context, event *domain.OrderEvent) error {
+	ctx = log.WithFunctionCtx(ctx, "HandleOrderEvent")
+
+	isCancellation := event.Type == domain.OrderEventCancelled ||
+		(event.Type == domain.OrderEventStatusChanged && event.Data.CurrentStatus == domain.OrderStatusCancelled)
+	if !isCancellation || event.Data.PreviousStatus == domain.OrderStatusCancelled {
+		return nil
+	}
+
+	order, err := uc.repo.GetOrderReference(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+	if order.IntegrationType != core.IntegrationTypeShopify {
+		return nil
+	}
+	// La cancelación vino de la propia tienda: no hay nada que escribir de vuelta
+	if event.Source() == order.IntegrationType {
+		return nil
+	}
+
+	key := domain.OrderCancelSyncKey(order.ID)
+	existing, err := uc.repo.GetFulfillmentSyncByKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Status == domain.FulfillmentSyncSynced {
+		return nil
+	}
+
+	sync := existing
+	if sync == nil {
+		sync = &domain.FulfillmentSync{
+			SyncKey:         key,
+			BusinessID:      order.BusinessID,
+			IntegrationID:   order.IntegrationID,
+			OrderID:         order.ID,
+			ExternalOrderID: order.ExternalID,
+			Action:          domain.FulfillmentActionCancelOrder,
+		}
+	}
+
+	return uc.schedule(ctx, sync)
+}
+
+// ProcessDue reintenta los write-backs pendientes o fallidos cuyo reintento venció
+func (uc *FulfillmentWritebackUseCase) ProcessDue(ctx context.Context, limit int) error {
+	ctx = log.WithFunctionCtx(ctx, "ProcessDue")
+
+	now := time.Now()
+	due, err := uc.repo.ListDueFulfillmentSyncs(ctx, now, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, sync := range due {
+		claimed, err := uc.repo.ClaimFulfillmentSync(ctx, sync.ID, *sync.NextRetryAt, now.Add(fulfillmentLease))
+		if err != nil {
+			uc.log.Error(ctx).Err(err).Uint("fulfillment_sync_id", sync.ID).Msg("Error al reservar write-back de fulfillment")
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := uc.process(ctx, sync); err != nil {
+			uc.log.Error(ctx).Err(err).
+				Uint("fulfillment_sync_id", sync.ID).
+				Str("order_id", sync.OrderID).
+				Msg("Error al procesar write-back de fulfillment vencido")
+		}
+	}
+
+	return nil
+}
+
+// Retry reprocesa un write-back de inmediato (incluso si agotó sus reintentos)
+func (uc *FulfillmentWritebackUseCase) Retry(ctx context.Context, id uint, businessID *uint) (*domain.FulfillmentSync, error) {
+	ctx = log.WithFunctionCtx(ctx, "RetryFulfillmentSync")
+
+	sync, err := uc.repo.GetFulfillmentSyncByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if businessID != nil && (sync.BusinessID == nil || *sync.BusinessID != *businessID) {
+		return nil, fmt.Errorf("%w: fulfillment sync %d", domain.ErrIntegrationForbidden, id)
+	}
+
+	now := time.Now()
+	claimed, err := uc.repo.ClaimFulfillmentSyncForRetry(ctx, sync.ID, now, now.Add(fulfillmentLease))
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, fmt.Errorf("%w: fulfillment sync %d", domain.ErrFulfillmentSyncInProgress, id)
+	}
+
+	// Releer tras reservarlo: el estado deseado pudo cambiar desde la primera lectura
+	sync, err = uc.repo.GetFulfillmentSyncByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.process(ctx, sync); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+// List lista los write-backs registrados
+func (uc *FulfillmentWritebackUseCase) List(ctx context.Context, filters domain.FulfillmentSyncFilters) ([]*domain.FulfillmentSync, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 || filters.PageSize > 100 {
+		filters.PageSize = 20
+	}
+	return uc.repo.ListFulfillmentSyncs(ctx, filters)
+}
+
+// schedule deja el write-back pendiente y lo procesa en línea solo si logra reservarlo. Los eventos llegan
+// por Redis Pub/Sub a todas las réplicas: la primera que registra el nuevo estado lo reserva y llama a
+// Shopify; las demás encuentran el mismo estado y no hacen nada. Si el registro se está procesando,
+// queda pendiente y el proceso en curso lo libera para el worker al terminar.
+func (uc *FulfillmentWritebackUseCase) schedule(ctx context.Context, sync *domain.FulfillmentSync) error {
+	now := time.Now()
+	scheduled, err := uc.repo.UpsertPendingFulfillmentSync(ctx, sync, now)
+	if err != nil || !scheduled {
+		return err
+	}
+	if sync.NextRetryAt == nil || sync.NextRetryAt.After(now) {
+		return nil
+	}
+
+	claimed, err := uc.repo.ClaimFulfillmentSync(ctx, sync.ID, *sync.NextRetryAt, now.Add(fulfillmentLease))
+	if err != nil || !claimed {
+		return err
+	}
+
+	return uc.process(ctx, sync)
+}
+
+// process ejecuta la acción contra Shopify y registra el resultado (y el próximo reintento si falla).
+// Los errores de Shopify quedan en el registro; solo se retornan errores de persistencia.
+func (uc *FulfillmentWritebackUseCase) process(ctx context.Context, sync *domain.FulfillmentSync) error {
+	execErr := uc.execute(ctx, sync)
+	now := time.Now()
+
+	if execErr == nil {
+		sync.Status = domain.FulfillmentSyncSynced
+		sync.LastError = ""
+		sync.NextRetryAt = nil
+		sync.SyncedAt = &now
+	} else {
+		sync.Attempts++
+		sync.Status = domain.FulfillmentSyncFailed
+		sync.LastError = execErr.Error()
+		sync.NextRetryAt = nil
+		if isRetryableWritebackError(execErr) {
+			sync.NextRetryAt = domain.NextFulfillmentRetry(sync.Attempts, now)
+		}
+		uc.log.Error(ctx).Err(execErr).
+			Uint("fulfillment_sync_id", sync.ID).
+			Str("order_id", sync.OrderID).
+			Str("action", sync.Action).
+			Int("attempts", sync.Attempts).
+			Bool("will_retry", sync.NextRetryAt != nil).
+			Msg("Error al escribir fulfillment en Shopify")
+	}
+
+	saved, err := uc.repo.SaveFulfillmentSyncResult(ctx, sync, time.Now())
+	if err != nil {
+		return err
+	}
+	if !saved {
+		uc.log.Info(ctx).
+			Uint("fulfillment_sync_id", sync.ID).
+			Str("order_id", sync.OrderID).
+			Str("action", sync.Action).
+			Msg("Write-back de fulfillment reprogramado mientras se procesaba, queda pendiente para el worker")
+	}
+	return nil
+}
+
+func (uc *FulfillmentWritebackUseCase) execute(ctx context.Context, sync *domain.FulfillmentSync) error {
+	integration, err := resolveShopifyIntegration(ctx, uc.coreIntegration, sync.IntegrationID, nil)
+	if err != nil {
+		return err
+	}
+	storeName, accessToken, err := storeCredentials(integration)
+	if err != nil {
+		return err
+	}
+
+	switch sync.Action {
+	case domain.FulfillmentActionFulfill:
+		tracking := domain.TrackingInfo{Number: sync.TrackingNumber, URL: sync.TrackingURL, Company: sync.Carrier}
+		notify := notifyCustomer(integration)
+		if sync.FulfillmentID != "" {
+			return uc.shopifyClient.UpdateFulfillmentTracking(ctx, storeName, accessToken, sync.FulfillmentID, tracking, notify)
+		}
+
+		fulfillmentOrders, err := uc.shopifyClient.ListFulfillmentOrders(ctx, storeName, accessToken, sync.ExternalOrderID)
+		if err != nil {
+			return err
+		}
+		var fulfillmentOrderIDs []int64
+		for _, fo := range fulfillmentOrders {
+			if fo.IsFulfillable() {
+				fulfillmentOrderIDs = append(fulfillmentOrderIDs, fo.ID)
+			}
+		}
+		if len(fulfillmentOrderIDs) == 0 {
+			return domain.ErrNoOpenFulfillmentOrders
+		}
+
+		fulfillmentID, err := uc.shopifyClient.CreateFulfillment(ctx, storeName, accessToken, fulfillmentOrderIDs, tracking, notify)
+		if err != nil {
+			return err
+		}
+		sync.FulfillmentID = fulfillmentID
+		return nil
+
+	case domain.FulfillmentActionCancelFulfillment:
+		if sync.FulfillmentID == "" {
+			return nil
+		}
+		return uc.shopifyClient.CancelFulfillment(ctx, storeName, accessToken, sync.FulfillmentID)
+
+	case domain.FulfillmentActionCancelOrder:
+		return uc.shopifyClient.CancelOrder(ctx, storeName, accessToken, sync.ExternalOrderID)
+
+	default:
+		return fmt.Errorf("unknown fulfillment action: %s", sync.Action)
+	}
+}
+
+// isRetryableWritebackError indica si el error puede resolverse reintentando más tarde
+func isRetryableWritebackError(err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrShopifyUnprocessable),
+		errors.Is(err, domain.ErrNoOpenFulfillmentOrders),
+		errors.Is(err, domain.ErrNotShopifyIntegration),
+		errors.Is(err, core.ErrIntegrationNotFound):
+		return false
+	default:
+		return true
+	}
+}
+
+func notifyCustomer(integration *core.IntegrationWithCredentials) bool {
+	var config map[string]interface{}
+	if len(integration.Config) > 0 {
+		_ = json.Unmarshal(integration.Config, &config)
+	}
+	notify, _ := config[notifyCustomerConfigKey].(bool)
+	return notify
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
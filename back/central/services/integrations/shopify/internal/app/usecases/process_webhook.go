@@ -2,11 +2,20 @@ package usecases
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
 )
 
+// webhookSecretKeys son las claves de credenciales donde puede estar el secreto de firma de webhooks
+var webhookSecretKeys = []string{"webhook_secret", "client_secret"}
+
 type ProcessWebhookUseCase struct {
 	coreIntegration core.IIntegrationCore
 	syncUseCase     *SyncOrdersUseCase
@@ -22,21 +31,83 @@ func NewProcessWebhookUseCase(
 	}
 }
 
-func (uc *ProcessWebhookUseCase) Execute(ctx context.Context, storeDomain string, payload map[string]interface{}) error {
-	// 1. Find integration by store domain
-	// We need to find the integration that matches this store.
-	// Core doesn't expose "GetIntegrationByConfigValue".
-	// We might need to iterate or add a method to Core.
-	// For now, let's assume we can't easily find it without a lookup.
-	// BUT, we can assume there's only one shopify integration per business? No, we don't know the business.
-	// We need to look up the integration ID based on the store name in the config.
+// Execute procesa un webhook de órdenes dirigido a una integración (tienda) específica.
+// Retorna false cuando el topic no es de órdenes y el webhook se ignora.
+func (uc *ProcessWebhookUseCase) Execute(ctx context.Context, req domain.WebhookRequest) (bool, error) {
+	// 1. Resolve the store by integration ID (several stores per business are allowed)
+	integration, err := resolveShopifyIntegration(ctx, uc.coreIntegration, req.IntegrationID, nil)
+	if err != nil {
+		return false, err
+	}
 
-	// TODO: Implement lookup in Core or Repository.
-	// For this implementation, I will skip the lookup and assume we have a way to get it,
-	// or I'll just log that we can't find it.
+	// 2. Verify the HMAC signature with the store secret
+	if err := verifyWebhookSignature(integration, req.Body, req.HMAC); err != nil {
+		return false, err
+	}
 
-	// Realistically, we need a "FindIntegrationByConfig" method in Core.
-	// Or we store the shop domain in a searchable field.
+	// 3. Verify the webhook comes from the store configured in this integration
+	storeName, _, err := storeCredentials(integration)
+	if err != nil {
+		return false, err
+	}
+	if req.ShopDomain != "" && normalizeShopDomain(req.ShopDomain) != normalizeShopDomain(storeName) {
+		return false, fmt.Errorf("%w: %s", domain.ErrWebhookShopMismatch, req.ShopDomain)
+	}
+
+	if !strings.HasPrefix(req.Topic, "orders/") {
+		return false, nil
+	}
+
+	// 4. Map and publish the order attributed to this integration
+	var data map[string]interface{}
+	if err := json.Unmarshal(req.Body, &data); err != nil {
+		return false, fmt.Errorf("failed to unmarshal webhook payload: %w", err)
+	}
+
+	unifiedOrder, err := uc.syncUseCase.mapToUnified(integration, data)
+	if err != nil {
+		return false, fmt.Errorf("failed to map webhook order: %w", err)
+	}
+
+	if err := uc.syncUseCase.publisher.Publish(ctx, unifiedOrder); err != nil {
+		return false, fmt.Errorf("failed to publish order: %w", err)
+	}
+
+	return true, nil
+}
+
+// verifyWebhookSignature valida el header X-Shopify-Hmac-Sha256 contra el cuerpo crudo
+func verifyWebhookSignature(integration *core.IntegrationWithCredentials, body []byte, signature string) error {
+	var secret string
+	for _, key := range webhookSecretKeys {
+		if value, ok := integration.DecryptedCredentials[key].(string); ok && value != "" {
+			secret = value
+			break
+		}
+	}
+	if secret == "" {
+		return fmt.Errorf("%w: webhook secret not configured for integration %d", domain.ErrInvalidWebhookSignature, integration.ID)
+	}
+	if signature == "" {
+		return fmt.Errorf("%w: missing signature header", domain.ErrInvalidWebhookSignature)
+	}
+
+	received, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed signature", domain.ErrInvalidWebhookSignature)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(received, mac.Sum(nil)) {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
 
-	return fmt.Errorf("not implemented: cannot lookup integration by store domain yet")
+// normalizeShopDomain reduce "Mi-Tienda.myshopify.com" y "mi-tienda" a la misma forma
+func normalizeShopDomain(shop string) string {
+	shop = strings.ToLower(strings.TrimSpace(shop))
+	return strings.TrimSuffix(shop, ".myshopify.com")
 }
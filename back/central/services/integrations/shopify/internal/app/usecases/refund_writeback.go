@@ -0,0 +1,162 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+// notifyRefundConfigKey es la clave de config de la integración que activa la notificación de Shopify al cliente por reembolsos
+const notifyRefundConfigKey = "notify_customer_on_refund"
+
+// RefundWriterUseCase registra en la tienda Shopify de origen los reembolsos hechos en Probability.
+// Se registra en el core de integraciones para que el módulo de pagos lo use.
+type RefundWriterUseCase struct {
+	shopifyClient domain.ShopifyClient
+}
+
+// NewRefundWriterUseCase crea el escritor de reembolsos de Shopify
+func NewRefundWriterUseCase(shopifyClient domain.ShopifyClient) *RefundWriterUseCase {
+	return &RefundWriterUseCase{shopifyClient: shopifyClient}
+}
+
+// PushRefund crea el reembolso en la orden de Shopify. El dinero se reparte entre las transacciones
+// exitosas de la orden (venta o captura) y las líneas se asocian por variante o SKU.
+func (uc *RefundWriterUseCase) PushRefund(ctx context.Context, integration *core.IntegrationWithCredentials, refund *core.RefundWriteBack) (*core.RefundWriteBackResult, error) {
+	if integration.IntegrationType == nil || integration.IntegrationType.Code != core.IntegrationTypeShopify {
+		return nil, fmt.Errorf("%w: integration %d", domain.ErrNotShopifyIntegration, integration.ID)
+	}
+
+	storeName, accessToken, err := storeCredentials(integration)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.shopifyClient.ListOrderTransactions(ctx, storeName, accessToken, refund.ExternalOrderID)
+	if err != nil {
+		return nil, err
+	}
+	refundTransactions, err := allocateRefund(transactions, refund.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	request := domain.RefundRequest{
+		Currency:     refund.Currency,
+		Note:         refundNote(refund),
+		Notify:       notifyRefund(integration),
+		Transactions: refundTransactions,
+	}
+
+	if len(refund.Lines) > 0 {
+		lineItems, err := uc.shopifyClient.ListOrderLineItems(ctx, storeName, accessToken, refund.ExternalOrderID)
+		if err != nil {
+			return nil, err
+		}
+		request.Lines = matchRefundLines(lineItems, refund.Lines)
+	}
+
+	refundID, err := uc.shopifyClient.CreateRefund(ctx, storeName, accessToken, refund.ExternalOrderID, request)
+	if err != nil {
+		return nil, err
+	}
+	return &core.RefundWriteBackResult{ExternalRefundID: refundID}, nil
+}
+
+// allocateRefund reparte el monto entre las transacciones exitosas de la orden, en orden, sin superar
+// lo que le queda a cada una: su monto menos los reembolsos exitosos ya hechos sobre ella
+func allocateRefund(transactions []domain.ShopifyTransaction, amount float64) ([]domain.RefundTransaction, error) {
+	refunded := make(map[int64]float64)
+	for _, tx := range transactions {
+		if tx.Kind != domain.TransactionKindRefund || tx.Status != domain.TransactionStatusSuccess || tx.ParentID == nil {
+			continue
+		}
+		if value, err := strconv.ParseFloat(tx.Amount, 64); err == nil {
+			refunded[*tx.ParentID] += value
+		}
+	}
+
+	remaining := amount
+	var result []domain.RefundTransaction
+	for _, tx := range transactions {
+		if remaining < 0.005 {
+			break
+		}
+		if tx.Status != domain.TransactionStatusSuccess ||
+			(tx.Kind != domain.TransactionKindSale && tx.Kind != domain.TransactionKindCapture) {
+			continue
+		}
+		captured, err := strconv.ParseFloat(tx.Amount, 64)
+		if err != nil {
+			continue
+		}
+		available := math.Round((captured-refunded[tx.ID])*100) / 100
+		if available <= 0 {
+			continue
+		}
+
+		portion := math.Min(available, remaining)
+		result = append(result, domain.RefundTransaction{
+			ParentID: tx.ID,
+			Amount:   math.Round(portion*100) / 100,
+			Gateway:  tx.Gateway,
+		})
+		remaining -= portion
+	}
+
+	if len(result) == 0 {
+		return nil, domain.ErrNoRefundableTransactions
+	}
+	if remaining >= 0.005 {
+		return nil, fmt.Errorf("%w: refund exceeds the captured amount by %.2f", domain.ErrShopifyUnprocessable, remaining)
+	}
+	return result, nil
+}
+
+// matchRefundLines asocia las líneas del reembolso con las líneas de la orden de Shopify por
+// ID de variante o, si no lo tiene, por SKU. Las líneas que no se encuentran se omiten.
+func matchRefundLines(lineItems []domain.ShopifyLineItem, lines []core.RefundWriteBackLine) []domain.RefundLineItem {
+	result := make([]domain.RefundLineItem, 0, len(lines))
+	for _, line := range lines {
+		for _, item := range lineItems {
+			if !sameLine(item, line) {
+				continue
+			}
+			result = append(result, domain.RefundLineItem{
+				LineItemID: item.ID,
+				Quantity:   min(line.Quantity, item.Quantity),
+			})
+			break
+		}
+	}
+	return result
+}
+
+func sameLine(item domain.ShopifyLineItem, line core.RefundWriteBackLine) bool {
+	if line.ExternalVariantID != "" && item.VariantID != nil {
+		return strconv.FormatInt(*item.VariantID, 10) == line.ExternalVariantID
+	}
+	return line.SKU != "" && strings.EqualFold(item.SKU, line.SKU)
+}
+
+func refundNote(refund *core.RefundWriteBack) string {
+	if refund.Note == "" {
+		return refund.Reason
+	}
+	return refund.Reason + ": " + refund.Note
+}
+
+func notifyRefund(integration *core.IntegrationWithCredentials) bool {
+	var config map[string]interface{}
+	if len(integration.Config) > 0 {
+		_ = json.Unmarshal(integration.Config, &config)
+	}
+	notify, _ := config[notifyRefundConfigKey].(bool)
+	return notify
+}
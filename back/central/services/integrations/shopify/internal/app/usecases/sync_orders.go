@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -29,54 +30,38 @@ func New(
 	}
 }
 
-func (uc *SyncOrdersUseCase) Execute(ctx context.Context, businessID *uint, createdMin *time.Time) error {
-	// 1. Get Integration credentials
-	integration, err := uc.coreIntegration.GetIntegrationByType(ctx, "shopify", businessID)
-	if err != nil {
-		return fmt.Errorf("failed to get shopify integration: %w", err)
-	}
-
-	if !integration.IsActive {
-		return fmt.Errorf("integration is not active")
-	}
+// Execute importa las órdenes de una tienda (integración) de Shopify y retorna la cantidad de órdenes publicadas
+func (uc *SyncOrdersUseCase) Execute(ctx context.Context, params domain.SyncOrdersParams) (int, error) {
+	published := 0
 
-	// Extract credentials
-	var credsMap map[string]interface{}
-	if len(integration.Credentials) > 0 {
-		if err := json.Unmarshal(integration.Credentials, &credsMap); err != nil {
-			return fmt.Errorf("failed to unmarshal credentials: %w", err)
-		}
-	}
-
-	accessToken, ok := credsMap["access_token"].(string)
-	if !ok || accessToken == "" {
-		return fmt.Errorf("access token not found in credentials")
+	// 1. Get the specific store integration with decrypted credentials
+	integration, err := resolveShopifyIntegration(ctx, uc.coreIntegration, params.IntegrationID, params.BusinessID)
+	if err != nil {
+		return published, err
 	}
 
-	// Extract config
-	var config map[string]interface{}
-	if len(integration.Config) > 0 {
-		_ = json.Unmarshal(integration.Config, &config)
-	}
-	storeName, _ := config["store_name"].(string)
-	if storeName == "" {
-		return fmt.Errorf("store name not found in config")
+	storeName, accessToken, err := storeCredentials(integration)
+	if err != nil {
+		return published, err
 	}
 
 	// 2. Prepare params
-	params := map[string]string{
+	query := map[string]string{
 		"status": "any",
 		"limit":  "250",
 	}
-	if createdMin != nil {
-		params["created_at_min"] = createdMin.Format(time.RFC3339)
+	if params.CreatedMin != nil {
+		query["created_at_min"] = params.CreatedMin.Format(time.RFC3339)
+	}
+	if params.UpdatedMin != nil {
+		query["updated_at_min"] = params.UpdatedMin.Format(time.RFC3339)
 	}
 
 	// 3. Fetch orders with pagination
 	for {
-		ordersData, nextPageURL, err := uc.shopifyClient.FetchOrders(ctx, storeName, accessToken, params)
+		ordersData, nextPageURL, err := uc.shopifyClient.FetchOrders(ctx, storeName, accessToken, query)
 		if err != nil {
-			return fmt.Errorf("failed to fetch orders: %w", err)
+			return published, fmt.Errorf("failed to fetch orders: %w", err)
 		}
 
 		for _, data := range ordersData {
@@ -89,18 +74,114 @@ func (uc *SyncOrdersUseCase) Execute(ctx context.Context, businessID *uint, crea
 
 			// Publish to queue
 			if err := uc.publisher.Publish(ctx, unifiedOrder); err != nil {
-				return fmt.Errorf("failed to publish order: %w", err)
+				return published, fmt.Errorf("failed to publish order: %w", err)
 			}
+			published++
 		}
 
 		if nextPageURL == "" {
 			break
 		}
-		// TODO: Handle pagination properly with next page URL
-		break
+		query, err = nextPageQuery(nextPageURL)
+		if err != nil {
+			return published, fmt.Errorf("failed to parse next page link: %w", err)
+		}
 	}
 
-	return nil
+	return published, nil
+}
+
+// nextPageQuery extrae los parámetros de la siguiente página del Link header de Shopify.
+// Con paginación por cursor solo se envían page_info y limit: los filtros originales
+// (status, created_at_min, updated_at_min) ya van codificados en page_info.
+func nextPageQuery(nextPageURL string) (map[string]string, error) {
+	parsed, err := url.Parse(nextPageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	values := parsed.Query()
+	pageInfo := values.Get("page_info")
+	if pageInfo == "" {
+		return nil, fmt.Errorf("page_info not found in %q", nextPageURL)
+	}
+
+	query := map[string]string{"page_info": pageInfo}
+	if limit := values.Get("limit"); limit != "" {
+		query["limit"] = limit
+	}
+	return query, nil
+}
+
+// ExecuteForBusiness sincroniza todas las tiendas Shopify activas de un negocio (nil = todos los negocios).
+// Un fallo en una tienda no detiene la sincronización de las demás.
+func (uc *SyncOrdersUseCase) ExecuteForBusiness(ctx context.Context, businessID *uint, createdMin *time.Time) ([]domain.StoreSyncResult, error) {
+	integrations, err := uc.coreIntegration.ListActiveIntegrationsByType(ctx, core.IntegrationTypeShopify, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shopify integrations: %w", err)
+	}
+
+	results := make([]domain.StoreSyncResult, 0, len(integrations))
+	for _, integration := range integrations {
+		published, err := uc.Execute(ctx, domain.SyncOrdersParams{
+			IntegrationID: integration.ID,
+			BusinessID:    businessID,
+			CreatedMin:    createdMin,
+		})
+
+		result := domain.StoreSyncResult{
+			IntegrationID:   integration.ID,
+			IntegrationName: integration.Name,
+			OrdersPublished: published,
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// resolveShopifyIntegration obtiene la integración por ID y verifica que sea una tienda Shopify activa del negocio indicado
+func resolveShopifyIntegration(ctx context.Context, coreIntegration core.IIntegrationCore, integrationID uint, businessID *uint) (*core.IntegrationWithCredentials, error) {
+	integration, err := coreIntegration.GetIntegrationByID(ctx, integrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shopify integration %d: %w", integrationID, err)
+	}
+
+	if integration.IntegrationType == nil || integration.IntegrationType.Code != core.IntegrationTypeShopify {
+		return nil, fmt.Errorf("%w: integration %d", domain.ErrNotShopifyIntegration, integrationID)
+	}
+
+	if businessID != nil && (integration.BusinessID == nil || *integration.BusinessID != *businessID) {
+		return nil, fmt.Errorf("%w: integration %d", domain.ErrIntegrationForbidden, integrationID)
+	}
+
+	if !integration.IsActive {
+		return nil, fmt.Errorf("%w: integration %d", domain.ErrIntegrationInactive, integrationID)
+	}
+
+	return integration, nil
+}
+
+// storeCredentials extrae el store_name de la config y el access_token de las credenciales desencriptadas
+func storeCredentials(integration *core.IntegrationWithCredentials) (string, string, error) {
+	accessToken, _ := integration.DecryptedCredentials["access_token"].(string)
+	if accessToken == "" {
+		return "", "", fmt.Errorf("%w: access token not found in credentials", domain.ErrMissingCredentials)
+	}
+
+	var config map[string]interface{}
+	if len(integration.Config) > 0 {
+		_ = json.Unmarshal(integration.Config, &config)
+	}
+	storeName, _ := config["store_name"].(string)
+	if storeName == "" {
+		return "", "", fmt.Errorf("%w: store name not found in config", domain.ErrMissingCredentials)
+	}
+
+	return storeName, accessToken, nil
 }
 
 func (uc *SyncOrdersUseCase) mapToUnified(integration *core.IntegrationWithCredentials, data map[string]interface{}) (*domain.UnifiedOrder, error) {
@@ -112,11 +193,7 @@ func (uc *SyncOrdersUseCase) mapToUnified(integration *core.IntegrationWithCrede
 		return ""
 	}
 
-	idVal := data["id"]
-	externalID := fmt.Sprintf("%v", idVal)
-	if f, ok := idVal.(float64); ok {
-		externalID = fmt.Sprintf("%.0f", f)
-	}
+	externalID := shopifyID(data["id"])
 
 	orderNumber := fmt.Sprintf("%v", data["order_number"])
 
@@ -142,7 +219,9 @@ func (uc *SyncOrdersUseCase) mapToUnified(integration *core.IntegrationWithCrede
 				}
 
 				items = append(items, domain.UnifiedOrderItem{
-					ExternalID: fmt.Sprintf("%v", itemMap["id"]),
+					ExternalID: shopifyID(itemMap["id"]),
+					ProductID:  optionalShopifyID(itemMap["product_id"]),
+					VariantID:  optionalShopifyID(itemMap["variant_id"]),
 					Name:       getString(itemMap, "name"),
 					SKU:        getString(itemMap, "sku"),
 					Quantity:   qty,
@@ -202,3 +281,23 @@ func (uc *SyncOrdersUseCase) mapToUnified(integration *core.IntegrationWithCrede
 		ImportedAt: time.Now(),
 	}, nil
 }
+
+// shopifyID formatea un ID numérico de Shopify decodificado de JSON (float64) sin notación científica
+func shopifyID(value interface{}) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', 0, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// optionalShopifyID retorna el ID o nil si Shopify no lo envía (productos eliminados o items personalizados)
+func optionalShopifyID(value interface{}) *string {
+	if value == nil {
+		return nil
+	}
+	id := shopifyID(value)
+	if id == "" {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,57 @@
+package domain
+
+// Valores de Shopify usados al mapear el catálogo
+const (
+	// ShopifyDefaultOptionName es el eje que Shopify asigna a los productos sin variantes reales
+	ShopifyDefaultOptionName = "Title"
+	// ShopifyInventoryManaged indica que Shopify controla el inventario de la variante
+	ShopifyInventoryManaged = "shopify"
+	// CatalogPageSize es el tamaño de página usado al recorrer el catálogo (máximo del Admin API)
+	CatalogPageSize = 250
+)
+
+// ShopifyProduct es un producto del Admin API de Shopify (solo los campos que se importan)
+type ShopifyProduct struct {
+	ID          int64            `json:"id"`
+	Title       string           `json:"title"`
+	BodyHTML    string           `json:"body_html"`
+	Vendor      string           `json:"vendor"`
+	ProductType string           `json:"product_type"`
+	Handle      string           `json:"handle"`
+	Status      string           `json:"status"`
+	Tags        string           `json:"tags"` // Separados por coma
+	Options     []ShopifyOption  `json:"options"`
+	Variants    []ShopifyVariant `json:"variants"`
+	Images      []ShopifyImage   `json:"images"`
+}
+
+// ShopifyOption es un eje de opción de un producto de Shopify (ej: "Size")
+type ShopifyOption struct {
+	Name     string   `json:"name"`
+	Position int      `json:"position"`
+	Values   []string `json:"values"`
+}
+
+// ShopifyVariant es una variante de un producto de Shopify
+type ShopifyVariant struct {
+	ID                  int64   `json:"id"`
+	Title               string  `json:"title"`
+	SKU                 string  `json:"sku"`
+	Barcode             *string `json:"barcode"`
+	Price               string  `json:"price"`
+	CompareAtPrice      *string `json:"compare_at_price"`
+	Position            int     `json:"position"`
+	Option1             *string `json:"option1"`
+	Option2             *string `json:"option2"`
+	Option3             *string `json:"option3"`
+	InventoryQuantity   int     `json:"inventory_quantity"`
+	InventoryManagement *string `json:"inventory_management"`
+	Weight              float64 `json:"weight"`
+	WeightUnit          string  `json:"weight_unit"`
+}
+
+// ShopifyImage es una imagen de un producto de Shopify
+type ShopifyImage struct {
+	Src      string `json:"src"`
+	Position int    `json:"position"`
+}
@@ -82,8 +82,37 @@ type UnifiedAddress struct {
 
 type UnifiedOrderItem struct {
 	ExternalID string  `json:"external_id"`
+	ProductID  *string `json:"product_id,omitempty"` // ID del producto en Shopify
+	VariantID  *string `json:"variant_id,omitempty"` // ID de la variante en Shopify (resuelve la variante del catálogo)
 	Name       string  `json:"name"`
 	SKU        string  `json:"sku"`
 	Quantity   int     `json:"quantity"`
 	UnitPrice  float64 `json:"unit_price"`
 }
+
+// SyncOrdersParams defines which store to sync and from when.
+// BusinessID, when set, restricts the sync to integrations of that business.
+// UpdatedMin also picks up orders created earlier but modified since the last sync.
+type SyncOrdersParams struct {
+	IntegrationID uint
+	BusinessID    *uint
+	CreatedMin    *time.Time
+	UpdatedMin    *time.Time
+}
+
+// StoreSyncResult is the outcome of syncing one store of a business
+type StoreSyncResult struct {
+	IntegrationID   uint   `json:"integration_id"`
+	IntegrationName string `json:"integration_name"`
+	OrdersPublished int    `json:"orders_published"`
+	Error           string `json:"error,omitempty"`
+}
+
+// WebhookRequest is an incoming Shopify webhook addressed to a specific integration
+type WebhookRequest struct {
+	IntegrationID uint
+	Topic         string // X-Shopify-Topic, e.g. "orders/create"
+	ShopDomain    string // X-Shopify-Shop-Domain
+	HMAC          string // X-Shopify-Hmac-Sha256 (base64)
+	Body          []byte // Raw body, required to verify the signature
+}
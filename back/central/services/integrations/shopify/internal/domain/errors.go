@@ -0,0 +1,44 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrUnauthorized indica que Shopify rechazó el access token (HTTP 401/403)
+	ErrUnauthorized = errors.New("shopify rejected the access token")
+
+	// ErrNotShopifyIntegration indica que la integración solicitada no es de tipo Shopify
+	ErrNotShopifyIntegration = errors.New("integration is not a shopify integration")
+
+	// ErrIntegrationInactive indica que la integración está desactivada
+	ErrIntegrationInactive = errors.New("integration is not active")
+
+	// ErrIntegrationForbidden indica que la integración pertenece a otro negocio
+	ErrIntegrationForbidden = errors.New("integration belongs to another business")
+
+	// ErrMissingCredentials indica que faltan el access token o el store_name de la tienda
+	ErrMissingCredentials = errors.New("shopify store credentials are incomplete")
+
+	// ErrInvalidWebhookSignature indica que la firma HMAC del webhook no es válida o no se puede verificar
+	ErrInvalidWebhookSignature = errors.New("invalid shopify webhook signature")
+
+	// ErrWebhookShopMismatch indica que el dominio del webhook no corresponde a la tienda de la integración
+	ErrWebhookShopMismatch = errors.New("webhook shop domain does not match the integration store")
+
+	// ErrShopifyUnprocessable indica que Shopify rechazó la operación (HTTP 422); reintentar no la corrige
+	ErrShopifyUnprocessable = errors.New("shopify rejected the request")
+
+	// ErrNoOpenFulfillmentOrders indica que la orden no tiene fulfillment orders pendientes en Shopify
+	ErrNoOpenFulfillmentOrders = errors.New("order has no open fulfillment orders in shopify")
+
+	// ErrOrderNotFound indica que la orden referenciada por el evento no existe
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrFulfillmentSyncNotFound indica que el registro de write-back no existe
+	ErrFulfillmentSyncNotFound = errors.New("fulfillment sync not found")
+
+	// ErrFulfillmentSyncInProgress indica que el write-back se está procesando y no se puede reintentar ahora
+	ErrFulfillmentSyncInProgress = errors.New("fulfillment sync is being processed")
+
+	// ErrNoRefundableTransactions indica que la orden no tiene pagos exitosos en Shopify contra los que reembolsar
+	ErrNoRefundableTransactions = errors.New("order has no refundable transactions in shopify")
+)
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Acciones de write-back hacia Shopify
+const (
+	FulfillmentActionFulfill           = "fulfill"
+	FulfillmentActionCancelFulfillment = "cancel_fulfillment"
+	FulfillmentActionCancelOrder       = "cancel_order"
+)
+
+// Estados del write-back
+const (
+	FulfillmentSyncPending = "pending"
+	FulfillmentSyncSynced  = "synced"
+	FulfillmentSyncFailed  = "failed"
+)
+
+// Eventos y estados consumidos desde los módulos de órdenes y envíos
+const (
+	ShipmentEventCreated    = "shipment.created"
+	ShipmentEventUpdated    = "shipment.updated"
+	ShipmentEventDeleted    = "shipment.deleted"
+	OrderEventStatusChanged = "order.status_changed"
+	OrderEventCancelled     = "order.cancelled"
+	ShipmentStatusCancelled = "cancelled"
+	OrderStatusCancelled    = "cancelled"
+
+	// EventSourceKey es la clave de Data.Extra con el origen del cambio (p. ej. la plataforma que lo reportó)
+	EventSourceKey = "source"
+)
+
+// Política de reintentos del write-back
+const (
+	FulfillmentMaxAttempts   = 8
+	FulfillmentRetryBaseWait = time.Minute
+	FulfillmentRetryMaxWait  = 6 * time.Hour
+)
+
+// FulfillmentSync es el registro de write-back de un envío o cancelación hacia Shopify
+type FulfillmentSync struct {
+	ID              uint       `json:"id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	SyncKey         string     `json:"sync_key"`
+	BusinessID      *uint      `json:"business_id,omitempty"`
+	IntegrationID   uint       `json:"integration_id"`
+	OrderID         string     `json:"order_id"`
+	ExternalOrderID string     `json:"external_order_id"`
+	ShipmentID      *uint      `json:"shipment_id,omitempty"`
+	Action          string     `json:"action"`
+	TrackingNumber  string     `json:"tracking_number,omitempty"`
+	TrackingURL     string     `json:"tracking_url,omitempty"`
+	Carrier         string     `json:"carrier,omitempty"`
+	FulfillmentID   string     `json:"fulfillment_id,omitempty"`
+	Status          string     `json:"status"`
+	Attempts        int        `json:"attempts"`
+	LastError       string     `json:"last_error,omitempty"`
+	NextRetryAt     *time.Time `json:"next_retry_at,omitempty"`
+	SyncedAt        *time.Time `json:"synced_at,omitempty"`
+}
+
+// FulfillmentSyncFilters filtra el listado de write-backs
+type FulfillmentSyncFilters struct {
+	BusinessID *uint
+	OrderID    string
+	Status     string
+	Page       int
+	PageSize   int
+}
+
+// OrderReference son los datos mínimos de una orden para escribir en su tienda de origen
+type OrderReference struct {
+	ID              string
+	BusinessID      *uint
+	IntegrationID   uint
+	IntegrationType string
+	ExternalID      string
+}
+
+// FulfillmentOrder es un fulfillment order de Shopify (agrupa las líneas a despachar desde una ubicación)
+type FulfillmentOrder struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+// IsFulfillable indica si el fulfillment order acepta nuevos fulfillments
+func (fo FulfillmentOrder) IsFulfillable() bool {
+	return fo.Status == "open" || fo.Status == "in_progress"
+}
+
+// TrackingInfo es la información de tracking que se envía a Shopify
+type TrackingInfo struct {
+	Number  string
+	URL     string
+	Company string
+}
+
+// ShipmentEvent es el evento publicado por el módulo de envíos
+type ShipmentEvent struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	ShipmentID uint      `json:"shipment_id"`
+	OrderID    string    `json:"order_id"`
+	Timestamp  time.Time `json:"timestamp"`
+	Data       struct {
+		TrackingNumber *string `json:"tracking_number,omitempty"`
+		TrackingURL    *string `json:"tracking_url,omitempty"`
+		Carrier        *string `json:"carrier,omitempty"`
+		PreviousStatus string  `json:"previous_status,omitempty"`
+		CurrentStatus  string  `json:"current_status"`
+	} `json:"data"`
+}
+
+// OrderEvent es el evento publicado por el módulo de órdenes
+type OrderEvent struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	OrderID       string    `json:"order_id"`
+	BusinessID    *uint     `json:"business_id,omitempty"`
+	IntegrationID *uint     `json:"integration_id,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+	Data          struct {
+		ExternalID     string                 `json:"external_id,omitempty"`
+		PreviousStatus string                 `json:"previous_status,omitempty"`
+		CurrentStatus  string                 `json:"current_status,omitempty"`
+		Platform       string                 `json:"platform,omitempty"`
+		Extra          map[string]interface{} `json:"extra,omitempty"`
+	} `json:"data"`
+}
+
+// Source retorna el origen del cambio que generó el evento; vacío si no lo informa
+func (e *OrderEvent) Source() string {
+	source, _ := e.Data.Extra[EventSourceKey].(string)
+	return source
+}
+
+// ShipmentSyncKey es la clave idempotente del write-back de un envío
+func ShipmentSyncKey(shipmentID uint) string {
+	return fmt.Sprintf("shipment:%d", shipmentID)
+}
+
+// OrderCancelSyncKey es la clave idempotente de la cancelación de una orden
+func OrderCancelSyncKey(orderID string) string {
+	return fmt.Sprintf("order:%s:cancel", orderID)
+}
+
+// NextFulfillmentRetry calcula el próximo reintento con backoff exponencial; nil si se agotaron los intentos
+func NextFulfillmentRetry(attempts int, now time.Time) *time.Time {
+	if attempts >= FulfillmentMaxAttempts {
+		return nil
+	}
+
+	wait := FulfillmentRetryBaseWait
+	for i := 1; i < attempts && wait < FulfillmentRetryMaxWait; i++ {
+		wait *= 2
+	}
+	if wait > FulfillmentRetryMaxWait {
+		wait = FulfillmentRetryMaxWait
+	}
+
+	next := now.Add(wait)
+	return &next
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // OrderPublisher defines the interface for publishing orders to the system (e.g., via RabbitMQ)
@@ -17,4 +18,57 @@ type ShopifyClient interface {
 	// FetchOrders retrieves orders from Shopify.
 	// Returns a list of orders (as maps/structs) and the next page cursor/link if any.
 	FetchOrders(ctx context.Context, storeName, accessToken string, params map[string]string) ([]map[string]interface{}, string, error)
+
+	// ListFulfillmentOrders retrieves the fulfillment orders of a Shopify order
+	ListFulfillmentOrders(ctx context.Context, storeName, accessToken, orderID string) ([]FulfillmentOrder, error)
+
+	// CreateFulfillment fulfills the given fulfillment orders with tracking info and returns the fulfillment ID
+	CreateFulfillment(ctx context.Context, storeName, accessToken string, fulfillmentOrderIDs []int64, tracking TrackingInfo, notifyCustomer bool) (string, error)
+
+	// UpdateFulfillmentTracking updates the tracking info of an existing fulfillment
+	UpdateFulfillmentTracking(ctx context.Context, storeName, accessToken, fulfillmentID string, tracking TrackingInfo, notifyCustomer bool) error
+
+	// CancelFulfillment cancels an existing fulfillment
+	CancelFulfillment(ctx context.Context, storeName, accessToken, fulfillmentID string) error
+
+	// CancelOrder cancels an order in Shopify
+	CancelOrder(ctx context.Context, storeName, accessToken, orderID string) error
+
+	// FetchProducts retrieves a page of the store catalog.
+	// pageInfo is the cursor returned by the previous page (empty = first page).
+	FetchProducts(ctx context.Context, storeName, accessToken, pageInfo string) ([]ShopifyProduct, string, error)
+
+	// ListOrderLineItems retrieves the line items of a Shopify order
+	ListOrderLineItems(ctx context.Context, storeName, accessToken, orderID string) ([]ShopifyLineItem, error)
+
+	// ListOrderTransactions retrieves the payment transactions of a Shopify order
+	ListOrderTransactions(ctx context.Context, storeName, accessToken, orderID string) ([]ShopifyTransaction, error)
+
+	// CreateRefund creates a refund on a Shopify order and returns the refund ID
+	CreateRefund(ctx context.Context, storeName, accessToken, orderID string, refund RefundRequest) (string, error)
+}
+
+// IFulfillmentRepository persists the fulfillment write-back state
+type IFulfillmentRepository interface {
+	GetOrderReference(ctx context.Context, orderID string) (*OrderReference, error)
+	GetFulfillmentSyncByKey(ctx context.Context, syncKey string) (*FulfillmentSync, error)
+	GetFulfillmentSyncByID(ctx context.Context, id uint) (*FulfillmentSync, error)
+
+	// UpsertPendingFulfillmentSync saves the desired state as pending (upsert on sync_key). It returns false
+	// when the record already had that same state, e.g. another replica handled the same event first
+	UpsertPendingFulfillmentSync(ctx context.Context, sync *FulfillmentSync, dueAt time.Time) (bool, error)
+
+	// SaveFulfillmentSyncResult saves the outcome of processing the record only if its desired state did not
+	// change meanwhile; otherwise it keeps the new state, stores the fulfillment ID and leaves it due at dueAt
+	SaveFulfillmentSyncResult(ctx context.Context, sync *FulfillmentSync, dueAt time.Time) (bool, error)
+	ListFulfillmentSyncs(ctx context.Context, filters FulfillmentSyncFilters) ([]*FulfillmentSync, int64, error)
+
+	// ListDueFulfillmentSyncs lists pending/failed write-backs whose retry time has passed
+	ListDueFulfillmentSyncs(ctx context.Context, now time.Time, limit int) ([]*FulfillmentSync, error)
+
+	// ClaimFulfillmentSync atomically moves next_retry_at forward so that only one worker processes the record
+	ClaimFulfillmentSync(ctx context.Context, id uint, expectedNextRetryAt time.Time, leaseUntil time.Time) (bool, error)
+	// ClaimFulfillmentSyncForRetry reserves a record for a manual retry (resetting its attempts) unless another
+	// process holds an active lease on it
+	ClaimFulfillmentSyncForRetry(ctx context.Context, id uint, now time.Time, leaseUntil time.Time) (bool, error)
 }
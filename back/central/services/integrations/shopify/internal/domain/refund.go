@@ -0,0 +1,56 @@
+package domain
+
+// Valores de Shopify usados al registrar reembolsos
+const (
+	// TransactionKindSale y TransactionKindCapture son las transacciones que se pueden reembolsar
+	TransactionKindSale    = "sale"
+	TransactionKindCapture = "capture"
+	// TransactionKindRefund es el tipo de las transacciones de reembolso
+	TransactionKindRefund = "refund"
+	// TransactionStatusSuccess es el estado de una transacción exitosa
+	TransactionStatusSuccess = "success"
+	// RefundRestockNone indica que las líneas reembolsadas no regresan al inventario de Shopify
+	// (el inventario lo gestiona Probability)
+	RefundRestockNone = "no_restock"
+)
+
+// ShopifyLineItem es una línea de una orden de Shopify (solo los campos usados en reembolsos)
+type ShopifyLineItem struct {
+	ID        int64  `json:"id"`
+	VariantID *int64 `json:"variant_id"`
+	SKU       string `json:"sku"`
+	Quantity  int    `json:"quantity"`
+}
+
+// ShopifyTransaction es una transacción de pago de una orden de Shopify
+type ShopifyTransaction struct {
+	ID       int64  `json:"id"`
+	ParentID *int64 `json:"parent_id"` // Transacción sobre la que se hizo (reembolsos y capturas)
+	Kind     string `json:"kind"`
+	Status   string `json:"status"`
+	Gateway  string `json:"gateway"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// RefundRequest es el reembolso a crear en una orden de Shopify
+type RefundRequest struct {
+	Currency     string
+	Note         string
+	Notify       bool
+	Lines        []RefundLineItem
+	Transactions []RefundTransaction
+}
+
+// RefundLineItem es una línea incluida en el reembolso
+type RefundLineItem struct {
+	LineItemID int64
+	Quantity   int
+}
+
+// RefundTransaction es el dinero reembolsado contra una transacción de la orden
+type RefundTransaction struct {
+	ParentID int64
+	Amount   float64
+	Gateway  string
+}
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// handleTimeout límite para procesar un evento (incluye llamadas al Admin API de Shopify)
+const handleTimeout = time.Minute
+
+// Subscriber consume eventos de órdenes y envíos desde Redis Pub/Sub para el write-back a Shopify
+type Subscriber struct {
+	redisClient     redisclient.IRedis
+	usecase         *usecases.FulfillmentWritebackUseCase
+	logger          log.ILogger
+	orderChannel    string
+	shipmentChannel string
+}
+
+// New crea el suscriptor de eventos del write-back de fulfillments
+func New(
+	redisClient redisclient.IRedis,
+	usecase *usecases.FulfillmentWritebackUseCase,
+	logger log.ILogger,
+	orderChannel string,
+	shipmentChannel string,
+) *Subscriber {
+	return &Subscriber{
+		redisClient:     redisClient,
+		usecase:         usecase,
+		logger:          logger,
+		orderChannel:    orderChannel,
+		shipmentChannel: shipmentChannel,
+	}
+}
+
+// Start se suscribe a los canales y procesa los eventos en background
+func (s *Subscriber) Start(ctx context.Context) error {
+	client := s.redisClient.Client(ctx)
+	if client == nil {
+		return fmt.Errorf("redis client no disponible")
+	}
+
+	pubsub := client.Subscribe(ctx, s.orderChannel, s.shipmentChannel)
+
+	s.logger.Info(ctx).
+		Str("order_channel", s.orderChannel).
+		Str("shipment_channel", s.shipmentChannel).
+		Msg("Suscriptor Redis iniciado para write-back de fulfillments Shopify")
+
+	go func() {
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+
+		for {
+			select {
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				s.handleMessage(ctx, msg.Channel, msg.Payload)
+			case <-ctx.Done():
+				s.logger.Info(ctx).Msg("Context cancelado, deteniendo suscriptor de fulfillments")
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *Subscriber) handleMessage(ctx context.Context, channel, payload string) {
+	handleCtx, cancel := context.WithTimeout(ctx, handleTimeout)
+	defer cancel()
+
+	var err error
+	switch channel {
+	case s.shipmentChannel:
+		var event domain.ShipmentEvent
+		if err = json.Unmarshal([]byte(payload), &event); err == nil {
+			err = s.usecase.HandleShipmentEvent(handleCtx, &event)
+		}
+	case s.orderChannel:
+		var event domain.OrderEvent
+		if err = json.Unmarshal([]byte(payload), &event); err == nil {
+			err = s.usecase.HandleOrderEvent(handleCtx, &event)
+		}
+	default:
+		return
+	}
+
+	if err != nil {
+		s.logger.Error(ctx).
+			Err(err).
+			Str("channel", channel).
+			Msg("Error procesando evento para write-back de fulfillment")
+	}
+}
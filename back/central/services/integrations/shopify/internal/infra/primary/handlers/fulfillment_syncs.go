@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+// ListFulfillmentSyncs lista los write-backs de fulfillment a Shopify (filtros: status, order_id)
+func (h *ShopifyHandlers) ListFulfillmentSyncs(c *gin.Context) {
+	businessID, ok := resolveBusinessScope(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid business_id"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	syncs, total, err := h.fulfillmentUseCase.List(c.Request.Context(), domain.FulfillmentSyncFilters{
+		BusinessID: businessID,
+		OrderID:    c.Query("order_id"),
+		Status:     c.Query("status"),
+		Page:       page,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		c.JSON(statusFromError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data":  syncs,
+		"total": total,
+		"page":  page,
+	})
+}
+
+// RetryFulfillmentSync reintenta de inmediato un write-back de fulfillment
+func (h *ShopifyHandlers) RetryFulfillmentSync(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid fulfillment sync id"})
+		return
+	}
+
+	businessID, ok := resolveBusinessScope(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid business_id"})
+		return
+	}
+
+	sync, err := h.fulfillmentUseCase.Retry(c.Request.Context(), uint(id), businessID)
+	if err != nil {
+		c.JSON(statusFromError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": sync})
+}
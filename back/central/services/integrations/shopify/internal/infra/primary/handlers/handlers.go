@@ -1,45 +1,163 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+	"github.com/secamc93/probability/back/central/services/integrations/core"
 	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
 )
 
 type ShopifyHandlers struct {
-	syncUseCase *usecases.SyncOrdersUseCase
+	syncUseCase        *usecases.SyncOrdersUseCase
+	webhookUseCase     *usecases.ProcessWebhookUseCase
+	fulfillmentUseCase *usecases.FulfillmentWritebackUseCase
 }
 
-func New(syncUseCase *usecases.SyncOrdersUseCase) *ShopifyHandlers {
+func New(
+	syncUseCase *usecases.SyncOrdersUseCase,
+	webhookUseCase *usecases.ProcessWebhookUseCase,
+	fulfillmentUseCase *usecases.FulfillmentWritebackUseCase,
+) *ShopifyHandlers {
 	return &ShopifyHandlers{
-		syncUseCase: syncUseCase,
+		syncUseCase:        syncUseCase,
+		webhookUseCase:     webhookUseCase,
+		fulfillmentUseCase: fulfillmentUseCase,
 	}
 }
 
+// SyncOrders sincroniza todas las tiendas Shopify activas del negocio del token.
+// Los super admins pueden indicar ?business_id=, o sincronizar todos los negocios si lo omiten.
 func (h *ShopifyHandlers) SyncOrders(c *gin.Context) {
-	// Get Business ID from context (assuming middleware sets it)
-	// businessID := c.GetUint("business_id")
+	businessID, ok := resolveBusinessScope(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid business_id"})
+		return
+	}
+
+	results, err := h.syncUseCase.ExecuteForBusiness(c.Request.Context(), businessID, parseSince(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
-	// Let's assume we sync the default shopify integration for the business.
-	businessID := uint(1) // Placeholder
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Sync finished and orders published to queue",
+		"stores":  results,
+	})
+}
 
-	// Parse optional created_at_min
-	var createdMin *time.Time
-	if dateStr := c.Query("since"); dateStr != "" {
-		t, err := time.Parse(time.RFC3339, dateStr)
-		if err == nil {
-			createdMin = &t
-		}
+// SyncIntegrationOrders sincroniza una tienda Shopify específica por ID de integración
+func (h *ShopifyHandlers) SyncIntegrationOrders(c *gin.Context) {
+	integrationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid integration id"})
+		return
 	}
 
-	// Trigger sync (async ideally, but sync for now)
-	err := h.syncUseCase.Execute(c.Request.Context(), &businessID, createdMin)
+	businessID, ok := resolveBusinessScope(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid business_id"})
+		return
+	}
+
+	published, err := h.syncUseCase.Execute(c.Request.Context(), domain.SyncOrdersParams{
+		IntegrationID: uint(integrationID),
+		BusinessID:    businessID,
+		CreatedMin:    parseSince(c),
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(statusFromError(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Sync started and orders published to queue"})
+	c.JSON(http.StatusOK, gin.H{
+		"message":          "Sync finished and orders published to queue",
+		"integration_id":   integrationID,
+		"orders_published": published,
+	})
+}
+
+// ReceiveWebhook recibe webhooks de Shopify dirigidos a una integración específica.
+// Shopify no envía JWT: la autenticidad se verifica con la firma HMAC.
+func (h *ShopifyHandlers) ReceiveWebhook(c *gin.Context) {
+	integrationID, err := strconv.ParseUint(c.Param("integration_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid integration id"})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read body"})
+		return
+	}
+
+	processed, err := h.webhookUseCase.Execute(c.Request.Context(), domain.WebhookRequest{
+		IntegrationID: uint(integrationID),
+		Topic:         c.GetHeader("X-Shopify-Topic"),
+		ShopDomain:    c.GetHeader("X-Shopify-Shop-Domain"),
+		HMAC:          c.GetHeader("X-Shopify-Hmac-Sha256"),
+		Body:          body,
+	})
+	if err != nil {
+		c.JSON(statusFromError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"processed": processed})
+}
+
+// resolveBusinessScope retorna el negocio al que se restringe la operación (nil = sin restricción, solo super admin)
+func resolveBusinessScope(c *gin.Context) (*uint, bool) {
+	if !middleware.IsSuperAdmin(c) {
+		businessID, ok := middleware.GetBusinessIDFromContext(c)
+		return &businessID, ok
+	}
+
+	if businessIDStr := c.Query("business_id"); businessIDStr != "" {
+		parsed, err := strconv.ParseUint(businessIDStr, 10, 32)
+		if err != nil {
+			return nil, false
+		}
+		businessID := uint(parsed)
+		return &businessID, true
+	}
+
+	return nil, true
+}
+
+// parseSince parsea el parámetro opcional since (RFC3339) usado como created_at_min
+func parseSince(c *gin.Context) *time.Time {
+	if dateStr := c.Query("since"); dateStr != "" {
+		if t, err := time.Parse(time.RFC3339, dateStr); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, core.ErrIntegrationNotFound), errors.Is(err, domain.ErrFulfillmentSyncNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrIntegrationForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrFulfillmentSyncInProgress):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidWebhookSignature), errors.Is(err, domain.ErrWebhookShopMismatch):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrNotShopifyIntegration),
+		errors.Is(err, domain.ErrIntegrationInactive),
+		errors.Is(err, domain.ErrMissingCredentials):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
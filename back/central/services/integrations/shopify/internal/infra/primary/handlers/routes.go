@@ -2,11 +2,20 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
 )
 
 func (h *ShopifyHandlers) RegisterRoutes(router *gin.RouterGroup) {
 	shopifyGroup := router.Group("/shopify")
 	{
-		shopifyGroup.POST("/sync", h.SyncOrders)
+		shopifyGroup.POST("/sync", middleware.JWT(), h.SyncOrders)
+		shopifyGroup.POST("/integrations/:id/sync", middleware.JWT(), h.SyncIntegrationOrders)
+
+		// Write-back de fulfillments (tracking y cancelaciones) hacia Shopify
+		shopifyGroup.GET("/fulfillment-syncs", middleware.JWT(), h.ListFulfillmentSyncs)
+		shopifyGroup.POST("/fulfillment-syncs/:id/retry", middleware.JWT(), h.RetryFulfillmentSync)
+
+		// Webhooks: uno por tienda, autenticados con HMAC
+		shopifyGroup.POST("/webhooks/:integration_id", h.ReceiveWebhook)
 	}
 }
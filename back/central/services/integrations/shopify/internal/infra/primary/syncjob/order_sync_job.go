@@ -0,0 +1,45 @@
+package syncjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+// orderSyncJob adapta la sincronización de órdenes de Shopify al scheduler de integraciones.
+// El cursor es la fecha (RFC3339) de inicio de la última ejecución exitosa y se usa como updated_at_min,
+// así también se re-sincronizan las órdenes creadas antes pero modificadas después (pagos, cancelaciones...).
+type orderSyncJob struct {
+	syncUseCase *usecases.SyncOrdersUseCase
+}
+
+func New(syncUseCase *usecases.SyncOrdersUseCase) scheduler.ISyncJob {
+	return &orderSyncJob{
+		syncUseCase: syncUseCase,
+	}
+}
+
+func (j *orderSyncJob) Sync(ctx context.Context, req scheduler.SyncRequest) (*scheduler.SyncResult, error) {
+	var updatedMin *time.Time
+	if req.Cursor != "" {
+		if t, err := time.Parse(time.RFC3339, req.Cursor); err == nil {
+			updatedMin = &t
+		}
+	}
+
+	published, err := j.syncUseCase.Execute(ctx, domain.SyncOrdersParams{
+		IntegrationID: req.IntegrationID,
+		UpdatedMin:    updatedMin,
+	})
+	if err != nil {
+		return &scheduler.SyncResult{ItemsProcessed: published}, err
+	}
+
+	return &scheduler.SyncResult{
+		Cursor:         req.StartedAt.UTC().Format(time.RFC3339),
+		ItemsProcessed: published,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+const (
+	// tickInterval cada cuánto se revisan los write-backs con reintento vencido
+	tickInterval = time.Minute
+	// batchSize máximo de write-backs reprocesados por ciclo
+	batchSize = 50
+)
+
+// FulfillmentRetryWorker reintenta los write-backs de fulfillment fallidos hacia Shopify
+type FulfillmentRetryWorker struct {
+	usecase *usecases.FulfillmentWritebackUseCase
+	logger  log.ILogger
+}
+
+// New crea el worker de reintentos de fulfillment
+func New(usecase *usecases.FulfillmentWritebackUseCase, logger log.ILogger) *FulfillmentRetryWorker {
+	return &FulfillmentRetryWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *FulfillmentRetryWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de reintentos de fulfillment Shopify iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := w.usecase.ProcessDue(ctx, batchSize); err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al reintentar write-backs de fulfillment")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de reintentos de fulfillment")
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+func (c *shopifyClient) FetchProducts(ctx context.Context, storeName, accessToken, pageInfo string) ([]domain.ShopifyProduct, string, error) {
+	if !strings.HasSuffix(storeName, ".myshopify.com") {
+		storeName = storeName + ".myshopify.com"
+	}
+
+	// Con page_info Shopify solo admite limit: los demás filtros viajan en el cursor
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(domain.CatalogPageSize))
+	if pageInfo != "" {
+		query.Set("page_info", pageInfo)
+	}
+	endpoint := fmt.Sprintf("https://%s/admin/api/%s/products.json?%s", storeName, adminAPIVersion, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("X-Shopify-Access-Token", accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, "", fmt.Errorf("%w: shopify api returned status: %d", domain.ErrUnauthorized, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return nil, "", fmt.Errorf("failed to fetch products, status: %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Products []domain.ShopifyProduct `json:"products"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", err
+	}
+
+	return result.Products, pageInfoFromLink(parseLinkHeader(resp.Header.Get("Link"))), nil
+}
+
+// pageInfoFromLink extrae el cursor page_info de la URL de la página siguiente
+func pageInfoFromLink(nextURL string) string {
+	if nextURL == "" {
+		return ""
+	}
+	parsed, err := url.Parse(nextURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Query().Get("page_info")
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+const adminAPIVersion = "2024-01"
+
+func (c *shopifyClient) ListFulfillmentOrders(ctx context.Context, storeName, accessToken, orderID string) ([]domain.FulfillmentOrder, error) {
+	var result struct {
+		FulfillmentOrders []domain.FulfillmentOrder `json:"fulfillment_orders"`
+	}
+	path := fmt.Sprintf("orders/%s/fulfillment_orders.json", orderID)
+	if err := c.doJSON(ctx, http.MethodGet, storeName, accessToken, path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to list fulfillment orders: %w", err)
+	}
+	return result.FulfillmentOrders, nil
+}
+
+func (c *shopifyClient) CreateFulfillment(ctx context.Context, storeName, accessToken string, fulfillmentOrderIDs []int64, tracking domain.TrackingInfo, notifyCustomer bool) (string, error) {
+	lineItems := make([]map[string]interface{}, 0, len(fulfillmentOrderIDs))
+	for _, id := range fulfillmentOrderIDs {
+		lineItems = append(lineItems, map[string]interface{}{"fulfillment_order_id": id})
+	}
+
+	body := map[string]interface{}{
+		"fulfillment": map[string]interface{}{
+			"line_items_by_fulfillment_order": lineItems,
+			"tracking_info":                   trackingInfoPayload(tracking),
+			"notify_customer":                 notifyCustomer,
+		},
+	}
+
+	var result struct {
+		Fulfillment struct {
+			ID int64 `json:"id"`
+		} `json:"fulfillment"`
+	}
+	if err := c.doJSON(ctx, http.MethodPost, storeName, accessToken, "fulfillments.json", body, &result); err != nil {
+		return "", fmt.Errorf("failed to create fulfillment: %w", err)
+	}
+	return strconv.FormatInt(result.Fulfillment.ID, 10), nil
+}
+
+func (c *shopifyClient) UpdateFulfillmentTracking(ctx context.Context, storeName, accessToken, fulfillmentID string, tracking domain.TrackingInfo, notifyCustomer bool) error {
+	body := map[string]interface{}{
+		"fulfillment": map[string]interface{}{
+			"tracking_info":   trackingInfoPayload(tracking),
+			"notify_customer": notifyCustomer,
+		},
+	}
+	path := fmt.Sprintf("fulfillments/%s/update_tracking.json", fulfillmentID)
+	if err := c.doJSON(ctx, http.MethodPost, storeName, accessToken, path, body, nil); err != nil {
+		return fmt.Errorf("failed to update fulfillment tracking: %w", err)
+	}
+	return nil
+}
+
+func (c *shopifyClient) CancelFulfillment(ctx context.Context, storeName, accessToken, fulfillmentID string) error {
+	path := fmt.Sprintf("fulfillments/%s/cancel.json", fulfillmentID)
+	if err := c.doJSON(ctx, http.MethodPost, storeName, accessToken, path, map[string]interface{}{}, nil); err != nil {
+		return fmt.Errorf("failed to cancel fulfillment: %w", err)
+	}
+	return nil
+}
+
+func (c *shopifyClient) CancelOrder(ctx context.Context, storeName, accessToken, orderID string) error {
+	path := fmt.Sprintf("orders/%s/cancel.json", orderID)
+	if err := c.doJSON(ctx, http.MethodPost, storeName, accessToken, path, map[string]interface{}{}, nil); err != nil {
+		return fmt.Errorf("failed to cancel order: %w", err)
+	}
+	return nil
+}
+
+// doJSON ejecuta una llamada al Admin API y decodifica la respuesta en out (si no es nil)
+func (c *shopifyClient) doJSON(ctx context.Context, method, storeName, accessToken, path string, body interface{}, out interface{}) error {
+	if !strings.HasSuffix(storeName, ".myshopify.com") {
+		storeName = storeName + ".myshopify.com"
+	}
+	url := fmt.Sprintf("https://%s/admin/api/%s/%s", storeName, adminAPIVersion, path)
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Shopify-Access-Token", accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		switch resp.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("%w: shopify api returned status: %d", domain.ErrUnauthorized, resp.StatusCode)
+		case http.StatusUnprocessableEntity, http.StatusNotFound:
+			return fmt.Errorf("%w: status %d: %s", domain.ErrShopifyUnprocessable, resp.StatusCode, strings.TrimSpace(string(detail)))
+		default:
+			return fmt.Errorf("shopify api returned status: %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func trackingInfoPayload(tracking domain.TrackingInfo) map[string]interface{} {
+	info := map[string]interface{}{"number": tracking.Number}
+	if tracking.URL != "" {
+		info["url"] = tracking.URL
+	}
+	if tracking.Company != "" {
+		info["company"] = tracking.Company
+	}
+	return info
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+)
+
+func (c *shopifyClient) ListOrderLineItems(ctx context.Context, storeName, accessToken, orderID string) ([]domain.ShopifyLineItem, error) {
+	var result struct {
+		Order struct {
+			LineItems []domain.ShopifyLineItem `json:"line_items"`
+		} `json:"order"`
+	}
+	path := fmt.Sprintf("orders/%s.json?fields=id,line_items", orderID)
+	if err := c.doJSON(ctx, http.MethodGet, storeName, accessToken, path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get order line items: %w", err)
+	}
+	return result.Order.LineItems, nil
+}
+
+func (c *shopifyClient) ListOrderTransactions(ctx context.Context, storeName, accessToken, orderID string) ([]domain.ShopifyTransaction, error) {
+	var result struct {
+		Transactions []domain.ShopifyTransaction `json:"transactions"`
+	}
+	path := fmt.Sprintf("orders/%s/transactions.json", orderID)
+	if err := c.doJSON(ctx, http.MethodGet, storeName, accessToken, path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to list order transactions: %w", err)
+	}
+	return result.Transactions, nil
+}
+
+func (c *shopifyClient) CreateRefund(ctx context.Context, storeName, accessToken, orderID string, refund domain.RefundRequest) (string, error) {
+	lineItems := make([]map[string]interface{}, 0, len(refund.Lines))
+	for _, line := range refund.Lines {
+		lineItems = append(lineItems, map[string]interface{}{
+			"line_item_id": line.LineItemID,
+			"quantity":     line.Quantity,
+			"restock_type": domain.RefundRestockNone,
+		})
+	}
+
+	transactions := make([]map[string]interface{}, 0, len(refund.Transactions))
+	for _, tx := range refund.Transactions {
+		transactions = append(transactions, map[string]interface{}{
+			"parent_id": tx.ParentID,
+			"amount":    strconv.FormatFloat(tx.Amount, 'f', 2, 64),
+			"kind":      domain.TransactionKindRefund,
+			"gateway":   tx.Gateway,
+		})
+	}
+
+	payload := map[string]interface{}{
+		"notify":            refund.Notify,
+		"refund_line_items": lineItems,
+		"transactions":      transactions,
+	}
+	if refund.Currency != "" {
+		payload["currency"] = refund.Currency
+	}
+	if refund.Note != "" {
+		payload["note"] = refund.Note
+	}
+
+	var result struct {
+		Refund struct {
+			ID int64 `json:"id"`
+		} `json:"refund"`
+	}
+	path := fmt.Sprintf("orders/%s/refunds.json", orderID)
+	if err := c.doJSON(ctx, http.MethodPost, storeName, accessToken, path, map[string]interface{}{"refund": payload}, &result); err != nil {
+		return "", fmt.Errorf("failed to create refund: %w", err)
+	}
+	return strconv.FormatInt(result.Refund.ID, 10), nil
+}
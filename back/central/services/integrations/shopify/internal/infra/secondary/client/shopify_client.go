@@ -51,6 +51,10 @@ func (c *shopifyClient) ValidateToken(ctx context.Context, storeName, accessToke
 		return true, result, nil
 	}
 
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return false, nil, fmt.Errorf("%w: shopify api returned status: %d", domain.ErrUnauthorized, resp.StatusCode)
+	}
+
 	return false, nil, fmt.Errorf("shopify api returned status: %d", resp.StatusCode)
 }
 
@@ -70,8 +74,8 @@ func (c *shopifyClient) FetchOrders(ctx context.Context, storeName, accessToken
 	for k, v := range params {
 		q.Add(k, v)
 	}
-	// Default params if not present
-	if q.Get("status") == "" {
+	// Default params if not present (con page_info Shopify rechaza los filtros, solo acepta limit)
+	if q.Get("status") == "" && q.Get("page_info") == "" {
 		q.Set("status", "any")
 	}
 	if q.Get("limit") == "" {
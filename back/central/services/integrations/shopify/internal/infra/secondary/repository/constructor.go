@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+type Repository struct {
+	db  db.IDatabase
+	log log.ILogger
+}
+
+// New crea el repositorio del write-back de fulfillments
+func New(database db.IDatabase, logger log.ILogger) domain.IFulfillmentRepository {
+	return &Repository{
+		db:  database,
+		log: logger,
+	}
+}
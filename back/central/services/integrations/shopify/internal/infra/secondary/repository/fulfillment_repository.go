@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/integrations/shopify/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetOrderReference obtiene los datos de origen de una orden
+func (r *Repository) GetOrderReference(ctx context.Context, orderID string) (*domain.OrderReference, error) {
+	var order models.Order
+	err := r.db.Conn(ctx).
+		Select("id", "business_id", "integration_id", "integration_type", "external_id").
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrOrderNotFound, orderID)
+		}
+		r.log.Error(ctx).Err(err).Str("order_id", orderID).Msg("Error al obtener orden")
+		return nil, fmt.Errorf("error al obtener orden: %w", err)
+	}
+
+	return &domain.OrderReference{
+		ID:              order.ID,
+		BusinessID:      order.BusinessID,
+		IntegrationID:   order.IntegrationID,
+		IntegrationType: order.IntegrationType,
+		ExternalID:      order.ExternalID,
+	}, nil
+}
+
+// GetFulfillmentSyncByKey obtiene un write-back por su clave; retorna nil si no existe
+func (r *Repository) GetFulfillmentSyncByKey(ctx context.Context, syncKey string) (*domain.FulfillmentSync, error) {
+	var model models.ShopifyFulfillmentSync
+	if err := r.db.Conn(ctx).Where("sync_key = ?", syncKey).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.log.Error(ctx).Err(err).Str("sync_key", syncKey).Msg("Error al obtener write-back de fulfillment")
+		return nil, fmt.Errorf("error al obtener write-back de fulfillment: %w", err)
+	}
+	return toDomain(&model), nil
+}
+
+// GetFulfillmentSyncByID obtiene un write-back por ID
+func (r *Repository) GetFulfillmentSyncByID(ctx context.Context, id uint) (*domain.FulfillmentSync, error) {
+	var model models.ShopifyFulfillmentSync
+	if err := r.db.Conn(ctx).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %d", domain.ErrFulfillmentSyncNotFound, id)
+		}
+		r.log.Error(ctx).Err(err).Uint("id", id).Msg("Error al obtener write-back de fulfillment")
+		return nil, fmt.Errorf("error al obtener write-back de fulfillment: %w", err)
+	}
+	return toDomain(&model), nil
+}
+
+// UpsertPendingFulfillmentSync guarda el estado deseado del write-back como pendiente (upsert por sync_key).
+// Si el registro ya tenía ese mismo estado no se modifica y retorna false: con varias réplicas recibiendo el
+// mismo evento, solo la primera lo programa. Un registro en proceso (pendiente con lease vigente) conserva
+// su lease y se retoma cuando termina el proceso en curso.
+func (r *Repository) UpsertPendingFulfillmentSync(ctx context.Context, sync *domain.FulfillmentSync, dueAt time.Time) (bool, error) {
+	model := toModel(sync)
+	model.ID = 0
+	model.FulfillmentID = ""
+	model.Status = domain.FulfillmentSyncPending
+	model.Attempts = 0
+	model.LastError = ""
+	model.NextRetryAt = &dueAt
+	model.SyncedAt = nil
+
+	result := r.db.Conn(ctx).Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "sync_key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"updated_at":      dueAt,
+				"deleted_at":      nil,
+				"action":          model.Action,
+				"tracking_number": model.TrackingNumber,
+				"tracking_url":    model.TrackingURL,
+				"carrier":         model.Carrier,
+				"status":          domain.FulfillmentSyncPending,
+				"attempts":        0,
+				"last_error":      "",
+				"synced_at":       nil,
+				"next_retry_at": gorm.Expr(
+					"CASE WHEN shopify_fulfillment_syncs.status = ? AND shopify_fulfillment_syncs.next_retry_at > ? THEN shopify_fulfillment_syncs.next_retry_at ELSE ? END",
+					domain.FulfillmentSyncPending, dueAt, dueAt,
+				),
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{gorm.Expr(
+				"shopify_fulfillment_syncs.deleted_at IS NOT NULL OR " +
+					"(shopify_fulfillment_syncs.action, shopify_fulfillment_syncs.tracking_number, shopify_fulfillment_syncs.tracking_url, shopify_fulfillment_syncs.carrier) " +
+					"IS DISTINCT FROM (excluded.action, excluded.tracking_number, excluded.tracking_url, excluded.carrier)",
+			)}},
+		},
+		clause.Returning{},
+	).Create(model)
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Str("sync_key", sync.SyncKey).Msg("Error al programar write-back de fulfillment")
+		return false, fmt.Errorf("error al programar write-back de fulfillment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	*sync = *toDomain(model)
+	return true, nil
+}
+
+// SaveFulfillmentSyncResult guarda el resultado de procesar el write-back solo si su estado deseado
+// (acción y tracking) no cambió mientras se procesaba. Si cambió, conserva el nuevo estado, guarda el
+// fulfillment creado (para poder actualizarlo o cancelarlo) y lo deja vencido en dueAt para el worker.
+func (r *Repository) SaveFulfillmentSyncResult(ctx context.Context, sync *domain.FulfillmentSync, dueAt time.Time) (bool, error) {
+	result := r.db.Conn(ctx).Model(&models.ShopifyFulfillmentSync{}).
+		Where("id = ? AND action = ? AND tracking_number = ? AND tracking_url = ? AND carrier = ?",
+			sync.ID, sync.Action, sync.TrackingNumber, sync.TrackingURL, sync.Carrier).
+		Updates(map[string]interface{}{
+			"fulfillment_id": sync.FulfillmentID,
+			"status":         sync.Status,
+			"attempts":       sync.Attempts,
+			"last_error":     sync.LastError,
+			"next_retry_at":  sync.NextRetryAt,
+			"synced_at":      sync.SyncedAt,
+		})
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("id", sync.ID).Msg("Error al guardar resultado de write-back de fulfillment")
+		return false, fmt.Errorf("error al guardar resultado de write-back de fulfillment: %w", result.Error)
+	}
+	if result.RowsAffected == 1 {
+		return true, nil
+	}
+
+	superseded := map[string]interface{}{"next_retry_at": dueAt}
+	if sync.FulfillmentID != "" {
+		superseded["fulfillment_id"] = sync.FulfillmentID
+	}
+	if err := r.db.Conn(ctx).Model(&models.ShopifyFulfillmentSync{}).
+		Where("id = ? AND status = ?", sync.ID, domain.FulfillmentSyncPending).
+		Updates(superseded).Error; err != nil {
+		r.log.Error(ctx).Err(err).Uint("id", sync.ID).Msg("Error al liberar write-back de fulfillment reprogramado")
+		return false, fmt.Errorf("error al liberar write-back de fulfillment reprogramado: %w", err)
+	}
+	return false, nil
+}
+
+// ListFulfillmentSyncs lista write-backs paginados
+func (r *Repository) ListFulfillmentSyncs(ctx context.Context, filters domain.FulfillmentSyncFilters) ([]*domain.FulfillmentSync, int64, error) {
+	query := r.db.Conn(ctx).Model(&models.ShopifyFulfillmentSync{})
+	if filters.BusinessID != nil {
+		query = query.Where("business_id = ?", *filters.BusinessID)
+	}
+	if filters.OrderID != "" {
+		query = query.Where("order_id = ?", filters.OrderID)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Error(ctx).Err(err).Msg("Error al contar write-backs de fulfillment")
+		return nil, 0, fmt.Errorf("error al contar write-backs de fulfillment: %w", err)
+	}
+
+	var modelsList []models.ShopifyFulfillmentSync
+	offset := (filters.Page - 1) * filters.PageSize
+	if err := query.Order("updated_at DESC").Offset(offset).Limit(filters.PageSize).Find(&modelsList).Error; err != nil {
+		r.log.Error(ctx).Err(err).Msg("Error al listar write-backs de fulfillment")
+		return nil, 0, fmt.Errorf("error al listar write-backs de fulfillment: %w", err)
+	}
+
+	result := make([]*domain.FulfillmentSync, len(modelsList))
+	for i := range modelsList {
+		result[i] = toDomain(&modelsList[i])
+	}
+	return result, total, nil
+}
+
+// ListDueFulfillmentSyncs lista write-backs pendientes o fallidos cuyo reintento ya venció
+func (r *Repository) ListDueFulfillmentSyncs(ctx context.Context, now time.Time, limit int) ([]*domain.FulfillmentSync, error) {
+	var modelsList []models.ShopifyFulfillmentSync
+	err := r.db.Conn(ctx).
+		Where("status IN ? AND next_retry_at IS NOT NULL AND next_retry_at <= ?",
+			[]string{domain.FulfillmentSyncPending, domain.FulfillmentSyncFailed}, now).
+		Order("next_retry_at ASC").
+		Limit(limit).
+		Find(&modelsList).Error
+	if err != nil {
+		r.log.Error(ctx).Err(err).Msg("Error al listar write-backs de fulfillment vencidos")
+		return nil, fmt.Errorf("error al listar write-backs de fulfillment vencidos: %w", err)
+	}
+
+	result := make([]*domain.FulfillmentSync, len(modelsList))
+	for i := range modelsList {
+		result[i] = toDomain(&modelsList[i])
+	}
+	return result, nil
+}
+
+// ClaimFulfillmentSync reserva un write-back moviendo next_retry_at solo si nadie lo tomó antes
+func (r *Repository) ClaimFulfillmentSync(ctx context.Context, id uint, expectedNextRetryAt time.Time, leaseUntil time.Time) (bool, error) {
+	result := r.db.Conn(ctx).Model(&models.ShopifyFulfillmentSync{}).
+		Where("id = ? AND next_retry_at = ?", id, expectedNextRetryAt).
+		Update("next_retry_at", leaseUntil)
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("id", id).Msg("Error al reservar write-back de fulfillment")
+		return false, fmt.Errorf("error al reservar write-back de fulfillment: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ClaimFulfillmentSyncForRetry reserva un write-back para un reintento manual reiniciando sus intentos,
+// salvo que otro proceso lo tenga reservado (lease vigente)
+func (r *Repository) ClaimFulfillmentSyncForRetry(ctx context.Context, id uint, now time.Time, leaseUntil time.Time) (bool, error) {
+	result := r.db.Conn(ctx).Model(&models.ShopifyFulfillmentSync{}).
+		Where("id = ? AND (next_retry_at IS NULL OR next_retry_at <= ?)", id, now).
+		Updates(map[string]interface{}{
+			"next_retry_at": leaseUntil,
+			"attempts":      0,
+		})
+	if result.Error != nil {
+		r.log.Error(ctx).Err(result.Error).Uint("id", id).Msg("Error al reservar write-back de fulfillment para reintento")
+		return false, fmt.Errorf("error al reservar write-back de fulfillment para reintento: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func toModel(sync *domain.FulfillmentSync) *models.ShopifyFulfillmentSync {
+	model := &models.ShopifyFulfillmentSync{
+		SyncKey:         sync.SyncKey,
+		BusinessID:      sync.BusinessID,
+		IntegrationID:   sync.IntegrationID,
+		OrderID:         sync.OrderID,
+		ExternalOrderID: sync.ExternalOrderID,
+		ShipmentID:      sync.ShipmentID,
+		Action:          sync.Action,
+		TrackingNumber:  sync.TrackingNumber,
+		TrackingURL:     sync.TrackingURL,
+		Carrier:         sync.Carrier,
+		FulfillmentID:   sync.FulfillmentID,
+		Status:          sync.Status,
+		Attempts:        sync.Attempts,
+		LastError:       sync.LastError,
+		NextRetryAt:     sync.NextRetryAt,
+		SyncedAt:        sync.SyncedAt,
+	}
+	model.ID = sync.ID
+	model.CreatedAt = sync.CreatedAt
+	return model
+}
+
+func toDomain(model *models.ShopifyFulfillmentSync) *domain.FulfillmentSync {
+	return &domain.FulfillmentSync{
+		ID:              model.ID,
+		CreatedAt:       model.CreatedAt,
+		UpdatedAt:       model.UpdatedAt,
+		SyncKey:         model.SyncKey,
+		BusinessID:      model.BusinessID,
+		IntegrationID:   model.IntegrationID,
+		OrderID:         model.OrderID,
+		ExternalOrderID: model.ExternalOrderID,
+		ShipmentID:      model.ShipmentID,
+		Action:          model.Action,
+		TrackingNumber:  model.TrackingNumber,
+		TrackingURL:     model.TrackingURL,
+		Carrier:         model.Carrier,
+		FulfillmentID:   model.FulfillmentID,
+		Status:          model.Status,
+		Attempts:        model.Attempts,
+		LastError:       model.LastError,
+		NextRetryAt:     model.NextRetryAt,
+		SyncedAt:        model.SyncedAt,
+	}
+}
@@ -2,6 +2,7 @@ package tester
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/secamc93/probability/back/central/services/integrations/core"
@@ -31,11 +32,14 @@ func (t *shopifyTester) TestConnection(ctx context.Context, config map[string]in
 
 	valid, _, err := t.client.ValidateToken(ctx, storeName, accessToken)
 	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return fmt.Errorf("%w: %w", core.ErrIntegrationAuthFailed, err)
+		}
 		return fmt.Errorf("failed to validate token: %w", err)
 	}
 
 	if !valid {
-		return fmt.Errorf("invalid credentials or store name")
+		return fmt.Errorf("%w: invalid credentials or store name", core.ErrIntegrationAuthFailed)
 	}
 
 	return nil
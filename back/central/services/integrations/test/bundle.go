@@ -1,21 +1,22 @@
 package test
 
 import (
-	"context"
-	"time"
-
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler"
 	"github.com/secamc93/probability/back/central/services/integrations/test/internal/app/generator"
 	"github.com/secamc93/probability/back/central/services/integrations/test/internal/app/usecases"
 	"github.com/secamc93/probability/back/central/services/integrations/test/internal/infra/primary/handlers"
-	"github.com/secamc93/probability/back/central/services/integrations/test/internal/infra/primary/worker"
+	"github.com/secamc93/probability/back/central/services/integrations/test/internal/infra/primary/syncjob"
 	"github.com/secamc93/probability/back/central/services/integrations/test/internal/infra/secondary/queue"
 	"github.com/secamc93/probability/back/central/shared/log"
 	"github.com/secamc93/probability/back/central/shared/rabbitmq"
 )
 
+// IntegrationTypeTest es el tipo de integración cuyas instancias generan órdenes de prueba programadas
+const IntegrationTypeTest = "test"
+
 // New inicializa el módulo de test para generar órdenes aleatorias
-func New(router *gin.RouterGroup, logger log.ILogger, rabbitMQ rabbitmq.IQueue) {
+func New(router *gin.RouterGroup, logger log.ILogger, rabbitMQ rabbitmq.IQueue, syncScheduler scheduler.ISyncScheduler) {
 	// 1. Init Generator
 	orderGenerator := generator.New()
 
@@ -36,24 +37,10 @@ func New(router *gin.RouterGroup, logger log.ILogger, rabbitMQ rabbitmq.IQueue)
 	// 5. Register Routes
 	h.RegisterRoutes(router)
 
-	// 6. Init and Start Scheduler (genera órdenes cada 30 segundos de las tres plataformas)
-	businessID := uint(7)
-	schedulerConfig := &worker.SchedulerConfig{
-		Interval:        30 * time.Second,
-		OrdersPerBatch:  1,
-		IntegrationID:   1, // Se ignorará, se usa por plataforma
-		BusinessID:      &businessID,
-		Platform:        "", // Se rotará entre las tres plataformas
-		Status:          "pending",
-		IncludePayment:  true,
-		IncludeShipment: true,
+	// 6. Register Sync Job with Scheduler (la generación automática se programa por integración de tipo "test")
+	if err := syncScheduler.RegisterJob(IntegrationTypeTest, syncjob.New(uc)); err != nil {
+		logger.Error().Msg("Failed to register test order generation job: " + err.Error())
 	}
 
-	orderScheduler := worker.NewOrderScheduler(uc, logger, schedulerConfig)
-	orderScheduler.Start(context.Background())
-
-	logger.Info().
-		Dur("interval", schedulerConfig.Interval).
-		Int("orders_per_batch", schedulerConfig.OrdersPerBatch).
-		Msg("Test module initialized - automatic order generation started")
+	logger.Info().Msg("Test module initialized - order generation job registered")
 }
@@ -0,0 +1,47 @@
+package syncjob
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/integrations/scheduler"
+	"github.com/secamc93/probability/back/central/services/integrations/test/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/integrations/test/internal/domain"
+)
+
+// simulatedPlatforms son los formatos de orden que genera cada ejecución (uno de cada plataforma)
+var simulatedPlatforms = []string{"shopify", "meli", "woocommerce"}
+
+// orderGenerationJob adapta la generación de órdenes de prueba al scheduler de integraciones:
+// cada integración de tipo "test" con programación genera órdenes a su nombre según su cron.
+type orderGenerationJob struct {
+	useCases *usecases.UseCases
+}
+
+func New(useCases *usecases.UseCases) scheduler.ISyncJob {
+	return &orderGenerationJob{
+		useCases: useCases,
+	}
+}
+
+func (j *orderGenerationJob) Sync(ctx context.Context, req scheduler.SyncRequest) (*scheduler.SyncResult, error) {
+	published := 0
+	for _, platform := range simulatedPlatforms {
+		response, err := j.useCases.GenerateAndPublishOrders(ctx, &domain.GenerateOrderRequest{
+			Count:           1,
+			IntegrationID:   req.IntegrationID,
+			BusinessID:      req.BusinessID,
+			Platform:        platform,
+			Status:          "pending",
+			IncludePayment:  true,
+			IncludeShipment: true,
+		})
+		if response != nil {
+			published += response.Published
+		}
+		if err != nil {
+			return &scheduler.SyncResult{ItemsProcessed: published}, err
+		}
+	}
+
+	return &scheduler.SyncResult{ItemsProcessed: published}, nil
+}
@@ -30,8 +30,9 @@ func New(whatsApp domain.IWhatsApp, logger log.ILogger, config env.IConfig) *Sen
 
 // SendMessage envía un mensaje de WhatsApp con el número de orden
 func (u *SendMessageUsecase) SendMessage(ctx context.Context, req domain.SendMessageRequest) (string, error) {
-	// Validar número de teléfono
-	if err := ValidatePhoneNumber(req.PhoneNumber); err != nil {
+	// Normalizar número de teléfono a E.164 (los números sin código de país usan DEFAULT_PHONE_COUNTRY)
+	number, err := NormalizePhoneNumber(req.PhoneNumber, u.config.Get("DEFAULT_PHONE_COUNTRY"))
+	if err != nil {
 		u.log.Error(ctx).Err(err).
 			Str("phone_number", req.PhoneNumber).
 			Str("order_number", req.OrderNumber).
@@ -56,7 +57,7 @@ func (u *SendMessageUsecase) SendMessage(ctx context.Context, req domain.SendMes
 	msg := domain.TemplateMessage{
 		MessagingProduct: "whatsapp",
 		RecipientType:    "individual",
-		To:               req.PhoneNumber,
+		To:               number.Digits(), // WhatsApp Cloud API espera el número E.164 sin "+"
 		Type:             "template",
 		Template: domain.TemplateData{
 			Name:     "order_status_9",
@@ -2,6 +2,10 @@ package modules
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements"
+	"github.com/secamc93/probability/back/central/services/modules/customers"
+	"github.com/secamc93/probability/back/central/services/modules/drivers"
 	"github.com/secamc93/probability/back/central/services/modules/events"
 	"github.com/secamc93/probability/back/central/services/modules/notification_config"
 	"github.com/secamc93/probability/back/central/services/modules/orders"
@@ -9,29 +13,43 @@ import (
 	"github.com/secamc93/probability/back/central/services/modules/payments"
 	"github.com/secamc93/probability/back/central/services/modules/products"
 	"github.com/secamc93/probability/back/central/services/modules/shipments"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
 	"github.com/secamc93/probability/back/central/shared/rabbitmq"
 	"github.com/secamc93/probability/back/central/shared/redis"
+	"github.com/secamc93/probability/back/central/shared/storage"
 )
 
 // New inicializa todos los módulos
-func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, rabbitMQ rabbitmq.IQueue, redisClient redis.IRedis) {
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, rabbitMQ rabbitmq.IQueue, redisClient redis.IRedis, integrationCore core.IIntegrationCore, s3Service storage.IS3Service) {
 	// Inicializar módulo de payments
-	payments.New(router, database, logger, environment)
+	payments.New(router, database, logger, environment, redisClient, integrationCore)
 
 	// Inicializar módulo de order status mappings
 	orderstatus.New(router, database, logger, environment)
 
 	// Inicializar módulo de orders
-	orders.New(router, database, logger, environment, rabbitMQ, redisClient)
+	orders.New(router, database, logger, environment, rabbitMQ, redisClient, s3Service)
 
 	// Inicializar módulo de products
-	products.New(router, database, logger, environment)
+	products.New(router, database, logger, environment, integrationCore, s3Service)
+
+	// Inicializar módulo de customers
+	customers.New(router, database, logger, environment)
 
 	// Inicializar módulo de shipments
-	shipments.New(router, database, logger, environment)
+	shipments.New(router, database, logger, environment, redisClient, integrationCore, s3Service)
+
+	// Inicializar módulo de warehouses (almacenes, picking y packing)
+	warehouses.New(router, database, logger, environment, redisClient)
+
+	// Inicializar módulo de drivers (conductores y rutas de última milla)
+	drivers.New(router, database, logger, environment)
+
+	// Inicializar módulo de cod settlements (conciliación del dinero contra entrega)
+	codsettlements.New(router, database, logger, environment)
 
 	// Inicializar módulo de notification configs
 	notification_config.New(router, database)
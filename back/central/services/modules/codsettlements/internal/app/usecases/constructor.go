@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/app/usecasesettlement"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCases contiene todos los casos de uso del módulo codsettlements
+type UseCases struct {
+	repo domain.IRepository
+
+	// Casos de uso modulares
+	Settlement *usecasesettlement.UseCaseSettlement
+}
+
+// New crea una nueva instancia de UseCases
+func New(repo domain.IRepository, logger log.ILogger) *UseCases {
+	return &UseCases{
+		repo:       repo,
+		Settlement: usecasesettlement.New(repo, logger),
+	}
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE LIQUIDACIONES - Delegar al caso de uso de liquidaciones
+// ───────────────────────────────────────────
+
+// CreateSettlement delega al caso de uso de liquidaciones
+func (uc *UseCases) CreateSettlement(ctx context.Context, req *domain.CreateSettlementRequest) (*domain.Settlement, error) {
+	return uc.Settlement.CreateSettlement(ctx, req)
+}
+
+// GetSettlementByID delega al caso de uso de liquidaciones
+func (uc *UseCases) GetSettlementByID(ctx context.Context, id uint) (*domain.Settlement, error) {
+	return uc.Settlement.GetSettlementByID(ctx, id)
+}
+
+// ListSettlements delega al caso de uso de liquidaciones
+func (uc *UseCases) ListSettlements(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.SettlementsListResponse, error) {
+	return uc.Settlement.ListSettlements(ctx, page, pageSize, filters)
+}
+
+// CloseSettlement delega al caso de uso de liquidaciones
+func (uc *UseCases) CloseSettlement(ctx context.Context, id uint) (*domain.Settlement, error) {
+	return uc.Settlement.CloseSettlement(ctx, id)
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE REMESAS - Delegar al caso de uso de liquidaciones
+// ───────────────────────────────────────────
+
+// DeclareRemittances delega al caso de uso de liquidaciones
+func (uc *UseCases) DeclareRemittances(ctx context.Context, id uint, req *domain.DeclareRemittancesRequest) (*domain.RemittanceResult, error) {
+	return uc.Settlement.DeclareRemittances(ctx, id, req)
+}
+
+// ImportStatement delega al caso de uso de liquidaciones
+func (uc *UseCases) ImportStatement(ctx context.Context, id uint, fileName, format string, data []byte) (*domain.RemittanceResult, error) {
+	return uc.Settlement.ImportStatement(ctx, id, fileName, format, data)
+}
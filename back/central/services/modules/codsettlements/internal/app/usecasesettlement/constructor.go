@@ -0,0 +1,20 @@
+package usecasesettlement
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCaseSettlement contiene los casos de uso de las liquidaciones contra entrega
+type UseCaseSettlement struct {
+	repo   domain.IRepository
+	logger log.ILogger
+}
+
+// New crea una nueva instancia de UseCaseSettlement
+func New(repo domain.IRepository, logger log.ILogger) *UseCaseSettlement {
+	return &UseCaseSettlement{
+		repo:   repo,
+		logger: logger,
+	}
+}
@@ -0,0 +1,285 @@
+package usecasesettlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/spreadsheet"
+)
+
+// DeclareRemittances registra lo remitido orden por orden (ej. el efectivo que entrega el conductor
+// al cerrar su ruta). Las órdenes se identifican por ID o por guía/rastreo.
+func (uc *UseCaseSettlement) DeclareRemittances(ctx context.Context, id uint, req *domain.DeclareRemittancesRequest) (*domain.RemittanceResult, error) {
+	lines := make([]domain.RemittanceLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = domain.RemittanceLine{
+			Row:       i + 1,
+			OrderID:   strings.TrimSpace(line.OrderID),
+			Reference: strings.TrimSpace(line.Reference),
+			Amount:    domain.RoundAmount(line.Amount),
+		}
+	}
+	return uc.applyRemittance(ctx, id, lines, nil)
+}
+
+// ImportStatement importa el extracto de recaudo de la transportadora (CSV o XLSX) y lo cruza con
+// las órdenes de la liquidación por guía o número de rastreo
+func (uc *UseCaseSettlement) ImportStatement(ctx context.Context, id uint, fileName, format string, data []byte) (*domain.RemittanceResult, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = spreadsheet.FormatFromFilename(fileName)
+	}
+	if !spreadsheet.IsSupportedFormat(format) {
+		return nil, domain.ErrUnsupportedFileFormat
+	}
+
+	rows, err := spreadsheet.Read(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidStatement, err)
+	}
+	lines, rowErrors, err := parseStatement(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.applyRemittance(ctx, id, lines, rowErrors)
+}
+
+// remittanceAttempts veces que se aplica una remesa cuando otra concilia la misma liquidación a la vez
+const remittanceAttempts = 3
+
+// applyRemittance aplica la remesa sobre la liquidación; si otra remesa la modificó mientras tanto,
+// vuelve a cargarla y la aplica de nuevo (lo ya conciliado por la otra se trata como re-importación)
+func (uc *UseCaseSettlement) applyRemittance(ctx context.Context, id uint, lines []domain.RemittanceLine, rowErrors []domain.RemittanceRowError) (*domain.RemittanceResult, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := uc.applyRemittanceOnce(ctx, id, lines, rowErrors)
+		if !errors.Is(err, domain.ErrSettlementModified) || attempt == remittanceAttempts {
+			return result, err
+		}
+		uc.logger.Warn(ctx).
+			Uint("settlement_id", id).
+			Int("attempt", attempt).
+			Msg("La liquidación cambió mientras se aplicaba la remesa, se vuelve a aplicar")
+	}
+}
+
+// applyRemittanceOnce cruza las líneas con los items de la liquidación: registra lo declarado, concilia
+// (con su pago) los items cuyo monto coincide y reporta las diferencias y las líneas sin orden
+func (uc *UseCaseSettlement) applyRemittanceOnce(ctx context.Context, id uint, lines []domain.RemittanceLine, rowErrors []domain.RemittanceRowError) (*domain.RemittanceResult, error) {
+	settlement, err := uc.getSettlement(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if settlement.Status == domain.SettlementStatusClosed {
+		return nil, domain.ErrSettlementClosed
+	}
+
+	result := &domain.RemittanceResult{
+		Lines:      len(lines),
+		Mismatches: []domain.RemittanceMismatch{},
+		Errors:     rowErrors,
+	}
+	if result.Errors == nil {
+		result.Errors = []domain.RemittanceRowError{}
+	}
+
+	// Reservar espacio para las líneas sin orden: los punteros a los items deben seguir siendo válidos
+	settlement.Items = slices.Grow(settlement.Items, len(lines))
+	index := newItemIndex(settlement)
+	seen := make(map[int]bool)
+	var changed, reconciled []*domain.SettlementItem
+
+	for _, line := range lines {
+		pos, found := index.find(line)
+		if !found {
+			item, mismatch, err := uc.unmatchedLine(ctx, settlement, index, line)
+			if err != nil {
+				return nil, err
+			}
+			result.Mismatches = append(result.Mismatches, mismatch)
+			changed = appendOnce(changed, item)
+			continue
+		}
+
+		item := &settlement.Items[pos]
+		switch {
+		case seen[pos]:
+			result.Mismatches = append(result.Mismatches, newMismatch(line, item, domain.MismatchDuplicate))
+			continue
+		case item.Status == domain.ItemStatusReconciled:
+			// Re-importar el mismo extracto no concilia dos veces la orden
+			seen[pos] = true
+			if item.DeclaredAmount == nil || math.Abs(*item.DeclaredAmount-line.Amount) > domain.VarianceTolerance {
+				result.Mismatches = append(result.Mismatches, newMismatch(line, item, domain.MismatchAlreadyReconciled))
+			}
+			continue
+		}
+		seen[pos] = true
+
+		if line.Reference != "" {
+			item.Reference = line.Reference
+		}
+		item.Metadata = line.Raw
+		item.Declare(line.Amount)
+		changed = appendOnce(changed, item)
+
+		switch item.Status {
+		case domain.ItemStatusReconciled:
+			reconciled = append(reconciled, item)
+			result.Reconciled++
+		case domain.ItemStatusShort:
+			result.Mismatches = append(result.Mismatches, newMismatch(line, item, domain.MismatchShort))
+		case domain.ItemStatusOver:
+			result.Mismatches = append(result.Mismatches, newMismatch(line, item, domain.MismatchOver))
+		}
+	}
+
+	settlement.RefreshTotals()
+	if len(changed) > 0 {
+		if err := uc.repo.SaveRemittance(ctx, settlement, changed, reconciled); err != nil {
+			return nil, fmt.Errorf("error saving remittance: %w", err)
+		}
+	}
+
+	uc.logger.Info(ctx).
+		Uint("settlement_id", settlement.ID).
+		Int("lines", result.Lines).
+		Int("reconciled", result.Reconciled).
+		Int("mismatches", len(result.Mismatches)).
+		Int("row_errors", len(result.Errors)).
+		Float64("variance", settlement.Variance).
+		Msg("Remesa contra entrega aplicada")
+
+	result.Settlement = settlement
+	return result, nil
+}
+
+// unmatchedLine registra una línea que no corresponde a ninguna orden de la liquidación. Re-importar
+// la misma referencia actualiza la línea existente en vez de duplicarla.
+func (uc *UseCaseSettlement) unmatchedLine(ctx context.Context, settlement *domain.Settlement, index *itemIndex, line domain.RemittanceLine) (*domain.SettlementItem, domain.RemittanceMismatch, error) {
+	reference := line.Reference
+	if reference == "" {
+		reference = line.OrderID
+	}
+
+	mismatch := domain.RemittanceMismatch{
+		Row:       line.Row,
+		Reference: reference,
+		OrderID:   line.OrderID,
+		Declared:  line.Amount,
+		Reason:    domain.MismatchUnknownReference,
+	}
+	if line.Reference != "" {
+		order, err := uc.repo.FindOrderByReference(ctx, settlement.BusinessID, domain.NormalizeReference(line.Reference))
+		if err != nil && !errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, mismatch, fmt.Errorf("error finding order by reference: %w", err)
+		}
+		if order != nil {
+			mismatch.OrderID = order.ID
+			mismatch.OrderNumber = order.OrderNumber
+			mismatch.Reason = domain.MismatchNotInSettlement
+		}
+	}
+
+	item := index.unmatched(settlement, reference)
+	amount := line.Amount
+	item.DeclaredAmount = &amount
+	item.Variance = amount
+	item.Metadata = line.Raw
+	item.Notes = mismatch.Reason
+	if mismatch.OrderNumber != "" {
+		item.Notes = fmt.Sprintf("%s (orden %s)", mismatch.Reason, mismatch.OrderNumber)
+	}
+	return item, mismatch, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// itemIndex ubica los items de la liquidación por ID de orden, guía, rastreo o referencia
+type itemIndex struct {
+	byOrderID   map[string]int
+	byReference map[string]int
+	unmatchedBy map[string]int
+}
+
+func newItemIndex(settlement *domain.Settlement) *itemIndex {
+	index := &itemIndex{
+		byOrderID:   make(map[string]int),
+		byReference: make(map[string]int),
+		unmatchedBy: make(map[string]int),
+	}
+	for i := range settlement.Items {
+		item := &settlement.Items[i]
+		if item.OrderID == nil {
+			index.unmatchedBy[domain.NormalizeReference(item.Reference)] = i
+			continue
+		}
+		index.byOrderID[*item.OrderID] = i
+		for _, ref := range []string{item.GuideID, item.TrackingNumber} {
+			if ref = domain.NormalizeReference(ref); ref != "" {
+				index.byReference[ref] = i
+			}
+		}
+	}
+	return index
+}
+
+// find retorna la posición del item de orden que corresponde a la línea
+func (x *itemIndex) find(line domain.RemittanceLine) (int, bool) {
+	if line.OrderID != "" {
+		if pos, ok := x.byOrderID[line.OrderID]; ok {
+			return pos, true
+		}
+	}
+	pos, ok := x.byReference[domain.NormalizeReference(line.Reference)]
+	return pos, ok && line.Reference != ""
+}
+
+// unmatched retorna la línea sin orden existente para la referencia o agrega una nueva
+func (x *itemIndex) unmatched(settlement *domain.Settlement, reference string) *domain.SettlementItem {
+	key := domain.NormalizeReference(reference)
+	if pos, ok := x.unmatchedBy[key]; ok {
+		return &settlement.Items[pos]
+	}
+	settlement.Items = append(settlement.Items, domain.SettlementItem{
+		SettlementID: settlement.ID,
+		Reference:    reference,
+		Status:       domain.ItemStatusUnmatched,
+	})
+	x.unmatchedBy[key] = len(settlement.Items) - 1
+	return &settlement.Items[len(settlement.Items)-1]
+}
+
+func newMismatch(line domain.RemittanceLine, item *domain.SettlementItem, reason string) domain.RemittanceMismatch {
+	expected, variance := item.ExpectedAmount, domain.RoundAmount(line.Amount-item.ExpectedAmount)
+	reference := line.Reference
+	if reference == "" {
+		reference = item.GuideID
+	}
+	return domain.RemittanceMismatch{
+		Row:         line.Row,
+		Reference:   reference,
+		OrderID:     *item.OrderID,
+		OrderNumber: item.OrderNumber,
+		Expected:    &expected,
+		Declared:    line.Amount,
+		Variance:    &variance,
+		Reason:      reason,
+	}
+}
+
+func appendOnce(items []*domain.SettlementItem, item *domain.SettlementItem) []*domain.SettlementItem {
+	if slices.Contains(items, item) {
+		return items
+	}
+	return append(items, item)
+}
@@ -0,0 +1,207 @@
+package usecasesettlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	CREATE SETTLEMENT
+//
+// ───────────────────────────────────────────
+
+// CreateSettlement crea la liquidación del conductor o transportadora con las órdenes contra entrega
+// entregadas en el periodo que aún no están pagadas ni en otra liquidación
+func (uc *UseCaseSettlement) CreateSettlement(ctx context.Context, req *domain.CreateSettlementRequest) (*domain.Settlement, error) {
+	from, to, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement := &domain.Settlement{
+		BusinessID: req.BusinessID,
+		PartyType:  req.PartyType,
+		PeriodFrom: from,
+		PeriodTo:   to,
+		Currency:   strings.ToUpper(strings.TrimSpace(req.Currency)),
+		Status:     domain.SettlementStatusOpen,
+		Notes:      req.Notes,
+		CreatedBy:  req.CreatedBy,
+	}
+	filters := domain.CodOrderFilters{
+		BusinessID: req.BusinessID,
+		From:       from,
+		To:         to.AddDate(0, 0, 1),
+		Currency:   settlement.Currency,
+		Limit:      domain.MaxSettlementOrders,
+	}
+
+	switch req.PartyType {
+	case domain.PartyTypeDriver:
+		if req.DriverID == nil || *req.DriverID == 0 {
+			return nil, fmt.Errorf("%w: driver_id is required for driver settlements", domain.ErrInvalidSettlementData)
+		}
+		name, err := uc.repo.GetDriverName(ctx, req.BusinessID, *req.DriverID)
+		if err != nil {
+			if errors.Is(err, domain.ErrDriverNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("error getting driver: %w", err)
+		}
+		settlement.DriverID = req.DriverID
+		settlement.PartyName = name
+		filters.DriverID = req.DriverID
+	case domain.PartyTypeCarrier:
+		code := strings.ToLower(strings.TrimSpace(req.CarrierCode))
+		if code == "" {
+			return nil, fmt.Errorf("%w: carrier_code is required for carrier settlements", domain.ErrInvalidSettlementData)
+		}
+		settlement.CarrierCode = code
+		settlement.PartyName = code
+		filters.CarrierCode = code
+	default:
+		return nil, fmt.Errorf("%w: party_type must be driver or carrier", domain.ErrInvalidSettlementData)
+	}
+
+	orders, err := uc.repo.ListUnsettledCodOrders(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cash on delivery orders: %w", err)
+	}
+	if len(orders) == 0 {
+		return nil, domain.ErrNoCodOrders
+	}
+
+	settlement.Items = make([]domain.SettlementItem, len(orders))
+	for i := range orders {
+		orderID := orders[i].ID
+		settlement.Items[i] = domain.SettlementItem{
+			OrderID:        &orderID,
+			OrderNumber:    orders[i].OrderNumber,
+			GuideID:        orders[i].GuideID,
+			TrackingNumber: orders[i].TrackingNumber,
+			ExpectedAmount: domain.RoundAmount(orders[i].CodTotal),
+			Status:         domain.ItemStatusPending,
+		}
+	}
+	settlement.RefreshTotals()
+
+	if err := uc.repo.CreateSettlement(ctx, settlement); err != nil {
+		return nil, fmt.Errorf("error creating cod settlement: %w", err)
+	}
+
+	uc.logger.Info(ctx).
+		Uint("settlement_id", settlement.ID).
+		Str("party_type", settlement.PartyType).
+		Str("party_name", settlement.PartyName).
+		Int("orders", settlement.OrderCount).
+		Float64("expected_amount", settlement.ExpectedAmount).
+		Msg("Liquidación contra entrega creada")
+
+	return settlement, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	GET / LIST SETTLEMENTS
+//
+// ───────────────────────────────────────────
+
+// GetSettlementByID obtiene una liquidación con sus items
+func (uc *UseCaseSettlement) GetSettlementByID(ctx context.Context, id uint) (*domain.Settlement, error) {
+	return uc.getSettlement(ctx, id, true)
+}
+
+// ListSettlements obtiene una lista paginada de liquidaciones con filtros
+func (uc *UseCaseSettlement) ListSettlements(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.SettlementsListResponse, error) {
+	// Validar paginación
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	settlements, total, err := uc.repo.ListSettlements(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cod settlements: %w", err)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	return &domain.SettlementsListResponse{
+		Data:       settlements,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	CLOSE SETTLEMENT
+//
+// ───────────────────────────────────────────
+
+// CloseSettlement cierra la liquidación: no admite más remesas y las diferencias quedan registradas
+func (uc *UseCaseSettlement) CloseSettlement(ctx context.Context, id uint) (*domain.Settlement, error) {
+	settlement, err := uc.getSettlement(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if settlement.Status == domain.SettlementStatusClosed {
+		return nil, domain.ErrSettlementClosed
+	}
+
+	now := time.Now()
+	settlement.Status = domain.SettlementStatusClosed
+	settlement.ClosedAt = &now
+	if err := uc.repo.CloseSettlement(ctx, settlement); err != nil {
+		return nil, fmt.Errorf("error closing cod settlement: %w", err)
+	}
+
+	uc.logger.Info(ctx).
+		Uint("settlement_id", settlement.ID).
+		Float64("variance", settlement.Variance).
+		Int("mismatches", settlement.MismatchCount).
+		Msg("Liquidación contra entrega cerrada")
+
+	return settlement, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+func (uc *UseCaseSettlement) getSettlement(ctx context.Context, id uint, withItems bool) (*domain.Settlement, error) {
+	settlement, err := uc.repo.GetSettlementByID(ctx, id, withItems)
+	if err != nil {
+		if errors.Is(err, domain.ErrSettlementNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting cod settlement: %w", err)
+	}
+	return settlement, nil
+}
+
+// parsePeriod interpreta el periodo YYYY-MM-DD (ambas fechas inclusive)
+func parsePeriod(rawFrom, rawTo string) (time.Time, time.Time, error) {
+	from, err := time.Parse("2006-01-02", strings.TrimSpace(rawFrom))
+	if err != nil {
+		return time.Time{}, time.Time{}, domain.ErrInvalidPeriod
+	}
+	to, err := time.Parse("2006-01-02", strings.TrimSpace(rawTo))
+	if err != nil || to.Before(from) {
+		return time.Time{}, time.Time{}, domain.ErrInvalidPeriod
+	}
+	return from, to, nil
+}
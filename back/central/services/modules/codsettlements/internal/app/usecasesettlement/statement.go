@@ -0,0 +1,131 @@
+package usecasesettlement
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+)
+
+// Encabezados aceptados en los extractos de las transportadoras (se comparan en minúsculas y sin
+// espacios ni guiones)
+var (
+	referenceColumns = []string{"guide", "guideid", "guidenumber", "guia", "numeroguia", "nguia", "tracking", "trackingnumber", "numerorastreo", "rastreo"}
+	amountColumns    = []string{"amount", "collected", "collectedamount", "codamount", "declaredamount", "valor", "valorrecaudado", "recaudo", "monto"}
+)
+
+// parseStatement lee las filas del extracto: la primera fila es el encabezado y debe tener una
+// columna de guía/rastreo y otra de monto. Las filas vacías se ignoran y las inválidas se reportan.
+func parseStatement(rows [][]string) ([]domain.RemittanceLine, []domain.RemittanceRowError, error) {
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("%w: empty file", domain.ErrInvalidStatement)
+	}
+
+	header := make([]string, len(rows[0]))
+	for i, name := range rows[0] {
+		header[i] = strings.TrimSpace(name)
+	}
+	refCol, amountCol := findColumn(header, referenceColumns), findColumn(header, amountColumns)
+	if refCol < 0 {
+		return nil, nil, fmt.Errorf("%w: missing guide or tracking number column", domain.ErrInvalidStatement)
+	}
+	if amountCol < 0 {
+		return nil, nil, fmt.Errorf("%w: missing amount column", domain.ErrInvalidStatement)
+	}
+
+	var lines []domain.RemittanceLine
+	rowErrors := []domain.RemittanceRowError{}
+	for i, record := range rows[1:] {
+		row := i + 2
+		field := func(col int) string {
+			if col < len(record) {
+				return strings.TrimSpace(record[col])
+			}
+			return ""
+		}
+
+		reference, rawAmount := field(refCol), field(amountCol)
+		if reference == "" && rawAmount == "" {
+			continue
+		}
+		if reference == "" {
+			rowErrors = append(rowErrors, domain.RemittanceRowError{Row: row, Error: "missing guide or tracking number"})
+			continue
+		}
+		amount, err := parseAmount(rawAmount)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.RemittanceRowError{Row: row, Error: err.Error()})
+			continue
+		}
+
+		raw := make(map[string]string, len(header))
+		for col, name := range header {
+			if value := field(col); name != "" && value != "" {
+				raw[name] = value
+			}
+		}
+		lines = append(lines, domain.RemittanceLine{
+			Row:       row,
+			Reference: reference,
+			Amount:    amount,
+			Raw:       raw,
+		})
+	}
+	return lines, rowErrors, nil
+}
+
+// findColumn retorna la posición de la primera columna del encabezado con alguno de los nombres
+func findColumn(header []string, names []string) int {
+	for i, name := range header {
+		key := strings.NewReplacer(" ", "", "_", "", "-", "", ".", "", "°", "", "º", "").Replace(strings.ToLower(name))
+		key = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u").Replace(key)
+		for _, candidate := range names {
+			if key == candidate {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseAmount interpreta montos con separadores de miles y decimales en formato latino (45.000,50)
+// o anglosajón (45,000.50). Con un solo tipo de separador, si aparece una vez seguido de exactamente
+// tres dígitos se toma como separador de miles (45.000 = 45000).
+func parseAmount(raw string) (float64, error) {
+	value := strings.NewReplacer("$", "", " ", "", " ", "").Replace(raw)
+	for _, currency := range []string{"COP", "USD", "MXN", "PEN", "CLP"} {
+		value = strings.TrimSuffix(strings.TrimPrefix(strings.ToUpper(value), currency), currency)
+	}
+
+	lastDot, lastComma := strings.LastIndex(value, "."), strings.LastIndex(value, ",")
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		// El último separador es el decimal
+		if lastComma > lastDot {
+			value = strings.ReplaceAll(value, ".", "")
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case lastComma >= 0:
+		value = normalizeSingleSeparator(value, ",")
+	case lastDot >= 0:
+		value = normalizeSingleSeparator(value, ".")
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || amount < 0 {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+	return domain.RoundAmount(amount), nil
+}
+
+// normalizeSingleSeparator convierte un monto con un solo tipo de separador al formato de ParseFloat
+func normalizeSingleSeparator(value, sep string) string {
+	parts := strings.Split(value, sep)
+	if len(parts) > 2 || len(parts[len(parts)-1]) == 3 {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrSettlementNotFound se retorna cuando una liquidación no existe
+	ErrSettlementNotFound = errors.New("cod settlement not found")
+
+	// ErrInvalidSettlementData se retorna cuando los datos de la liquidación son inválidos
+	ErrInvalidSettlementData = errors.New("invalid cod settlement data")
+
+	// ErrInvalidPeriod se retorna cuando el periodo de la liquidación es inválido
+	ErrInvalidPeriod = errors.New("invalid period, dates must be YYYY-MM-DD and date_from must not be after date_to")
+
+	// ErrDriverNotFound se retorna cuando el conductor de la liquidación no existe en el negocio
+	ErrDriverNotFound = errors.New("driver not found")
+
+	// ErrNoCodOrders se retorna cuando no hay órdenes contra entrega pendientes de liquidar
+	ErrNoCodOrders = errors.New("no delivered cash on delivery orders pending settlement in the period")
+
+	// ErrSettlementClosed se retorna cuando se intenta modificar una liquidación cerrada
+	ErrSettlementClosed = errors.New("cod settlement is closed")
+
+	// ErrSettlementModified se retorna cuando otra remesa concilió items de la liquidación mientras se aplicaba esta
+	ErrSettlementModified = errors.New("cod settlement was modified by another remittance")
+
+	// ErrOrderNotFound se retorna cuando ninguna orden coincide con la referencia
+	ErrOrderNotFound = errors.New("order not found")
+)
+
+var (
+	// ErrInvalidStatement se retorna cuando el extracto no se puede leer
+	ErrInvalidStatement = errors.New("invalid remittance statement")
+
+	// ErrUnsupportedFileFormat se retorna cuando el extracto no es CSV ni XLSX
+	ErrUnsupportedFileFormat = errors.New("unsupported file format, must be csv or xlsx")
+)
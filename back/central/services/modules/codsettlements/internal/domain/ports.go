@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+)
+
+// ───────────────────────────────────────────
+//
+//	REPOSITORY INTERFACE
+//
+// ───────────────────────────────────────────
+
+// IRepository define todos los métodos de repositorio del módulo codsettlements
+type IRepository interface {
+	// Settlements
+	CreateSettlement(ctx context.Context, settlement *Settlement) error
+	GetSettlementByID(ctx context.Context, id uint, withItems bool) (*Settlement, error)
+	ListSettlements(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]Settlement, int64, error)
+	CloseSettlement(ctx context.Context, settlement *Settlement) error
+
+	// SaveRemittance guarda en una transacción los items modificados y los totales de la liquidación,
+	// y registra un pago completado por cada item conciliado recalculando el saldo de su orden.
+	// Retorna ErrSettlementModified si otra remesa concilió alguno de los items mientras tanto.
+	SaveRemittance(ctx context.Context, settlement *Settlement, items []*SettlementItem, reconciled []*SettlementItem) error
+
+	// COD orders
+	ListUnsettledCodOrders(ctx context.Context, filters CodOrderFilters) ([]CodOrder, error)
+	FindOrderByReference(ctx context.Context, businessID uint, reference string) (*CodOrder, error)
+
+	// Party
+	GetDriverName(ctx context.Context, businessID, driverID uint) (string, error)
+}
@@ -0,0 +1,68 @@
+package domain
+
+// ───────────────────────────────────────────
+//
+//	REMITTANCES - Dinero remitido por el conductor o la transportadora
+//
+// ───────────────────────────────────────────
+
+// Motivos de las diferencias reportadas al aplicar una remesa
+const (
+	MismatchShort             = "short"              // Remitió menos de lo esperado
+	MismatchOver              = "over"               // Remitió más de lo esperado
+	MismatchNotInSettlement   = "not_in_settlement"  // La orden existe pero no está en esta liquidación
+	MismatchUnknownReference  = "unknown_reference"  // Ninguna orden del negocio tiene esa guía o rastreo
+	MismatchDuplicate         = "duplicate"          // La orden aparece más de una vez en la remesa
+	MismatchAlreadyReconciled = "already_reconciled" // La orden ya se concilió con otro monto
+)
+
+// MaxStatementFileSize es el tamaño máximo del extracto de la transportadora
+const MaxStatementFileSize = 5 << 20
+
+// RemittanceLine es una línea remitida: identifica la orden (por ID o por guía/rastreo) y el monto
+type RemittanceLine struct {
+	Row       int               // Fila del extracto (0 en declaraciones manuales)
+	OrderID   string            // ID de la orden (declaraciones manuales)
+	Reference string            // Guía o número de rastreo
+	Amount    float64           // Monto remitido
+	Raw       map[string]string // Fila original del extracto
+}
+
+// DeclaredLine es una línea de la declaración manual (ej. el efectivo que entrega el conductor)
+type DeclaredLine struct {
+	OrderID   string  `json:"order_id" binding:"required_without=Reference,max=36"`
+	Reference string  `json:"reference" binding:"required_without=OrderID,max=128"`
+	Amount    float64 `json:"amount" binding:"gte=0"`
+}
+
+// DeclareRemittancesRequest registra lo remitido orden por orden
+type DeclareRemittancesRequest struct {
+	Lines []DeclaredLine `json:"lines" binding:"required,min=1,max=1000,dive"`
+}
+
+// RemittanceMismatch es una diferencia encontrada al aplicar la remesa
+type RemittanceMismatch struct {
+	Row         int      `json:"row,omitempty"`
+	Reference   string   `json:"reference,omitempty"`
+	OrderID     string   `json:"order_id,omitempty"`
+	OrderNumber string   `json:"order_number,omitempty"`
+	Expected    *float64 `json:"expected,omitempty"`
+	Declared    float64  `json:"declared"`
+	Variance    *float64 `json:"variance,omitempty"`
+	Reason      string   `json:"reason"`
+}
+
+// RemittanceRowError es una fila del extracto que no se pudo leer
+type RemittanceRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// RemittanceResult resume la aplicación de una remesa
+type RemittanceResult struct {
+	Lines      int                  `json:"lines"`
+	Reconciled int                  `json:"reconciled"`
+	Mismatches []RemittanceMismatch `json:"mismatches"`
+	Errors     []RemittanceRowError `json:"errors"`
+	Settlement *Settlement          `json:"settlement"`
+}
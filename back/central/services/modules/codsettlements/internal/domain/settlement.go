@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	COD SETTLEMENT - Liquidación del dinero contra entrega
+//
+// ───────────────────────────────────────────
+
+// Quién remite el dinero recaudado
+const (
+	PartyTypeDriver  = "driver"
+	PartyTypeCarrier = "carrier"
+)
+
+// Estados de la liquidación
+const (
+	SettlementStatusOpen       = "open"       // Esperando remesas
+	SettlementStatusReconciled = "reconciled" // Todas las órdenes conciliadas sin diferencias
+	SettlementStatusClosed     = "closed"     // Cerrada manualmente, no admite más remesas
+)
+
+// Estados de los items de la liquidación
+const (
+	ItemStatusPending    = "pending"    // Sin remesa
+	ItemStatusReconciled = "reconciled" // Lo remitido coincide con lo esperado y se registró el pago
+	ItemStatusShort      = "short"      // Se remitió menos de lo esperado
+	ItemStatusOver       = "over"       // Se remitió más de lo esperado
+	ItemStatusUnmatched  = "unmatched"  // Línea remitida que no corresponde a ninguna orden de la liquidación
+)
+
+// OrderStatusDelivered es el estado de las órdenes que entran en la liquidación (orders/domain/status.go)
+const OrderStatusDelivered = "delivered"
+
+// VarianceTolerance es la diferencia máxima (por redondeo) para considerar que un monto coincide
+const VarianceTolerance = 0.01
+
+// Pagos creados por la conciliación contra entrega
+const (
+	CodPaymentGateway      = "cod"
+	PaymentStatusCompleted = "completed"
+)
+
+// MaxSettlementOrders es el máximo de órdenes en una liquidación
+const MaxSettlementOrders = 5000
+
+// Settlement es la liquidación de un conductor o transportadora
+type Settlement struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID uint      `json:"business_id"`
+
+	PartyType   string `json:"party_type"`
+	DriverID    *uint  `json:"driver_id,omitempty"`
+	CarrierCode string `json:"carrier_code,omitempty"`
+	PartyName   string `json:"party_name"`
+
+	PeriodFrom time.Time `json:"period_from"`
+	PeriodTo   time.Time `json:"period_to"`
+	Currency   string    `json:"currency"`
+
+	ExpectedAmount  float64 `json:"expected_amount"`
+	DeclaredAmount  float64 `json:"declared_amount"`
+	Variance        float64 `json:"variance"`
+	OrderCount      int     `json:"order_count"`
+	ReconciledCount int     `json:"reconciled_count"`
+	MismatchCount   int     `json:"mismatch_count"`
+
+	Status   string     `json:"status"`
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	Notes    *string    `json:"notes,omitempty"`
+
+	CreatedBy *uint `json:"created_by,omitempty"`
+
+	Items []SettlementItem `json:"items,omitempty"`
+}
+
+// SettlementItem es una orden de la liquidación o una línea remitida sin orden
+type SettlementItem struct {
+	ID           uint      `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	SettlementID uint      `json:"settlement_id"`
+	OrderID      *string   `json:"order_id,omitempty"`
+	OrderNumber  string    `json:"order_number,omitempty"`
+
+	GuideID        string `json:"guide_id,omitempty"`
+	TrackingNumber string `json:"tracking_number,omitempty"`
+	Reference      string `json:"reference,omitempty"`
+
+	ExpectedAmount float64  `json:"expected_amount"`
+	DeclaredAmount *float64 `json:"declared_amount,omitempty"`
+	Variance       float64  `json:"variance"`
+
+	Status       string            `json:"status"`
+	PaymentID    *uint             `json:"payment_id,omitempty"`
+	ReconciledAt *time.Time        `json:"reconciled_at,omitempty"`
+	Notes        string            `json:"notes,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+// IsMismatch indica si el item tiene diferencias o no corresponde a ninguna orden
+func (i *SettlementItem) IsMismatch() bool {
+	return i.Status == ItemStatusShort || i.Status == ItemStatusOver || i.Status == ItemStatusUnmatched
+}
+
+// Declare registra el monto remitido para la orden y calcula la diferencia con lo esperado
+func (i *SettlementItem) Declare(amount float64) {
+	i.DeclaredAmount = &amount
+	i.Variance = RoundAmount(amount - i.ExpectedAmount)
+	switch {
+	case math.Abs(i.Variance) <= VarianceTolerance:
+		i.Variance = 0
+		i.Status = ItemStatusReconciled
+	case i.Variance < 0:
+		i.Status = ItemStatusShort
+	default:
+		i.Status = ItemStatusOver
+	}
+}
+
+// RefreshTotals recalcula los totales y el estado de la liquidación a partir de sus items
+func (s *Settlement) RefreshTotals() {
+	s.ExpectedAmount, s.DeclaredAmount = 0, 0
+	s.OrderCount, s.ReconciledCount, s.MismatchCount = 0, 0, 0
+	for i := range s.Items {
+		item := &s.Items[i]
+		if item.OrderID != nil {
+			s.OrderCount++
+			s.ExpectedAmount += item.ExpectedAmount
+		}
+		if item.DeclaredAmount != nil {
+			s.DeclaredAmount += *item.DeclaredAmount
+		}
+		if item.Status == ItemStatusReconciled {
+			s.ReconciledCount++
+		}
+		if item.IsMismatch() {
+			s.MismatchCount++
+		}
+	}
+	s.ExpectedAmount = RoundAmount(s.ExpectedAmount)
+	s.DeclaredAmount = RoundAmount(s.DeclaredAmount)
+	s.Variance = RoundAmount(s.DeclaredAmount - s.ExpectedAmount)
+
+	if s.Status != SettlementStatusClosed && s.OrderCount > 0 &&
+		s.ReconciledCount == s.OrderCount && s.MismatchCount == 0 {
+		s.Status = SettlementStatusReconciled
+	}
+}
+
+// RoundAmount redondea un monto a dos decimales
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// NormalizeReference normaliza una guía o número de rastreo para cruzarla con el extracto
+func NormalizeReference(reference string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(reference), ""))
+}
+
+// CodOrder es una orden entregada con dinero contra entrega pendiente de remitir
+type CodOrder struct {
+	ID             string
+	BusinessID     *uint
+	OrderNumber    string
+	GuideID        string
+	TrackingNumber string
+	CodTotal       float64
+	Currency       string
+	IsPaid         bool
+	Status         string
+	DeliveredAt    *time.Time
+}
+
+// CodOrderFilters define las órdenes que entran en una liquidación
+type CodOrderFilters struct {
+	BusinessID  uint
+	DriverID    *uint
+	CarrierCode string
+	From        time.Time
+	To          time.Time // Exclusivo
+	Currency    string
+	Limit       int
+}
+
+// ───────────────────────────────────────────
+//
+//	REQUEST / RESPONSE DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateSettlementRequest crea la liquidación de un conductor o transportadora con las órdenes
+// contra entrega entregadas en el periodo (fechas YYYY-MM-DD, ambas inclusive)
+type CreateSettlementRequest struct {
+	BusinessID  uint    `json:"business_id" binding:"required"`
+	PartyType   string  `json:"party_type" binding:"required,oneof=driver carrier"`
+	DriverID    *uint   `json:"driver_id"`
+	CarrierCode string  `json:"carrier_code" binding:"omitempty,max=50"`
+	DateFrom    string  `json:"date_from" binding:"required"`
+	DateTo      string  `json:"date_to" binding:"required"`
+	Currency    string  `json:"currency" binding:"required,len=3"`
+	Notes       *string `json:"notes"`
+	CreatedBy   *uint   `json:"created_by"`
+}
+
+// SettlementsListResponse representa la respuesta paginada de liquidaciones
+type SettlementsListResponse struct {
+	Data       []Settlement `json:"data"`
+	Total      int64        `json:"total"`
+	Page       int          `json:"page"`
+	PageSize   int          `json:"page_size"`
+	TotalPages int          `json:"total_pages"`
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+)
+
+// Handlers contiene todos los handlers del módulo codsettlements
+type Handlers struct {
+	uc *usecases.UseCases
+}
+
+// New crea una nueva instancia de Handlers
+func New(uc *usecases.UseCases) *Handlers {
+	return &Handlers{
+		uc: uc,
+	}
+}
+
+// parseSettlementID convierte el parámetro de ruta en un ID de liquidación válido
+func parseSettlementID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de liquidación inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondSettlementError traduce los errores de dominio del módulo a respuestas HTTP
+func respondSettlementError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrSettlementNotFound),
+		errors.Is(err, domain.ErrDriverNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidSettlementData),
+		errors.Is(err, domain.ErrInvalidPeriod),
+		errors.Is(err, domain.ErrNoCodOrders),
+		errors.Is(err, domain.ErrInvalidStatement),
+		errors.Is(err, domain.ErrUnsupportedFileFormat):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrSettlementClosed),
+		errors.Is(err, domain.ErrSettlementModified):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
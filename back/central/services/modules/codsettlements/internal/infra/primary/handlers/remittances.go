@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+)
+
+// DeclareRemittances godoc
+// @Summary      Declarar remesa
+// @Description  Registra lo remitido orden por orden (por ID de orden o por guía/rastreo), por ejemplo el efectivo que entrega el conductor. Las órdenes cuyo monto coincide se concilian con un pago completado; las diferencias y las líneas sin orden se reportan.
+// @Tags         COD Settlements
+// @Accept       json
+// @Produce      json
+// @Param        id       path  int                               true  "ID de la liquidación"
+// @Param        request  body  domain.DeclareRemittancesRequest  true  "Líneas remitidas (máx. 1000)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.RemittanceResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements/{id}/remittances [post]
+func (h *Handlers) DeclareRemittances(c *gin.Context) {
+	id, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.DeclareRemittancesRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.uc.DeclareRemittances(c.Request.Context(), id, &req)
+	if err != nil {
+		respondSettlementError(c, err, "Error al registrar la remesa")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Remesa registrada exitosamente",
+		"data":    result,
+	})
+}
+
+// ImportStatement godoc
+// @Summary      Importar extracto de la transportadora
+// @Description  Importa el extracto de recaudo (CSV o XLSX) con una columna de guía o rastreo (guide, guia, tracking_number...) y otra de monto (amount, valor_recaudado...). Cada línea se cruza con las órdenes de la liquidación; las que coinciden se concilian con un pago completado y las diferencias se reportan. Re-importar el mismo extracto no duplica pagos.
+// @Tags         COD Settlements
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id      path      int     true   "ID de la liquidación"
+// @Param        file    formData  file    true   "Extracto CSV o XLSX (máx. 5 MB)"
+// @Param        format  formData  string  false  "Formato (csv, xlsx). Por defecto se deduce de la extensión"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.RemittanceResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements/{id}/remittances/import [post]
+func (h *Handlers) ImportStatement(c *gin.Context) {
+	id, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Archivo CSV o XLSX requerido",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if fileHeader.Size > domain.MaxStatementFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "El archivo supera el tamaño máximo de 5 MB",
+			"error":   "file too large",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el archivo",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el archivo",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.uc.ImportStatement(c.Request.Context(), id, fileHeader.Filename, c.PostForm("format"), data)
+	if err != nil {
+		respondSettlementError(c, err, "Error al importar el extracto")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Extracto importado exitosamente",
+		"data":    result,
+	})
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registra todas las rutas del módulo codsettlements
+func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
+	settlements := router.Group("/cod-settlements")
+	{
+		// Liquidaciones
+		settlements.GET("", h.ListSettlements)
+		settlements.GET("/:id", h.GetSettlementByID)
+		settlements.POST("", h.CreateSettlement)
+		settlements.POST("/:id/close", h.CloseSettlement)
+
+		// Remesas
+		settlements.POST("/:id/remittances", h.DeclareRemittances)
+		settlements.POST("/:id/remittances/import", h.ImportStatement)
+	}
+}
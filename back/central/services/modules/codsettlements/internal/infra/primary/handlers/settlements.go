@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+)
+
+// CreateSettlement godoc
+// @Summary      Crear liquidación contra entrega
+// @Description  Crea la liquidación de un conductor o una transportadora con las órdenes contra entrega entregadas en el periodo que aún no están pagadas ni en otra liquidación, con el monto esperado a remitir
+// @Tags         COD Settlements
+// @Accept       json
+// @Produce      json
+// @Param        settlement  body      domain.CreateSettlementRequest  true  "Remitente, periodo (YYYY-MM-DD) y moneda"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.Settlement
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements [post]
+func (h *Handlers) CreateSettlement(c *gin.Context) {
+	var req domain.CreateSettlementRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	settlement, err := h.uc.CreateSettlement(c.Request.Context(), &req)
+	if err != nil {
+		respondSettlementError(c, err, "Error al crear la liquidación")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Liquidación creada exitosamente",
+		"data":    settlement,
+	})
+}
+
+// GetSettlementByID godoc
+// @Summary      Obtener liquidación por ID
+// @Description  Obtiene la liquidación con sus órdenes: monto esperado, declarado, diferencia y estado de cada una, y las líneas remitidas que no corresponden a ninguna orden
+// @Tags         COD Settlements
+// @Produce      json
+// @Param        id   path      int  true  "ID de la liquidación"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Settlement
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements/{id} [get]
+func (h *Handlers) GetSettlementByID(c *gin.Context) {
+	id, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	// Llamar al caso de uso
+	settlement, err := h.uc.GetSettlementByID(c.Request.Context(), id)
+	if err != nil {
+		respondSettlementError(c, err, "Error al obtener la liquidación")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Liquidación obtenida exitosamente",
+		"data":    settlement,
+	})
+}
+
+// ListSettlements godoc
+// @Summary      Listar liquidaciones contra entrega
+// @Description  Obtiene una lista paginada de liquidaciones (sin items), de la más reciente a la más antigua
+// @Tags         COD Settlements
+// @Produce      json
+// @Param        page             query  int     false  "Número de página (default: 1, min: 1)"
+// @Param        page_size        query  int     false  "Tamaño de página (default: 10, min: 1, max: 100)"
+// @Param        business_id      query  int     false  "Filtrar por ID de negocio"
+// @Param        party_type       query  string  false  "Filtrar por remitente (driver, carrier)"
+// @Param        driver_id        query  int     false  "Filtrar por conductor"
+// @Param        carrier_code     query  string  false  "Filtrar por transportadora"
+// @Param        status           query  string  false  "Filtrar por estado (open, reconciled, closed)"
+// @Param        with_mismatches  query  bool    false  "Solo liquidaciones con diferencias"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.SettlementsListResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements [get]
+func (h *Handlers) ListSettlements(c *gin.Context) {
+	// Obtener y validar parámetros de paginación
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+
+	// Limitar el tamaño máximo de página
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	// Construir filtros
+	filters := make(map[string]interface{})
+
+	for _, key := range []string{"business_id", "driver_id"} {
+		if raw := c.Query(key); raw != "" {
+			if id, err := strconv.ParseUint(raw, 10, 32); err == nil && id > 0 {
+				filters[key] = uint(id)
+			}
+		}
+	}
+
+	if raw := c.Query("with_mismatches"); raw != "" {
+		if value, err := strconv.ParseBool(raw); err == nil {
+			filters["with_mismatches"] = value
+		}
+	}
+
+	for _, key := range []string{"party_type", "carrier_code", "status"} {
+		if value := c.Query(key); value != "" {
+			filters[key] = value
+		}
+	}
+
+	// Llamar al caso de uso
+	response, err := h.uc.ListSettlements(c.Request.Context(), page, pageSize, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener liquidaciones",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Liquidaciones obtenidas exitosamente",
+		"data":        response.Data,
+		"total":       response.Total,
+		"page":        response.Page,
+		"page_size":   response.PageSize,
+		"total_pages": response.TotalPages,
+	})
+}
+
+// CloseSettlement godoc
+// @Summary      Cerrar liquidación
+// @Description  Cierra la liquidación: no admite más remesas y las diferencias pendientes quedan registradas
+// @Tags         COD Settlements
+// @Produce      json
+// @Param        id   path      int  true  "ID de la liquidación"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Settlement
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /cod-settlements/{id}/close [post]
+func (h *Handlers) CloseSettlement(c *gin.Context) {
+	id, ok := parseSettlementID(c)
+	if !ok {
+		return
+	}
+
+	settlement, err := h.uc.CloseSettlement(c.Request.Context(), id)
+	if err != nil {
+		respondSettlementError(c, err, "Error al cerrar la liquidación")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Liquidación cerrada exitosamente",
+		"data":    settlement,
+	})
+}
@@ -0,0 +1,141 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ToDBSettlement convierte una liquidación de dominio a modelo de base de datos (sin items)
+func ToDBSettlement(s *domain.Settlement) *models.CodSettlement {
+	if s == nil {
+		return nil
+	}
+	return &models.CodSettlement{
+		Model: gorm.Model{
+			ID:        s.ID,
+			CreatedAt: s.CreatedAt,
+			UpdatedAt: s.UpdatedAt,
+		},
+		BusinessID:      s.BusinessID,
+		PartyType:       s.PartyType,
+		DriverID:        s.DriverID,
+		CarrierCode:     s.CarrierCode,
+		PartyName:       s.PartyName,
+		PeriodFrom:      s.PeriodFrom,
+		PeriodTo:        s.PeriodTo,
+		Currency:        s.Currency,
+		ExpectedAmount:  s.ExpectedAmount,
+		DeclaredAmount:  s.DeclaredAmount,
+		Variance:        s.Variance,
+		OrderCount:      s.OrderCount,
+		ReconciledCount: s.ReconciledCount,
+		MismatchCount:   s.MismatchCount,
+		Status:          s.Status,
+		ClosedAt:        s.ClosedAt,
+		Notes:           s.Notes,
+		CreatedBy:       s.CreatedBy,
+	}
+}
+
+// ToDomainSettlement convierte un modelo de base de datos a liquidación de dominio
+func ToDomainSettlement(s *models.CodSettlement) *domain.Settlement {
+	if s == nil {
+		return nil
+	}
+	settlement := &domain.Settlement{
+		ID:              s.ID,
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+		BusinessID:      s.BusinessID,
+		PartyType:       s.PartyType,
+		DriverID:        s.DriverID,
+		CarrierCode:     s.CarrierCode,
+		PartyName:       s.PartyName,
+		PeriodFrom:      s.PeriodFrom,
+		PeriodTo:        s.PeriodTo,
+		Currency:        s.Currency,
+		ExpectedAmount:  s.ExpectedAmount,
+		DeclaredAmount:  s.DeclaredAmount,
+		Variance:        s.Variance,
+		OrderCount:      s.OrderCount,
+		ReconciledCount: s.ReconciledCount,
+		MismatchCount:   s.MismatchCount,
+		Status:          s.Status,
+		ClosedAt:        s.ClosedAt,
+		Notes:           s.Notes,
+		CreatedBy:       s.CreatedBy,
+	}
+	if len(s.Items) > 0 {
+		settlement.Items = make([]domain.SettlementItem, len(s.Items))
+		for i := range s.Items {
+			settlement.Items[i] = *ToDomainSettlementItem(&s.Items[i])
+		}
+	}
+	return settlement
+}
+
+// ToDBSettlementItem convierte un item de dominio a modelo de base de datos
+func ToDBSettlementItem(i *domain.SettlementItem) *models.CodSettlementItem {
+	if i == nil {
+		return nil
+	}
+	item := &models.CodSettlementItem{
+		Model: gorm.Model{
+			ID:        i.ID,
+			CreatedAt: i.CreatedAt,
+			UpdatedAt: i.UpdatedAt,
+		},
+		SettlementID:   i.SettlementID,
+		OrderID:        i.OrderID,
+		OrderNumber:    i.OrderNumber,
+		GuideID:        i.GuideID,
+		TrackingNumber: i.TrackingNumber,
+		Reference:      i.Reference,
+		ExpectedAmount: i.ExpectedAmount,
+		DeclaredAmount: i.DeclaredAmount,
+		Variance:       i.Variance,
+		Status:         i.Status,
+		PaymentID:      i.PaymentID,
+		ReconciledAt:   i.ReconciledAt,
+		Notes:          i.Notes,
+	}
+	if len(i.Metadata) > 0 {
+		if raw, err := json.Marshal(i.Metadata); err == nil {
+			item.Metadata = datatypes.JSON(raw)
+		}
+	}
+	return item
+}
+
+// ToDomainSettlementItem convierte un modelo de base de datos a item de dominio
+func ToDomainSettlementItem(i *models.CodSettlementItem) *domain.SettlementItem {
+	if i == nil {
+		return nil
+	}
+	item := &domain.SettlementItem{
+		ID:             i.ID,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+		SettlementID:   i.SettlementID,
+		OrderID:        i.OrderID,
+		OrderNumber:    i.OrderNumber,
+		GuideID:        i.GuideID,
+		TrackingNumber: i.TrackingNumber,
+		Reference:      i.Reference,
+		ExpectedAmount: i.ExpectedAmount,
+		DeclaredAmount: i.DeclaredAmount,
+		Variance:       i.Variance,
+		Status:         i.Status,
+		PaymentID:      i.PaymentID,
+		ReconciledAt:   i.ReconciledAt,
+		Notes:          i.Notes,
+	}
+	if len(i.Metadata) > 0 {
+		_ = json.Unmarshal(i.Metadata, &item.Metadata)
+	}
+	return item
+}
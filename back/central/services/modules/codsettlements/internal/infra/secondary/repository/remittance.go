@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// codOrderRow es el resultado crudo de la orden con la guía y entrega de su último envío
+type codOrderRow struct {
+	ID             string
+	BusinessID     *uint
+	OrderNumber    string
+	GuideID        string
+	TrackingNumber string
+	CodTotal       float64
+	Currency       string
+	IsPaid         bool
+	Status         string
+	DeliveredAt    *time.Time
+}
+
+// codOrderSelect toma la guía, el rastreo y la fecha de entrega del último envío y, si no tiene, de la orden
+const codOrderSelect = `o.id, o.business_id, o.order_number,
+	COALESCE(ls.guide_id, o.guide_id, '') AS guide_id,
+	COALESCE(ls.tracking_number, o.tracking_number, '') AS tracking_number,
+	COALESCE(o.cod_total, 0) AS cod_total, o.currency, o.is_paid, o.status,
+	COALESCE(ls.delivered_at, o.delivered_at) AS delivered_at`
+
+// ListUnsettledCodOrders obtiene las órdenes contra entrega entregadas en el periodo, sin pagar y que
+// no están en ninguna liquidación. Para transportadoras solo cuentan las órdenes con un envío suyo.
+func (r *Repository) ListUnsettledCodOrders(ctx context.Context, filters domain.CodOrderFilters) ([]domain.CodOrder, error) {
+	query := r.db.Conn(ctx).Table("orders AS o")
+
+	if filters.CarrierCode != "" {
+		query = query.Joins(`JOIN LATERAL (
+			SELECT s.guide_id, s.tracking_number, s.delivered_at FROM shipments s
+			WHERE s.order_id = o.id AND s.deleted_at IS NULL AND LOWER(s.carrier_code) = LOWER(?)
+			ORDER BY s.id DESC LIMIT 1) ls ON true`, filters.CarrierCode)
+	} else {
+		query = query.Joins(`LEFT JOIN LATERAL (
+			SELECT s.guide_id, s.tracking_number, s.delivered_at FROM shipments s
+			WHERE s.order_id = o.id AND s.deleted_at IS NULL
+			ORDER BY s.id DESC LIMIT 1) ls ON true`)
+	}
+	if filters.DriverID != nil {
+		query = query.Where("o.driver_id = ?", *filters.DriverID)
+	}
+
+	var rows []codOrderRow
+	err := query.
+		Select(codOrderSelect).
+		Where("o.deleted_at IS NULL AND o.business_id = ? AND o.status = ?", filters.BusinessID, domain.OrderStatusDelivered).
+		Where("o.cod_total > 0 AND o.is_paid = ? AND o.currency = ?", false, filters.Currency).
+		Where("COALESCE(ls.delivered_at, o.delivered_at, o.updated_at) >= ? AND COALESCE(ls.delivered_at, o.delivered_at, o.updated_at) < ?", filters.From, filters.To).
+		Where("NOT EXISTS (SELECT 1 FROM cod_settlement_items csi WHERE csi.order_id = o.id AND csi.deleted_at IS NULL)").
+		Order("o.delivered_at ASC, o.created_at ASC").
+		Limit(filters.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.CodOrder, len(rows))
+	for i := range rows {
+		orders[i] = toCodOrder(&rows[i])
+	}
+	return orders, nil
+}
+
+// FindOrderByReference busca la orden del negocio cuya guía o número de rastreo (de la orden o de
+// alguno de sus envíos) coincide con la referencia normalizada
+func (r *Repository) FindOrderByReference(ctx context.Context, businessID uint, reference string) (*domain.CodOrder, error) {
+	var rows []codOrderRow
+	err := r.db.Conn(ctx).
+		Table("orders AS o").
+		Joins(`LEFT JOIN LATERAL (
+			SELECT s.guide_id, s.tracking_number, s.delivered_at FROM shipments s
+			WHERE s.order_id = o.id AND s.deleted_at IS NULL
+			AND (UPPER(REPLACE(s.guide_id, ' ', '')) = ? OR UPPER(REPLACE(s.tracking_number, ' ', '')) = ?)
+			ORDER BY s.id DESC LIMIT 1) ls ON true`, reference, reference).
+		Select(codOrderSelect).
+		Where("o.deleted_at IS NULL AND o.business_id = ?", businessID).
+		Where(`ls.guide_id IS NOT NULL OR ls.tracking_number IS NOT NULL
+			OR UPPER(REPLACE(o.guide_id, ' ', '')) = ? OR UPPER(REPLACE(o.tracking_number, ' ', '')) = ?`, reference, reference).
+		Order("o.created_at DESC").
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	order := toCodOrder(&rows[0])
+	return &order, nil
+}
+
+// SaveRemittance guarda la remesa en una transacción con la liquidación bloqueada. Si otra remesa ya
+// concilió alguno de los items (se leyeron sin bloqueo) retorna ErrSettlementModified. Guarda primero
+// los items (los nuevos reciben su ID) y luego, por cada item conciliado, registra un pago completado
+// por el monto remitido y recalcula el saldo de la orden.
+func (r *Repository) SaveRemittance(ctx context.Context, settlement *domain.Settlement, items []*domain.SettlementItem, reconciled []*domain.SettlementItem) error {
+	return r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked models.CodSettlement
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Where("id = ?", settlement.ID).
+			First(&locked).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrSettlementNotFound
+			}
+			return err
+		}
+		if locked.Status == domain.SettlementStatusClosed {
+			return domain.ErrSettlementClosed
+		}
+
+		var itemIDs []uint
+		for _, item := range items {
+			if item.ID > 0 {
+				itemIDs = append(itemIDs, item.ID)
+			}
+		}
+		if len(itemIDs) > 0 {
+			var alreadyReconciled int64
+			if err := tx.Model(&models.CodSettlementItem{}).
+				Where("id IN ? AND status = ?", itemIDs, domain.ItemStatusReconciled).
+				Count(&alreadyReconciled).Error; err != nil {
+				return err
+			}
+			if alreadyReconciled > 0 {
+				return domain.ErrSettlementModified
+			}
+		}
+
+		for _, item := range items {
+			dbItem := mappers.ToDBSettlementItem(item)
+			if err := tx.Save(dbItem).Error; err != nil {
+				return err
+			}
+			item.ID = dbItem.ID
+			item.CreatedAt = dbItem.CreatedAt
+			item.UpdatedAt = dbItem.UpdatedAt
+		}
+
+		now := time.Now()
+		for _, item := range reconciled {
+			if err := reconcileItem(tx, settlement, item, now); err != nil {
+				return err
+			}
+			if err := tx.Model(&models.CodSettlementItem{}).
+				Where("id = ?", item.ID).
+				Updates(map[string]interface{}{
+					"payment_id":    item.PaymentID,
+					"reconciled_at": item.ReconciledAt,
+					"notes":         item.Notes,
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.CodSettlement{}).
+			Where("id = ?", settlement.ID).
+			Updates(map[string]interface{}{
+				"expected_amount":  settlement.ExpectedAmount,
+				"declared_amount":  settlement.DeclaredAmount,
+				"variance":         settlement.Variance,
+				"order_count":      settlement.OrderCount,
+				"reconciled_count": settlement.ReconciledCount,
+				"mismatch_count":   settlement.MismatchCount,
+				"status":           settlement.Status,
+			}).Error
+	})
+}
+
+// reconcileItem registra el pago contra entrega de la orden del item y recalcula el saldo de la orden
+// con todos sus pagos: queda pagada solo si los pagos completados cubren el total
+func reconcileItem(tx *gorm.DB, settlement *domain.Settlement, item *domain.SettlementItem, now time.Time) error {
+	if item.OrderID == nil || item.DeclaredAmount == nil {
+		return fmt.Errorf("settlement item %d has no order or declared amount", item.ID)
+	}
+
+	var order models.Order
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "payment_method_id", "is_paid").
+		Where("id = ?", *item.OrderID).
+		First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", domain.ErrOrderNotFound, *item.OrderID)
+		}
+		return err
+	}
+
+	item.ReconciledAt = &now
+	if order.IsPaid {
+		item.Notes = "La orden ya estaba pagada, no se registró un pago nuevo"
+		return nil
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"settlement_id":      settlement.ID,
+		"settlement_item_id": item.ID,
+		"party_type":         settlement.PartyType,
+		"party_name":         settlement.PartyName,
+	})
+	if err != nil {
+		return err
+	}
+
+	gateway := domain.CodPaymentGateway
+	paymentReference := fmt.Sprintf("COD-SETTLEMENT-%d", settlement.ID)
+	payment := &models.Payment{
+		OrderID:          order.ID,
+		PaymentMethodID:  order.PaymentMethodID,
+		Amount:           *item.DeclaredAmount,
+		Currency:         settlement.Currency,
+		Status:           domain.PaymentStatusCompleted,
+		PaidAt:           &now,
+		ProcessedAt:      &now,
+		PaymentReference: &paymentReference,
+		Gateway:          &gateway,
+		Metadata:         datatypes.JSON(metadata),
+	}
+	if item.Reference != "" {
+		payment.TransactionID = &item.Reference
+	}
+	if err := tx.Create(payment).Error; err != nil {
+		return err
+	}
+
+	summary, err := paymentledger.RecomputeOrder(tx, order.ID)
+	if err != nil {
+		return err
+	}
+	if !summary.IsPaid {
+		item.Notes = fmt.Sprintf("Pago registrado; la orden queda con saldo pendiente de %.2f", summary.BalanceDue)
+	}
+
+	item.PaymentID = &payment.ID
+	return nil
+}
+
+// toCodOrder convierte la fila cruda en la orden de dominio
+func toCodOrder(row *codOrderRow) domain.CodOrder {
+	return domain.CodOrder{
+		ID:             row.ID,
+		BusinessID:     row.BusinessID,
+		OrderNumber:    row.OrderNumber,
+		GuideID:        row.GuideID,
+		TrackingNumber: row.TrackingNumber,
+		CodTotal:       row.CodTotal,
+		Currency:       row.Currency,
+		IsPaid:         row.IsPaid,
+		Status:         row.Status,
+		DeliveredAt:    row.DeliveredAt,
+	}
+}
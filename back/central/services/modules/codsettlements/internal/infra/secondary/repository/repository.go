@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/codsettlements/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// Repository implementa el repositorio de liquidaciones contra entrega
+type Repository struct {
+	db db.IDatabase
+}
+
+// New crea una nueva instancia del repositorio
+func New(database db.IDatabase) domain.IRepository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// CreateSettlement crea la liquidación con sus items en una transacción
+func (r *Repository) CreateSettlement(ctx context.Context, settlement *domain.Settlement) error {
+	dbSettlement := mappers.ToDBSettlement(settlement)
+	dbItems := make([]*models.CodSettlementItem, len(settlement.Items))
+
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbSettlement).Error; err != nil {
+			return err
+		}
+		for i := range settlement.Items {
+			settlement.Items[i].SettlementID = dbSettlement.ID
+			dbItems[i] = mappers.ToDBSettlementItem(&settlement.Items[i])
+		}
+		if len(dbItems) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(dbItems, 500).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	// Actualizar el modelo de dominio con los valores generados
+	settlement.ID = dbSettlement.ID
+	settlement.CreatedAt = dbSettlement.CreatedAt
+	settlement.UpdatedAt = dbSettlement.UpdatedAt
+	for i := range dbItems {
+		settlement.Items[i].ID = dbItems[i].ID
+		settlement.Items[i].CreatedAt = dbItems[i].CreatedAt
+		settlement.Items[i].UpdatedAt = dbItems[i].UpdatedAt
+	}
+	return nil
+}
+
+// GetSettlementByID obtiene una liquidación por su ID, opcionalmente con sus items
+func (r *Repository) GetSettlementByID(ctx context.Context, id uint, withItems bool) (*domain.Settlement, error) {
+	query := r.db.Conn(ctx)
+	if withItems {
+		query = query.Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		})
+	}
+
+	var settlement models.CodSettlement
+	if err := query.Where("id = ?", id).First(&settlement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSettlementNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainSettlement(&settlement), nil
+}
+
+// ListSettlements obtiene una lista paginada de liquidaciones (sin items) con filtros
+func (r *Repository) ListSettlements(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.Settlement, int64, error) {
+	var dbSettlements []models.CodSettlement
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.CodSettlement{})
+
+	// Filtro por business_id
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	// Filtro por tipo de remitente
+	if partyType, ok := filters["party_type"].(string); ok && partyType != "" {
+		query = query.Where("party_type = ?", partyType)
+	}
+
+	// Filtro por conductor
+	if driverID, ok := filters["driver_id"].(uint); ok && driverID > 0 {
+		query = query.Where("driver_id = ?", driverID)
+	}
+
+	// Filtro por transportadora
+	if carrierCode, ok := filters["carrier_code"].(string); ok && carrierCode != "" {
+		query = query.Where("LOWER(carrier_code) = LOWER(?)", carrierCode)
+	}
+
+	// Filtro por estado
+	if status, ok := filters["status"].(string); ok && status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Solo liquidaciones con diferencias
+	if withMismatches, ok := filters["with_mismatches"].(bool); ok && withMismatches {
+		query = query.Where("mismatch_count > 0")
+	}
+
+	// Contar total (antes de aplicar paginación y ordenamiento)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Aplicar ordenamiento y paginación
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&dbSettlements).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Convertir a dominio
+	settlements := make([]domain.Settlement, len(dbSettlements))
+	for i := range dbSettlements {
+		settlements[i] = *mappers.ToDomainSettlement(&dbSettlements[i])
+	}
+
+	return settlements, total, nil
+}
+
+// CloseSettlement guarda el cierre de la liquidación
+func (r *Repository) CloseSettlement(ctx context.Context, settlement *domain.Settlement) error {
+	return r.db.Conn(ctx).
+		Model(&models.CodSettlement{}).
+		Where("id = ?", settlement.ID).
+		Updates(map[string]interface{}{
+			"status":    settlement.Status,
+			"closed_at": settlement.ClosedAt,
+		}).Error
+}
+
+// GetDriverName obtiene el nombre del conductor del negocio
+func (r *Repository) GetDriverName(ctx context.Context, businessID, driverID uint) (string, error) {
+	var driver models.Driver
+	err := r.db.Conn(ctx).
+		Select("id", "name").
+		Where("id = ? AND business_id = ?", driverID, businessID).
+		First(&driver).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", domain.ErrDriverNotFound
+		}
+		return "", err
+	}
+	return driver.Name, nil
+}
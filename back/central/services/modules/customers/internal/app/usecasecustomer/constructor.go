@@ -0,0 +1,17 @@
+package usecasecustomer
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// UseCaseCustomer contiene los casos de uso CRUD y de perfil de clientes
+type UseCaseCustomer struct {
+	repo domain.IRepository
+}
+
+// New crea una nueva instancia de UseCaseCustomer
+func New(repo domain.IRepository) *UseCaseCustomer {
+	return &UseCaseCustomer{
+		repo: repo,
+	}
+}
@@ -0,0 +1,287 @@
+package usecasecustomer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/normalize"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// ───────────────────────────────────────────
+//
+//	CREATE CUSTOMER
+//
+// ───────────────────────────────────────────
+
+// CreateCustomer crea un nuevo cliente
+func (uc *UseCaseCustomer) CreateCustomer(ctx context.Context, req *domain.CreateCustomerRequest) (*domain.CustomerResponse, error) {
+	customer := &domain.Customer{
+		BusinessID: req.BusinessID,
+		Name:       strings.TrimSpace(req.Name),
+		Email:      normalize.Email(req.Email),
+		Phone:      strings.TrimSpace(req.Phone),
+		Dni:        normalizeDni(req.Dni),
+	}
+
+	if customer.Name == "" || customer.Email == "" {
+		return nil, domain.ErrInvalidCustomerData
+	}
+
+	if err := uc.normalizePhone(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ensureUnique(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateCustomer(ctx, customer); err != nil {
+		return nil, fmt.Errorf("error creating customer: %w", err)
+	}
+
+	return toCustomerResponse(customer), nil
+}
+
+// ───────────────────────────────────────────
+//
+//	GET CUSTOMER BY ID
+//
+// ───────────────────────────────────────────
+
+// GetCustomerByID obtiene un cliente por su ID
+func (uc *UseCaseCustomer) GetCustomerByID(ctx context.Context, id uint) (*domain.CustomerResponse, error) {
+	customer, err := uc.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("error getting customer: %w", err)
+	}
+
+	return toCustomerResponse(customer), nil
+}
+
+// ───────────────────────────────────────────
+//
+//	LIST CUSTOMERS
+//
+// ───────────────────────────────────────────
+
+// ListCustomers obtiene una lista paginada de clientes con filtros
+func (uc *UseCaseCustomer) ListCustomers(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.CustomersListResponse, error) {
+	// Validar paginación
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	customers, total, err := uc.repo.ListCustomers(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing customers: %w", err)
+	}
+
+	customerResponses := make([]domain.CustomerResponse, len(customers))
+	for i := range customers {
+		customerResponses[i] = *toCustomerResponse(&customers[i])
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	return &domain.CustomersListResponse{
+		Data:       customerResponses,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	UPDATE CUSTOMER
+//
+// ───────────────────────────────────────────
+
+// UpdateCustomer actualiza un cliente existente
+func (uc *UseCaseCustomer) UpdateCustomer(ctx context.Context, id uint, req *domain.UpdateCustomerRequest) (*domain.CustomerResponse, error) {
+	customer, err := uc.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("error getting customer: %w", err)
+	}
+
+	if req.Name != nil {
+		customer.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Email != nil {
+		customer.Email = normalize.Email(*req.Email)
+	}
+	if req.Phone != nil {
+		customer.Phone = strings.TrimSpace(*req.Phone)
+		if err := uc.normalizePhone(ctx, customer); err != nil {
+			return nil, err
+		}
+	}
+	if req.Dni != nil {
+		customer.Dni = normalizeDni(req.Dni)
+	}
+
+	if customer.Name == "" || customer.Email == "" {
+		return nil, domain.ErrInvalidCustomerData
+	}
+
+	if err := uc.ensureUnique(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateCustomer(ctx, customer); err != nil {
+		return nil, fmt.Errorf("error updating customer: %w", err)
+	}
+
+	return toCustomerResponse(customer), nil
+}
+
+// ───────────────────────────────────────────
+//
+//	DELETE CUSTOMER
+//
+// ───────────────────────────────────────────
+
+// DeleteCustomer elimina (soft delete) un cliente
+func (uc *UseCaseCustomer) DeleteCustomer(ctx context.Context, id uint) error {
+	if _, err := uc.repo.GetCustomerByID(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) {
+			return domain.ErrCustomerNotFound
+		}
+		return fmt.Errorf("error getting customer: %w", err)
+	}
+
+	if err := uc.repo.DeleteCustomer(ctx, id); err != nil {
+		return fmt.Errorf("error deleting customer: %w", err)
+	}
+
+	return nil
+}
+
+// ───────────────────────────────────────────
+//
+//	CUSTOMER PROFILE
+//
+// ───────────────────────────────────────────
+
+// GetCustomerProfile obtiene la vista 360 del cliente con su historial de entregas
+func (uc *UseCaseCustomer) GetCustomerProfile(ctx context.Context, id uint) (*domain.CustomerProfileResponse, error) {
+	customer, err := uc.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("error getting customer: %w", err)
+	}
+
+	stats, err := uc.repo.GetCustomerOrderStats(ctx, customer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting customer order stats: %w", err)
+	}
+
+	profile := &domain.CustomerProfileResponse{
+		Customer:                   *toCustomerResponse(customer),
+		OrderCount:                 stats.OrderCount,
+		LifetimeValue:              stats.LifetimeValue,
+		DeliveredCount:             stats.DeliveredCount,
+		ReturnedCount:              stats.ReturnedCount,
+		CancelledCount:             stats.CancelledCount,
+		AverageDeliveryProbability: stats.AverageDeliveryProbability,
+		LastOrder:                  stats.LastOrder,
+	}
+
+	// Tasa de entrega sobre las órdenes con desenlace conocido
+	if resolved := stats.DeliveredCount + stats.ReturnedCount + stats.CancelledCount; resolved > 0 {
+		rate := float64(stats.DeliveredCount) / float64(resolved)
+		profile.DeliveryRate = &rate
+	}
+
+	return profile, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// ensureUnique valida que no exista otro cliente del negocio con el mismo email o DNI
+func (uc *UseCaseCustomer) ensureUnique(ctx context.Context, customer *domain.Customer) error {
+	exists, err := uc.repo.CustomerEmailExists(ctx, customer.BusinessID, customer.Email, customer.ID)
+	if err != nil {
+		return fmt.Errorf("error checking if customer exists: %w", err)
+	}
+	if exists {
+		return domain.ErrCustomerAlreadyExists
+	}
+
+	if customer.Dni != nil {
+		exists, err = uc.repo.CustomerDniExists(ctx, customer.BusinessID, *customer.Dni, customer.ID)
+		if err != nil {
+			return fmt.Errorf("error checking if customer exists: %w", err)
+		}
+		if exists {
+			return domain.ErrCustomerAlreadyExists
+		}
+	}
+
+	return nil
+}
+
+// normalizePhone calcula la forma E.164 del teléfono del cliente con el país por defecto del negocio.
+// Los teléfonos que no se pueden normalizar se conservan tal cual y quedan sin E.164.
+func (uc *UseCaseCustomer) normalizePhone(ctx context.Context, customer *domain.Customer) error {
+	customer.PhoneE164 = ""
+	if customer.Phone == "" {
+		return nil
+	}
+
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, customer.BusinessID)
+	if err != nil {
+		return fmt.Errorf("error getting business default country: %w", err)
+	}
+
+	customer.PhoneE164 = phone.Normalize(customer.Phone, country).E164
+	return nil
+}
+
+// normalizeDni limpia el DNI y lo convierte en nil si queda vacío
+func normalizeDni(dni *string) *string {
+	if dni == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*dni)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// toCustomerResponse convierte un cliente de dominio a su DTO de respuesta
+func toCustomerResponse(c *domain.Customer) *domain.CustomerResponse {
+	return &domain.CustomerResponse{
+		ID:         c.ID,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		BusinessID: c.BusinessID,
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		PhoneE164:  c.PhoneE164,
+		Dni:        c.Dni,
+	}
+}
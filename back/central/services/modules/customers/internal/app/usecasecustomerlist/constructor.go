@@ -0,0 +1,17 @@
+package usecasecustomerlist
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// UseCaseCustomerList contiene los casos de uso de las listas de bloqueo y confianza
+type UseCaseCustomerList struct {
+	repo domain.IRepository
+}
+
+// New crea una nueva instancia de UseCaseCustomerList
+func New(repo domain.IRepository) *UseCaseCustomerList {
+	return &UseCaseCustomerList{
+		repo: repo,
+	}
+}
@@ -0,0 +1,164 @@
+package usecasecustomerlist
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// columnas requeridas del CSV de importación
+var requiredImportColumns = []string{"list_type", "match_type", "value"}
+
+// ImportListEntriesCSV importa entradas de blocklist/allowlist desde un CSV con encabezado.
+// Columnas: list_type, match_type, value y opcionalmente reason y expires_at
+// (RFC3339 o YYYY-MM-DD). Acepta "," o ";" como separador. Si el valor ya existe
+// en el negocio, la entrada se actualiza, de modo que re-importar la misma planilla es idempotente.
+func (uc *UseCaseCustomerList) ImportListEntriesCSV(ctx context.Context, businessID uint, file io.Reader, createdBy *uint) (*domain.CustomerListImportResult, error) {
+	reader, err := newCSVReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidCSV, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range requiredImportColumns {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", domain.ErrInvalidCSV, required)
+		}
+	}
+
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting business default country: %w", err)
+	}
+
+	result := &domain.CustomerListImportResult{}
+	row := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		row++
+		if err != nil {
+			result.AddError(row, err)
+			continue
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		created, err := uc.importRow(ctx, businessID, country, createdBy, field)
+		if err != nil {
+			result.AddError(row, err)
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// importRow crea o actualiza la entrada de una fila. Retorna true si la entrada es nueva.
+// country es el país por defecto del negocio, usado para normalizar teléfonos.
+func (uc *UseCaseCustomerList) importRow(ctx context.Context, businessID uint, country string, createdBy *uint, field func(string) string) (bool, error) {
+	listType := strings.ToLower(field("list_type"))
+	if !domain.IsValidListType(listType) {
+		return false, domain.ErrInvalidListType
+	}
+	matchType := strings.ToLower(field("match_type"))
+	value := field("value")
+	normalized, err := domain.NormalizeListValue(matchType, value, country)
+	if err != nil {
+		return false, err
+	}
+	expiresAt, err := parseExpiresAt(field("expires_at"))
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := uc.repo.GetListEntryByValue(ctx, businessID, matchType, normalized)
+	if err != nil {
+		return false, fmt.Errorf("error checking list entry: %w", err)
+	}
+
+	if existing != nil {
+		existing.ListType = listType
+		existing.Value = value
+		existing.Reason = field("reason")
+		existing.ExpiresAt = expiresAt
+		existing.Source = domain.ListSourceCSVImport
+		return false, uc.repo.UpdateListEntry(ctx, existing)
+	}
+
+	entry := &domain.CustomerListEntry{
+		BusinessID:      businessID,
+		ListType:        listType,
+		MatchType:       matchType,
+		Value:           value,
+		NormalizedValue: normalized,
+		Reason:          field("reason"),
+		ExpiresAt:       expiresAt,
+		Source:          domain.ListSourceCSVImport,
+		CreatedBy:       createdBy,
+	}
+	if err := uc.repo.CreateListEntry(ctx, entry); err != nil {
+		return false, fmt.Errorf("error creating list entry: %w", err)
+	}
+	return true, nil
+}
+
+// newCSVReader crea un lector CSV detectando el separador a partir del encabezado
+func newCSVReader(file io.Reader) (*csv.Reader, error) {
+	buffered := bufio.NewReader(file)
+	firstLine, err := buffered.Peek(buffered.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidCSV, err)
+	}
+	if i := strings.IndexByte(string(firstLine), '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	reader := csv.NewReader(buffered)
+	if strings.Count(string(firstLine), ";") > strings.Count(string(firstLine), ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	return reader, nil
+}
+
+// parseExpiresAt interpreta la fecha de vencimiento en RFC3339 o YYYY-MM-DD
+func parseExpiresAt(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return &t, nil
+	}
+	if t, err := time.Parse("2006-01-02", raw); err == nil {
+		return &t, nil
+	}
+	return nil, fmt.Errorf("invalid expires_at %q, expected RFC3339 or YYYY-MM-DD", raw)
+}
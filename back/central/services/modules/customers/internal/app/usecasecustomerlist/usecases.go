@@ -0,0 +1,130 @@
+package usecasecustomerlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// CreateListEntry crea una entrada de blocklist/allowlist.
+// Un mismo valor solo puede estar en una lista por negocio.
+func (uc *UseCaseCustomerList) CreateListEntry(ctx context.Context, req *domain.CreateCustomerListEntryRequest) (*domain.CustomerListEntry, error) {
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, req.BusinessID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting business default country: %w", err)
+	}
+	normalized, err := domain.NormalizeListValue(req.MatchType, req.Value, country)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.IsValidListType(req.ListType) {
+		return nil, domain.ErrInvalidListType
+	}
+
+	existing, err := uc.repo.GetListEntryByValue(ctx, req.BusinessID, req.MatchType, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("error checking list entry: %w", err)
+	}
+	if existing != nil {
+		return nil, domain.ErrListEntryAlreadyExists
+	}
+
+	entry := &domain.CustomerListEntry{
+		BusinessID:      req.BusinessID,
+		ListType:        req.ListType,
+		MatchType:       req.MatchType,
+		Value:           strings.TrimSpace(req.Value),
+		NormalizedValue: normalized,
+		Reason:          strings.TrimSpace(req.Reason),
+		ExpiresAt:       req.ExpiresAt,
+		Source:          domain.ListSourceManual,
+		CreatedBy:       req.CreatedBy,
+	}
+
+	if err := uc.repo.CreateListEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("error creating list entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetListEntryByID obtiene una entrada de lista por su ID
+func (uc *UseCaseCustomerList) GetListEntryByID(ctx context.Context, id uint) (*domain.CustomerListEntry, error) {
+	entry, err := uc.repo.GetListEntryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrListEntryNotFound) {
+			return nil, domain.ErrListEntryNotFound
+		}
+		return nil, fmt.Errorf("error getting list entry: %w", err)
+	}
+	return entry, nil
+}
+
+// ListListEntries obtiene una lista paginada de entradas con filtros
+func (uc *UseCaseCustomerList) ListListEntries(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.CustomerListEntriesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	entries, total, err := uc.repo.ListListEntries(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing list entries: %w", err)
+	}
+
+	return &domain.CustomerListEntriesResponse{
+		Data:       entries,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int(math.Ceil(float64(total) / float64(pageSize))),
+	}, nil
+}
+
+// UpdateListEntry actualiza el tipo de lista, el motivo o el vencimiento de una entrada
+func (uc *UseCaseCustomerList) UpdateListEntry(ctx context.Context, id uint, req *domain.UpdateCustomerListEntryRequest) (*domain.CustomerListEntry, error) {
+	entry, err := uc.GetListEntryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ListType != nil {
+		if !domain.IsValidListType(*req.ListType) {
+			return nil, domain.ErrInvalidListType
+		}
+		entry.ListType = *req.ListType
+	}
+	if req.Reason != nil {
+		entry.Reason = strings.TrimSpace(*req.Reason)
+	}
+	if req.ClearExpires {
+		entry.ExpiresAt = nil
+	} else if req.ExpiresAt != nil {
+		entry.ExpiresAt = req.ExpiresAt
+	}
+
+	if err := uc.repo.UpdateListEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteListEntry elimina una entrada de lista
+func (uc *UseCaseCustomerList) DeleteListEntry(ctx context.Context, id uint) error {
+	if _, err := uc.GetListEntryByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.DeleteListEntry(ctx, id); err != nil {
+		return fmt.Errorf("error deleting list entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package usecasededup
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// UseCaseDedup contiene los casos de uso de detección y fusión de clientes duplicados
+type UseCaseDedup struct {
+	repo domain.IRepository
+}
+
+// New crea una nueva instancia de UseCaseDedup
+func New(repo domain.IRepository) *UseCaseDedup {
+	return &UseCaseDedup{
+		repo: repo,
+	}
+}
@@ -0,0 +1,199 @@
+package usecasededup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/normalize"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// maxGroupSize limita los grupos que comparten una misma clave: grupos más grandes
+// suelen corresponder a valores de relleno ("0000000", "sin@email.com") y no a duplicados reales
+const maxGroupSize = 25
+
+// FindDuplicates detecta pares de clientes de un negocio que probablemente son la misma persona
+// y propone su fusión. Compara DNI, email (incluyendo alias), teléfono normalizado y
+// nombre aproximado dentro de la misma dirección de envío.
+func (uc *UseCaseDedup) FindDuplicates(ctx context.Context, businessID uint, minScore float64, limit int) (*domain.DuplicateCandidatesResponse, error) {
+	customers, err := uc.repo.ListCustomersForDedup(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing customers for dedup: %w", err)
+	}
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, businessID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting business default country: %w", err)
+	}
+
+	// Agrupar clientes por cada clave de coincidencia
+	groups := map[string]map[string][]int{
+		domain.MatchReasonDni:         {},
+		domain.MatchReasonEmail:       {},
+		domain.MatchReasonEmailAlias:  {},
+		domain.MatchReasonPhone:       {},
+		domain.MatchReasonNameAddress: {},
+	}
+	for i := range customers {
+		for reason, key := range matchKeys(&customers[i], country) {
+			groups[reason][key] = append(groups[reason][key], i)
+		}
+	}
+
+	// Acumular motivos por par de clientes
+	pairs := make(map[[2]int]map[string]bool)
+	for reason, byKey := range groups {
+		for _, members := range byKey {
+			if len(members) < 2 || len(members) > maxGroupSize {
+				continue
+			}
+			for a := 0; a < len(members); a++ {
+				for b := a + 1; b < len(members); b++ {
+					i, j := members[a], members[b]
+					if reason == domain.MatchReasonNameAddress &&
+						normalize.NameSimilarity(customers[i].Name, customers[j].Name) < domain.MinNameSimilarity {
+						continue
+					}
+					if reason == domain.MatchReasonEmailAlias &&
+						normalize.Email(customers[i].Email) == normalize.Email(customers[j].Email) {
+						continue // ya cubierto por la coincidencia exacta de email
+					}
+					pair := [2]int{i, j}
+					if pairs[pair] == nil {
+						pairs[pair] = make(map[string]bool)
+					}
+					pairs[pair][reason] = true
+				}
+			}
+		}
+	}
+
+	candidates := make([]domain.DuplicateCandidate, 0, len(pairs))
+	for pair, reasonSet := range pairs {
+		reasons := sortedReasons(reasonSet)
+		score := matchScore(reasons)
+		if score < minScore {
+			continue
+		}
+
+		a, b := &customers[pair[0]], &customers[pair[1]]
+		candidates = append(candidates, domain.DuplicateCandidate{
+			Customers:           []domain.CustomerResponse{toResponse(&a.Customer), toResponse(&b.Customer)},
+			SuggestedSurvivorID: suggestSurvivor(a, b),
+			Score:               score,
+			MatchReasons:        reasons,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].SuggestedSurvivorID < candidates[j].SuggestedSurvivorID
+	})
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return &domain.DuplicateCandidatesResponse{
+		BusinessID: businessID,
+		Data:       candidates,
+		Total:      len(candidates),
+	}, nil
+}
+
+// matchKeys calcula las claves de coincidencia de un cliente por cada motivo.
+// El teléfono se compara en E.164; country normaliza los clientes guardados sin E.164.
+func matchKeys(c *domain.DedupCustomer, country string) map[string]string {
+	keys := make(map[string]string)
+
+	if c.Dni != nil {
+		if dni := normalize.DocumentID(*c.Dni); dni != "" {
+			keys[domain.MatchReasonDni] = dni
+		}
+	}
+	if email := normalize.Email(c.Email); email != "" {
+		keys[domain.MatchReasonEmail] = email
+	}
+	if alias := normalize.EmailAliasKey(c.Email); alias != "" {
+		keys[domain.MatchReasonEmailAlias] = alias
+	}
+	phoneE164 := c.PhoneE164
+	if phoneE164 == "" {
+		phoneE164 = phone.Normalize(c.Phone, country).E164
+	}
+	if phoneE164 != "" {
+		keys[domain.MatchReasonPhone] = phoneE164
+	}
+	if street := normalize.Text(c.ShippingStreet); street != "" {
+		keys[domain.MatchReasonNameAddress] = street + "|" + normalize.Text(c.ShippingCity)
+	}
+
+	return keys
+}
+
+// matchReasons calcula los motivos de coincidencia entre dos clientes concretos
+func matchReasons(a, b *domain.DedupCustomer, country string) []string {
+	keysA, keysB := matchKeys(a, country), matchKeys(b, country)
+	reasonSet := make(map[string]bool)
+	for reason, key := range keysA {
+		if keysB[reason] != key {
+			continue
+		}
+		if reason == domain.MatchReasonNameAddress &&
+			normalize.NameSimilarity(a.Name, b.Name) < domain.MinNameSimilarity {
+			continue
+		}
+		reasonSet[reason] = true
+	}
+	if reasonSet[domain.MatchReasonEmail] {
+		delete(reasonSet, domain.MatchReasonEmailAlias)
+	}
+	return sortedReasons(reasonSet)
+}
+
+// matchScore combina los pesos de los motivos como probabilidades independientes
+func matchScore(reasons []string) float64 {
+	miss := 1.0
+	for _, reason := range reasons {
+		miss *= 1 - domain.MatchReasonWeights[reason]
+	}
+	return 1 - miss
+}
+
+// suggestSurvivor propone conservar el cliente con más órdenes y, a igualdad, el más antiguo
+func suggestSurvivor(a, b *domain.DedupCustomer) uint {
+	if a.OrderCount != b.OrderCount {
+		if a.OrderCount > b.OrderCount {
+			return a.ID
+		}
+		return b.ID
+	}
+	if a.ID < b.ID {
+		return a.ID
+	}
+	return b.ID
+}
+
+func sortedReasons(set map[string]bool) []string {
+	reasons := make([]string, 0, len(set))
+	for reason := range set {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+func toResponse(c *domain.Customer) domain.CustomerResponse {
+	return domain.CustomerResponse{
+		ID:         c.ID,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		BusinessID: c.BusinessID,
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		Dni:        c.Dni,
+	}
+}
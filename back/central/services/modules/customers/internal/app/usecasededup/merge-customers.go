@@ -0,0 +1,123 @@
+package usecasededup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// mergeAttempts es la cantidad de veces que se recalcula una fusión cuando los clientes cambian entre la lectura y el bloqueo
+const mergeAttempts = 3
+
+// MergeCustomers fusiona el cliente origen en el cliente sobreviviente.
+// Las órdenes del origen pasan al sobreviviente, que además hereda email, teléfono
+// y DNI si no los tenía. El origen queda eliminado y se guarda un registro de auditoría.
+func (uc *UseCaseDedup) MergeCustomers(ctx context.Context, survivorID uint, req *domain.MergeCustomersRequest) (*domain.MergeCustomersResponse, error) {
+	if survivorID == req.SourceCustomerID {
+		return nil, domain.ErrCannotMergeSameCustomer
+	}
+
+	var err error
+	for attempt := 1; attempt <= mergeAttempts; attempt++ {
+		var response *domain.MergeCustomersResponse
+		response, err = uc.mergeCustomersOnce(ctx, survivorID, req)
+		if !errors.Is(err, domain.ErrCustomersModified) {
+			return response, err
+		}
+	}
+	return nil, err
+}
+
+// mergeCustomersOnce lee ambos clientes, calcula la fusión y la aplica. El repositorio la rechaza
+// con ErrCustomersModified si alguno de los clientes cambió desde la lectura.
+func (uc *UseCaseDedup) mergeCustomersOnce(ctx context.Context, survivorID uint, req *domain.MergeCustomersRequest) (*domain.MergeCustomersResponse, error) {
+
+	survivor, err := uc.getCustomer(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+	source, err := uc.getCustomer(ctx, req.SourceCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if survivor.BusinessID != source.BusinessID {
+		return nil, domain.ErrCustomersFromDifferentBusiness
+	}
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, survivor.BusinessID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting business default country: %w", err)
+	}
+
+	survivorSnapshot := *survivor
+	sourceSnapshot := *source
+
+	reasons := matchReasons(
+		&domain.DedupCustomer{Customer: *survivor},
+		&domain.DedupCustomer{Customer: *source},
+		country,
+	)
+
+	// El sobreviviente hereda los datos de contacto que le falten
+	if survivor.Name == "" {
+		survivor.Name = source.Name
+	}
+	if survivor.Email == "" {
+		survivor.Email = source.Email
+	}
+	if survivor.Phone == "" {
+		survivor.Phone = source.Phone
+		survivor.PhoneE164 = source.PhoneE164
+	}
+	if survivor.Dni == nil && source.Dni != nil {
+		dni := *source.Dni
+		survivor.Dni = &dni
+	}
+
+	merge := &domain.CustomerMerge{
+		BusinessID:       survivor.BusinessID,
+		SurvivorClientID: survivor.ID,
+		MergedClientID:   source.ID,
+		MatchReasons:     reasons,
+		Score:            matchScore(reasons),
+		Reason:           req.Reason,
+		SurvivorSnapshot: &survivorSnapshot,
+		MergedSnapshot:   &sourceSnapshot,
+	}
+
+	if err := uc.repo.MergeCustomers(ctx, survivor, source, merge); err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) || errors.Is(err, domain.ErrCustomersModified) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error merging customers: %w", err)
+	}
+
+	return &domain.MergeCustomersResponse{
+		Survivor:    toResponse(survivor),
+		MergeID:     merge.ID,
+		OrdersMoved: merge.OrdersMoved,
+	}, nil
+}
+
+// ListCustomerMerges obtiene el historial de fusiones de un cliente.
+// Incluye las fusiones de clientes ya eliminados, cuyo historial sigue siendo consultable.
+func (uc *UseCaseDedup) ListCustomerMerges(ctx context.Context, customerID uint) ([]domain.CustomerMerge, error) {
+	merges, err := uc.repo.ListCustomerMerges(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing customer merges: %w", err)
+	}
+
+	return merges, nil
+}
+
+func (uc *UseCaseDedup) getCustomer(ctx context.Context, id uint) (*domain.Customer, error) {
+	customer, err := uc.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCustomerNotFound) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("error getting customer: %w", err)
+	}
+	return customer, nil
+}
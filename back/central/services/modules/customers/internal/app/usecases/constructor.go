@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"io"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/app/usecasecustomer"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/app/usecasecustomerlist"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/app/usecasededup"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// UseCases contiene todos los casos de uso del módulo customers
+type UseCases struct {
+	repo domain.IRepository
+
+	// Casos de uso modulares
+	CustomerCRUD  *usecasecustomer.UseCaseCustomer
+	CustomerDedup *usecasededup.UseCaseDedup
+	CustomerList  *usecasecustomerlist.UseCaseCustomerList
+}
+
+// New crea una nueva instancia de UseCases
+func New(repo domain.IRepository) *UseCases {
+	return &UseCases{
+		repo:          repo,
+		CustomerCRUD:  usecasecustomer.New(repo),
+		CustomerDedup: usecasededup.New(repo),
+		CustomerList:  usecasecustomerlist.New(repo),
+	}
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE COMPATIBILIDAD - Delegar al CRUD
+// ───────────────────────────────────────────
+
+// CreateCustomer delega al caso de uso CRUD
+func (uc *UseCases) CreateCustomer(ctx context.Context, req *domain.CreateCustomerRequest) (*domain.CustomerResponse, error) {
+	return uc.CustomerCRUD.CreateCustomer(ctx, req)
+}
+
+// GetCustomerByID delega al caso de uso CRUD
+func (uc *UseCases) GetCustomerByID(ctx context.Context, id uint) (*domain.CustomerResponse, error) {
+	return uc.CustomerCRUD.GetCustomerByID(ctx, id)
+}
+
+// ListCustomers delega al caso de uso CRUD
+func (uc *UseCases) ListCustomers(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.CustomersListResponse, error) {
+	return uc.CustomerCRUD.ListCustomers(ctx, page, pageSize, filters)
+}
+
+// UpdateCustomer delega al caso de uso CRUD
+func (uc *UseCases) UpdateCustomer(ctx context.Context, id uint, req *domain.UpdateCustomerRequest) (*domain.CustomerResponse, error) {
+	return uc.CustomerCRUD.UpdateCustomer(ctx, id, req)
+}
+
+// DeleteCustomer delega al caso de uso CRUD
+func (uc *UseCases) DeleteCustomer(ctx context.Context, id uint) error {
+	return uc.CustomerCRUD.DeleteCustomer(ctx, id)
+}
+
+// GetCustomerProfile delega al caso de uso CRUD
+func (uc *UseCases) GetCustomerProfile(ctx context.Context, id uint) (*domain.CustomerProfileResponse, error) {
+	return uc.CustomerCRUD.GetCustomerProfile(ctx, id)
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE DEDUPLICACIÓN - Delegar al caso de uso de dedup
+// ───────────────────────────────────────────
+
+// FindDuplicates delega al caso de uso de deduplicación
+func (uc *UseCases) FindDuplicates(ctx context.Context, businessID uint, minScore float64, limit int) (*domain.DuplicateCandidatesResponse, error) {
+	return uc.CustomerDedup.FindDuplicates(ctx, businessID, minScore, limit)
+}
+
+// MergeCustomers delega al caso de uso de deduplicación
+func (uc *UseCases) MergeCustomers(ctx context.Context, survivorID uint, req *domain.MergeCustomersRequest) (*domain.MergeCustomersResponse, error) {
+	return uc.CustomerDedup.MergeCustomers(ctx, survivorID, req)
+}
+
+// ListCustomerMerges delega al caso de uso de deduplicación
+func (uc *UseCases) ListCustomerMerges(ctx context.Context, customerID uint) ([]domain.CustomerMerge, error) {
+	return uc.CustomerDedup.ListCustomerMerges(ctx, customerID)
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE BLOCKLIST / ALLOWLIST - Delegar al caso de uso de listas
+// ───────────────────────────────────────────
+
+// CreateListEntry delega al caso de uso de listas
+func (uc *UseCases) CreateListEntry(ctx context.Context, req *domain.CreateCustomerListEntryRequest) (*domain.CustomerListEntry, error) {
+	return uc.CustomerList.CreateListEntry(ctx, req)
+}
+
+// GetListEntryByID delega al caso de uso de listas
+func (uc *UseCases) GetListEntryByID(ctx context.Context, id uint) (*domain.CustomerListEntry, error) {
+	return uc.CustomerList.GetListEntryByID(ctx, id)
+}
+
+// ListListEntries delega al caso de uso de listas
+func (uc *UseCases) ListListEntries(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.CustomerListEntriesResponse, error) {
+	return uc.CustomerList.ListListEntries(ctx, page, pageSize, filters)
+}
+
+// UpdateListEntry delega al caso de uso de listas
+func (uc *UseCases) UpdateListEntry(ctx context.Context, id uint, req *domain.UpdateCustomerListEntryRequest) (*domain.CustomerListEntry, error) {
+	return uc.CustomerList.UpdateListEntry(ctx, id, req)
+}
+
+// DeleteListEntry delega al caso de uso de listas
+func (uc *UseCases) DeleteListEntry(ctx context.Context, id uint) error {
+	return uc.CustomerList.DeleteListEntry(ctx, id)
+}
+
+// ImportListEntriesCSV delega al caso de uso de listas
+func (uc *UseCases) ImportListEntriesCSV(ctx context.Context, businessID uint, file io.Reader, createdBy *uint) (*domain.CustomerListImportResult, error) {
+	return uc.CustomerList.ImportListEntriesCSV(ctx, businessID, file, createdBy)
+}
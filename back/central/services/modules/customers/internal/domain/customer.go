@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// Customer representa un cliente de un negocio
+type Customer struct {
+	ID         uint       `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+	BusinessID uint       `json:"business_id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	Phone      string     `json:"phone"`
+	PhoneE164  string     `json:"phone_e164,omitempty"`
+	Dni        *string    `json:"dni,omitempty"`
+}
+
+// CustomerOrderStats contiene las métricas agregadas de las órdenes de un cliente
+type CustomerOrderStats struct {
+	OrderCount                 int64
+	LifetimeValue              float64
+	DeliveredCount             int64
+	ReturnedCount              int64
+	CancelledCount             int64
+	AverageDeliveryProbability *float64
+	LastOrder                  *CustomerLastOrder
+}
+
+// CustomerLastOrder resume la orden más reciente de un cliente
+type CustomerLastOrder struct {
+	ID                  string    `json:"id"`
+	OrderNumber         string    `json:"order_number"`
+	Status              string    `json:"status"`
+	TotalAmount         float64   `json:"total_amount"`
+	Currency            string    `json:"currency"`
+	DeliveryProbability *float64  `json:"delivery_probability,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// Estados de orden usados para agregar el historial de entregas del cliente
+var (
+	// DeliveredOrderStatuses son los estados que cuentan como entregados
+	DeliveredOrderStatuses = []string{"delivered", "completed"}
+
+	// ReturnedOrderStatuses son los estados que cuentan como devoluciones
+	ReturnedOrderStatuses = []string{"returned", "refunded"}
+
+	// CancelledOrderStatuses son los estados que cuentan como cancelados
+	CancelledOrderStatuses = []string{"cancelled"}
+
+	// NonRevenueOrderStatuses son los estados excluidos del valor de vida del cliente
+	NonRevenueOrderStatuses = []string{"cancelled", "refunded", "returned", "failed"}
+)
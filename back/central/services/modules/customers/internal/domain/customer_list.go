@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/secamc93/probability/back/central/shared/normalize"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// Tipos de lista de clientes
+const (
+	ListTypeBlocklist = "blocklist"
+	ListTypeAllowlist = "allowlist"
+)
+
+// Campos contra los que se compara una entrada de lista
+const (
+	ListMatchPhone   = "phone"
+	ListMatchEmail   = "email"
+	ListMatchDni     = "dni"
+	ListMatchAddress = "address"
+)
+
+// Orígenes de una entrada de lista
+const (
+	ListSourceManual    = "manual"
+	ListSourceCSVImport = "csv_import"
+)
+
+// CustomerListEntry es una entrada de la lista de bloqueo o de confianza de un negocio
+type CustomerListEntry struct {
+	ID              uint       `json:"id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	BusinessID      uint       `json:"business_id"`
+	ListType        string     `json:"list_type"`
+	MatchType       string     `json:"match_type"`
+	Value           string     `json:"value"`
+	NormalizedValue string     `json:"normalized_value"`
+	Reason          string     `json:"reason"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	Source          string     `json:"source"`
+	CreatedBy       *uint      `json:"created_by,omitempty"`
+}
+
+// IsActive indica si la entrada sigue vigente
+func (e *CustomerListEntry) IsActive(now time.Time) bool {
+	return e.ExpiresAt == nil || e.ExpiresAt.After(now)
+}
+
+// IsValidListType indica si el tipo de lista es soportado
+func IsValidListType(listType string) bool {
+	return listType == ListTypeBlocklist || listType == ListTypeAllowlist
+}
+
+// NormalizeListValue normaliza el valor de una entrada según el campo comparado.
+// Los teléfonos se guardan en E.164 usando defaultCountry (país por defecto del negocio) para los
+// números sin código de país. Debe coincidir con la normalización aplicada a las órdenes en la ingesta.
+func NormalizeListValue(matchType, value, defaultCountry string) (string, error) {
+	var normalized string
+	switch matchType {
+	case ListMatchPhone:
+		normalized = phone.Normalize(value, defaultCountry).E164
+	case ListMatchEmail:
+		normalized = normalize.EmailAliasKey(value)
+	case ListMatchDni:
+		normalized = normalize.DocumentID(value)
+	case ListMatchAddress:
+		normalized = normalize.Text(value)
+	default:
+		return "", ErrInvalidListMatchType
+	}
+	if normalized == "" {
+		return "", ErrInvalidListValue
+	}
+	return normalized, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateCustomerListEntryRequest representa la solicitud para crear una entrada de lista
+type CreateCustomerListEntryRequest struct {
+	BusinessID uint       `json:"business_id" binding:"required"`
+	ListType   string     `json:"list_type" binding:"required,oneof=blocklist allowlist"`
+	MatchType  string     `json:"match_type" binding:"required,oneof=phone email dni address"`
+	Value      string     `json:"value" binding:"required,max=255"`
+	Reason     string     `json:"reason" binding:"omitempty,max=500"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedBy  *uint      `json:"created_by"`
+}
+
+// UpdateCustomerListEntryRequest representa la solicitud para actualizar una entrada de lista
+type UpdateCustomerListEntryRequest struct {
+	ListType     *string    `json:"list_type" binding:"omitempty,oneof=blocklist allowlist"`
+	Reason       *string    `json:"reason" binding:"omitempty,max=500"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	ClearExpires bool       `json:"clear_expires"` // Quitar el vencimiento
+}
+
+// CustomerListEntriesResponse representa la respuesta paginada de entradas de lista
+type CustomerListEntriesResponse struct {
+	Data       []CustomerListEntry `json:"data"`
+	Total      int64               `json:"total"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+// CustomerListImportRowError describe una fila del CSV que no se pudo importar
+type CustomerListImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// CustomerListImportResult resume una importación masiva de entradas
+type CustomerListImportResult struct {
+	Created int                          `json:"created"`
+	Updated int                          `json:"updated"`
+	Failed  int                          `json:"failed"`
+	Errors  []CustomerListImportRowError `json:"errors,omitempty"`
+}
+
+// AddError registra una fila fallida en el resultado de la importación
+func (r *CustomerListImportResult) AddError(row int, err error) {
+	r.Failed++
+	r.Errors = append(r.Errors, CustomerListImportRowError{Row: row, Error: err.Error()})
+}
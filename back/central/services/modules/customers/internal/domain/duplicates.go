@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// Motivos por los que dos clientes se consideran posibles duplicados
+const (
+	MatchReasonDni         = "dni"
+	MatchReasonEmail       = "email"
+	MatchReasonEmailAlias  = "email_alias"
+	MatchReasonPhone       = "phone"
+	MatchReasonNameAddress = "name_address"
+)
+
+// MatchReasonWeights es el peso de cada motivo en el puntaje de similitud (0-1)
+var MatchReasonWeights = map[string]float64{
+	MatchReasonDni:         0.98,
+	MatchReasonEmail:       0.95,
+	MatchReasonEmailAlias:  0.85,
+	MatchReasonPhone:       0.85,
+	MatchReasonNameAddress: 0.70,
+}
+
+// MinNameSimilarity es la similitud mínima de nombre para proponer una coincidencia por nombre + dirección
+const MinNameSimilarity = 0.85
+
+// DedupCustomer es un cliente con los datos necesarios para detectar duplicados
+type DedupCustomer struct {
+	Customer
+	OrderCount     int64
+	ShippingStreet string
+	ShippingCity   string
+	LastOrderAt    *time.Time
+}
+
+// CustomerMerge es el registro de auditoría de una fusión de clientes
+type CustomerMerge struct {
+	ID               uint      `json:"id"`
+	CreatedAt        time.Time `json:"created_at"`
+	BusinessID       uint      `json:"business_id"`
+	SurvivorClientID uint      `json:"survivor_client_id"`
+	MergedClientID   uint      `json:"merged_client_id"`
+	MatchReasons     []string  `json:"match_reasons"`
+	Score            float64   `json:"score"`
+	Reason           string    `json:"reason"`
+	OrdersMoved      int64     `json:"orders_moved"`
+	SurvivorSnapshot *Customer `json:"survivor_snapshot,omitempty"`
+	MergedSnapshot   *Customer `json:"merged_snapshot,omitempty"`
+}
+
+// ───────────────────────────────────────────
+//
+//	DTOs
+//
+// ───────────────────────────────────────────
+
+// DuplicateCandidate es una propuesta de fusión entre dos clientes
+type DuplicateCandidate struct {
+	Customers           []CustomerResponse `json:"customers"`
+	SuggestedSurvivorID uint               `json:"suggested_survivor_id"`
+	Score               float64            `json:"score"`
+	MatchReasons        []string           `json:"match_reasons"`
+}
+
+// DuplicateCandidatesResponse representa la respuesta del listado de duplicados
+type DuplicateCandidatesResponse struct {
+	BusinessID uint                 `json:"business_id"`
+	Data       []DuplicateCandidate `json:"data"`
+	Total      int                  `json:"total"`
+}
+
+// MergeCustomersRequest representa la solicitud para fusionar un cliente en otro
+type MergeCustomersRequest struct {
+	SourceCustomerID uint   `json:"source_customer_id" binding:"required"`
+	Reason           string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// MergeCustomersResponse representa el resultado de una fusión
+type MergeCustomersResponse struct {
+	Survivor    CustomerResponse `json:"survivor"`
+	MergeID     uint             `json:"merge_id"`
+	OrdersMoved int64            `json:"orders_moved"`
+}
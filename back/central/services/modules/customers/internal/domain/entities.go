@@ -0,0 +1,67 @@
+package domain
+
+import "time"
+
+// ───────────────────────────────────────────
+//
+//	REQUEST DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateCustomerRequest representa la solicitud para crear un cliente
+type CreateCustomerRequest struct {
+	BusinessID uint    `json:"business_id" binding:"required"`
+	Name       string  `json:"name" binding:"required,max=255"`
+	Email      string  `json:"email" binding:"required,email,max=255"`
+	Phone      string  `json:"phone" binding:"omitempty,max=20"`
+	Dni        *string `json:"dni" binding:"omitempty,max=30"`
+}
+
+// UpdateCustomerRequest representa la solicitud para actualizar un cliente
+type UpdateCustomerRequest struct {
+	Name  *string `json:"name" binding:"omitempty,min=1,max=255"`
+	Email *string `json:"email" binding:"omitempty,email,max=255"`
+	Phone *string `json:"phone" binding:"omitempty,max=20"`
+	Dni   *string `json:"dni" binding:"omitempty,max=30"`
+}
+
+// ───────────────────────────────────────────
+//
+//	RESPONSE DTOs
+//
+// ───────────────────────────────────────────
+
+// CustomerResponse representa la respuesta de un cliente
+type CustomerResponse struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID uint      `json:"business_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	Phone      string    `json:"phone"`
+	PhoneE164  string    `json:"phone_e164,omitempty"`
+	Dni        *string   `json:"dni,omitempty"`
+}
+
+// CustomersListResponse representa la respuesta paginada de clientes
+type CustomersListResponse struct {
+	Data       []CustomerResponse `json:"data"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"page_size"`
+	TotalPages int                `json:"total_pages"`
+}
+
+// CustomerProfileResponse representa la vista 360 de un cliente
+type CustomerProfileResponse struct {
+	Customer                   CustomerResponse   `json:"customer"`
+	OrderCount                 int64              `json:"order_count"`
+	LifetimeValue              float64            `json:"lifetime_value"`
+	DeliveredCount             int64              `json:"delivered_count"`
+	ReturnedCount              int64              `json:"returned_count"`
+	CancelledCount             int64              `json:"cancelled_count"`
+	DeliveryRate               *float64           `json:"delivery_rate,omitempty"`
+	AverageDeliveryProbability *float64           `json:"average_delivery_probability,omitempty"`
+	LastOrder                  *CustomerLastOrder `json:"last_order,omitempty"`
+}
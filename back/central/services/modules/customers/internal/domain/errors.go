@@ -0,0 +1,45 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrCustomerNotFound se retorna cuando un cliente no existe
+	ErrCustomerNotFound = errors.New("customer not found")
+
+	// ErrCustomerAlreadyExists se retorna cuando ya existe un cliente con el mismo email o DNI en el negocio
+	ErrCustomerAlreadyExists = errors.New("customer with this email or DNI already exists for this business")
+
+	// ErrInvalidCustomerData se retorna cuando los datos del cliente son inválidos
+	ErrInvalidCustomerData = errors.New("invalid customer data")
+)
+
+var (
+	// ErrCannotMergeSameCustomer se retorna cuando se intenta fusionar un cliente consigo mismo
+	ErrCannotMergeSameCustomer = errors.New("cannot merge a customer into itself")
+
+	// ErrCustomersFromDifferentBusiness se retorna cuando los clientes a fusionar pertenecen a negocios distintos
+	ErrCustomersFromDifferentBusiness = errors.New("customers belong to different businesses")
+
+	// ErrCustomersModified se retorna cuando alguno de los clientes cambió mientras se preparaba la fusión
+	ErrCustomersModified = errors.New("customers were modified while merging, try again")
+)
+
+var (
+	// ErrListEntryNotFound se retorna cuando una entrada de lista no existe
+	ErrListEntryNotFound = errors.New("customer list entry not found")
+
+	// ErrListEntryAlreadyExists se retorna cuando ya existe una entrada para el mismo valor
+	ErrListEntryAlreadyExists = errors.New("customer list entry already exists for this value")
+
+	// ErrInvalidListMatchType se retorna cuando el campo de comparación no es soportado
+	ErrInvalidListMatchType = errors.New("invalid list match type, must be phone, email, dni or address")
+
+	// ErrInvalidListType se retorna cuando el tipo de lista no es soportado
+	ErrInvalidListType = errors.New("invalid list type, must be blocklist or allowlist")
+
+	// ErrInvalidListValue se retorna cuando el valor queda vacío tras normalizarlo
+	ErrInvalidListValue = errors.New("invalid list value for the given match type")
+
+	// ErrInvalidCSV se retorna cuando el archivo de importación no tiene el formato esperado
+	ErrInvalidCSV = errors.New("invalid CSV file")
+)
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+)
+
+// ───────────────────────────────────────────
+//
+//	REPOSITORY INTERFACE
+//
+// ───────────────────────────────────────────
+
+// IRepository define todos los métodos de repositorio del módulo customers
+type IRepository interface {
+	// CRUD Operations
+	CreateCustomer(ctx context.Context, customer *Customer) error
+	GetCustomerByID(ctx context.Context, id uint) (*Customer, error)
+	ListCustomers(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]Customer, int64, error)
+	UpdateCustomer(ctx context.Context, customer *Customer) error
+	DeleteCustomer(ctx context.Context, id uint) error
+
+	// Validation (excludeID permite ignorar al propio cliente en actualizaciones)
+	CustomerEmailExists(ctx context.Context, businessID uint, email string, excludeID uint) (bool, error)
+	CustomerDniExists(ctx context.Context, businessID uint, dni string, excludeID uint) (bool, error)
+
+	// Business
+	GetBusinessDefaultCountry(ctx context.Context, businessID uint) (string, error)
+
+	// Profile
+	GetCustomerOrderStats(ctx context.Context, customerID uint) (*CustomerOrderStats, error)
+
+	// Deduplication
+	ListCustomersForDedup(ctx context.Context, businessID uint) ([]DedupCustomer, error)
+	MergeCustomers(ctx context.Context, survivor, merged *Customer, merge *CustomerMerge) error
+	ListCustomerMerges(ctx context.Context, customerID uint) ([]CustomerMerge, error)
+
+	// Blocklist / Allowlist
+	CreateListEntry(ctx context.Context, entry *CustomerListEntry) error
+	GetListEntryByID(ctx context.Context, id uint) (*CustomerListEntry, error)
+	GetListEntryByValue(ctx context.Context, businessID uint, matchType, normalizedValue string) (*CustomerListEntry, error)
+	ListListEntries(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]CustomerListEntry, int64, error)
+	UpdateListEntry(ctx context.Context, entry *CustomerListEntry) error
+	DeleteListEntry(ctx context.Context, id uint) error
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/app/usecases"
+)
+
+// Handlers contiene todos los handlers del módulo customers
+type Handlers struct {
+	uc *usecases.UseCases
+}
+
+// New crea una nueva instancia de Handlers
+func New(uc *usecases.UseCases) *Handlers {
+	return &Handlers{
+		uc: uc,
+	}
+}
+
+// parseCustomerID convierte el parámetro de ruta en un ID de cliente válido
+func parseCustomerID(raw string) (uint, bool) {
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
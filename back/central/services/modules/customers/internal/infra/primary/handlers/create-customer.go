@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// CreateCustomer godoc
+// @Summary      Crear cliente
+// @Description  Crea un nuevo cliente para un negocio
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        customer  body      domain.CreateCustomerRequest  true  "Datos del cliente"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.CustomerResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers [post]
+func (h *Handlers) CreateCustomer(c *gin.Context) {
+	var req domain.CreateCustomerRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	customer, err := h.uc.CreateCustomer(c.Request.Context(), &req)
+	if err != nil {
+		if err == domain.ErrInvalidCustomerData {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Datos del cliente inválidos",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err == domain.ErrCustomerAlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{
+				"success": false,
+				"message": "Ya existe un cliente con este email o DNI",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al crear cliente",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Cliente creado exitosamente",
+		"data":    customer,
+	})
+}
@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// maxListImportSize es el tamaño máximo del archivo CSV de importación (5 MB)
+const maxListImportSize = 5 << 20
+
+// ListCustomerListEntries godoc
+// @Summary      Listar entradas de blocklist/allowlist
+// @Description  Obtiene una lista paginada de entradas de las listas de bloqueo y confianza de clientes
+// @Tags         Customer Lists
+// @Accept       json
+// @Produce      json
+// @Param        page         query    int     false  "Número de página (default: 1, min: 1)"
+// @Param        page_size    query    int     false  "Tamaño de página (default: 10, min: 1, max: 100)"
+// @Param        business_id  query    int     false  "Filtrar por ID de negocio"
+// @Param        list_type    query    string  false  "Filtrar por tipo de lista (blocklist, allowlist)"
+// @Param        match_type   query    string  false  "Filtrar por campo (phone, email, dni, address)"
+// @Param        search       query    string  false  "Búsqueda parcial en valor y motivo"
+// @Param        active_only  query    bool    false  "Solo entradas vigentes (no vencidas)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerListEntriesResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists [get]
+func (h *Handlers) ListCustomerListEntries(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	filters := make(map[string]interface{})
+	if businessID := c.Query("business_id"); businessID != "" {
+		if id, err := strconv.ParseUint(businessID, 10, 32); err == nil && id > 0 {
+			filters["business_id"] = uint(id)
+		}
+	}
+	for _, key := range []string{"list_type", "match_type", "search"} {
+		if value := c.Query(key); value != "" {
+			filters[key] = strings.ToLower(value)
+		}
+	}
+	if activeOnly, err := strconv.ParseBool(c.Query("active_only")); err == nil {
+		filters["active_only"] = activeOnly
+	}
+
+	response, err := h.uc.ListListEntries(c.Request.Context(), page, pageSize, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener entradas de lista",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Entradas de lista obtenidas exitosamente",
+		"data":        response.Data,
+		"total":       response.Total,
+		"page":        response.Page,
+		"page_size":   response.PageSize,
+		"total_pages": response.TotalPages,
+	})
+}
+
+// GetCustomerListEntry godoc
+// @Summary      Obtener entrada de blocklist/allowlist
+// @Description  Obtiene una entrada de lista por su ID
+// @Tags         Customer Lists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID de la entrada"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerListEntry
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists/{id} [get]
+func (h *Handlers) GetCustomerListEntry(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de entrada inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	entry, err := h.uc.GetListEntryByID(c.Request.Context(), id)
+	if err != nil {
+		respondListEntryError(c, err, "Error al obtener entrada de lista")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Entrada de lista obtenida exitosamente",
+		"data":    entry,
+	})
+}
+
+// CreateCustomerListEntry godoc
+// @Summary      Crear entrada de blocklist/allowlist
+// @Description  Agrega un teléfono, email, DNI o dirección a la lista de bloqueo o de confianza del negocio
+// @Tags         Customer Lists
+// @Accept       json
+// @Produce      json
+// @Param        entry  body      domain.CreateCustomerListEntryRequest  true  "Datos de la entrada"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.CustomerListEntry
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists [post]
+func (h *Handlers) CreateCustomerListEntry(c *gin.Context) {
+	var req domain.CreateCustomerListEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.uc.CreateListEntry(c.Request.Context(), &req)
+	if err != nil {
+		respondListEntryError(c, err, "Error al crear entrada de lista")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Entrada de lista creada exitosamente",
+		"data":    entry,
+	})
+}
+
+// UpdateCustomerListEntry godoc
+// @Summary      Actualizar entrada de blocklist/allowlist
+// @Description  Cambia el tipo de lista, el motivo o el vencimiento de una entrada
+// @Tags         Customer Lists
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                                    true  "ID de la entrada"
+// @Param        entry  body      domain.UpdateCustomerListEntryRequest  true  "Datos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerListEntry
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists/{id} [put]
+func (h *Handlers) UpdateCustomerListEntry(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de entrada inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	var req domain.UpdateCustomerListEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.uc.UpdateListEntry(c.Request.Context(), id, &req)
+	if err != nil {
+		respondListEntryError(c, err, "Error al actualizar entrada de lista")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Entrada de lista actualizada exitosamente",
+		"data":    entry,
+	})
+}
+
+// DeleteCustomerListEntry godoc
+// @Summary      Eliminar entrada de blocklist/allowlist
+// @Description  Elimina (soft delete) una entrada de lista
+// @Tags         Customer Lists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID de la entrada"
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists/{id} [delete]
+func (h *Handlers) DeleteCustomerListEntry(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de entrada inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	if err := h.uc.DeleteListEntry(c.Request.Context(), id); err != nil {
+		respondListEntryError(c, err, "Error al eliminar entrada de lista")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Entrada de lista eliminada exitosamente",
+	})
+}
+
+// ImportCustomerListEntries godoc
+// @Summary      Importar blocklist/allowlist desde CSV
+// @Description  Importa entradas desde un CSV con encabezado: list_type, match_type, value y opcionalmente reason, expires_at (RFC3339 o YYYY-MM-DD). Las entradas existentes se actualizan.
+// @Tags         Customer Lists
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        business_id  formData  int   true   "ID del negocio"
+// @Param        created_by   formData  int   false  "ID del usuario que importa"
+// @Param        file         formData  file  true   "Archivo CSV"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerListImportResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customer-lists/import [post]
+func (h *Handlers) ImportCustomerListEntries(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.PostForm("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' inválido",
+			"error":   "business_id es requerido y debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	var createdBy *uint
+	if raw := c.PostForm("created_by"); raw != "" {
+		if id, err := strconv.ParseUint(raw, 10, 32); err == nil && id > 0 {
+			userID := uint(id)
+			createdBy = &userID
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Archivo CSV requerido",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if fileHeader.Size > maxListImportSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "El archivo supera el tamaño máximo de 5 MB",
+			"error":   "file too large",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el archivo",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	result, err := h.uc.ImportListEntriesCSV(c.Request.Context(), uint(businessID), file, createdBy)
+	if err != nil {
+		respondListEntryError(c, err, "Error al importar entradas de lista")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Importación de entradas de lista completada",
+		"data":    result,
+	})
+}
+
+// respondListEntryError traduce los errores de dominio de las listas a respuestas HTTP
+func respondListEntryError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrListEntryNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"message": "Entrada de lista no encontrada",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrListEntryAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": "El valor ya está registrado en una lista del negocio",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrInvalidListType),
+		errors.Is(err, domain.ErrInvalidListMatchType),
+		errors.Is(err, domain.ErrInvalidListValue),
+		errors.Is(err, domain.ErrInvalidCSV):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": message,
+			"error":   err.Error(),
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// GetCustomerProfile godoc
+// @Summary      Perfil 360 del cliente
+// @Description  Obtiene el cliente junto con su historial: número de órdenes, valor de vida, entregadas vs devueltas vs canceladas, última orden y probabilidad de entrega promedio
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del cliente"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerProfileResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers/{id}/profile [get]
+func (h *Handlers) GetCustomerProfile(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de cliente inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	profile, err := h.uc.GetCustomerProfile(c.Request.Context(), id)
+	if err != nil {
+		if err == domain.ErrCustomerNotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Cliente no encontrado",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener perfil del cliente",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Perfil del cliente obtenido exitosamente",
+		"data":    profile,
+	})
+}
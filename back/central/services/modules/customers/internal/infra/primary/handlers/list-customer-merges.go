@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListCustomerMerges godoc
+// @Summary      Historial de fusiones del cliente
+// @Description  Obtiene los registros de auditoría de las fusiones en las que participó el cliente, como sobreviviente o como fusionado
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del cliente"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.CustomerMerge
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers/{id}/merges [get]
+func (h *Handlers) ListCustomerMerges(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de cliente inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	merges, err := h.uc.ListCustomerMerges(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener historial de fusiones",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Historial de fusiones obtenido exitosamente",
+		"data":    merges,
+	})
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListDuplicates godoc
+// @Summary      Detectar clientes duplicados
+// @Description  Propone fusiones entre clientes de un negocio que coinciden por DNI, email (incluyendo alias), teléfono normalizado o nombre aproximado con la misma dirección
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        business_id  query    int     true   "ID del negocio"
+// @Param        min_score    query    number  false  "Puntaje mínimo de similitud entre 0 y 1 (default: 0.7)"
+// @Param        limit        query    int     false  "Máximo de propuestas (default: 100, max: 500)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.DuplicateCandidatesResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers/duplicates [get]
+func (h *Handlers) ListDuplicates(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' inválido",
+			"error":   "business_id es requerido y debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	minScore, err := strconv.ParseFloat(c.DefaultQuery("min_score", "0.7"), 64)
+	if err != nil || minScore < 0 || minScore > 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'min_score' inválido. Debe ser un número entre 0 y 1",
+			"error":   "invalid min_score parameter",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'limit' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid limit parameter",
+		})
+		return
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	// Llamar al caso de uso
+	response, err := h.uc.FindDuplicates(c.Request.Context(), uint(businessID), minScore, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al detectar clientes duplicados",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Clientes duplicados obtenidos exitosamente",
+		"data":    response.Data,
+		"total":   response.Total,
+	})
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// MergeCustomers godoc
+// @Summary      Fusionar clientes
+// @Description  Fusiona el cliente origen en el cliente indicado: re-asigna sus órdenes, completa los datos de contacto faltantes, elimina el origen y registra la auditoría
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                           true  "ID del cliente que se conserva"
+// @Param        request  body      domain.MergeCustomersRequest  true  "Cliente a fusionar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.MergeCustomersResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers/{id}/merge [post]
+func (h *Handlers) MergeCustomers(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de cliente inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	var req domain.MergeCustomersRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	result, err := h.uc.MergeCustomers(c.Request.Context(), id, &req)
+	if err != nil {
+		if err == domain.ErrCustomerNotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Cliente no encontrado",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err == domain.ErrCustomersModified {
+			c.JSON(http.StatusConflict, gin.H{
+				"success": false,
+				"message": "Los clientes cambiaron durante la fusión, intenta de nuevo",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err == domain.ErrCannotMergeSameCustomer || err == domain.ErrCustomersFromDifferentBusiness {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Los clientes no se pueden fusionar",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al fusionar clientes",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Clientes fusionados exitosamente",
+		"data":    result,
+	})
+}
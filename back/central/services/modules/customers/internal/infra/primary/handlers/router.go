@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registra todas las rutas del módulo customers
+func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
+	customers := router.Group("/customers")
+	{
+		// CRUD básico
+		customers.GET("", h.ListCustomers)
+		customers.GET("/:id", h.GetCustomerByID)
+		customers.POST("", h.CreateCustomer)
+		customers.PUT("/:id", h.UpdateCustomer)
+		customers.DELETE("/:id", h.DeleteCustomer)
+
+		// Vista 360 del cliente
+		customers.GET("/:id/profile", h.GetCustomerProfile)
+
+		// Deduplicación y fusión
+		customers.GET("/duplicates", h.ListDuplicates)
+		customers.POST("/:id/merge", h.MergeCustomers)
+		customers.GET("/:id/merges", h.ListCustomerMerges)
+	}
+
+	customerLists := router.Group("/customer-lists")
+	{
+		// Blocklist / Allowlist
+		customerLists.GET("", h.ListCustomerListEntries)
+		customerLists.GET("/:id", h.GetCustomerListEntry)
+		customerLists.POST("", h.CreateCustomerListEntry)
+		customerLists.PUT("/:id", h.UpdateCustomerListEntry)
+		customerLists.DELETE("/:id", h.DeleteCustomerListEntry)
+		customerLists.POST("/import", h.ImportCustomerListEntries)
+	}
+}
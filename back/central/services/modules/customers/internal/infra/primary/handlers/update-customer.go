@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+)
+
+// UpdateCustomer godoc
+// @Summary      Actualizar cliente
+// @Description  Actualiza un cliente existente
+// @Tags         Customers
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                           true  "ID del cliente"
+// @Param        customer  body      domain.UpdateCustomerRequest  true  "Datos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CustomerResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /customers/{id} [put]
+func (h *Handlers) UpdateCustomer(c *gin.Context) {
+	id, ok := parseCustomerID(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de cliente inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	var req domain.UpdateCustomerRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	customer, err := h.uc.UpdateCustomer(c.Request.Context(), id, &req)
+	if err != nil {
+		if err == domain.ErrCustomerNotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Cliente no encontrado",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err == domain.ErrInvalidCustomerData {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Datos del cliente inválidos",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		if err == domain.ErrCustomerAlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{
+				"success": false,
+				"message": "Ya existe un cliente con este email o DNI",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al actualizar cliente",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Cliente actualizado exitosamente",
+		"data":    customer,
+	})
+}
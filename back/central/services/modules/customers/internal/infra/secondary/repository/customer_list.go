@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// CreateListEntry crea una entrada de blocklist/allowlist
+func (r *Repository) CreateListEntry(ctx context.Context, entry *domain.CustomerListEntry) error {
+	dbEntry := mappers.ToDBCustomerListEntry(entry)
+	if err := r.db.Conn(ctx).Create(dbEntry).Error; err != nil {
+		return err
+	}
+	entry.ID = dbEntry.ID
+	entry.CreatedAt = dbEntry.CreatedAt
+	entry.UpdatedAt = dbEntry.UpdatedAt
+	return nil
+}
+
+// GetListEntryByID obtiene una entrada de lista por su ID
+func (r *Repository) GetListEntryByID(ctx context.Context, id uint) (*domain.CustomerListEntry, error) {
+	var entry models.CustomerListEntry
+	err := r.db.Conn(ctx).
+		Where("id = ?", id).
+		First(&entry).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrListEntryNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainCustomerListEntry(&entry), nil
+}
+
+// GetListEntryByValue obtiene la entrada de un negocio para un valor normalizado.
+// Retorna nil si no existe.
+func (r *Repository) GetListEntryByValue(ctx context.Context, businessID uint, matchType, normalizedValue string) (*domain.CustomerListEntry, error) {
+	var entry models.CustomerListEntry
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND match_type = ? AND normalized_value = ?", businessID, matchType, normalizedValue).
+		First(&entry).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainCustomerListEntry(&entry), nil
+}
+
+// ListListEntries obtiene una lista paginada de entradas con filtros
+func (r *Repository) ListListEntries(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.CustomerListEntry, int64, error) {
+	var entries []models.CustomerListEntry
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.CustomerListEntry{})
+
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	if listType, ok := filters["list_type"].(string); ok && listType != "" {
+		query = query.Where("list_type = ?", listType)
+	}
+
+	if matchType, ok := filters["match_type"].(string); ok && matchType != "" {
+		query = query.Where("match_type = ?", matchType)
+	}
+
+	// Búsqueda parcial sobre el valor original y el motivo
+	if search, ok := filters["search"].(string); ok && search != "" {
+		like := "%" + search + "%"
+		query = query.Where("value ILIKE ? OR reason ILIKE ?", like, like)
+	}
+
+	// Solo entradas vigentes
+	if activeOnly, ok := filters["active_only"].(bool); ok && activeOnly {
+		query = query.Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]domain.CustomerListEntry, len(entries))
+	for i := range entries {
+		result[i] = *mappers.ToDomainCustomerListEntry(&entries[i])
+	}
+
+	return result, total, nil
+}
+
+// UpdateListEntry actualiza una entrada de lista
+func (r *Repository) UpdateListEntry(ctx context.Context, entry *domain.CustomerListEntry) error {
+	err := r.db.Conn(ctx).
+		Model(&models.CustomerListEntry{}).
+		Where("id = ?", entry.ID).
+		Updates(map[string]interface{}{
+			"list_type":  entry.ListType,
+			"value":      entry.Value,
+			"reason":     entry.Reason,
+			"expires_at": entry.ExpiresAt,
+			"source":     entry.Source,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("error updating list entry: %w", err)
+	}
+	return nil
+}
+
+// DeleteListEntry elimina (soft delete) una entrada de lista
+func (r *Repository) DeleteListEntry(ctx context.Context, id uint) error {
+	return r.db.Conn(ctx).Where("id = ?", id).Delete(&models.CustomerListEntry{}).Error
+}
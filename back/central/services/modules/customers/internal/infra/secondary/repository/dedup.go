@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// dedupRow es el resultado crudo de la consulta de clientes para deduplicación
+type dedupRow struct {
+	models.Client
+	OrderCount     int64
+	ShippingStreet string
+	ShippingCity   string
+	LastOrderAt    *time.Time
+}
+
+// ListCustomersForDedup obtiene los clientes activos de un negocio junto con su
+// número de órdenes y la dirección de envío de su orden más reciente
+func (r *Repository) ListCustomersForDedup(ctx context.Context, businessID uint) ([]domain.DedupCustomer, error) {
+	var rows []dedupRow
+	err := r.db.Conn(ctx).
+		Table("clients").
+		Select(`clients.*,
+			(SELECT COUNT(*) FROM orders o WHERE o.customer_id = clients.id) AS order_count,
+			latest.shipping_street AS shipping_street,
+			latest.shipping_city AS shipping_city,
+			latest.created_at AS last_order_at`).
+		Joins(`LEFT JOIN LATERAL (
+			SELECT o.shipping_street, o.shipping_city, o.created_at
+			FROM orders o
+			WHERE o.customer_id = clients.id
+			ORDER BY o.created_at DESC
+			LIMIT 1
+		) latest ON true`).
+		Where("clients.business_id = ? AND clients.deleted_at IS NULL", businessID).
+		Order("clients.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]domain.DedupCustomer, len(rows))
+	for i := range rows {
+		customers[i] = domain.DedupCustomer{
+			Customer:       *mappers.ToDomainCustomer(&rows[i].Client),
+			OrderCount:     rows[i].OrderCount,
+			ShippingStreet: rows[i].ShippingStreet,
+			ShippingCity:   rows[i].ShippingCity,
+			LastOrderAt:    rows[i].LastOrderAt,
+		}
+	}
+
+	return customers, nil
+}
+
+// MergeCustomers fusiona el cliente merged en survivor dentro de una transacción:
+// re-asigna las órdenes, actualiza el sobreviviente, elimina el fusionado y guarda la auditoría.
+// Retorna ErrCustomersModified si alguno de los clientes cambió después de que se calculó la fusión.
+func (r *Repository) MergeCustomers(ctx context.Context, survivor, merged *domain.Customer, merge *domain.CustomerMerge) error {
+	survivorSnapshot, err := json.Marshal(merge.SurvivorSnapshot)
+	if err != nil {
+		return fmt.Errorf("error serializing survivor snapshot: %w", err)
+	}
+	mergedSnapshot, err := json.Marshal(merge.MergedSnapshot)
+	if err != nil {
+		return fmt.Errorf("error serializing merged snapshot: %w", err)
+	}
+	reasons, err := json.Marshal(merge.MatchReasons)
+	if err != nil {
+		return fmt.Errorf("error serializing match reasons: %w", err)
+	}
+
+	return r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		// Bloquear y releer ambos clientes para evitar fusiones concurrentes: los datos heredados
+		// y las fotos de auditoría se calcularon con la versión leída antes de la transacción
+		var locked []models.Client
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uint{survivor.ID, merged.ID}).
+			Find(&locked).Error; err != nil {
+			return err
+		}
+		if len(locked) != 2 {
+			return domain.ErrCustomerNotFound
+		}
+		for _, current := range locked {
+			read := merge.SurvivorSnapshot
+			if current.ID == merged.ID {
+				read = merge.MergedSnapshot
+			}
+			if read == nil || !current.UpdatedAt.Equal(read.UpdatedAt) {
+				return domain.ErrCustomersModified
+			}
+		}
+
+		// 1. Re-asignar las órdenes del cliente fusionado
+		result := tx.Model(&models.Order{}).
+			Where("customer_id = ?", merged.ID).
+			Update("customer_id", survivor.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		merge.OrdersMoved = result.RowsAffected
+
+		// 2. Liberar email y DNI del fusionado: los índices únicos incluyen filas eliminadas,
+		// y el sobreviviente puede heredar esos valores
+		mergedEmail := fmt.Sprintf("merged-%d:%s", merged.ID, merged.Email)
+		if runes := []rune(mergedEmail); len(runes) > 255 {
+			mergedEmail = string(runes[:255])
+		}
+		if err := tx.Model(&models.Client{}).
+			Where("id = ?", merged.ID).
+			Updates(map[string]interface{}{"email": mergedEmail, "dni": nil}).Error; err != nil {
+			return err
+		}
+
+		// 3. Actualizar el sobreviviente con los datos heredados
+		if err := tx.Model(&models.Client{}).
+			Where("id = ?", survivor.ID).
+			Updates(map[string]interface{}{
+				"name":       survivor.Name,
+				"email":      survivor.Email,
+				"phone":      survivor.Phone,
+				"phone_e164": survivor.PhoneE164,
+				"dni":        survivor.Dni,
+			}).Error; err != nil {
+			return err
+		}
+
+		// 4. Eliminar (soft delete) el cliente fusionado
+		if err := tx.Where("id = ?", merged.ID).Delete(&models.Client{}).Error; err != nil {
+			return err
+		}
+
+		// 5. Registrar la auditoría
+		record := &models.ClientMerge{
+			BusinessID:       merge.BusinessID,
+			SurvivorClientID: survivor.ID,
+			MergedClientID:   merged.ID,
+			MatchReasons:     reasons,
+			Score:            merge.Score,
+			Reason:           merge.Reason,
+			OrdersMoved:      merge.OrdersMoved,
+			SurvivorSnapshot: survivorSnapshot,
+			MergedSnapshot:   mergedSnapshot,
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+
+		merge.ID = record.ID
+		merge.CreatedAt = record.CreatedAt
+		return nil
+	})
+}
+
+// ListCustomerMerges obtiene el historial de fusiones en las que participó un cliente
+func (r *Repository) ListCustomerMerges(ctx context.Context, customerID uint) ([]domain.CustomerMerge, error) {
+	var records []models.ClientMerge
+	err := r.db.Conn(ctx).
+		Where("survivor_client_id = ? OR merged_client_id = ?", customerID, customerID).
+		Order("created_at DESC").
+		Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make([]domain.CustomerMerge, len(records))
+	for i := range records {
+		merges[i] = *mappers.ToDomainCustomerMerge(&records[i])
+	}
+
+	return merges, nil
+}
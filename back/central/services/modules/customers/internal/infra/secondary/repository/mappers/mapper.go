@@ -0,0 +1,132 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ToDBCustomer convierte un cliente de dominio a modelo de base de datos
+func ToDBCustomer(c *domain.Customer) *models.Client {
+	if c == nil {
+		return nil
+	}
+	client := &models.Client{
+		Model: gorm.Model{
+			ID:        c.ID,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		},
+		BusinessID: c.BusinessID,
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		PhoneE164:  c.PhoneE164,
+		Dni:        c.Dni,
+	}
+	if c.DeletedAt != nil {
+		client.DeletedAt = gorm.DeletedAt{Time: *c.DeletedAt, Valid: true}
+	}
+	return client
+}
+
+// ToDomainCustomer convierte un modelo de base de datos a cliente de dominio
+func ToDomainCustomer(c *models.Client) *domain.Customer {
+	if c == nil {
+		return nil
+	}
+	customer := &domain.Customer{
+		ID:         c.ID,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		BusinessID: c.BusinessID,
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		PhoneE164:  c.PhoneE164,
+		Dni:        c.Dni,
+	}
+	if c.DeletedAt.Valid {
+		deletedAt := c.DeletedAt.Time
+		customer.DeletedAt = &deletedAt
+	}
+	return customer
+}
+
+// ToDomainCustomerMerge convierte un registro de auditoría de fusión a dominio
+func ToDomainCustomerMerge(m *models.ClientMerge) *domain.CustomerMerge {
+	if m == nil {
+		return nil
+	}
+	merge := &domain.CustomerMerge{
+		ID:               m.ID,
+		CreatedAt:        m.CreatedAt,
+		BusinessID:       m.BusinessID,
+		SurvivorClientID: m.SurvivorClientID,
+		MergedClientID:   m.MergedClientID,
+		Score:            m.Score,
+		Reason:           m.Reason,
+		OrdersMoved:      m.OrdersMoved,
+	}
+	// Los campos JSON son informativos: si no se pueden leer se omiten
+	_ = json.Unmarshal(m.MatchReasons, &merge.MatchReasons)
+	if len(m.SurvivorSnapshot) > 0 {
+		var snapshot domain.Customer
+		if err := json.Unmarshal(m.SurvivorSnapshot, &snapshot); err == nil {
+			merge.SurvivorSnapshot = &snapshot
+		}
+	}
+	if len(m.MergedSnapshot) > 0 {
+		var snapshot domain.Customer
+		if err := json.Unmarshal(m.MergedSnapshot, &snapshot); err == nil {
+			merge.MergedSnapshot = &snapshot
+		}
+	}
+	return merge
+}
+
+// ToDBCustomerListEntry convierte una entrada de lista de dominio a modelo de base de datos
+func ToDBCustomerListEntry(e *domain.CustomerListEntry) *models.CustomerListEntry {
+	if e == nil {
+		return nil
+	}
+	return &models.CustomerListEntry{
+		Model: gorm.Model{
+			ID:        e.ID,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+		BusinessID:      e.BusinessID,
+		ListType:        e.ListType,
+		MatchType:       e.MatchType,
+		Value:           e.Value,
+		NormalizedValue: e.NormalizedValue,
+		Reason:          e.Reason,
+		ExpiresAt:       e.ExpiresAt,
+		Source:          e.Source,
+		CreatedBy:       e.CreatedBy,
+	}
+}
+
+// ToDomainCustomerListEntry convierte un modelo de base de datos a entrada de lista de dominio
+func ToDomainCustomerListEntry(e *models.CustomerListEntry) *domain.CustomerListEntry {
+	if e == nil {
+		return nil
+	}
+	return &domain.CustomerListEntry{
+		ID:              e.ID,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+		BusinessID:      e.BusinessID,
+		ListType:        e.ListType,
+		MatchType:       e.MatchType,
+		Value:           e.Value,
+		NormalizedValue: e.NormalizedValue,
+		Reason:          e.Reason,
+		ExpiresAt:       e.ExpiresAt,
+		Source:          e.Source,
+		CreatedBy:       e.CreatedBy,
+	}
+}
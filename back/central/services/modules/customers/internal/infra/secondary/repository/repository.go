@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/customers/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// Repository implementa el repositorio de clientes
+type Repository struct {
+	db db.IDatabase
+}
+
+// New crea una nueva instancia del repositorio
+func New(database db.IDatabase) domain.IRepository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// CreateCustomer crea un nuevo cliente en la base de datos
+func (r *Repository) CreateCustomer(ctx context.Context, customer *domain.Customer) error {
+	dbCustomer := mappers.ToDBCustomer(customer)
+	if err := r.db.Conn(ctx).Create(dbCustomer).Error; err != nil {
+		return err
+	}
+	// Actualizar el modelo de dominio con los valores generados
+	customer.ID = dbCustomer.ID
+	customer.CreatedAt = dbCustomer.CreatedAt
+	customer.UpdatedAt = dbCustomer.UpdatedAt
+	return nil
+}
+
+// GetCustomerByID obtiene un cliente por su ID
+func (r *Repository) GetCustomerByID(ctx context.Context, id uint) (*domain.Customer, error) {
+	var client models.Client
+	err := r.db.Conn(ctx).
+		Where("id = ?", id).
+		First(&client).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainCustomer(&client), nil
+}
+
+// ListCustomers obtiene una lista paginada de clientes con filtros
+func (r *Repository) ListCustomers(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.Customer, int64, error) {
+	var clients []models.Client
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.Client{})
+
+	// Filtro por business_id
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	// Búsqueda general por nombre, email, teléfono o DNI
+	if search, ok := filters["search"].(string); ok && search != "" {
+		like := "%" + search + "%"
+		query = query.Where(
+			"name ILIKE ? OR email ILIKE ? OR phone ILIKE ? OR dni ILIKE ?",
+			like, like, like, like,
+		)
+	}
+
+	// Filtros específicos (búsqueda parcial, case-insensitive)
+	if name, ok := filters["name"].(string); ok && name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+
+	if email, ok := filters["email"].(string); ok && email != "" {
+		query = query.Where("email ILIKE ?", "%"+email+"%")
+	}
+
+	if phone, ok := filters["phone"].(string); ok && phone != "" {
+		query = query.Where("(phone ILIKE ? OR phone_e164 ILIKE ?)", "%"+phone+"%", "%"+phone+"%")
+	}
+
+	// Filtro por DNI (búsqueda exacta)
+	if dni, ok := filters["dni"].(string); ok && dni != "" {
+		query = query.Where("dni = ?", dni)
+	}
+
+	// Contar total (antes de aplicar paginación y ordenamiento)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Aplicar ordenamiento
+	sortBy := "created_at"
+	if sort, ok := filters["sort_by"].(string); ok && sort != "" {
+		sortFieldMap := map[string]string{
+			"id":         "id",
+			"name":       "name",
+			"email":      "email",
+			"created_at": "created_at",
+			"updated_at": "updated_at",
+		}
+		if mappedField, exists := sortFieldMap[sort]; exists {
+			sortBy = mappedField
+		}
+	}
+
+	sortOrder := "desc"
+	if order, ok := filters["sort_order"].(string); ok && order == "asc" {
+		sortOrder = order
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+
+	// Aplicar paginación
+	offset := (page - 1) * pageSize
+	if err := query.Offset(offset).Limit(pageSize).Find(&clients).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Convertir a dominio
+	customers := make([]domain.Customer, len(clients))
+	for i := range clients {
+		customers[i] = *mappers.ToDomainCustomer(&clients[i])
+	}
+
+	return customers, total, nil
+}
+
+// UpdateCustomer actualiza un cliente existente
+func (r *Repository) UpdateCustomer(ctx context.Context, customer *domain.Customer) error {
+	dbCustomer := mappers.ToDBCustomer(customer)
+	if err := r.db.Conn(ctx).Save(dbCustomer).Error; err != nil {
+		return err
+	}
+	customer.UpdatedAt = dbCustomer.UpdatedAt
+	return nil
+}
+
+// DeleteCustomer elimina (soft delete) un cliente
+func (r *Repository) DeleteCustomer(ctx context.Context, id uint) error {
+	return r.db.Conn(ctx).Where("id = ?", id).Delete(&models.Client{}).Error
+}
+
+// CustomerEmailExists verifica si existe otro cliente con el email en el negocio
+func (r *Repository) CustomerEmailExists(ctx context.Context, businessID uint, email string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Conn(ctx).
+		Model(&models.Client{}).
+		Where("business_id = ? AND LOWER(email) = LOWER(?) AND id <> ?", businessID, email, excludeID).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// CustomerDniExists verifica si existe otro cliente con el DNI en el negocio
+func (r *Repository) CustomerDniExists(ctx context.Context, businessID uint, dni string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Conn(ctx).
+		Model(&models.Client{}).
+		Where("business_id = ? AND dni = ? AND id <> ?", businessID, dni, excludeID).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	PROFILE
+//
+// ───────────────────────────────────────────
+
+// customerOrderAggregate es el resultado crudo de la agregación de órdenes
+type customerOrderAggregate struct {
+	OrderCount                 int64
+	LifetimeValue              float64
+	DeliveredCount             int64
+	ReturnedCount              int64
+	CancelledCount             int64
+	AverageDeliveryProbability *float64
+}
+
+// GetBusinessDefaultCountry obtiene el país por defecto del negocio usado para normalizar teléfonos
+func (r *Repository) GetBusinessDefaultCountry(ctx context.Context, businessID uint) (string, error) {
+	var countries []string
+	err := r.db.Conn(ctx).
+		Model(&models.Business{}).
+		Where("id = ?", businessID).
+		Limit(1).
+		Pluck("default_country", &countries).Error
+	if err != nil {
+		return "", err
+	}
+	if len(countries) == 0 {
+		return "", nil
+	}
+	return countries[0], nil
+}
+
+// GetCustomerOrderStats agrega las órdenes del cliente en una sola consulta
+func (r *Repository) GetCustomerOrderStats(ctx context.Context, customerID uint) (*domain.CustomerOrderStats, error) {
+	var agg customerOrderAggregate
+	err := r.db.Conn(ctx).
+		Model(&models.Order{}).
+		Select(
+			`COUNT(*) AS order_count,
+			COALESCE(SUM(CASE WHEN status NOT IN ? THEN total_amount ELSE 0 END), 0) AS lifetime_value,
+			COUNT(*) FILTER (WHERE status IN ?) AS delivered_count,
+			COUNT(*) FILTER (WHERE status IN ?) AS returned_count,
+			COUNT(*) FILTER (WHERE status IN ?) AS cancelled_count,
+			AVG(delivery_probability) AS average_delivery_probability`,
+			domain.NonRevenueOrderStatuses,
+			domain.DeliveredOrderStatuses,
+			domain.ReturnedOrderStatuses,
+			domain.CancelledOrderStatuses,
+		).
+		Where("customer_id = ?", customerID).
+		Scan(&agg).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.CustomerOrderStats{
+		OrderCount:                 agg.OrderCount,
+		LifetimeValue:              agg.LifetimeValue,
+		DeliveredCount:             agg.DeliveredCount,
+		ReturnedCount:              agg.ReturnedCount,
+		CancelledCount:             agg.CancelledCount,
+		AverageDeliveryProbability: agg.AverageDeliveryProbability,
+	}
+
+	if agg.OrderCount == 0 {
+		return stats, nil
+	}
+
+	// Última orden del cliente
+	var lastOrder models.Order
+	err = r.db.Conn(ctx).
+		Where("customer_id = ?", customerID).
+		Order("created_at DESC").
+		First(&lastOrder).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return stats, nil
+		}
+		return nil, err
+	}
+
+	stats.LastOrder = &domain.CustomerLastOrder{
+		ID:                  lastOrder.ID,
+		OrderNumber:         lastOrder.OrderNumber,
+		Status:              lastOrder.Status,
+		TotalAmount:         lastOrder.TotalAmount,
+		Currency:            lastOrder.Currency,
+		DeliveryProbability: lastOrder.DeliveryProbability,
+		CreatedAt:           lastOrder.CreatedAt,
+	}
+
+	return stats, nil
+}
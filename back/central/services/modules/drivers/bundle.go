@@ -0,0 +1,26 @@
+package drivers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/infra/secondary/repository"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/env"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// New inicializa el módulo de drivers (conductores, rutas de última milla y asignación de órdenes)
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig) {
+	// 1. Init Repositories
+	repo := repository.New(database)
+
+	// 2. Init Use Cases
+	uc := usecases.New(repo, logger)
+
+	// 3. Init Handlers
+	h := handlers.New(uc)
+
+	// 4. Register Routes
+	h.RegisterRoutes(router)
+}
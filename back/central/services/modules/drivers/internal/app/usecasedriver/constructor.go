@@ -0,0 +1,17 @@
+package usecasedriver
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// UseCaseDriver contiene los casos de uso CRUD de conductores
+type UseCaseDriver struct {
+	repo domain.IRepository
+}
+
+// New crea una nueva instancia de UseCaseDriver
+func New(repo domain.IRepository) *UseCaseDriver {
+	return &UseCaseDriver{
+		repo: repo,
+	}
+}
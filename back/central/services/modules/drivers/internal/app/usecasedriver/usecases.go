@@ -0,0 +1,218 @@
+package usecasedriver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	CREATE DRIVER
+//
+// ───────────────────────────────────────────
+
+// CreateDriver crea un nuevo conductor para un negocio
+func (uc *UseCaseDriver) CreateDriver(ctx context.Context, req *domain.CreateDriverRequest) (*domain.Driver, error) {
+	driver := &domain.Driver{
+		BusinessID:        req.BusinessID,
+		UserID:            req.UserID,
+		Name:              strings.TrimSpace(req.Name),
+		Phone:             strings.TrimSpace(req.Phone),
+		Email:             strings.ToLower(strings.TrimSpace(req.Email)),
+		DocumentNumber:    strings.TrimSpace(req.DocumentNumber),
+		VehicleType:       req.VehicleType,
+		VehiclePlate:      strings.ToUpper(strings.TrimSpace(req.VehiclePlate)),
+		VehicleCapacityKg: req.VehicleCapacityKg,
+		Zone:              domain.NormalizeZone(req.Zone),
+		IsActive:          true,
+		Notes:             req.Notes,
+	}
+
+	if driver.Name == "" {
+		return nil, domain.ErrInvalidDriverData
+	}
+
+	if err := uc.ensureUserLinkable(ctx, driver); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateDriver(ctx, driver); err != nil {
+		return nil, fmt.Errorf("error creating driver: %w", err)
+	}
+
+	return driver, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	GET DRIVER BY ID
+//
+// ───────────────────────────────────────────
+
+// GetDriverByID obtiene un conductor por su ID
+func (uc *UseCaseDriver) GetDriverByID(ctx context.Context, id uint) (*domain.Driver, error) {
+	driver, err := uc.repo.GetDriverByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDriverNotFound) {
+			return nil, domain.ErrDriverNotFound
+		}
+		return nil, fmt.Errorf("error getting driver: %w", err)
+	}
+
+	return driver, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	LIST DRIVERS
+//
+// ───────────────────────────────────────────
+
+// ListDrivers obtiene una lista paginada de conductores con filtros
+func (uc *UseCaseDriver) ListDrivers(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.DriversListResponse, error) {
+	// Validar paginación
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	if zone, ok := filters["zone"].(string); ok {
+		filters["zone"] = domain.NormalizeZone(zone)
+	}
+
+	drivers, total, err := uc.repo.ListDrivers(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing drivers: %w", err)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	return &domain.DriversListResponse{
+		Data:       drivers,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	UPDATE DRIVER
+//
+// ───────────────────────────────────────────
+
+// UpdateDriver actualiza un conductor existente (el negocio no cambia)
+func (uc *UseCaseDriver) UpdateDriver(ctx context.Context, id uint, req *domain.UpdateDriverRequest) (*domain.Driver, error) {
+	driver, err := uc.GetDriverByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UnlinkUser {
+		driver.UserID = nil
+	} else if req.UserID != nil {
+		driver.UserID = req.UserID
+	}
+	if req.Name != nil {
+		driver.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Phone != nil {
+		driver.Phone = strings.TrimSpace(*req.Phone)
+	}
+	if req.Email != nil {
+		driver.Email = strings.ToLower(strings.TrimSpace(*req.Email))
+	}
+	if req.DocumentNumber != nil {
+		driver.DocumentNumber = strings.TrimSpace(*req.DocumentNumber)
+	}
+	if req.VehicleType != nil {
+		driver.VehicleType = *req.VehicleType
+	}
+	if req.VehiclePlate != nil {
+		driver.VehiclePlate = strings.ToUpper(strings.TrimSpace(*req.VehiclePlate))
+	}
+	if req.VehicleCapacityKg != nil {
+		driver.VehicleCapacityKg = req.VehicleCapacityKg
+	}
+	if req.Zone != nil {
+		driver.Zone = domain.NormalizeZone(*req.Zone)
+	}
+	if req.IsActive != nil {
+		driver.IsActive = *req.IsActive
+	}
+	if req.Notes != nil {
+		driver.Notes = req.Notes
+	}
+
+	if driver.Name == "" {
+		return nil, domain.ErrInvalidDriverData
+	}
+
+	if err := uc.ensureUserLinkable(ctx, driver); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateDriver(ctx, driver); err != nil {
+		return nil, fmt.Errorf("error updating driver: %w", err)
+	}
+
+	return driver, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	DELETE DRIVER
+//
+// ───────────────────────────────────────────
+
+// DeleteDriver elimina (soft delete) un conductor. Las órdenes y envíos conservan el driver_id
+// y el nombre desnormalizado.
+func (uc *UseCaseDriver) DeleteDriver(ctx context.Context, id uint) error {
+	if _, err := uc.GetDriverByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.DeleteDriver(ctx, id); err != nil {
+		return fmt.Errorf("error deleting driver: %w", err)
+	}
+
+	return nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// ensureUserLinkable valida que la cuenta de usuario exista y no esté vinculada a otro conductor
+func (uc *UseCaseDriver) ensureUserLinkable(ctx context.Context, driver *domain.Driver) error {
+	if driver.UserID == nil {
+		return nil
+	}
+
+	exists, err := uc.repo.UserExists(ctx, *driver.UserID)
+	if err != nil {
+		return fmt.Errorf("error checking user: %w", err)
+	}
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+
+	linked, err := uc.repo.UserLinkedToDriver(ctx, *driver.UserID, driver.ID)
+	if err != nil {
+		return fmt.Errorf("error checking user link: %w", err)
+	}
+	if linked {
+		return domain.ErrUserAlreadyLinked
+	}
+	return nil
+}
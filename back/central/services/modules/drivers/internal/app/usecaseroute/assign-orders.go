@@ -0,0 +1,118 @@
+package usecaseroute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// AssignOrders asigna varias órdenes de última milla al conductor. Las órdenes de otro negocio,
+// que no son de última milla o que ya no se pueden despachar se omiten indicando el motivo.
+func (uc *UseCaseRoute) AssignOrders(ctx context.Context, driverID uint, req *domain.AssignOrdersRequest) (*domain.AssignOrdersResult, error) {
+	driver, err := uc.getDriver(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if !driver.IsActive {
+		return nil, domain.ErrDriverInactive
+	}
+
+	result, err := uc.classifyOrders(ctx, req.OrderIDs, driver.BusinessID, true)
+	if err != nil {
+		return nil, err
+	}
+	result.Driver = driver.Ref()
+	if len(result.Assigned) == 0 {
+		return result, nil
+	}
+
+	updatedShipments, err := uc.repo.AssignOrdersToDriver(ctx, result.Assigned, driver)
+	if err != nil {
+		return nil, fmt.Errorf("error assigning orders to driver: %w", err)
+	}
+	result.UpdatedShipments = updatedShipments
+
+	uc.logger.Info(ctx).
+		Uint("driver_id", driver.ID).
+		Int("assigned", len(result.Assigned)).
+		Int("skipped", len(result.Skipped)).
+		Int64("updated_shipments", updatedShipments).
+		Msg("Órdenes asignadas al conductor")
+
+	return result, nil
+}
+
+// UnassignOrders quita el conductor de varias órdenes del negocio y de sus envíos activos
+func (uc *UseCaseRoute) UnassignOrders(ctx context.Context, businessID uint, req *domain.AssignOrdersRequest) (*domain.AssignOrdersResult, error) {
+	result, err := uc.classifyOrders(ctx, req.OrderIDs, businessID, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Assigned) == 0 {
+		return result, nil
+	}
+
+	updatedShipments, err := uc.repo.AssignOrdersToDriver(ctx, result.Assigned, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error unassigning orders: %w", err)
+	}
+	result.UpdatedShipments = updatedShipments
+
+	uc.logger.Info(ctx).
+		Uint("business_id", businessID).
+		Int("unassigned", len(result.Assigned)).
+		Int("skipped", len(result.Skipped)).
+		Int64("updated_shipments", updatedShipments).
+		Msg("Órdenes desasignadas de su conductor")
+
+	return result, nil
+}
+
+// classifyOrders separa las órdenes que se pueden (des)asignar de las que se omiten
+func (uc *UseCaseRoute) classifyOrders(ctx context.Context, orderIDs []string, businessID uint, requireLastMile bool) (*domain.AssignOrdersResult, error) {
+	ids := make([]string, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		id = strings.TrimSpace(id)
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	orders, err := uc.repo.ListDispatchOrders(ctx, domain.DispatchOrderFilters{OrderIDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("error listing orders: %w", err)
+	}
+	byID := make(map[string]*domain.DispatchOrder, len(orders))
+	for i := range orders {
+		byID[orders[i].ID] = &orders[i]
+	}
+
+	result := &domain.AssignOrdersResult{
+		Assigned: []string{},
+		Skipped:  []domain.AssignSkip{},
+	}
+	for _, id := range ids {
+		reason := ""
+		o, ok := byID[id]
+		switch {
+		case !ok:
+			reason = domain.AssignSkipNotFound
+		case o.BusinessID == nil || *o.BusinessID != businessID:
+			reason = domain.AssignSkipOtherBusiness
+		case requireLastMile && !o.IsLastMile:
+			reason = domain.AssignSkipNotLastMile
+		case !slices.Contains(domain.AssignableOrderStatuses, o.Status):
+			reason = domain.AssignSkipInvalidStatus
+		}
+
+		if reason != "" {
+			result.Skipped = append(result.Skipped, domain.AssignSkip{OrderID: id, Reason: reason})
+			continue
+		}
+		result.Assigned = append(result.Assigned, id)
+	}
+	return result, nil
+}
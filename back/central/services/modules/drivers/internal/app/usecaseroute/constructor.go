@@ -0,0 +1,20 @@
+package usecaseroute
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCaseRoute contiene los casos de uso de planificación de rutas y asignación de órdenes
+type UseCaseRoute struct {
+	repo   domain.IRepository
+	logger log.ILogger
+}
+
+// New crea una nueva instancia de UseCaseRoute
+func New(repo domain.IRepository, logger log.ILogger) *UseCaseRoute {
+	return &UseCaseRoute{
+		repo:   repo,
+		logger: logger,
+	}
+}
@@ -0,0 +1,153 @@
+package usecaseroute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// GetDriverManifest genera la hoja de ruta del conductor con las órdenes que tiene asignadas
+func (uc *UseCaseRoute) GetDriverManifest(ctx context.Context, driverID uint, start *domain.GeoPoint) (*domain.RouteManifest, error) {
+	driver, err := uc.getDriver(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.driverManifest(ctx, driver, start)
+}
+
+// GetMyManifest genera la hoja de ruta del conductor vinculado a la cuenta de usuario (app móvil)
+func (uc *UseCaseRoute) GetMyManifest(ctx context.Context, userID uint, start *domain.GeoPoint) (*domain.RouteManifest, error) {
+	driver, err := uc.repo.GetDriverByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDriverNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting driver: %w", err)
+	}
+	return uc.driverManifest(ctx, driver, start)
+}
+
+func (uc *UseCaseRoute) driverManifest(ctx context.Context, driver *domain.Driver, start *domain.GeoPoint) (*domain.RouteManifest, error) {
+	orders, err := uc.repo.ListDispatchOrders(ctx, domain.DispatchOrderFilters{
+		DriverID: &driver.ID,
+		Statuses: domain.ManifestOrderStatuses,
+		Limit:    domain.MaxDispatchOrders,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing driver orders: %w", err)
+	}
+
+	if start == nil {
+		start = warehouseStart(orders)
+	}
+
+	manifest := buildManifest(orders, start)
+	manifest.Driver = driver.Ref()
+	manifest.Zone = driver.Zone
+	return &manifest, nil
+}
+
+// buildManifest ordena las paradas y calcula distancias y dinero a recaudar. Las órdenes sin
+// coordenadas o con el centroide de la ciudad no se pueden optimizar y quedan al final en orden de creación.
+func buildManifest(orders []domain.DispatchOrder, start *domain.GeoPoint) domain.RouteManifest {
+	manifest := domain.RouteManifest{
+		GeneratedAt: time.Now().UTC(),
+		Start:       start,
+		StopCount:   len(orders),
+		CodTotals:   make(map[string]float64),
+		Stops:       make([]domain.RouteStop, 0, len(orders)),
+	}
+
+	var routed, unrouted []*domain.DispatchOrder
+	var points []domain.GeoPoint
+	for i := range orders {
+		o := &orders[i]
+		if !o.HasPreciseLocation() {
+			unrouted = append(unrouted, o)
+			continue
+		}
+		routed = append(routed, o)
+		points = append(points, domain.GeoPoint{Lat: *o.Lat, Lng: *o.Lng})
+	}
+
+	previous := start
+	for _, idx := range domain.PlanRoute(start, points) {
+		stop := toRouteStop(routed[idx], len(manifest.Stops)+1)
+		stop.Routed = true
+		if previous != nil {
+			distance := math.Round(domain.DistanceMeters(*previous, points[idx]))
+			stop.DistanceFromPreviousMeters = &distance
+			manifest.TotalDistanceMeters += distance
+		}
+		previous = &points[idx]
+		manifest.Stops = append(manifest.Stops, stop)
+	}
+	for _, o := range unrouted {
+		manifest.Stops = append(manifest.Stops, toRouteStop(o, len(manifest.Stops)+1))
+	}
+	manifest.UnroutedCount = len(unrouted)
+
+	for _, stop := range manifest.Stops {
+		if stop.CodAmount > 0 {
+			manifest.CodOrderCount++
+			manifest.CodTotals[stop.Currency] += stop.CodAmount
+		}
+	}
+	for currency, total := range manifest.CodTotals {
+		manifest.CodTotals[currency] = math.Round(total*100) / 100
+	}
+
+	return manifest
+}
+
+func toRouteStop(o *domain.DispatchOrder, sequence int) domain.RouteStop {
+	return domain.RouteStop{
+		Sequence:         sequence,
+		OrderID:          o.ID,
+		OrderNumber:      o.OrderNumber,
+		Status:           o.Status,
+		CustomerName:     o.CustomerName,
+		CustomerPhone:    o.CustomerPhone,
+		Street:           o.Street,
+		City:             o.City,
+		Lat:              o.Lat,
+		Lng:              o.Lng,
+		GeocodePrecision: o.GeocodePrecision,
+		CodAmount:        o.CodAmount(),
+		Currency:         o.Currency,
+		IsPaid:           o.IsPaid,
+	}
+}
+
+// warehouseStart retorna las coordenadas del almacén del que salen más órdenes (nil si ninguno
+// tiene coordenadas)
+func warehouseStart(orders []domain.DispatchOrder) *domain.GeoPoint {
+	counts := make(map[domain.GeoPoint]int)
+	var best *domain.GeoPoint
+	for i := range orders {
+		if orders[i].WarehouseLat == nil || orders[i].WarehouseLng == nil {
+			continue
+		}
+		point := domain.GeoPoint{Lat: *orders[i].WarehouseLat, Lng: *orders[i].WarehouseLng}
+		counts[point]++
+		if best == nil || counts[point] > counts[*best] {
+			best = &point
+		}
+	}
+	return best
+}
+
+func (uc *UseCaseRoute) getDriver(ctx context.Context, id uint) (*domain.Driver, error) {
+	driver, err := uc.repo.GetDriverByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDriverNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting driver: %w", err)
+	}
+	return driver, nil
+}
@@ -0,0 +1,131 @@
+package usecaseroute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// Claves de los lotes de órdenes sin zona o sin conductor
+const (
+	unzonedBatchKey    = "UNZONED"
+	unassignedBatchKey = "unassigned"
+)
+
+// GenerateRouteBatches agrupa las órdenes de última milla listas para despacho por zona o por
+// conductor asignado y sugiere el orden de visita de cada lote. En los lotes por zona se sugieren
+// los conductores activos de esa zona.
+func (uc *UseCaseRoute) GenerateRouteBatches(ctx context.Context, filters domain.RouteBatchFilters) (*domain.RouteBatchesResponse, error) {
+	if filters.GroupBy == "" {
+		filters.GroupBy = domain.BatchGroupByZone
+	}
+	if filters.GroupBy != domain.BatchGroupByZone && filters.GroupBy != domain.BatchGroupByDriver {
+		return nil, domain.ErrInvalidGroupBy
+	}
+	zone := domain.NormalizeZone(filters.Zone)
+
+	orders, err := uc.repo.ListDispatchOrders(ctx, domain.DispatchOrderFilters{
+		BusinessID:   filters.BusinessID,
+		Statuses:     domain.DispatchOrderStatuses,
+		OnlyLastMile: true,
+		Zone:         zone,
+		Limit:        domain.MaxDispatchOrders + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing dispatch orders: %w", err)
+	}
+
+	response := &domain.RouteBatchesResponse{
+		GroupBy:     filters.GroupBy,
+		GeneratedAt: time.Now().UTC(),
+		Batches:     []domain.RouteBatch{},
+	}
+	if len(orders) > domain.MaxDispatchOrders {
+		orders = orders[:domain.MaxDispatchOrders]
+		response.Truncated = true
+	}
+	response.OrderCount = len(orders)
+	if len(orders) == 0 {
+		return response, nil
+	}
+
+	drivers, err := uc.repo.ListActiveDrivers(ctx, filters.BusinessID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing drivers: %w", err)
+	}
+
+	groups := make(map[string][]domain.DispatchOrder)
+	var keys []string
+	for _, o := range orders {
+		key := batchKey(&o, filters.GroupBy)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], o)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		batchOrders := groups[key]
+		start := filters.Start
+		if start == nil {
+			start = warehouseStart(batchOrders)
+		}
+
+		batch := domain.RouteBatch{
+			Key:   key,
+			Route: buildManifest(batchOrders, start),
+		}
+		if filters.GroupBy == domain.BatchGroupByZone {
+			batch.Route.Zone = key
+			batch.SuggestedDrivers = zoneDrivers(drivers, batchOrders[0])
+		} else if key != unassignedBatchKey {
+			batch.Route.Driver = batchDriver(drivers, batchOrders[0])
+		}
+		response.Batches = append(response.Batches, batch)
+	}
+
+	return response, nil
+}
+
+// batchKey retorna el lote de la orden: su zona o el conductor asignado
+func batchKey(o *domain.DispatchOrder, groupBy string) string {
+	if groupBy == domain.BatchGroupByDriver {
+		if o.DriverID == nil {
+			return unassignedBatchKey
+		}
+		return strconv.FormatUint(uint64(*o.DriverID), 10)
+	}
+	if zone := o.Zone(); zone != "" {
+		return zone
+	}
+	return unzonedBatchKey
+}
+
+// zoneDrivers retorna los conductores activos cuya zona coincide con el código o el nombre de la
+// ciudad de la orden
+func zoneDrivers(drivers []domain.Driver, o domain.DispatchOrder) []domain.DriverRef {
+	cityCode, city := domain.NormalizeZone(o.CityCode), domain.NormalizeZone(o.City)
+	var refs []domain.DriverRef
+	for i := range drivers {
+		zone := drivers[i].Zone
+		if zone != "" && (zone == cityCode || zone == city) {
+			refs = append(refs, *drivers[i].Ref())
+		}
+	}
+	return refs
+}
+
+// batchDriver retorna el conductor del lote. Si ya no está activo se usa el nombre guardado en la orden.
+func batchDriver(drivers []domain.Driver, o domain.DispatchOrder) *domain.DriverRef {
+	for i := range drivers {
+		if drivers[i].ID == *o.DriverID {
+			return drivers[i].Ref()
+		}
+	}
+	return &domain.DriverRef{ID: *o.DriverID, Name: o.DriverName}
+}
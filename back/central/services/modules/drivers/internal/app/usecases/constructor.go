@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/app/usecasedriver"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/app/usecaseroute"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCases contiene todos los casos de uso del módulo drivers
+type UseCases struct {
+	repo domain.IRepository
+
+	// Casos de uso modulares
+	DriverCRUD *usecasedriver.UseCaseDriver
+	Routing    *usecaseroute.UseCaseRoute
+}
+
+// New crea una nueva instancia de UseCases
+func New(repo domain.IRepository, logger log.ILogger) *UseCases {
+	return &UseCases{
+		repo:       repo,
+		DriverCRUD: usecasedriver.New(repo),
+		Routing:    usecaseroute.New(repo, logger),
+	}
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE CONDUCTORES - Delegar al CRUD
+// ───────────────────────────────────────────
+
+// CreateDriver delega al caso de uso CRUD
+func (uc *UseCases) CreateDriver(ctx context.Context, req *domain.CreateDriverRequest) (*domain.Driver, error) {
+	return uc.DriverCRUD.CreateDriver(ctx, req)
+}
+
+// GetDriverByID delega al caso de uso CRUD
+func (uc *UseCases) GetDriverByID(ctx context.Context, id uint) (*domain.Driver, error) {
+	return uc.DriverCRUD.GetDriverByID(ctx, id)
+}
+
+// ListDrivers delega al caso de uso CRUD
+func (uc *UseCases) ListDrivers(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.DriversListResponse, error) {
+	return uc.DriverCRUD.ListDrivers(ctx, page, pageSize, filters)
+}
+
+// UpdateDriver delega al caso de uso CRUD
+func (uc *UseCases) UpdateDriver(ctx context.Context, id uint, req *domain.UpdateDriverRequest) (*domain.Driver, error) {
+	return uc.DriverCRUD.UpdateDriver(ctx, id, req)
+}
+
+// DeleteDriver delega al caso de uso CRUD
+func (uc *UseCases) DeleteDriver(ctx context.Context, id uint) error {
+	return uc.DriverCRUD.DeleteDriver(ctx, id)
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE RUTAS - Delegar al caso de uso de rutas
+// ───────────────────────────────────────────
+
+// GenerateRouteBatches delega al caso de uso de rutas
+func (uc *UseCases) GenerateRouteBatches(ctx context.Context, filters domain.RouteBatchFilters) (*domain.RouteBatchesResponse, error) {
+	return uc.Routing.GenerateRouteBatches(ctx, filters)
+}
+
+// GetDriverManifest delega al caso de uso de rutas
+func (uc *UseCases) GetDriverManifest(ctx context.Context, driverID uint, start *domain.GeoPoint) (*domain.RouteManifest, error) {
+	return uc.Routing.GetDriverManifest(ctx, driverID, start)
+}
+
+// GetMyManifest delega al caso de uso de rutas
+func (uc *UseCases) GetMyManifest(ctx context.Context, userID uint, start *domain.GeoPoint) (*domain.RouteManifest, error) {
+	return uc.Routing.GetMyManifest(ctx, userID, start)
+}
+
+// AssignOrders delega al caso de uso de rutas
+func (uc *UseCases) AssignOrders(ctx context.Context, driverID uint, req *domain.AssignOrdersRequest) (*domain.AssignOrdersResult, error) {
+	return uc.Routing.AssignOrders(ctx, driverID, req)
+}
+
+// UnassignOrders delega al caso de uso de rutas
+func (uc *UseCases) UnassignOrders(ctx context.Context, businessID uint, req *domain.AssignOrdersRequest) (*domain.AssignOrdersResult, error) {
+	return uc.Routing.UnassignOrders(ctx, businessID, req)
+}
@@ -0,0 +1,210 @@
+package domain
+
+import "time"
+
+// ───────────────────────────────────────────
+//
+//	DISPATCH - Órdenes de última milla, rutas y asignación
+//
+// ───────────────────────────────────────────
+
+// Estados de orden usados en el despacho (deben coincidir con orders/domain/status.go)
+const (
+	OrderStatusProcessing  = "processing"
+	OrderStatusReadyToShip = "ready_to_ship"
+	OrderStatusShipped     = "shipped"
+)
+
+// Formas de agrupar los lotes de ruta
+const (
+	BatchGroupByZone   = "zone"
+	BatchGroupByDriver = "driver"
+)
+
+var (
+	// DispatchOrderStatuses son los estados de las órdenes listas para salir a ruta
+	DispatchOrderStatuses = []string{OrderStatusReadyToShip}
+
+	// ManifestOrderStatuses son los estados de las órdenes que componen el manifiesto del conductor
+	ManifestOrderStatuses = []string{OrderStatusReadyToShip, OrderStatusShipped}
+
+	// AssignableOrderStatuses son los estados desde los que una orden se puede asignar a un conductor
+	AssignableOrderStatuses = []string{OrderStatusProcessing, OrderStatusReadyToShip, OrderStatusShipped}
+
+	// DriverShipmentStatuses son los estados de los envíos que toman el conductor asignado a la orden
+	DriverShipmentStatuses = []string{"pending", "in_transit"}
+)
+
+// Precisión de las coordenadas de la orden (deben coincidir con models.Order.GeocodePrecision)
+const (
+	GeocodePrecisionAddress = "address" // Coordenadas de la placa
+	GeocodePrecisionStreet  = "street"  // Coordenadas de la vía
+	GeocodePrecisionCity    = "city"    // Centroide de la ciudad
+)
+
+// MaxDispatchOrders es el máximo de órdenes que se planifican en una sola consulta
+const MaxDispatchOrders = 1000
+
+// MaxAssignOrders es el máximo de órdenes por asignación masiva
+const MaxAssignOrders = 200
+
+// DispatchOrder es una orden con los datos que necesita la planificación de rutas
+type DispatchOrder struct {
+	ID            string
+	BusinessID    *uint
+	OrderNumber   string
+	Status        string
+	IsLastMile    bool
+	CustomerName  string
+	CustomerPhone string
+	Street        string
+	City          string
+	CityCode      string
+	Lat           *float64
+	Lng           *float64
+	// GeocodePrecision es la precisión de Lat/Lng: "address", "street" o "city" (centroide)
+	GeocodePrecision string
+	TotalAmount      float64
+	CodTotal         *float64
+	Currency         string
+	IsPaid           bool
+	DriverID         *uint
+	DriverName       string
+	WarehouseID      *uint
+	WarehouseLat     *float64
+	WarehouseLng     *float64
+	CreatedAt        time.Time
+}
+
+// Zone retorna la zona de reparto de la orden: el código de la ciudad o, si no lo tiene, su nombre
+func (o *DispatchOrder) Zone() string {
+	if zone := NormalizeZone(o.CityCode); zone != "" {
+		return zone
+	}
+	return NormalizeZone(o.City)
+}
+
+// HasPreciseLocation indica si las coordenadas de la orden ubican la dirección (placa o vía).
+// Los centroides de ciudad no sirven para ordenar paradas dentro de la misma ciudad.
+func (o *DispatchOrder) HasPreciseLocation() bool {
+	if o.Lat == nil || o.Lng == nil {
+		return false
+	}
+	return o.GeocodePrecision == GeocodePrecisionAddress || o.GeocodePrecision == GeocodePrecisionStreet
+}
+
+// CodAmount retorna el valor a cobrar contra entrega (0 si no aplica o ya está pagada)
+func (o *DispatchOrder) CodAmount() float64 {
+	if o.IsPaid || o.CodTotal == nil || *o.CodTotal <= 0 {
+		return 0
+	}
+	return *o.CodTotal
+}
+
+// DispatchOrderFilters define qué órdenes se consultan para el despacho (los campos vacíos no filtran)
+type DispatchOrderFilters struct {
+	BusinessID   uint
+	Statuses     []string
+	OnlyLastMile bool
+	DriverID     *uint
+	OrderIDs     []string
+	Zone         string // Zona normalizada (NormalizeZone): código de la ciudad o, si no lo tiene, su nombre
+	Limit        int
+}
+
+// RouteBatchFilters define los lotes de ruta a generar
+type RouteBatchFilters struct {
+	BusinessID uint
+	GroupBy    string    // "zone" (por defecto) o "driver"
+	Zone       string    // Solo el lote de esta zona
+	Start      *GeoPoint // Punto de salida; por defecto el almacén de las órdenes
+}
+
+// ───────────────────────────────────────────
+//
+//	ROUTE MANIFEST
+//
+// ───────────────────────────────────────────
+
+// RouteStop es una parada de la ruta
+type RouteStop struct {
+	Sequence                   int      `json:"sequence"`
+	OrderID                    string   `json:"order_id"`
+	OrderNumber                string   `json:"order_number"`
+	Status                     string   `json:"status"`
+	CustomerName               string   `json:"customer_name"`
+	CustomerPhone              string   `json:"customer_phone,omitempty"`
+	Street                     string   `json:"street"`
+	City                       string   `json:"city"`
+	Lat                        *float64 `json:"lat,omitempty"`
+	Lng                        *float64 `json:"lng,omitempty"`
+	GeocodePrecision           string   `json:"geocode_precision,omitempty"`
+	Routed                     bool     `json:"routed"` // false = sin coordenadas precisas, va al final sin optimizar
+	DistanceFromPreviousMeters *float64 `json:"distance_from_previous_meters,omitempty"`
+	CodAmount                  float64  `json:"cod_amount"`
+	Currency                   string   `json:"currency"`
+	IsPaid                     bool     `json:"is_paid"`
+}
+
+// RouteManifest es la hoja de ruta: paradas en orden de visita y el dinero a recaudar
+type RouteManifest struct {
+	Driver              *DriverRef         `json:"driver,omitempty"`
+	Zone                string             `json:"zone,omitempty"`
+	GeneratedAt         time.Time          `json:"generated_at"`
+	Start               *GeoPoint          `json:"start,omitempty"`
+	StopCount           int                `json:"stop_count"`
+	UnroutedCount       int                `json:"unrouted_count"`
+	TotalDistanceMeters float64            `json:"total_distance_meters"`
+	CodOrderCount       int                `json:"cod_order_count"`
+	CodTotals           map[string]float64 `json:"cod_totals"` // Total a recaudar por moneda
+	Stops               []RouteStop        `json:"stops"`
+}
+
+// RouteBatch es un lote de órdenes listas para despacho con su ruta sugerida
+type RouteBatch struct {
+	Key              string        `json:"key"`
+	SuggestedDrivers []DriverRef   `json:"suggested_drivers,omitempty"` // Conductores activos de la zona
+	Route            RouteManifest `json:"route"`
+}
+
+// RouteBatchesResponse agrupa los lotes de ruta generados
+type RouteBatchesResponse struct {
+	GroupBy     string       `json:"group_by"`
+	GeneratedAt time.Time    `json:"generated_at"`
+	OrderCount  int          `json:"order_count"`
+	Truncated   bool         `json:"truncated"`
+	Batches     []RouteBatch `json:"batches"`
+}
+
+// ───────────────────────────────────────────
+//
+//	ASSIGNMENT
+//
+// ───────────────────────────────────────────
+
+// Motivos por los que una orden no se asigna
+const (
+	AssignSkipNotFound      = "not_found"
+	AssignSkipOtherBusiness = "other_business"
+	AssignSkipNotLastMile   = "not_last_mile"
+	AssignSkipInvalidStatus = "invalid_status"
+)
+
+// AssignOrdersRequest asigna (o desasigna) varias órdenes a la vez
+type AssignOrdersRequest struct {
+	OrderIDs []string `json:"order_ids" binding:"required,min=1,max=200,dive,required"`
+}
+
+// AssignOrdersResult resume una asignación masiva
+type AssignOrdersResult struct {
+	Driver           *DriverRef   `json:"driver,omitempty"`
+	Assigned         []string     `json:"assigned"`
+	Skipped          []AssignSkip `json:"skipped"`
+	UpdatedShipments int64        `json:"updated_shipments"`
+}
+
+// AssignSkip es una orden que no se asignó y el motivo
+type AssignSkip struct {
+	OrderID string `json:"order_id"`
+	Reason  string `json:"reason"`
+}
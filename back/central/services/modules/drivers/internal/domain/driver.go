@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	DRIVER - Conductores de última milla
+//
+// ───────────────────────────────────────────
+
+// Tipos de vehículo soportados
+const (
+	VehicleTypeMotorcycle = "motorcycle"
+	VehicleTypeCar        = "car"
+	VehicleTypeVan        = "van"
+	VehicleTypeTruck      = "truck"
+	VehicleTypeBicycle    = "bicycle"
+)
+
+// Driver representa un conductor del negocio
+type Driver struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID uint      `json:"business_id"`
+	UserID     *uint     `json:"user_id,omitempty"`
+
+	Name           string `json:"name"`
+	Phone          string `json:"phone"`
+	Email          string `json:"email,omitempty"`
+	DocumentNumber string `json:"document_number,omitempty"`
+
+	VehicleType       string   `json:"vehicle_type,omitempty"`
+	VehiclePlate      string   `json:"vehicle_plate,omitempty"`
+	VehicleCapacityKg *float64 `json:"vehicle_capacity_kg,omitempty"`
+
+	Zone     string  `json:"zone,omitempty"`
+	IsActive bool    `json:"is_active"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+// DriverRef identifica un conductor en las rutas y manifiestos
+type DriverRef struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	Phone        string `json:"phone,omitempty"`
+	VehicleType  string `json:"vehicle_type,omitempty"`
+	VehiclePlate string `json:"vehicle_plate,omitempty"`
+	Zone         string `json:"zone,omitempty"`
+}
+
+// Ref retorna la referencia corta del conductor
+func (d *Driver) Ref() *DriverRef {
+	return &DriverRef{
+		ID:           d.ID,
+		Name:         d.Name,
+		Phone:        d.Phone,
+		VehicleType:  d.VehicleType,
+		VehiclePlate: d.VehiclePlate,
+		Zone:         d.Zone,
+	}
+}
+
+// NormalizeZone normaliza una zona (código o nombre de ciudad) para compararla
+func NormalizeZone(zone string) string {
+	return strings.ToUpper(strings.TrimSpace(zone))
+}
+
+// ───────────────────────────────────────────
+//
+//	REQUEST / RESPONSE DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateDriverRequest representa la solicitud para crear un conductor
+type CreateDriverRequest struct {
+	BusinessID        uint     `json:"business_id" binding:"required"`
+	UserID            *uint    `json:"user_id"`
+	Name              string   `json:"name" binding:"required,max=255"`
+	Phone             string   `json:"phone" binding:"omitempty,max=32"`
+	Email             string   `json:"email" binding:"omitempty,email,max=255"`
+	DocumentNumber    string   `json:"document_number" binding:"omitempty,max=64"`
+	VehicleType       string   `json:"vehicle_type" binding:"omitempty,oneof=motorcycle car van truck bicycle"`
+	VehiclePlate      string   `json:"vehicle_plate" binding:"omitempty,max=20"`
+	VehicleCapacityKg *float64 `json:"vehicle_capacity_kg" binding:"omitempty,gt=0"`
+	Zone              string   `json:"zone" binding:"omitempty,max=128"`
+	Notes             *string  `json:"notes"`
+}
+
+// UpdateDriverRequest representa la solicitud para actualizar un conductor.
+// UnlinkUser desvincula la cuenta de usuario de la app móvil.
+type UpdateDriverRequest struct {
+	UserID            *uint    `json:"user_id"`
+	UnlinkUser        bool     `json:"unlink_user"`
+	Name              *string  `json:"name" binding:"omitempty,min=1,max=255"`
+	Phone             *string  `json:"phone" binding:"omitempty,max=32"`
+	Email             *string  `json:"email" binding:"omitempty,email,max=255"`
+	DocumentNumber    *string  `json:"document_number" binding:"omitempty,max=64"`
+	VehicleType       *string  `json:"vehicle_type" binding:"omitempty,oneof=motorcycle car van truck bicycle"`
+	VehiclePlate      *string  `json:"vehicle_plate" binding:"omitempty,max=20"`
+	VehicleCapacityKg *float64 `json:"vehicle_capacity_kg" binding:"omitempty,gt=0"`
+	Zone              *string  `json:"zone" binding:"omitempty,max=128"`
+	IsActive          *bool    `json:"is_active"`
+	Notes             *string  `json:"notes"`
+}
+
+// DriversListResponse representa la respuesta paginada de conductores
+type DriversListResponse struct {
+	Data       []Driver `json:"data"`
+	Total      int64    `json:"total"`
+	Page       int      `json:"page"`
+	PageSize   int      `json:"page_size"`
+	TotalPages int      `json:"total_pages"`
+}
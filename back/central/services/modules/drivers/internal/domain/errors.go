@@ -0,0 +1,28 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrDriverNotFound se retorna cuando un conductor no existe
+	ErrDriverNotFound = errors.New("driver not found")
+
+	// ErrInvalidDriverData se retorna cuando los datos del conductor son inválidos
+	ErrInvalidDriverData = errors.New("invalid driver data")
+
+	// ErrUserNotFound se retorna cuando la cuenta de usuario a vincular no existe
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrUserAlreadyLinked se retorna cuando la cuenta de usuario ya está vinculada a otro conductor
+	ErrUserAlreadyLinked = errors.New("user is already linked to another driver")
+
+	// ErrDriverInactive se retorna cuando se intenta asignar órdenes a un conductor inactivo
+	ErrDriverInactive = errors.New("driver is inactive")
+)
+
+var (
+	// ErrInvalidGroupBy se retorna cuando la forma de agrupar los lotes no es soportada
+	ErrInvalidGroupBy = errors.New("invalid group_by, must be zone or driver")
+
+	// ErrInvalidStartPoint se retorna cuando el punto de salida de la ruta es inválido
+	ErrInvalidStartPoint = errors.New("invalid start point, latitude and longitude are both required")
+)
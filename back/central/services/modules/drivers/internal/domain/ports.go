@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+)
+
+// ───────────────────────────────────────────
+//
+//	REPOSITORY INTERFACE
+//
+// ───────────────────────────────────────────
+
+// IRepository define todos los métodos de repositorio del módulo drivers
+type IRepository interface {
+	// CRUD Operations
+	CreateDriver(ctx context.Context, driver *Driver) error
+	GetDriverByID(ctx context.Context, id uint) (*Driver, error)
+	GetDriverByUserID(ctx context.Context, userID uint) (*Driver, error)
+	ListDrivers(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]Driver, int64, error)
+	ListActiveDrivers(ctx context.Context, businessID uint) ([]Driver, error)
+	UpdateDriver(ctx context.Context, driver *Driver) error
+	DeleteDriver(ctx context.Context, id uint) error
+
+	// User account link (excludeID permite ignorar al propio conductor en actualizaciones)
+	UserExists(ctx context.Context, userID uint) (bool, error)
+	UserLinkedToDriver(ctx context.Context, userID uint, excludeID uint) (bool, error)
+
+	// Dispatch
+	ListDispatchOrders(ctx context.Context, filters DispatchOrderFilters) ([]DispatchOrder, error)
+	// AssignOrdersToDriver asigna las órdenes (y sus envíos activos) al conductor; driver nil las desasigna
+	AssignOrdersToDriver(ctx context.Context, orderIDs []string, driver *Driver) (int64, error)
+}
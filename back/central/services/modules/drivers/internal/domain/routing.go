@@ -0,0 +1,121 @@
+package domain
+
+import "math"
+
+// ───────────────────────────────────────────
+//
+//	ROUTING - Orden de las paradas de una ruta
+//
+// ───────────────────────────────────────────
+
+// earthRadiusMeters radio medio de la Tierra usado para calcular distancias
+const earthRadiusMeters = 6371000.0
+
+// maxTwoOptPasses limita las pasadas de mejora 2-opt para rutas grandes
+const maxTwoOptPasses = 50
+
+// GeoPoint es una coordenada geográfica
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// DistanceMeters calcula la distancia en metros entre dos coordenadas (fórmula de haversine)
+func DistanceMeters(a, b GeoPoint) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.Lat - a.Lat)
+	dLng := toRad(b.Lng - a.Lng)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Lat))*math.Cos(toRad(b.Lat))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// PlanRoute ordena las paradas con el vecino más cercano y mejora el resultado con 2-opt.
+// La ruta es abierta (no regresa al origen). Con start la ruta sale desde ese punto; sin él,
+// arranca en la primera parada. Retorna los índices de stops en el orden de visita.
+func PlanRoute(start *GeoPoint, stops []GeoPoint) []int {
+	if len(stops) == 0 {
+		return nil
+	}
+
+	route := nearestNeighbor(start, stops)
+	twoOpt(start, stops, route)
+	return route
+}
+
+// nearestNeighbor construye la ruta yendo siempre a la parada pendiente más cercana
+func nearestNeighbor(start *GeoPoint, stops []GeoPoint) []int {
+	visited := make([]bool, len(stops))
+	route := make([]int, 0, len(stops))
+
+	var current GeoPoint
+	if start != nil {
+		current = *start
+	} else {
+		current = stops[0]
+		visited[0] = true
+		route = append(route, 0)
+	}
+
+	for len(route) < len(stops) {
+		next, best := -1, math.MaxFloat64
+		for i := range stops {
+			if visited[i] {
+				continue
+			}
+			if d := DistanceMeters(current, stops[i]); d < best {
+				next, best = i, d
+			}
+		}
+		visited[next] = true
+		route = append(route, next)
+		current = stops[next]
+	}
+	return route
+}
+
+// twoOpt invierte tramos de la ruta mientras acorten la distancia total. Sin start la primera
+// parada también puede moverse, porque ningún punto de partida la fija.
+func twoOpt(start *GeoPoint, stops []GeoPoint, route []int) {
+	n := len(route)
+	if n < 2 {
+		return
+	}
+
+	// point retorna la coordenada de la posición i de la ruta (-1 = punto de partida)
+	point := func(i int) GeoPoint {
+		if i < 0 {
+			return *start
+		}
+		return stops[route[i]]
+	}
+
+	for pass := 0; pass < maxTwoOptPasses; pass++ {
+		improved := false
+		for i := 0; i < n-1; i++ {
+			for k := i + 1; k < n; k++ {
+				// Tramo route[i..k]: se reemplazan las aristas (i-1,i) y (k,k+1) por (i-1,k) y (i,k+1).
+				// En una ruta abierta las aristas que no existen (antes del inicio o después del final) no cuentan.
+				before, after := 0.0, 0.0
+				if i > 0 || start != nil {
+					before += DistanceMeters(point(i-1), point(i))
+					after += DistanceMeters(point(i-1), point(k))
+				}
+				if k+1 < n {
+					before += DistanceMeters(point(k), point(k+1))
+					after += DistanceMeters(point(i), point(k+1))
+				}
+				if after < before-1e-6 {
+					for l, r := i, k; l < r; l, r = l+1, r-1 {
+						route[l], route[r] = route[r], route[l]
+					}
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			return
+		}
+	}
+}
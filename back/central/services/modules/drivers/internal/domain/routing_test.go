@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b GeoPoint
+		want float64
+	}{
+		{name: "mismo punto", a: GeoPoint{Lat: 4.65, Lng: -74.05}, b: GeoPoint{Lat: 4.65, Lng: -74.05}, want: 0},
+		{name: "un grado de latitud", a: GeoPoint{Lat: 0, Lng: 0}, b: GeoPoint{Lat: 1, Lng: 0}, want: 111195},
+		{name: "Bogotá a Medellín", a: GeoPoint{Lat: 4.711, Lng: -74.0721}, b: GeoPoint{Lat: 6.2442, Lng: -75.5812}, want: 239700},
+		{name: "antípodas", a: GeoPoint{Lat: 0, Lng: 0}, b: GeoPoint{Lat: 0, Lng: 180}, want: math.Pi * earthRadiusMeters},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DistanceMeters(tt.a, tt.b)
+			if math.Abs(got-tt.want) > tt.want*0.005+1 {
+				t.Errorf("DistanceMeters() = %.0f, want ~%.0f", got, tt.want)
+			}
+			if back := DistanceMeters(tt.b, tt.a); math.Abs(back-got) > 1e-6 {
+				t.Errorf("DistanceMeters no es simétrica: %.3f vs %.3f", got, back)
+			}
+		})
+	}
+}
+
+func TestPlanRoute(t *testing.T) {
+	// Paradas sobre una misma línea (lng creciente) para que la ruta óptima sea evidente
+	line := func(lngs ...float64) []GeoPoint {
+		points := make([]GeoPoint, len(lngs))
+		for i, lng := range lngs {
+			points[i] = GeoPoint{Lat: 4.6, Lng: lng}
+		}
+		return points
+	}
+
+	tests := []struct {
+		name  string
+		start *GeoPoint
+		stops []GeoPoint
+		want  []int
+	}{
+		{name: "sin paradas", stops: nil, want: nil},
+		{name: "una parada", stops: line(-74.1), want: []int{0}},
+		{name: "con punto de partida", start: &GeoPoint{Lat: 4.6, Lng: -74.2}, stops: line(-74.03, -74.1, -74.06, -74.15), want: []int{3, 1, 2, 0}},
+		{name: "sin punto de partida la primera parada también se reordena", stops: line(-74.1, -74.03, -74.15, -74.06), want: []int{1, 3, 0, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanRoute(tt.start, tt.stops)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanRoute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanRouteTwoOptImprovesNearestNeighbor(t *testing.T) {
+	start := &GeoPoint{Lat: 0, Lng: 0}
+	// El vecino más cercano deja tramos cruzados (1, 0, 3, 2) que 2-opt deshace
+	stops := []GeoPoint{
+		{Lat: -0.006, Lng: 0.005},
+		{Lat: 0.001, Lng: 0.003},
+		{Lat: 0.008, Lng: 0.007},
+		{Lat: -0.009, Lng: 0.001},
+	}
+
+	routeLength := func(route []int) float64 {
+		total, current := 0.0, *start
+		for _, i := range route {
+			total += DistanceMeters(current, stops[i])
+			current = stops[i]
+		}
+		return total
+	}
+
+	greedy := nearestNeighbor(start, stops)
+	planned := PlanRoute(start, stops)
+	if len(planned) != len(stops) {
+		t.Fatalf("PlanRoute() = %v, want a visit for every stop", planned)
+	}
+	seen := make(map[int]bool)
+	for _, i := range planned {
+		if seen[i] {
+			t.Fatalf("PlanRoute() = %v, stop %d visited twice", planned, i)
+		}
+		seen[i] = true
+	}
+	if routeLength(planned) >= routeLength(greedy) {
+		t.Errorf("2-opt route %v (%.0f m) does not improve nearest neighbor %v (%.0f m)",
+			planned, routeLength(planned), greedy, routeLength(greedy))
+	}
+}
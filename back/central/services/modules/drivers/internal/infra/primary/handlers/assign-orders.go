@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// AssignOrders godoc
+// @Summary      Asignar órdenes al conductor
+// @Description  Asigna varias órdenes de última milla al conductor (y a sus envíos pendientes o en tránsito). Las órdenes que no existen, son de otro negocio, no son de última milla o no están en un estado despachable se omiten con el motivo.
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        id       path  int                         true  "ID del conductor"
+// @Param        request  body  domain.AssignOrdersRequest  true  "Órdenes a asignar (máx. 200)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.AssignOrdersResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/{id}/assign-orders [post]
+func (h *Handlers) AssignOrders(c *gin.Context) {
+	id, ok := parseDriverID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.AssignOrdersRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.uc.AssignOrders(c.Request.Context(), id, &req)
+	if err != nil {
+		respondDriverError(c, err, "Error al asignar órdenes al conductor")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Órdenes asignadas exitosamente",
+		"data":    result,
+	})
+}
+
+// UnassignOrders godoc
+// @Summary      Desasignar órdenes
+// @Description  Quita el conductor de varias órdenes del negocio y de sus envíos pendientes o en tránsito
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        business_id  query  int                         true  "ID del negocio"
+// @Param        request      body   domain.AssignOrdersRequest  true  "Órdenes a desasignar (máx. 200)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.AssignOrdersResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/unassign-orders [post]
+func (h *Handlers) UnassignOrders(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' requerido",
+			"error":   "invalid business_id parameter",
+		})
+		return
+	}
+
+	var req domain.AssignOrdersRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.uc.UnassignOrders(c.Request.Context(), uint(businessID), &req)
+	if err != nil {
+		respondDriverError(c, err, "Error al desasignar órdenes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Órdenes desasignadas exitosamente",
+		"data":    result,
+	})
+}
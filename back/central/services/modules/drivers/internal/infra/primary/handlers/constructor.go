@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// Handlers contiene todos los handlers del módulo drivers
+type Handlers struct {
+	uc *usecases.UseCases
+}
+
+// New crea una nueva instancia de Handlers
+func New(uc *usecases.UseCases) *Handlers {
+	return &Handlers{
+		uc: uc,
+	}
+}
+
+// parseDriverID convierte el parámetro de ruta en un ID de conductor válido
+func parseDriverID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de conductor inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// parseStartPoint lee el punto de salida opcional de la ruta (start_lat y start_lng)
+func parseStartPoint(c *gin.Context) (*domain.GeoPoint, bool) {
+	rawLat, rawLng := c.Query("start_lat"), c.Query("start_lng")
+	if rawLat == "" && rawLng == "" {
+		return nil, true
+	}
+
+	lat, latErr := strconv.ParseFloat(rawLat, 64)
+	lng, lngErr := strconv.ParseFloat(rawLng, 64)
+	if latErr != nil || lngErr != nil || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetros 'start_lat' y 'start_lng' inválidos",
+			"error":   domain.ErrInvalidStartPoint.Error(),
+		})
+		return nil, false
+	}
+	return &domain.GeoPoint{Lat: lat, Lng: lng}, true
+}
+
+// respondDriverError traduce los errores de dominio del módulo a respuestas HTTP
+func respondDriverError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrDriverNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidDriverData),
+		errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrDriverInactive),
+		errors.Is(err, domain.ErrInvalidGroupBy),
+		errors.Is(err, domain.ErrInvalidStartPoint):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrUserAlreadyLinked):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
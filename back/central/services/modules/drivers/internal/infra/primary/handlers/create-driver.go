@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// CreateDriver godoc
+// @Summary      Crear conductor
+// @Description  Crea un conductor para un negocio con su vehículo y zona de reparto. Opcionalmente lo vincula a una cuenta de usuario para la app móvil.
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        driver  body      domain.CreateDriverRequest  true  "Datos del conductor"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.Driver
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers [post]
+func (h *Handlers) CreateDriver(c *gin.Context) {
+	var req domain.CreateDriverRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	driver, err := h.uc.CreateDriver(c.Request.Context(), &req)
+	if err != nil {
+		respondDriverError(c, err, "Error al crear conductor")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Conductor creado exitosamente",
+		"data":    driver,
+	})
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteDriver godoc
+// @Summary      Eliminar conductor
+// @Description  Elimina (soft delete) un conductor y libera su cuenta de usuario. Las órdenes y envíos conservan su conductor desnormalizado.
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del conductor"
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/{id} [delete]
+func (h *Handlers) DeleteDriver(c *gin.Context) {
+	id, ok := parseDriverID(c)
+	if !ok {
+		return
+	}
+
+	// Llamar al caso de uso
+	if err := h.uc.DeleteDriver(c.Request.Context(), id); err != nil {
+		respondDriverError(c, err, "Error al eliminar conductor")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Conductor eliminado exitosamente",
+	})
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetDriverByID godoc
+// @Summary      Obtener conductor por ID
+// @Description  Obtiene un conductor específico por su ID
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del conductor"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Driver
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/{id} [get]
+func (h *Handlers) GetDriverByID(c *gin.Context) {
+	id, ok := parseDriverID(c)
+	if !ok {
+		return
+	}
+
+	// Llamar al caso de uso
+	driver, err := h.uc.GetDriverByID(c.Request.Context(), id)
+	if err != nil {
+		respondDriverError(c, err, "Error al obtener conductor")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Conductor obtenido exitosamente",
+		"data":    driver,
+	})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListDrivers godoc
+// @Summary      Listar conductores
+// @Description  Obtiene una lista paginada de conductores con filtros por negocio, estado, zona, vehículo y búsqueda
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        page          query    int     false  "Número de página (default: 1, min: 1)"
+// @Param        page_size     query    int     false  "Tamaño de página (default: 10, min: 1, max: 100)"
+// @Param        business_id   query    int     false  "Filtrar por ID de negocio"
+// @Param        is_active     query    bool    false  "Filtrar por estado activo"
+// @Param        zone          query    string  false  "Filtrar por zona de reparto"
+// @Param        vehicle_type  query    string  false  "Filtrar por tipo de vehículo (motorcycle, car, van, truck, bicycle)"
+// @Param        search        query    string  false  "Búsqueda parcial en nombre, teléfono, documento o placa"
+// @Param        sort_by       query    string  false  "Campo para ordenar (id, name, zone, created_at) (default: name)"
+// @Param        sort_order    query    string  false  "Orden (asc, desc) (default: asc)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.DriversListResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers [get]
+func (h *Handlers) ListDrivers(c *gin.Context) {
+	// Obtener y validar parámetros de paginación
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+
+	// Limitar el tamaño máximo de página
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	// Construir filtros
+	filters := make(map[string]interface{})
+
+	if businessID := c.Query("business_id"); businessID != "" {
+		if id, err := strconv.ParseUint(businessID, 10, 32); err == nil && id > 0 {
+			filters["business_id"] = uint(id)
+		}
+	}
+
+	if isActive := c.Query("is_active"); isActive != "" {
+		if value, err := strconv.ParseBool(isActive); err == nil {
+			filters["is_active"] = value
+		}
+	}
+
+	for _, key := range []string{"zone", "vehicle_type", "search", "sort_by", "sort_order"} {
+		if value := c.Query(key); value != "" {
+			filters[key] = value
+		}
+	}
+
+	// Llamar al caso de uso
+	response, err := h.uc.ListDrivers(c.Request.Context(), page, pageSize, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener conductores",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"message":     "Conductores obtenidos exitosamente",
+		"data":        response.Data,
+		"total":       response.Total,
+		"page":        response.Page,
+		"page_size":   response.PageSize,
+		"total_pages": response.TotalPages,
+	})
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+)
+
+// GetDriverManifest godoc
+// @Summary      Hoja de ruta del conductor
+// @Description  Genera la hoja de ruta con las órdenes asignadas al conductor (listas para despacho o enviadas): paradas en orden de visita, distancias y total contra entrega a recaudar por moneda
+// @Tags         Drivers
+// @Produce      json
+// @Param        id         path   int     true   "ID del conductor"
+// @Param        start_lat  query  number  false  "Latitud del punto de salida (default: almacén de las órdenes)"
+// @Param        start_lng  query  number  false  "Longitud del punto de salida (default: almacén de las órdenes)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.RouteManifest
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/{id}/manifest [get]
+func (h *Handlers) GetDriverManifest(c *gin.Context) {
+	id, ok := parseDriverID(c)
+	if !ok {
+		return
+	}
+
+	start, ok := parseStartPoint(c)
+	if !ok {
+		return
+	}
+
+	manifest, err := h.uc.GetDriverManifest(c.Request.Context(), id, start)
+	if err != nil {
+		respondDriverError(c, err, "Error al generar la hoja de ruta")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Hoja de ruta generada exitosamente",
+		"data":    manifest,
+	})
+}
+
+// GetMyManifest godoc
+// @Summary      Mi hoja de ruta
+// @Description  Genera la hoja de ruta del conductor vinculado al usuario autenticado (app móvil del conductor)
+// @Tags         Drivers
+// @Produce      json
+// @Param        start_lat  query  number  false  "Latitud del punto de salida (ej. ubicación actual del conductor)"
+// @Param        start_lng  query  number  false  "Longitud del punto de salida (ej. ubicación actual del conductor)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.RouteManifest
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/me/manifest [get]
+func (h *Handlers) GetMyManifest(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"message": "Usuario no autenticado",
+			"error":   "user not authenticated",
+		})
+		return
+	}
+
+	start, ok := parseStartPoint(c)
+	if !ok {
+		return
+	}
+
+	manifest, err := h.uc.GetMyManifest(c.Request.Context(), userID, start)
+	if err != nil {
+		respondDriverError(c, err, "Error al generar la hoja de ruta")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Hoja de ruta generada exitosamente",
+		"data":    manifest,
+	})
+}
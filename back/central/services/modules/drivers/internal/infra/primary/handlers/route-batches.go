@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// GenerateRouteBatches godoc
+// @Summary      Generar lotes de ruta
+// @Description  Agrupa por zona (código o nombre de la ciudad) o por conductor asignado las órdenes de última milla listas para despacho y ordena las paradas de cada lote con sus coordenadas (vecino más cercano + 2-opt). Incluye el total contra entrega a recaudar. Las órdenes sin coordenadas van al final del lote sin optimizar.
+// @Tags         Drivers
+// @Produce      json
+// @Param        business_id  query  int     true   "ID del negocio"
+// @Param        group_by     query  string  false  "Agrupar por zone o driver (default: zone)"
+// @Param        zone         query  string  false  "Generar solo el lote de esta zona"
+// @Param        start_lat    query  number  false  "Latitud del punto de salida (default: almacén de las órdenes)"
+// @Param        start_lng    query  number  false  "Longitud del punto de salida (default: almacén de las órdenes)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.RouteBatchesResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/route-batches [get]
+func (h *Handlers) GenerateRouteBatches(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' requerido",
+			"error":   "invalid business_id parameter",
+		})
+		return
+	}
+
+	start, ok := parseStartPoint(c)
+	if !ok {
+		return
+	}
+
+	filters := domain.RouteBatchFilters{
+		BusinessID: uint(businessID),
+		GroupBy:    c.Query("group_by"),
+		Zone:       c.Query("zone"),
+		Start:      start,
+	}
+
+	response, err := h.uc.GenerateRouteBatches(c.Request.Context(), filters)
+	if err != nil {
+		respondDriverError(c, err, "Error al generar los lotes de ruta")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Lotes de ruta generados exitosamente",
+		"data":    response,
+	})
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+)
+
+// RegisterRoutes registra todas las rutas del módulo drivers
+func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
+	drivers := router.Group("/drivers")
+	{
+		// CRUD básico
+		drivers.GET("", h.ListDrivers)
+		drivers.GET("/:id", h.GetDriverByID)
+		drivers.POST("", h.CreateDriver)
+		drivers.PUT("/:id", h.UpdateDriver)
+		drivers.DELETE("/:id", h.DeleteDriver)
+
+		// Planificación de rutas
+		drivers.GET("/route-batches", h.GenerateRouteBatches)
+		drivers.GET("/:id/manifest", h.GetDriverManifest)
+		drivers.GET("/me/manifest", middleware.JWT(), h.GetMyManifest)
+
+		// Asignación masiva de órdenes
+		drivers.POST("/:id/assign-orders", h.AssignOrders)
+		drivers.POST("/unassign-orders", h.UnassignOrders)
+	}
+}
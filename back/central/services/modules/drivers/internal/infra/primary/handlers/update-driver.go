@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+)
+
+// UpdateDriver godoc
+// @Summary      Actualizar conductor
+// @Description  Actualiza un conductor existente (el negocio no se puede cambiar). Con unlink_user se desvincula la cuenta de usuario.
+// @Tags         Drivers
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                         true  "ID del conductor"
+// @Param        driver  body      domain.UpdateDriverRequest  true  "Datos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Driver
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /drivers/{id} [put]
+func (h *Handlers) UpdateDriver(c *gin.Context) {
+	id, ok := parseDriverID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateDriverRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	driver, err := h.uc.UpdateDriver(c.Request.Context(), id, &req)
+	if err != nil {
+		respondDriverError(c, err, "Error al actualizar conductor")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Conductor actualizado exitosamente",
+		"data":    driver,
+	})
+}
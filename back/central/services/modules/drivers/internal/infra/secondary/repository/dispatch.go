@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// dispatchOrderRow es el resultado crudo de la orden con las coordenadas de su almacén
+type dispatchOrderRow struct {
+	ID                string
+	BusinessID        *uint
+	OrderNumber       string
+	Status            string
+	IsLastMile        bool
+	CustomerName      string
+	CustomerPhone     string
+	CustomerPhoneE164 string
+	ShippingStreet    string
+	ShippingCity      string
+	ShippingCityCode  string
+	ShippingLat       *float64
+	ShippingLng       *float64
+	GeocodePrecision  string
+	TotalAmount       float64
+	CodTotal          *float64
+	Currency          string
+	IsPaid            bool
+	DriverID          *uint
+	DriverName        string
+	WarehouseID       *uint
+	WarehouseLat      *float64
+	WarehouseLng      *float64
+	CreatedAt         time.Time
+}
+
+// ListDispatchOrders obtiene las órdenes a despachar, de la más antigua a la más reciente
+func (r *Repository) ListDispatchOrders(ctx context.Context, filters domain.DispatchOrderFilters) ([]domain.DispatchOrder, error) {
+	query := r.db.Conn(ctx).
+		Table("orders AS o").
+		Select(`o.id, o.business_id, o.order_number, o.status, o.is_last_mile,
+			o.customer_name, o.customer_phone, o.customer_phone_e164,
+			o.shipping_street, o.shipping_city, o.shipping_city_code, o.shipping_lat, o.shipping_lng, o.geocode_precision,
+			o.total_amount, o.cod_total, o.currency, o.is_paid,
+			o.driver_id, o.driver_name, o.warehouse_id,
+			w.lat AS warehouse_lat, w.lng AS warehouse_lng, o.created_at`).
+		Joins("LEFT JOIN warehouses w ON w.id = o.warehouse_id AND w.deleted_at IS NULL").
+		Where("o.deleted_at IS NULL")
+
+	if filters.BusinessID > 0 {
+		query = query.Where("o.business_id = ?", filters.BusinessID)
+	}
+	if len(filters.Statuses) > 0 {
+		query = query.Where("o.status IN ?", filters.Statuses)
+	}
+	if filters.OnlyLastMile {
+		query = query.Where("o.is_last_mile = ?", true)
+	}
+	if filters.DriverID != nil {
+		query = query.Where("o.driver_id = ?", *filters.DriverID)
+	}
+	if len(filters.OrderIDs) > 0 {
+		query = query.Where("o.id IN ?", filters.OrderIDs)
+	}
+	if filters.Zone != "" {
+		// Misma regla que DispatchOrder.Zone, para que el límite se aplique sobre las órdenes de la zona
+		query = query.Where("UPPER(TRIM(COALESCE(NULLIF(TRIM(o.shipping_city_code), ''), o.shipping_city))) = ?", filters.Zone)
+	}
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+
+	var rows []dispatchOrderRow
+	if err := query.Order("o.created_at ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.DispatchOrder, len(rows))
+	for i := range rows {
+		orders[i] = toDispatchOrder(&rows[i])
+	}
+	return orders, nil
+}
+
+// AssignOrdersToDriver asigna el conductor a las órdenes y a sus envíos aún activos en una
+// transacción. Con driver nil quita la asignación. Retorna el número de envíos actualizados.
+func (r *Repository) AssignOrdersToDriver(ctx context.Context, orderIDs []string, driver *domain.Driver) (int64, error) {
+	updates := map[string]interface{}{
+		"driver_id":   nil,
+		"driver_name": "",
+	}
+	if driver != nil {
+		updates["driver_id"] = driver.ID
+		updates["driver_name"] = driver.Name
+	}
+
+	var updatedShipments int64
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Order{}).
+			Where("id IN ?", orderIDs).
+			Updates(updates).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Shipment{}).
+			Where("order_id IN ? AND status IN ?", orderIDs, domain.DriverShipmentStatuses).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		updatedShipments = result.RowsAffected
+		return nil
+	})
+	return updatedShipments, err
+}
+
+// toDispatchOrder convierte la fila cruda en la orden de dominio
+func toDispatchOrder(row *dispatchOrderRow) domain.DispatchOrder {
+	phone := row.CustomerPhoneE164
+	if phone == "" {
+		phone = row.CustomerPhone
+	}
+	return domain.DispatchOrder{
+		ID:               row.ID,
+		BusinessID:       row.BusinessID,
+		OrderNumber:      row.OrderNumber,
+		Status:           row.Status,
+		IsLastMile:       row.IsLastMile,
+		CustomerName:     row.CustomerName,
+		CustomerPhone:    phone,
+		Street:           row.ShippingStreet,
+		City:             row.ShippingCity,
+		CityCode:         row.ShippingCityCode,
+		Lat:              row.ShippingLat,
+		Lng:              row.ShippingLng,
+		GeocodePrecision: row.GeocodePrecision,
+		TotalAmount:      row.TotalAmount,
+		CodTotal:         row.CodTotal,
+		Currency:         row.Currency,
+		IsPaid:           row.IsPaid,
+		DriverID:         row.DriverID,
+		DriverName:       row.DriverName,
+		WarehouseID:      row.WarehouseID,
+		WarehouseLat:     row.WarehouseLat,
+		WarehouseLng:     row.WarehouseLng,
+		CreatedAt:        row.CreatedAt,
+	}
+}
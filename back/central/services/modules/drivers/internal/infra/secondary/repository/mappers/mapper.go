@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ToDBDriver convierte un conductor de dominio a modelo de base de datos
+func ToDBDriver(d *domain.Driver) *models.Driver {
+	if d == nil {
+		return nil
+	}
+	return &models.Driver{
+		Model: gorm.Model{
+			ID:        d.ID,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		},
+		BusinessID:        d.BusinessID,
+		UserID:            d.UserID,
+		Name:              d.Name,
+		Phone:             d.Phone,
+		Email:             d.Email,
+		DocumentNumber:    d.DocumentNumber,
+		VehicleType:       d.VehicleType,
+		VehiclePlate:      d.VehiclePlate,
+		VehicleCapacityKg: d.VehicleCapacityKg,
+		Zone:              d.Zone,
+		IsActive:          d.IsActive,
+		Notes:             d.Notes,
+	}
+}
+
+// ToDomainDriver convierte un modelo de base de datos a conductor de dominio
+func ToDomainDriver(d *models.Driver) *domain.Driver {
+	if d == nil {
+		return nil
+	}
+	return &domain.Driver{
+		ID:                d.ID,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+		BusinessID:        d.BusinessID,
+		UserID:            d.UserID,
+		Name:              d.Name,
+		Phone:             d.Phone,
+		Email:             d.Email,
+		DocumentNumber:    d.DocumentNumber,
+		VehicleType:       d.VehicleType,
+		VehiclePlate:      d.VehiclePlate,
+		VehicleCapacityKg: d.VehicleCapacityKg,
+		Zone:              d.Zone,
+		IsActive:          d.IsActive,
+		Notes:             d.Notes,
+	}
+}
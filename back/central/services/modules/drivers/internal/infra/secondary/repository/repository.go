@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/drivers/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// Repository implementa el repositorio de conductores
+type Repository struct {
+	db db.IDatabase
+}
+
+// New crea una nueva instancia del repositorio
+func New(database db.IDatabase) domain.IRepository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// CreateDriver crea un nuevo conductor en la base de datos
+func (r *Repository) CreateDriver(ctx context.Context, driver *domain.Driver) error {
+	dbDriver := mappers.ToDBDriver(driver)
+	if err := r.db.Conn(ctx).Create(dbDriver).Error; err != nil {
+		return err
+	}
+	// Actualizar el modelo de dominio con los valores generados
+	driver.ID = dbDriver.ID
+	driver.CreatedAt = dbDriver.CreatedAt
+	driver.UpdatedAt = dbDriver.UpdatedAt
+	return nil
+}
+
+// GetDriverByID obtiene un conductor por su ID
+func (r *Repository) GetDriverByID(ctx context.Context, id uint) (*domain.Driver, error) {
+	var driver models.Driver
+	err := r.db.Conn(ctx).
+		Where("id = ?", id).
+		First(&driver).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDriverNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainDriver(&driver), nil
+}
+
+// GetDriverByUserID obtiene el conductor vinculado a una cuenta de usuario
+func (r *Repository) GetDriverByUserID(ctx context.Context, userID uint) (*domain.Driver, error) {
+	var driver models.Driver
+	err := r.db.Conn(ctx).
+		Where("user_id = ?", userID).
+		First(&driver).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDriverNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainDriver(&driver), nil
+}
+
+// ListDrivers obtiene una lista paginada de conductores con filtros
+func (r *Repository) ListDrivers(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.Driver, int64, error) {
+	var dbDrivers []models.Driver
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.Driver{})
+
+	// Filtro por business_id
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	// Filtro por estado
+	if isActive, ok := filters["is_active"].(bool); ok {
+		query = query.Where("is_active = ?", isActive)
+	}
+
+	// Filtro por zona
+	if zone, ok := filters["zone"].(string); ok && zone != "" {
+		query = query.Where("zone = ?", zone)
+	}
+
+	// Filtro por tipo de vehículo
+	if vehicleType, ok := filters["vehicle_type"].(string); ok && vehicleType != "" {
+		query = query.Where("vehicle_type = ?", vehicleType)
+	}
+
+	// Búsqueda por nombre, teléfono, documento o placa
+	if search, ok := filters["search"].(string); ok && search != "" {
+		like := "%" + search + "%"
+		query = query.Where("name ILIKE ? OR phone ILIKE ? OR document_number ILIKE ? OR vehicle_plate ILIKE ?", like, like, like, like)
+	}
+
+	// Contar total (antes de aplicar paginación y ordenamiento)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Aplicar ordenamiento
+	sortBy := "name"
+	if sort, ok := filters["sort_by"].(string); ok && sort != "" {
+		sortFieldMap := map[string]string{
+			"id":         "id",
+			"name":       "name",
+			"zone":       "zone",
+			"created_at": "created_at",
+		}
+		if mappedField, exists := sortFieldMap[sort]; exists {
+			sortBy = mappedField
+		}
+	}
+
+	sortOrder := "asc"
+	if order, ok := filters["sort_order"].(string); ok && order == "desc" {
+		sortOrder = order
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+
+	// Aplicar paginación
+	offset := (page - 1) * pageSize
+	if err := query.Offset(offset).Limit(pageSize).Find(&dbDrivers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Convertir a dominio
+	drivers := make([]domain.Driver, len(dbDrivers))
+	for i := range dbDrivers {
+		drivers[i] = *mappers.ToDomainDriver(&dbDrivers[i])
+	}
+
+	return drivers, total, nil
+}
+
+// ListActiveDrivers obtiene todos los conductores activos del negocio
+func (r *Repository) ListActiveDrivers(ctx context.Context, businessID uint) ([]domain.Driver, error) {
+	var dbDrivers []models.Driver
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND is_active = ?", businessID, true).
+		Order("name ASC").
+		Find(&dbDrivers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	drivers := make([]domain.Driver, len(dbDrivers))
+	for i := range dbDrivers {
+		drivers[i] = *mappers.ToDomainDriver(&dbDrivers[i])
+	}
+	return drivers, nil
+}
+
+// UpdateDriver actualiza un conductor existente
+func (r *Repository) UpdateDriver(ctx context.Context, driver *domain.Driver) error {
+	dbDriver := mappers.ToDBDriver(driver)
+	if err := r.db.Conn(ctx).Save(dbDriver).Error; err != nil {
+		return err
+	}
+	driver.UpdatedAt = dbDriver.UpdatedAt
+	return nil
+}
+
+// DeleteDriver elimina (soft delete) un conductor y libera su cuenta de usuario para que pueda
+// vincularse a otro conductor (el índice único de user_id también cubre los registros eliminados)
+func (r *Repository) DeleteDriver(ctx context.Context, id uint) error {
+	return r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Driver{}).
+			Where("id = ?", id).
+			Update("user_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&models.Driver{}).Error
+	})
+}
+
+// UserExists verifica si existe la cuenta de usuario
+func (r *Repository) UserExists(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Conn(ctx).
+		Model(&models.User{}).
+		Where("id = ?", userID).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// UserLinkedToDriver verifica si la cuenta de usuario ya está vinculada a otro conductor
+func (r *Repository) UserLinkedToDriver(ctx context.Context, userID uint, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Conn(ctx).
+		Model(&models.Driver{}).
+		Where("user_id = ? AND id <> ?", userID, excludeID).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
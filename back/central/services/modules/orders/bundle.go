@@ -4,22 +4,32 @@ import (
 	"context"
 
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseexport"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseinventory"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseorder"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseordermapping"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseorderpayment"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseshipmentsync"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/primary/events"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/primary/handlers"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/primary/queue"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/primary/worker"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/files"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/geocoding"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/redis"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/orderevents"
 	"github.com/secamc93/probability/back/central/shared/rabbitmq"
 	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+	"github.com/secamc93/probability/back/central/shared/storage"
 )
 
 // New inicializa el módulo de orders
-func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, rabbitMQ rabbitmq.IQueue, redisClient redisclient.IRedis) {
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, rabbitMQ rabbitmq.IQueue, redisClient redisclient.IRedis, s3Service storage.IS3Service) {
 	// 1. Init Repositories
 	repo := repository.New(database)
 
@@ -30,23 +40,74 @@ func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, env
 		if redisChannel == "" {
 			redisChannel = "probability:orders:events" // Valor por defecto
 		}
-		eventPublisher = redis.NewOrderEventPublisher(redisClient, logger, redisChannel)
+		eventPublisher = orderevents.NewPublisher(redisClient, logger, redisChannel)
 		logger.Info(context.Background()).
 			Str("channel", redisChannel).
 			Msg("Order event publisher initialized")
 	}
 
-	// 3. Init Use Cases
-	orderCRUD := usecaseorder.New(repo, eventPublisher)
-	orderMapping := usecaseordermapping.New(repo, logger, eventPublisher)
+	// 3. Init Geocoder (opcional, con cache en Redis por dirección normalizada)
+	geocoder := geocoding.New(environment, logger, redisClient)
 
-	// 4. Init Handlers
-	h := handlers.New(orderCRUD, orderMapping)
+	// 4. Init Inventory (reserva al crear, descuenta al enviar, libera al cancelar)
+	var productPublisher domain.IProductEventPublisher
+	if redisClient != nil {
+		productChannel := environment.Get("REDIS_PRODUCT_EVENTS_CHANNEL")
+		if productChannel == "" {
+			productChannel = "probability:products:events" // Valor por defecto
+		}
+		productPublisher = redis.NewProductEventPublisher(redisClient, logger, productChannel)
+	}
+	inventory := usecaseinventory.New(repo, logger, productPublisher, environment.Get("INVENTORY_INSUFFICIENT_STOCK_POLICY"))
+
+	// 5. Init Use Cases
+	orderCRUD := usecaseorder.New(repo, eventPublisher, inventory)
+	orderMapping := usecaseordermapping.New(repo, logger, eventPublisher, geocoder, inventory)
+	orderPayments := usecaseorderpayment.New(repo, eventPublisher, logger)
 
-	// 5. Register Routes
+	// Exportación: los trabajos asíncronos requieren S3 para publicar el archivo
+	var exportStorage domain.IExportStorage
+	if s3Service != nil {
+		exportStorage = files.New(s3Service)
+	}
+	export := usecaseexport.New(repo, exportStorage)
+
+	// 6. Init Handlers
+	h := handlers.New(orderCRUD, orderMapping, inventory, export, orderPayments)
+
+	// 7. Register Routes
 	h.RegisterRoutes(router)
 
-	// 6. Init RabbitMQ Consumer (si RabbitMQ está disponible)
+	// 8. Init Shipment Subscriber (descuenta el stock cuando el envío sale a despacho y
+	// lleva el estado, tracking y entrega de los envíos a la orden según las reglas configuradas)
+	if redisClient != nil {
+		shipmentRules, err := domain.ParseShipmentStatusRules(environment.Get("ORDER_SHIPMENT_STATUS_RULES"))
+		if err != nil {
+			logger.Warn(context.Background()).
+				Err(err).
+				Msg("Reglas de estado por envío inválidas, se usan las reglas por defecto")
+			shipmentRules = domain.DefaultShipmentStatusRules
+		}
+		shipmentSync := usecaseshipmentsync.New(repo, eventPublisher, logger, shipmentRules)
+
+		shipmentChannel := environment.Get("REDIS_SHIPMENT_EVENTS_CHANNEL")
+		if shipmentChannel == "" {
+			shipmentChannel = "probability:shipments:events" // Valor por defecto
+		}
+		shipmentSubscriber := events.NewShipmentSubscriber(redisClient, inventory, shipmentSync, logger, shipmentChannel)
+		if err := shipmentSubscriber.Start(context.Background()); err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Failed to start order shipment subscriber")
+		}
+	}
+
+	// 9. Init Export Worker (genera los archivos de las exportaciones encoladas)
+	if exportStorage != nil {
+		worker.New(export, logger).Start(context.Background())
+	}
+
+	// 10. Init RabbitMQ Consumer (si RabbitMQ está disponible)
 	if rabbitMQ != nil {
 		orderConsumer := queue.New(rabbitMQ, logger, orderMapping)
 		go func() {
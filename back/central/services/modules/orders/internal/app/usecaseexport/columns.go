@@ -0,0 +1,151 @@
+package usecaseexport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// orderColumns extrae el valor de cada columna de orden exportable
+var orderColumns = map[string]func(o *domain.Order) string{
+	domain.ExportColumnID:              func(o *domain.Order) string { return o.ID },
+	domain.ExportColumnOrderNumber:     func(o *domain.Order) string { return o.OrderNumber },
+	domain.ExportColumnInternalNumber:  func(o *domain.Order) string { return o.InternalNumber },
+	domain.ExportColumnExternalID:      func(o *domain.Order) string { return o.ExternalID },
+	domain.ExportColumnPlatform:        func(o *domain.Order) string { return o.Platform },
+	domain.ExportColumnIntegrationID:   func(o *domain.Order) string { return strconv.FormatUint(uint64(o.IntegrationID), 10) },
+	domain.ExportColumnBusinessID:      func(o *domain.Order) string { return formatUint(o.BusinessID) },
+	domain.ExportColumnStatus:          func(o *domain.Order) string { return o.Status },
+	domain.ExportColumnOriginalStatus:  func(o *domain.Order) string { return o.OriginalStatus },
+	domain.ExportColumnCreatedAt:       func(o *domain.Order) string { return formatTime(&o.CreatedAt) },
+	domain.ExportColumnOccurredAt:      func(o *domain.Order) string { return formatTime(&o.OccurredAt) },
+	domain.ExportColumnCustomerName:    func(o *domain.Order) string { return o.CustomerName },
+	domain.ExportColumnCustomerEmail:   func(o *domain.Order) string { return o.CustomerEmail },
+	domain.ExportColumnCustomerPhone:   func(o *domain.Order) string { return o.CustomerPhone },
+	domain.ExportColumnCustomerDNI:     func(o *domain.Order) string { return o.CustomerDNI },
+	domain.ExportColumnShippingStreet:  func(o *domain.Order) string { return o.ShippingStreet },
+	domain.ExportColumnShippingCity:    func(o *domain.Order) string { return o.ShippingCity },
+	domain.ExportColumnShippingState:   func(o *domain.Order) string { return o.ShippingState },
+	domain.ExportColumnShippingCountry: func(o *domain.Order) string { return o.ShippingCountry },
+	domain.ExportColumnShippingPostal:  func(o *domain.Order) string { return o.ShippingPostalCode },
+	domain.ExportColumnSubtotal:        func(o *domain.Order) string { return formatFloat(&o.Subtotal) },
+	domain.ExportColumnTax:             func(o *domain.Order) string { return formatFloat(&o.Tax) },
+	domain.ExportColumnDiscount:        func(o *domain.Order) string { return formatFloat(&o.Discount) },
+	domain.ExportColumnShippingCost:    func(o *domain.Order) string { return formatFloat(&o.ShippingCost) },
+	domain.ExportColumnTotalAmount:     func(o *domain.Order) string { return formatFloat(&o.TotalAmount) },
+	domain.ExportColumnCurrency:        func(o *domain.Order) string { return o.Currency },
+	domain.ExportColumnCodTotal:        func(o *domain.Order) string { return formatFloat(o.CodTotal) },
+	domain.ExportColumnIsPaid:          func(o *domain.Order) string { return strconv.FormatBool(o.IsPaid) },
+	domain.ExportColumnPaidAt:          func(o *domain.Order) string { return formatTime(o.PaidAt) },
+	domain.ExportColumnTrackingNumber:  func(o *domain.Order) string { return deref(o.TrackingNumber) },
+	domain.ExportColumnGuideID:         func(o *domain.Order) string { return deref(o.GuideID) },
+	domain.ExportColumnDeliveredAt:     func(o *domain.Order) string { return formatTime(o.DeliveredAt) },
+	domain.ExportColumnWarehouseID:     func(o *domain.Order) string { return formatUint(o.WarehouseID) },
+	domain.ExportColumnWarehouseName:   func(o *domain.Order) string { return o.WarehouseName },
+	domain.ExportColumnDriverID:        func(o *domain.Order) string { return formatUint(o.DriverID) },
+	domain.ExportColumnDriverName:      func(o *domain.Order) string { return o.DriverName },
+	domain.ExportColumnItemsCount:      func(o *domain.Order) string { return strconv.Itoa(len(o.OrderItems)) },
+	domain.ExportColumnNotes:           func(o *domain.Order) string { return deref(o.Notes) },
+}
+
+// itemColumns extrae el valor de cada columna de item exportable
+var itemColumns = map[string]func(i *domain.OrderItem) string{
+	domain.ExportColumnItemSKU:        func(i *domain.OrderItem) string { return i.ProductSKU },
+	domain.ExportColumnItemName:       func(i *domain.OrderItem) string { return i.ProductName },
+	domain.ExportColumnItemVariantID:  func(i *domain.OrderItem) string { return deref(i.ProductVariantID) },
+	domain.ExportColumnItemQuantity:   func(i *domain.OrderItem) string { return strconv.Itoa(i.Quantity) },
+	domain.ExportColumnItemUnitPrice:  func(i *domain.OrderItem) string { return formatFloat(&i.UnitPrice) },
+	domain.ExportColumnItemDiscount:   func(i *domain.OrderItem) string { return formatFloat(&i.Discount) },
+	domain.ExportColumnItemTax:        func(i *domain.OrderItem) string { return formatFloat(&i.Tax) },
+	domain.ExportColumnItemTotalPrice: func(i *domain.OrderItem) string { return formatFloat(&i.TotalPrice) },
+}
+
+// resolveColumns valida las columnas pedidas o retorna las columnas por defecto.
+// Las columnas de item solo se permiten al exportar una fila por item.
+func resolveColumns(columns []string, itemLines bool) ([]string, error) {
+	if len(columns) == 0 {
+		result := append([]string(nil), domain.DefaultOrderExportColumns...)
+		if itemLines {
+			result = append(result, domain.DefaultItemExportColumns...)
+		}
+		return result, nil
+	}
+
+	result := make([]string, 0, len(columns))
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if column == "" || seen[column] {
+			continue
+		}
+		if _, ok := itemColumns[column]; ok {
+			if !itemLines {
+				return nil, fmt.Errorf("%w: column %q requires item_lines", domain.ErrInvalidExportColumns, column)
+			}
+		} else if _, ok := orderColumns[column]; !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", domain.ErrInvalidExportColumns, column)
+		}
+		seen[column] = true
+		result = append(result, column)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: no columns selected", domain.ErrInvalidExportColumns)
+	}
+	return result, nil
+}
+
+// orderRows convierte una orden en filas: una sola fila, o una por item con item_lines
+// (las órdenes sin items generan una fila con las columnas de item vacías)
+func orderRows(order *domain.Order, columns []string, itemLines bool) [][]string {
+	if !itemLines || len(order.OrderItems) == 0 {
+		return [][]string{orderRow(order, nil, columns)}
+	}
+	rows := make([][]string, len(order.OrderItems))
+	for i := range order.OrderItems {
+		rows[i] = orderRow(order, &order.OrderItems[i], columns)
+	}
+	return rows
+}
+
+func orderRow(order *domain.Order, item *domain.OrderItem, columns []string) []string {
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		if extract, ok := orderColumns[column]; ok {
+			row[i] = extract(order)
+		} else if extract, ok := itemColumns[column]; ok && item != nil {
+			row[i] = extract(item)
+		}
+	}
+	return row
+}
+
+func formatFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func formatUint(value *uint) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*value), 10)
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil || value.IsZero() {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,19 @@
+package usecaseexport
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// UseCaseExport contiene los casos de uso de exportación de órdenes a CSV/XLSX
+type UseCaseExport struct {
+	repo    domain.IRepository
+	storage domain.IExportStorage // nil = solo exportación directa en CSV (sin trabajos asíncronos)
+}
+
+// New crea una nueva instancia de UseCaseExport
+func New(repo domain.IRepository, storage domain.IExportStorage) *UseCaseExport {
+	return &UseCaseExport{
+		repo:    repo,
+		storage: storage,
+	}
+}
@@ -0,0 +1,258 @@
+package usecaseexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/spreadsheet"
+)
+
+const (
+	// exportPageSize órdenes leídas por página al exportar
+	exportPageSize = 200
+	// staleJobTimeout tiempo sin avance tras el cual un trabajo en proceso se considera abandonado
+	staleJobTimeout = 15 * time.Minute
+)
+
+// ExportCSV valida la exportación y escribe el CSV por páginas en el writer que retorna start.
+// start se llama solo si la exportación es válida, para que el llamador pueda responder el error
+// antes de empezar a escribir. Rangos mayores a domain.MaxSyncExportOrders retornan ErrExportTooLarge.
+func (uc *UseCaseExport) ExportCSV(ctx context.Context, req *domain.OrderExportRequest, start func() io.Writer) error {
+	columns, err := resolveColumns(req.Columns, req.ItemLines)
+	if err != nil {
+		return err
+	}
+
+	filters := req.Filters.ToMap()
+	_, total, err := uc.repo.ListOrders(ctx, 1, 1, filters)
+	if err != nil {
+		return fmt.Errorf("error counting orders: %w", err)
+	}
+	if total > domain.MaxSyncExportOrders {
+		return fmt.Errorf("%w: %d orders, max %d", domain.ErrExportTooLarge, total, domain.MaxSyncExportOrders)
+	}
+
+	stream := spreadsheet.NewCSVStream(start())
+	if err := stream.WriteRows([][]string{columns}); err != nil {
+		return err
+	}
+	return uc.eachOrderPage(ctx, filters, func(orders []domain.Order, _ int64) error {
+		rows := make([][]string, 0, len(orders))
+		for i := range orders {
+			rows = append(rows, orderRows(&orders[i], columns, req.ItemLines)...)
+		}
+		return stream.WriteRows(rows)
+	})
+}
+
+// CreateExportJob encola una exportación asíncrona cuyo archivo se sube al almacenamiento
+func (uc *UseCaseExport) CreateExportJob(ctx context.Context, req *domain.OrderExportRequest) (*domain.OrderExportJob, error) {
+	if uc.storage == nil {
+		return nil, domain.ErrExportUnavailable
+	}
+
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = domain.ExportFormatXLSX
+	}
+	if !spreadsheet.IsSupportedFormat(format) {
+		return nil, domain.ErrUnsupportedExportFormat
+	}
+	columns, err := resolveColumns(req.Columns, req.ItemLines)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &domain.OrderExportJob{
+		BusinessID: req.Filters.BusinessID,
+		Format:     format,
+		Status:     domain.ExportJobStatusPending,
+		Columns:    columns,
+		ItemLines:  req.ItemLines,
+		Filters:    req.Filters,
+		CreatedBy:  req.CreatedBy,
+	}
+	if err := uc.repo.CreateExportJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("error creating export job: %w", err)
+	}
+	return job, nil
+}
+
+// GetExportJob obtiene un trabajo de exportación con su avance y link de descarga
+func (uc *UseCaseExport) GetExportJob(ctx context.Context, id uint) (*domain.OrderExportJob, error) {
+	job, err := uc.repo.GetExportJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrExportJobNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting export job: %w", err)
+	}
+	return job, nil
+}
+
+// ListExportJobs lista los trabajos de exportación (de un negocio si se indica)
+func (uc *UseCaseExport) ListExportJobs(ctx context.Context, businessID *uint, page, pageSize int) (*domain.ExportJobsListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	jobs, total, err := uc.repo.ListExportJobs(ctx, businessID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("error listing export jobs: %w", err)
+	}
+
+	return &domain.ExportJobsListResponse{
+		Data:       jobs,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int(math.Ceil(float64(total) / float64(pageSize))),
+	}, nil
+}
+
+// ProcessPending toma y procesa hasta limit trabajos pendientes. Los errores de cada trabajo
+// quedan registrados en el propio trabajo; solo se retornan los errores al consultarlos o actualizarlos.
+func (uc *UseCaseExport) ProcessPending(ctx context.Context, limit int) (int, error) {
+	if uc.storage == nil {
+		return 0, nil
+	}
+
+	// Recuperar trabajos de instancias que se detuvieron a mitad de proceso
+	if _, err := uc.repo.ReleaseStaleExportJobs(ctx, staleJobTimeout); err != nil {
+		return 0, fmt.Errorf("error releasing stale export jobs: %w", err)
+	}
+
+	ids, err := uc.repo.ListPendingExportJobIDs(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error listing pending export jobs: %w", err)
+	}
+
+	processed := 0
+	var errs []error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Varias instancias pueden ver el mismo trabajo pendiente: solo una lo toma
+		claimed, err := uc.repo.ClaimExportJob(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error claiming export job %d: %w", id, err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := uc.processJob(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+		processed++
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// processJob genera el archivo de un trabajo ya tomado, lo sube y registra el resultado
+func (uc *UseCaseExport) processJob(ctx context.Context, id uint) error {
+	job, err := uc.repo.GetExportJob(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error getting export job %d: %w", id, err)
+	}
+
+	buildErr := uc.buildJobFile(ctx, job)
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = domain.ExportJobStatusCompleted
+	if buildErr != nil {
+		job.Status = domain.ExportJobStatusFailed
+		job.ErrorMessage = buildErr.Error()
+		if len(job.ErrorMessage) > 1000 {
+			job.ErrorMessage = job.ErrorMessage[:1000]
+		}
+	}
+
+	if err := uc.repo.UpdateExportJob(ctx, job); err != nil {
+		return fmt.Errorf("error updating export job %d: %w", job.ID, err)
+	}
+	return nil
+}
+
+// buildJobFile recorre las órdenes filtradas, escribe el archivo y lo sube al almacenamiento
+func (uc *UseCaseExport) buildJobFile(ctx context.Context, job *domain.OrderExportJob) error {
+	columns, err := resolveColumns(job.Columns, job.ItemLines)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{columns}
+	job.ProcessedOrders = 0
+	err = uc.eachOrderPage(ctx, job.Filters.ToMap(), func(orders []domain.Order, total int64) error {
+		job.TotalOrders = int(total)
+		for i := range orders {
+			rows = append(rows, orderRows(&orders[i], columns, job.ItemLines)...)
+		}
+		job.ProcessedOrders += len(orders)
+		job.RowCount = len(rows) - 1
+		return uc.repo.UpdateExportJobProgress(ctx, job.ID, job.ProcessedOrders, job.RowCount)
+	})
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := spreadsheet.Write(job.Format, &buf, rows); err != nil {
+		return fmt.Errorf("error writing export file: %w", err)
+	}
+
+	job.ResultFileKey = fmt.Sprintf("orders/exports/%d/orders-%d.%s", job.ID, job.ID, job.Format)
+	url, err := uc.storage.Upload(ctx, job.ResultFileKey, buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("error uploading export file: %w", err)
+	}
+	job.ResultFileURL = url
+	return nil
+}
+
+// eachOrderPage recorre por páginas las órdenes que cumplen los filtros. Las órdenes creadas después
+// de empezar no se incluyen, para que no desplacen las páginas ni se repitan o salten órdenes.
+func (uc *UseCaseExport) eachOrderPage(ctx context.Context, filters map[string]interface{}, fn func(orders []domain.Order, total int64) error) error {
+	pageFilters := make(map[string]interface{}, len(filters)+1)
+	for key, value := range filters {
+		pageFilters[key] = value
+	}
+	pageFilters["created_until"] = time.Now()
+
+	read := 0
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		orders, total, err := uc.repo.ListOrders(ctx, page, exportPageSize, pageFilters)
+		if err != nil {
+			return fmt.Errorf("error listing orders: %w", err)
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+		if err := fn(orders, total); err != nil {
+			return err
+		}
+
+		read += len(orders)
+		if len(orders) < exportPageSize || int64(read) >= total {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package usecaseinventory
+
+import (
+	"context"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// IInventoryUseCase define la reserva, descuento y liberación del stock de las órdenes
+type IInventoryUseCase interface {
+	// RejectsInsufficientStock indica si las órdenes sin stock suficiente se rechazan en lugar de marcarse
+	RejectsInsufficientStock() bool
+	CreateReservedOrder(ctx context.Context, order *domain.Order, lines []domain.StockLine) (*domain.InventoryResult, error)
+	Commit(ctx context.Context, orderID, reason string) error
+	Release(ctx context.Context, orderID, reason string) error
+	HandleOrderStatusChange(ctx context.Context, orderID, previousStatus, currentStatus string)
+	HandleShipmentStatus(ctx context.Context, orderID, status string)
+	ListMovements(ctx context.Context, orderID string) ([]domain.InventoryMovement, error)
+}
+
+// UseCaseInventory implementa IInventoryUseCase
+type UseCaseInventory struct {
+	repo             domain.IRepository
+	logger           log.ILogger
+	productPublisher domain.IProductEventPublisher
+	policy           string
+}
+
+// New crea el caso de uso de inventario. policy es flag (por defecto) o reject;
+// productPublisher es opcional y recibe los eventos de stock bajo.
+func New(repo domain.IRepository, logger log.ILogger, productPublisher domain.IProductEventPublisher, policy string) IInventoryUseCase {
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	if policy != domain.InsufficientStockPolicyReject {
+		policy = domain.InsufficientStockPolicyFlag
+	}
+	return &UseCaseInventory{
+		repo:             repo,
+		logger:           logger,
+		productPublisher: productPublisher,
+		policy:           policy,
+	}
+}
+
+// RejectsInsufficientStock indica si la política configurada rechaza las órdenes sin stock
+func (uc *UseCaseInventory) RejectsInsufficientStock() bool {
+	return uc.policy == domain.InsufficientStockPolicyReject
+}
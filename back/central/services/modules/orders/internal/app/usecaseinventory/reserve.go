@@ -0,0 +1,50 @@
+package usecaseinventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// CreateReservedOrder crea la orden y reserva su stock en una sola transacción. Con la política
+// reject una orden sin stock suficiente no se crea y se retorna domain.ErrInsufficientStock.
+func (uc *UseCaseInventory) CreateReservedOrder(ctx context.Context, order *domain.Order, lines []domain.StockLine) (*domain.InventoryResult, error) {
+	result, err := uc.repo.CreateOrderWithStock(ctx, order, lines, uc.RejectsInsufficientStock())
+	if err != nil {
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error creating order with stock reservation: %w", err)
+	}
+	order.InventoryStatus = result.Status
+
+	if len(result.Shortages) > 0 {
+		uc.logger.Warn(ctx).
+			Str("order_id", order.ID).
+			Int("shortages", len(result.Shortages)).
+			Msg("Orden creada sin stock suficiente")
+	}
+
+	uc.publishLowStock(ctx, order.ID, result.LowStock)
+	return result, nil
+}
+
+// publishLowStock publica de forma asíncrona un evento product.low_stock por producto
+func (uc *UseCaseInventory) publishLowStock(ctx context.Context, orderID string, products []domain.LowStockProduct) {
+	if uc.productPublisher == nil || len(products) == 0 {
+		return
+	}
+	for _, product := range products {
+		event := domain.NewLowStockEvent(product, orderID)
+		go func() {
+			if err := uc.productPublisher.PublishProductEvent(context.Background(), event); err != nil {
+				uc.logger.Error(ctx).
+					Err(err).
+					Str("product_id", event.ProductID).
+					Msg("Error al publicar evento de stock bajo")
+			}
+		}()
+	}
+}
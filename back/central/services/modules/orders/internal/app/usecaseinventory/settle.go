@@ -0,0 +1,88 @@
+package usecaseinventory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// Commit descuenta del stock lo reservado por la orden
+func (uc *UseCaseInventory) Commit(ctx context.Context, orderID, reason string) error {
+	committed, err := uc.repo.CommitStock(ctx, orderID, reason)
+	if err != nil {
+		return fmt.Errorf("error committing stock: %w", err)
+	}
+	if committed {
+		uc.logger.Info(ctx).
+			Str("order_id", orderID).
+			Str("reason", reason).
+			Msg("Stock reservado descontado")
+	}
+	return nil
+}
+
+// Release libera lo reservado por la orden
+func (uc *UseCaseInventory) Release(ctx context.Context, orderID, reason string) error {
+	released, err := uc.repo.ReleaseStock(ctx, orderID, reason)
+	if err != nil {
+		return fmt.Errorf("error releasing stock: %w", err)
+	}
+	if released {
+		uc.logger.Info(ctx).
+			Str("order_id", orderID).
+			Str("reason", reason).
+			Msg("Reserva de stock liberada")
+	}
+	return nil
+}
+
+// HandleOrderStatusChange descuenta o libera el stock según el nuevo estado de la orden.
+// Los errores se registran y no interrumpen la actualización de la orden.
+func (uc *UseCaseInventory) HandleOrderStatusChange(ctx context.Context, orderID, previousStatus, currentStatus string) {
+	if previousStatus == currentStatus {
+		return
+	}
+
+	var err error
+	switch domain.OrderStatus(currentStatus) {
+	case domain.OrderStatusShipped, domain.OrderStatusDelivered, domain.OrderStatusCompleted:
+		err = uc.Commit(ctx, orderID, domain.InventoryReasonOrderShipped)
+	case domain.OrderStatusCancelled:
+		err = uc.Release(ctx, orderID, domain.InventoryReasonOrderCancelled)
+	case domain.OrderStatusFailed:
+		err = uc.Release(ctx, orderID, domain.InventoryReasonOrderFailed)
+	default:
+		return
+	}
+	if err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("order_id", orderID).
+			Str("status", currentStatus).
+			Msg("Error al actualizar el inventario por cambio de estado de la orden")
+	}
+}
+
+// HandleShipmentStatus descuenta el stock reservado cuando el envío de la orden sale a despacho
+func (uc *UseCaseInventory) HandleShipmentStatus(ctx context.Context, orderID, status string) {
+	if orderID == "" || !slices.Contains(domain.ShipmentStatusesCommittingStock, status) {
+		return
+	}
+	if err := uc.Commit(ctx, orderID, domain.InventoryReasonShipmentShipped); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("order_id", orderID).
+			Str("shipment_status", status).
+			Msg("Error al descontar el stock por envío")
+	}
+}
+
+// ListMovements obtiene el libro de movimientos de inventario de una orden
+func (uc *UseCaseInventory) ListMovements(ctx context.Context, orderID string) ([]domain.InventoryMovement, error) {
+	if _, err := uc.repo.GetOrderByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListInventoryMovementsByOrder(ctx, orderID)
+}
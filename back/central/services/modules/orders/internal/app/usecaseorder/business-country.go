@@ -0,0 +1,43 @@
+package usecaseorder
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// businessDefaultCountry obtiene el país por defecto del negocio para normalizar teléfonos y direcciones.
+// Si no se puede determinar se usa el país por defecto de la plataforma.
+func (uc *UseCaseOrder) businessDefaultCountry(ctx context.Context, businessID *uint) string {
+	if businessID == nil || *businessID == 0 {
+		return phone.DefaultCountry
+	}
+
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, *businessID)
+	if err != nil || !phone.IsSupportedCountry(country) {
+		return phone.DefaultCountry
+	}
+	return country
+}
+
+// applyShippingAddress normaliza la dirección de envío contra el gazetteer y asigna las coordenadas:
+// las enviadas en la solicitud se respetan; si no vienen se usa el centroide de la ciudad.
+func applyShippingAddress(order *domain.Order, country string, lat, lng *float64) {
+	normalized := order.ApplyNormalizedAddress(country)
+	if lat != nil && lng != nil {
+		order.ApplyGeocode(&domain.GeocodeResult{
+			Lat:       *lat,
+			Lng:       *lng,
+			Precision: domain.GeocodePrecisionAddress,
+			Source:    domain.GeocodeSourceManual,
+		})
+		return
+	}
+
+	order.ShippingLat = nil
+	order.ShippingLng = nil
+	order.GeocodePrecision = ""
+	order.GeocodeSource = ""
+	order.ApplyGeocode(domain.CentroidGeocode(normalized))
+}
@@ -1,6 +1,7 @@
 package usecaseorder
 
 import (
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseinventory"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
 )
 
@@ -8,12 +9,15 @@ import (
 type UseCaseOrder struct {
 	repo           domain.IRepository
 	eventPublisher domain.IOrderEventPublisher
+	inventory      usecaseinventory.IInventoryUseCase
 }
 
-// New crea una nueva instancia de UseCaseOrder
-func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher) *UseCaseOrder {
+// New crea una nueva instancia de UseCaseOrder. inventory es opcional: sin él los cambios
+// de estado no descuentan ni liberan stock.
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, inventory usecaseinventory.IInventoryUseCase) *UseCaseOrder {
 	return &UseCaseOrder{
 		repo:           repo,
 		eventPublisher: eventPublisher,
+		inventory:      inventory,
 	}
 }
@@ -117,6 +117,11 @@ func (uc *UseCaseOrder) CreateOrder(ctx context.Context, req *domain.CreateOrder
 		ImportedAt: req.ImportedAt,
 	}
 
+	// Normalizar teléfono y dirección de envío con el país del negocio
+	country := uc.businessDefaultCountry(ctx, order.BusinessID)
+	order.SetCustomerPhone(req.CustomerPhone, country)
+	applyShippingAddress(order, country, req.ShippingLat, req.ShippingLng)
+
 	// Guardar en la base de datos
 	if err := uc.repo.CreateOrder(ctx, order); err != nil {
 		return nil, fmt.Errorf("error creating order: %w", err)
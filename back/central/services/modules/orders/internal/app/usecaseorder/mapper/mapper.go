@@ -42,6 +42,11 @@ func ToOrderResponse(order *domain.Order) *domain.OrderResponse {
 		CustomerPhone: order.CustomerPhone,
 		CustomerDNI:   order.CustomerDNI,
 
+		// Teléfono normalizado
+		CustomerPhoneE164:  order.CustomerPhoneE164,
+		CustomerPhoneValid: order.CustomerPhoneValid,
+		CustomerPhoneType:  order.CustomerPhoneType,
+
 		// Dirección de envío (desnormalizado)
 		ShippingStreet:     order.ShippingStreet,
 		ShippingCity:       order.ShippingCity,
@@ -51,6 +56,16 @@ func ToOrderResponse(order *domain.Order) *domain.OrderResponse {
 		ShippingLat:        order.ShippingLat,
 		ShippingLng:        order.ShippingLng,
 
+		// Normalización y geocodificación de la dirección
+		ShippingCityCode:    order.ShippingCityCode,
+		ShippingStateCode:   order.ShippingStateCode,
+		AddressCompleteness: order.AddressCompleteness,
+		GeocodePrecision:    order.GeocodePrecision,
+		GeocodeSource:       order.GeocodeSource,
+
+		// Inventario
+		InventoryStatus: order.InventoryStatus,
+
 		// Información de pago
 		PaymentMethodID: order.PaymentMethodID,
 		IsPaid:          order.IsPaid,
@@ -55,7 +55,7 @@ func (uc *UseCaseOrder) UpdateOrder(ctx context.Context, id string, req *domain.
 		order.CustomerEmail = *req.CustomerEmail
 	}
 	if req.CustomerPhone != nil {
-		order.CustomerPhone = *req.CustomerPhone
+		order.SetCustomerPhone(*req.CustomerPhone, uc.businessDefaultCountry(ctx, order.BusinessID))
 	}
 	if req.CustomerDNI != nil {
 		order.CustomerDNI = *req.CustomerDNI
@@ -77,11 +77,21 @@ func (uc *UseCaseOrder) UpdateOrder(ctx context.Context, id string, req *domain.
 	if req.ShippingPostalCode != nil {
 		order.ShippingPostalCode = *req.ShippingPostalCode
 	}
-	if req.ShippingLat != nil {
-		order.ShippingLat = req.ShippingLat
-	}
-	if req.ShippingLng != nil {
-		order.ShippingLng = req.ShippingLng
+	// Renormalizar y ubicar la dirección solo si cambió algún campo
+	addressChanged := req.ShippingStreet != nil || req.ShippingCity != nil || req.ShippingState != nil ||
+		req.ShippingCountry != nil || req.ShippingPostalCode != nil
+	if addressChanged || req.ShippingLat != nil || req.ShippingLng != nil {
+		lat, lng := req.ShippingLat, req.ShippingLng
+		if !addressChanged {
+			// Solo cambian las coordenadas: completar la que no vino con la actual
+			if lat == nil {
+				lat = order.ShippingLat
+			}
+			if lng == nil {
+				lng = order.ShippingLng
+			}
+		}
+		applyShippingAddress(order, uc.businessDefaultCountry(ctx, order.BusinessID), lat, lng)
 	}
 
 	// Información de pago
@@ -219,6 +229,11 @@ func (uc *UseCaseOrder) UpdateOrder(ctx context.Context, id string, req *domain.
 		return nil, fmt.Errorf("error updating order: %w", err)
 	}
 
+	// Descontar o liberar el stock reservado según el nuevo estado
+	if uc.inventory != nil && previousStatus != order.Status {
+		uc.inventory.HandleOrderStatusChange(ctx, order.ID, previousStatus, order.Status)
+	}
+
 	// Publicar eventos si hay publicador disponible
 	if uc.eventPublisher != nil {
 		// Publicar evento de actualización
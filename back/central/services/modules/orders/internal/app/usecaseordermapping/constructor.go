@@ -3,6 +3,7 @@ package usecaseordermapping
 import (
 	"context"
 
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseinventory"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
 	"github.com/secamc93/probability/back/central/shared/log"
 )
@@ -15,12 +16,18 @@ type UseCaseOrderMapping struct {
 	repo           domain.IRepository
 	logger         log.ILogger
 	eventPublisher domain.IOrderEventPublisher
+	geocoder       domain.IGeocoder
+	inventory      usecaseinventory.IInventoryUseCase
 }
 
-func New(repo domain.IRepository, logger log.ILogger, eventPublisher domain.IOrderEventPublisher) IOrderMappingUseCase {
+// New crea el caso de uso de mapeo de órdenes. geocoder es opcional: sin geocoder las órdenes
+// sin coordenadas quedan con el centroide de la ciudad. inventory es opcional: sin él no se reserva stock.
+func New(repo domain.IRepository, logger log.ILogger, eventPublisher domain.IOrderEventPublisher, geocoder domain.IGeocoder, inventory usecaseinventory.IInventoryUseCase) IOrderMappingUseCase {
 	return &UseCaseOrderMapping{
 		repo:           repo,
 		logger:         logger,
 		eventPublisher: eventPublisher,
+		geocoder:       geocoder,
+		inventory:      inventory,
 	}
 }
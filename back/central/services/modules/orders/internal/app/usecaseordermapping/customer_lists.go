@@ -0,0 +1,107 @@
+package usecaseordermapping
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"gorm.io/datatypes"
+)
+
+// checkCustomerLists compara la orden contra la blocklist/allowlist del negocio (country es el país por defecto del negocio).
+// Un error al consultar las listas no bloquea la ingesta: se registra y la orden sigue su flujo normal.
+func (uc *UseCaseOrderMapping) checkCustomerLists(ctx context.Context, businessID uint, dto *domain.CanonicalOrderDTO, country string) *domain.CustomerListMatch {
+	keys := domain.CustomerListKeys(dto, country)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	entries, err := uc.repo.FindActiveCustomerListEntries(ctx, businessID, keys)
+	if err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Uint("business_id", businessID).
+			Str("external_id", dto.ExternalID).
+			Msg("Error al consultar blocklist/allowlist de clientes")
+		return nil
+	}
+
+	return domain.ResolveCustomerListMatch(entries)
+}
+
+// applyCustomerListMatch aplica el resultado de las listas a la orden: los clientes bloqueados
+// quedan con Approved=false y los de confianza se aprueban sin revisión manual.
+// En ambos casos la orden se etiqueta en Metadata.
+func applyCustomerListMatch(order *domain.Order, match *domain.CustomerListMatch) {
+	if match == nil {
+		return
+	}
+
+	approved := match.ListType == domain.CustomerListAllowlist
+	order.Approved = &approved
+
+	metadata := make(map[string]interface{})
+	if len(order.Metadata) > 0 {
+		// Si la metadata del canal no es un objeto JSON se reemplaza por uno nuevo
+		_ = json.Unmarshal(order.Metadata, &metadata)
+		if metadata == nil {
+			metadata = make(map[string]interface{})
+		}
+	}
+	metadata[domain.CustomerListMetadataKey] = match
+
+	if raw, err := json.Marshal(metadata); err == nil {
+		order.Metadata = datatypes.JSON(raw)
+	}
+}
+
+// publishCustomerBlocked publica el evento de orden con cliente bloqueado
+func (uc *UseCaseOrderMapping) publishCustomerBlocked(ctx context.Context, order *domain.Order, match *domain.CustomerListMatch) {
+	if uc.eventPublisher == nil || match == nil || match.ListType != domain.CustomerListBlocklist {
+		return
+	}
+
+	entryIDs := make([]uint, len(match.Entries))
+	matchTypes := make([]string, len(match.Entries))
+	reasons := make([]string, 0, len(match.Entries))
+	for i, entry := range match.Entries {
+		entryIDs[i] = entry.ID
+		matchTypes[i] = entry.MatchType
+		if entry.Reason != "" {
+			reasons = append(reasons, entry.Reason)
+		}
+	}
+
+	eventData := domain.OrderEventData{
+		OrderNumber:    order.OrderNumber,
+		InternalNumber: order.InternalNumber,
+		ExternalID:     order.ExternalID,
+		CurrentStatus:  order.Status,
+		CustomerEmail:  order.CustomerEmail,
+		TotalAmount:    &order.TotalAmount,
+		Currency:       order.Currency,
+		Platform:       order.Platform,
+		Extra: map[string]interface{}{
+			"list_entry_ids": entryIDs,
+			"match_types":    matchTypes,
+			"reasons":        reasons,
+			"customer_phone": order.CustomerPhone,
+		},
+	}
+	event := domain.NewOrderEvent(domain.OrderEventTypeCustomerBlocked, order.ID, eventData)
+	event.BusinessID = order.BusinessID
+	if order.IntegrationID > 0 {
+		integrationID := order.IntegrationID
+		event.IntegrationID = &integrationID
+	}
+
+	// Publicar de forma asíncrona (no bloquear si falla)
+	go func() {
+		if err := uc.eventPublisher.PublishOrderEvent(ctx, event); err != nil {
+			uc.logger.Error(ctx).
+				Err(err).
+				Str("order_id", order.ID).
+				Msg("Error al publicar evento de cliente bloqueado")
+		}
+	}()
+}
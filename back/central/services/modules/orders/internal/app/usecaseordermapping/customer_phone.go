@@ -0,0 +1,37 @@
+package usecaseordermapping
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// businessDefaultCountry obtiene el país por defecto del negocio, usado para normalizar teléfonos y direcciones.
+// Si no se puede consultar el país del negocio se usa el país por defecto de la plataforma.
+func (uc *UseCaseOrderMapping) businessDefaultCountry(ctx context.Context, businessID uint) string {
+	country, err := uc.repo.GetBusinessDefaultCountry(ctx, businessID)
+	if err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Uint("business_id", businessID).
+			Msg("Error al obtener el país por defecto del negocio, se usa el país por defecto")
+		return phone.DefaultCountry
+	}
+	if !phone.IsSupportedCountry(country) {
+		return phone.DefaultCountry
+	}
+	return country
+}
+
+// normalizeCustomerPhone normaliza el teléfono del cliente a E.164 usando el país por defecto del negocio
+func (uc *UseCaseOrderMapping) normalizeCustomerPhone(ctx context.Context, dto *domain.CanonicalOrderDTO, country string) phone.Number {
+	number := phone.Normalize(dto.CustomerPhone, country)
+	if dto.CustomerPhone != "" && !number.Valid {
+		uc.logger.Warn(ctx).
+			Str("external_id", dto.ExternalID).
+			Str("reason", number.Reason).
+			Msg("Teléfono del cliente inválido")
+	}
+	return number
+}
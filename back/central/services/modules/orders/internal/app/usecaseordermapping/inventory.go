@@ -0,0 +1,53 @@
+package usecaseordermapping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// resolveOrderProducts resuelve los items contra el catálogo (variante o producto, creándolos si no
+// existen) y arma las líneas de stock con los IDs internos. Los items resueltos conservan el orden
+// de los items de la orden.
+func (uc *UseCaseOrderMapping) resolveOrderProducts(ctx context.Context, businessID, integrationID uint, items []domain.CanonicalOrderItemDTO) ([]domain.CatalogItem, []domain.StockLine, error) {
+	catalogItems := make([]domain.CatalogItem, 0, len(items))
+	lines := make([]domain.StockLine, 0, len(items))
+	for _, itemDTO := range items {
+		item, err := uc.ResolveCatalogItem(ctx, businessID, integrationID, itemDTO)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error processing product for item %s: %w", itemDTO.ProductSKU, err)
+		}
+		line := domain.StockLine{
+			ProductID: item.ProductID,
+			SKU:       item.SKU,
+			Quantity:  itemDTO.Quantity,
+		}
+		if item.VariantID != nil {
+			line.VariantID = *item.VariantID
+		}
+		catalogItems = append(catalogItems, *item)
+		lines = append(lines, line)
+	}
+	return catalogItems, lines, nil
+}
+
+// createOrder guarda la orden principal. Con inventario la orden y la reserva de su stock se
+// guardan en la misma transacción: con la política reject una orden sin stock no se crea y se
+// retorna domain.ErrInsufficientStock; con flag la orden queda marcada con su estado de inventario.
+func (uc *UseCaseOrderMapping) createOrder(ctx context.Context, order *domain.Order, lines []domain.StockLine) error {
+	if uc.inventory == nil || len(lines) == 0 {
+		if err := uc.repo.CreateOrder(ctx, order); err != nil {
+			return fmt.Errorf("error creating order: %w", err)
+		}
+		return nil
+	}
+	if _, err := uc.inventory.CreateReservedOrder(ctx, order, lines); err != nil {
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return err
+		}
+		return fmt.Errorf("error creating order: %w", err)
+	}
+	return nil
+}
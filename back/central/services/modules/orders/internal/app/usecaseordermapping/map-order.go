@@ -29,8 +29,12 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 		return nil, domain.ErrOrderAlreadyExists
 	}
 
+	// 1.4. Normalizar el teléfono del cliente a E.164 con el país del negocio
+	country := uc.businessDefaultCountry(ctx, *dto.BusinessID)
+	customerPhone := uc.normalizeCustomerPhone(ctx, dto, country)
+
 	// 1.5. Validar/Crear Cliente
-	client, err := uc.GetOrCreateCustomer(ctx, *dto.BusinessID, dto)
+	client, err := uc.GetOrCreateCustomer(ctx, *dto.BusinessID, dto, customerPhone)
 	if err != nil {
 		return nil, fmt.Errorf("error processing customer: %w", err)
 	}
@@ -39,6 +43,9 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 		clientID = &client.ID
 	}
 
+	// 1.6. Validar cliente contra la blocklist/allowlist del negocio
+	listMatch := uc.checkCustomerLists(ctx, *dto.BusinessID, dto, country)
+
 	// 2. Crear la entidad de dominio Order
 	order := &domain.Order{
 		// Identificadores de integración
@@ -100,34 +107,45 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 		ImportedAt: dto.ImportedAt,
 	}
 
-	// 2.1. Asignar PaymentMethodID desde el primer pago
+	// Teléfono crudo y E.164 (marca números inválidos o fijos)
+	order.ApplyCustomerPhone(customerPhone)
+
+	// 2.0. Aplicar blocklist/allowlist (aprobación y etiqueta en metadata)
+	applyCustomerListMatch(order, listMatch)
+
+	// 2.1. Calcular el saldo con todos los pagos (pagos mixtos, abonos y saldo): el método de pago
+	// es el del pago principal y la orden queda pagada cuando los pagos completados cubren el total
+	payments := mapPayments(dto.Payments)
+	paymentSummary := domain.SummarizePayments(order.ID, order.TotalAmount, order.Currency, payments)
 	order.PaymentMethodID = 1 // Valor por defecto
-	if len(dto.Payments) > 0 && dto.Payments[0].PaymentMethodID > 0 {
-		order.PaymentMethodID = dto.Payments[0].PaymentMethodID
-		if dto.Payments[0].Status == "completed" && dto.Payments[0].PaidAt != nil {
-			order.IsPaid = true
-			order.PaidAt = dto.Payments[0].PaidAt
-		}
+	if paymentSummary.PaymentMethodID > 0 {
+		order.PaymentMethodID = paymentSummary.PaymentMethodID
+	}
+	order.IsPaid = paymentSummary.IsPaid
+	order.PaidAt = paymentSummary.PaidAt
+
+	// 2.2. Normalizar y geocodificar la dirección de envío
+	uc.applyShippingAddress(ctx, order, dto, country)
+
+	// 2.3. Validar/Crear Productos
+	catalogItems, stockLines, err := uc.resolveOrderProducts(ctx, *dto.BusinessID, dto.IntegrationID, dto.OrderItems)
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Guardar la orden principal
-	if err := uc.repo.CreateOrder(ctx, order); err != nil {
-		return nil, fmt.Errorf("error creating order: %w", err)
+	// 3. Guardar la orden principal reservando el stock (rechaza la orden si la política es reject)
+	if err := uc.createOrder(ctx, order, stockLines); err != nil {
+		return nil, err
 	}
 
 	// 4. Guardar OrderItems
 	if len(dto.OrderItems) > 0 {
 		orderItems := make([]*domain.OrderItem, len(dto.OrderItems))
 		for i, itemDTO := range dto.OrderItems {
-			// Validar/Crear Producto
-			_, err := uc.GetOrCreateProduct(ctx, *dto.BusinessID, itemDTO)
-			if err != nil {
-				return nil, fmt.Errorf("error processing product for item %s: %w", itemDTO.ProductSKU, err)
-			}
-
 			orderItems[i] = &domain.OrderItem{
 				OrderID:          order.ID,
-				ProductID:        itemDTO.ProductID,
+				ProductID:        &catalogItems[i].ProductID,
+				ProductVariantID: catalogItems[i].VariantID,
 				ProductSKU:       itemDTO.ProductSKU,
 				ProductName:      itemDTO.ProductName,
 				ProductTitle:     itemDTO.ProductTitle,
@@ -176,34 +194,26 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 				Metadata:     addrDTO.Metadata,
 			}
 		}
+		// Completar las coordenadas de la dirección de envío con las de la orden
+		for _, addr := range addresses {
+			if addr.Type == "shipping" && addr.Latitude == nil && order.ShippingLat != nil {
+				addr.Latitude = order.ShippingLat
+				addr.Longitude = order.ShippingLng
+			}
+		}
 		if err := uc.repo.CreateAddresses(ctx, addresses); err != nil {
 			return nil, fmt.Errorf("error creating addresses: %w", err)
 		}
 	}
 
 	// 6. Guardar Payments
-	if len(dto.Payments) > 0 {
-		payments := make([]*domain.Payment, len(dto.Payments))
-		for i, payDTO := range dto.Payments {
-			payments[i] = &domain.Payment{
-				OrderID:          order.ID,
-				PaymentMethodID:  payDTO.PaymentMethodID,
-				Amount:           payDTO.Amount,
-				Currency:         payDTO.Currency,
-				ExchangeRate:     payDTO.ExchangeRate,
-				Status:           payDTO.Status,
-				PaidAt:           payDTO.PaidAt,
-				ProcessedAt:      payDTO.ProcessedAt,
-				TransactionID:    payDTO.TransactionID,
-				PaymentReference: payDTO.PaymentReference,
-				Gateway:          payDTO.Gateway,
-				RefundAmount:     payDTO.RefundAmount,
-				RefundedAt:       payDTO.RefundedAt,
-				FailureReason:    payDTO.FailureReason,
-				Metadata:         payDTO.Metadata,
-			}
+	if len(payments) > 0 {
+		orderPayments := make([]*domain.Payment, len(payments))
+		for i := range payments {
+			payments[i].OrderID = order.ID
+			orderPayments[i] = &payments[i]
 		}
-		if err := uc.repo.CreatePayments(ctx, payments); err != nil {
+		if err := uc.repo.CreatePayments(ctx, orderPayments); err != nil {
 			return nil, fmt.Errorf("error creating payments: %w", err)
 		}
 	}
@@ -282,6 +292,11 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 			TotalAmount:    &order.TotalAmount,
 			Currency:       order.Currency,
 			Platform:       order.Platform,
+			Extra: map[string]interface{}{
+				"source":           order.IntegrationType,
+				"delivery_signals": order.DeliverySignals(),
+				"inventory_status": order.InventoryStatus,
+			},
 		}
 		event := domain.NewOrderEvent(domain.OrderEventTypeCreated, order.ID, eventData)
 		event.BusinessID = order.BusinessID
@@ -300,10 +315,37 @@ func (uc *UseCaseOrderMapping) MapAndSaveOrder(ctx context.Context, dto *domain.
 		}()
 	}
 
+	// 9.1. Publicar evento de cliente bloqueado
+	uc.publishCustomerBlocked(ctx, order, listMatch)
+
 	// 10. Retornar la respuesta mapeada
 	return mapOrderToResponse(order), nil
 }
 
+// mapPayments convierte los pagos canónicos de la orden a pagos de dominio
+func mapPayments(payments []domain.CanonicalPaymentDTO) []domain.Payment {
+	result := make([]domain.Payment, len(payments))
+	for i, payDTO := range payments {
+		result[i] = domain.Payment{
+			PaymentMethodID:  payDTO.PaymentMethodID,
+			Amount:           payDTO.Amount,
+			Currency:         payDTO.Currency,
+			ExchangeRate:     payDTO.ExchangeRate,
+			Status:           payDTO.Status,
+			PaidAt:           payDTO.PaidAt,
+			ProcessedAt:      payDTO.ProcessedAt,
+			TransactionID:    payDTO.TransactionID,
+			PaymentReference: payDTO.PaymentReference,
+			Gateway:          payDTO.Gateway,
+			RefundAmount:     payDTO.RefundAmount,
+			RefundedAt:       payDTO.RefundedAt,
+			FailureReason:    payDTO.FailureReason,
+			Metadata:         payDTO.Metadata,
+		}
+	}
+	return result
+}
+
 // mapOrderToResponse convierte un modelo Order a OrderResponse
 func mapOrderToResponse(order *domain.Order) *domain.OrderResponse {
 	return &domain.OrderResponse{
@@ -339,6 +381,11 @@ func mapOrderToResponse(order *domain.Order) *domain.OrderResponse {
 		CustomerPhone: order.CustomerPhone,
 		CustomerDNI:   order.CustomerDNI,
 
+		// Teléfono normalizado
+		CustomerPhoneE164:  order.CustomerPhoneE164,
+		CustomerPhoneValid: order.CustomerPhoneValid,
+		CustomerPhoneType:  order.CustomerPhoneType,
+
 		// Dirección de envío (desnormalizado)
 		ShippingStreet:     order.ShippingStreet,
 		ShippingCity:       order.ShippingCity,
@@ -348,6 +395,16 @@ func mapOrderToResponse(order *domain.Order) *domain.OrderResponse {
 		ShippingLat:        order.ShippingLat,
 		ShippingLng:        order.ShippingLng,
 
+		// Normalización y geocodificación de la dirección
+		ShippingCityCode:    order.ShippingCityCode,
+		ShippingStateCode:   order.ShippingStateCode,
+		AddressCompleteness: order.AddressCompleteness,
+		GeocodePrecision:    order.GeocodePrecision,
+		GeocodeSource:       order.GeocodeSource,
+
+		// Inventario
+		InventoryStatus: order.InventoryStatus,
+
 		// Información de pago
 		PaymentMethodID: order.PaymentMethodID,
 		IsPaid:          order.IsPaid,
@@ -0,0 +1,61 @@
+package usecaseordermapping
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// shippingAddressDTO retorna la primera dirección de envío de la orden, o nil si no tiene
+func shippingAddressDTO(dto *domain.CanonicalOrderDTO) *domain.CanonicalAddressDTO {
+	for i := range dto.Addresses {
+		if dto.Addresses[i].Type == "shipping" {
+			return &dto.Addresses[i]
+		}
+	}
+	return nil
+}
+
+// applyShippingAddress copia la dirección de envío a la orden, la normaliza contra el gazetteer
+// y completa las coordenadas. Se respetan las coordenadas enviadas por el canal; si no vienen
+// se consulta el geocoder y, como último recurso, se usa el centroide de la ciudad.
+// Un error del geocoder no bloquea la ingesta.
+func (uc *UseCaseOrderMapping) applyShippingAddress(ctx context.Context, order *domain.Order, dto *domain.CanonicalOrderDTO, country string) {
+	if addr := shippingAddressDTO(dto); addr != nil {
+		order.ShippingStreet = addr.Street
+		order.ShippingCity = addr.City
+		order.ShippingState = addr.State
+		order.ShippingCountry = addr.Country
+		order.ShippingPostalCode = addr.PostalCode
+		if addr.Latitude != nil && addr.Longitude != nil {
+			order.ApplyGeocode(&domain.GeocodeResult{
+				Lat:       *addr.Latitude,
+				Lng:       *addr.Longitude,
+				Precision: domain.GeocodePrecisionAddress,
+				Source:    domain.GeocodeSourceChannel,
+			})
+		}
+	}
+
+	normalized := order.ApplyNormalizedAddress(country)
+	if order.ShippingLat != nil || order.ShippingAddress().IsEmpty() {
+		return
+	}
+
+	if uc.geocoder != nil {
+		result, err := uc.geocoder.Geocode(ctx, domain.NewGeocodeQuery(normalized))
+		if err != nil {
+			uc.logger.Error(ctx).
+				Err(err).
+				Str("external_id", order.ExternalID).
+				Str("address_key", normalized.Key).
+				Msg("Error al geocodificar la dirección de envío")
+		}
+		if result != nil {
+			order.ApplyGeocode(result)
+			return
+		}
+	}
+
+	order.ApplyGeocode(domain.CentroidGeocode(normalized))
+}
@@ -5,22 +5,26 @@ import (
 	"fmt"
 
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/normalize"
+	"github.com/secamc93/probability/back/central/shared/phone"
 )
 
-// GetOrCreateCustomer verifica si el cliente existe, si no, lo crea
-func (uc *UseCaseOrderMapping) GetOrCreateCustomer(ctx context.Context, businessID uint, dto *domain.CanonicalOrderDTO) (*domain.Client, error) {
-	if dto.CustomerEmail == "" {
-		return nil, nil // No hay email para validar
-	}
+// GetOrCreateCustomer verifica si el cliente existe, si no, lo crea.
+// Busca por email (sin distinguir mayúsculas), luego por DNI y luego por teléfono normalizado,
+// de modo que un mismo comprador que llega por distintos canales quede en un solo cliente.
+func (uc *UseCaseOrderMapping) GetOrCreateCustomer(ctx context.Context, businessID uint, dto *domain.CanonicalOrderDTO, customerPhone phone.Number) (*domain.Client, error) {
+	email := normalize.Email(dto.CustomerEmail)
 
 	// 1. Buscar cliente existente por email
-	client, err := uc.repo.GetClientByEmail(ctx, businessID, dto.CustomerEmail)
-	if err != nil {
-		return nil, fmt.Errorf("error searching client by email: %w", err)
-	}
+	if email != "" {
+		client, err := uc.repo.GetClientByEmail(ctx, businessID, email)
+		if err != nil {
+			return nil, fmt.Errorf("error searching client by email: %w", err)
+		}
 
-	if client != nil {
-		return client, nil
+		if client != nil {
+			return client, nil
+		}
 	}
 
 	// 2. Si no existe por email y hay DNI, buscar por DNI antes de crear
@@ -35,12 +39,30 @@ func (uc *UseCaseOrderMapping) GetOrCreateCustomer(ctx context.Context, business
 		}
 	}
 
-	// 3. Crear nuevo cliente solo si no existe ni por email ni por DNI
+	// 3. Buscar por teléfono normalizado (órdenes de canales que solo envían teléfono)
+	if dto.CustomerPhone != "" {
+		clientByPhone, err := uc.repo.GetClientByPhone(ctx, businessID, customerPhone.E164)
+		if err != nil {
+			return nil, fmt.Errorf("error searching client by phone: %w", err)
+		}
+
+		if clientByPhone != nil {
+			return clientByPhone, nil
+		}
+	}
+
+	// Sin email no se crea el cliente (el email forma parte del índice único por negocio)
+	if email == "" {
+		return nil, nil
+	}
+
+	// 4. Crear nuevo cliente solo si no existe ni por email, ni por DNI, ni por teléfono
 	newClient := &domain.Client{
 		BusinessID: businessID,
 		Name:       dto.CustomerName,
-		Email:      dto.CustomerEmail,
+		Email:      email,
 		Phone:      dto.CustomerPhone,
+		PhoneE164:  customerPhone.E164,
 	}
 
 	// Solo asignar DNI si no está vacío (para evitar violaciones de constraint único)
@@ -7,29 +7,85 @@ import (
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
 )
 
-// GetOrCreateProduct verifica si el producto existe, si no, lo crea
-func (uc *UseCaseOrderMapping) GetOrCreateProduct(ctx context.Context, businessID uint, itemDTO domain.CanonicalOrderItemDTO) (*domain.Product, error) {
+// ResolveCatalogItem resuelve el item de la orden a una variante o producto del catálogo, en orden:
+//  1. Variante asociada al variant_id externo en la integración
+//  2. Variante con el SKU del item
+//  3. Producto con el SKU del item
+//  4. Nueva variante bajo el producto con el product_id externo (si el item trae variant_id)
+//  5. Nuevo producto con el SKU del item
+func (uc *UseCaseOrderMapping) ResolveCatalogItem(ctx context.Context, businessID, integrationID uint, itemDTO domain.CanonicalOrderItemDTO) (*domain.CatalogItem, error) {
 	if itemDTO.ProductSKU == "" {
 		return nil, fmt.Errorf("product SKU is required")
 	}
 
-	// 1. Buscar producto existente
+	var externalVariantID string
+	if itemDTO.VariantID != nil {
+		externalVariantID = *itemDTO.VariantID
+	}
+
+	// 1. Buscar variante por su ID en la integración
+	if externalVariantID != "" && integrationID > 0 {
+		variant, err := uc.repo.GetVariantByExternalID(ctx, businessID, integrationID, externalVariantID)
+		if err != nil {
+			return nil, fmt.Errorf("error searching variant: %w", err)
+		}
+		if variant != nil {
+			return variantCatalogItem(variant), nil
+		}
+	}
+
+	// 2. Buscar variante por SKU (y asociarla a la integración para las siguientes órdenes)
+	variant, err := uc.repo.GetVariantBySKU(ctx, businessID, itemDTO.ProductSKU)
+	if err != nil {
+		return nil, fmt.Errorf("error searching variant: %w", err)
+	}
+	if variant != nil {
+		uc.linkVariantIntegration(ctx, variant, integrationID, externalVariantID)
+		return variantCatalogItem(variant), nil
+	}
+
+	// 3. Buscar producto existente
 	product, err := uc.repo.GetProductBySKU(ctx, businessID, itemDTO.ProductSKU)
 	if err != nil {
 		return nil, fmt.Errorf("error searching product: %w", err)
 	}
-
 	if product != nil {
-		return product, nil
+		return &domain.CatalogItem{ProductID: product.ID, SKU: product.SKU}, nil
 	}
 
-	// 2. Crear nuevo producto si no existe
 	// Nota: Usamos el ProductID externo como ExternalID si está disponible
 	var externalID string
 	if itemDTO.ProductID != nil {
 		externalID = *itemDTO.ProductID
 	}
 
+	// 4. Crear la variante bajo el producto padre ya importado desde el canal
+	if externalVariantID != "" && externalID != "" {
+		parent, err := uc.repo.GetProductByExternalID(ctx, businessID, externalID)
+		if err != nil {
+			return nil, fmt.Errorf("error searching parent product: %w", err)
+		}
+		if parent != nil {
+			title := itemDTO.ProductTitle
+			if title == "" {
+				title = itemDTO.ProductName
+			}
+			newVariant := &domain.ProductVariant{
+				ProductID:  parent.ID,
+				BusinessID: businessID,
+				SKU:        itemDTO.ProductSKU,
+				Title:      title,
+				ExternalID: externalVariantID,
+			}
+			if err := uc.repo.CreateVariant(ctx, newVariant); err != nil {
+				return nil, fmt.Errorf("error creating variant: %w", err)
+			}
+			uc.linkVariantIntegration(ctx, newVariant, integrationID, externalVariantID)
+			return variantCatalogItem(newVariant), nil
+		}
+	}
+
+	// 5. Crear nuevo producto si no existe
 	newProduct := &domain.Product{
 		BusinessID: businessID,
 		SKU:        itemDTO.ProductSKU,
@@ -41,5 +97,25 @@ func (uc *UseCaseOrderMapping) GetOrCreateProduct(ctx context.Context, businessI
 		return nil, fmt.Errorf("error creating product: %w", err)
 	}
 
-	return newProduct, nil
+	return &domain.CatalogItem{ProductID: newProduct.ID, SKU: newProduct.SKU}, nil
+}
+
+// linkVariantIntegration registra el variant_id externo de la variante; un error no interrumpe la orden
+func (uc *UseCaseOrderMapping) linkVariantIntegration(ctx context.Context, variant *domain.ProductVariant, integrationID uint, externalVariantID string) {
+	if externalVariantID == "" || integrationID == 0 {
+		return
+	}
+	if err := uc.repo.LinkVariantIntegration(ctx, variant, integrationID, externalVariantID); err != nil {
+		uc.logger.Warn(ctx).
+			Err(err).
+			Str("variant_id", variant.ID).
+			Str("external_variant_id", externalVariantID).
+			Msg("No se pudo asociar la variante con la integración")
+	}
+}
+
+// variantCatalogItem construye el item de catálogo de una variante
+func variantCatalogItem(variant *domain.ProductVariant) *domain.CatalogItem {
+	variantID := variant.ID
+	return &domain.CatalogItem{ProductID: variant.ProductID, VariantID: &variantID, SKU: variant.SKU}
 }
@@ -0,0 +1,32 @@
+package usecaseorderpayment
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// IOrderPaymentUseCase define el libro de pagos de la orden (pagos mixtos, abonos y saldo)
+type IOrderPaymentUseCase interface {
+	// GetLedger obtiene los pagos de la orden con el saldo pagado, pendiente y por pagar
+	GetLedger(ctx context.Context, orderID string) (*domain.OrderPaymentLedger, error)
+	// RecordPayment registra un pago adicional de la orden y recalcula su saldo
+	RecordPayment(ctx context.Context, orderID string, req *domain.RecordPaymentRequest) (*domain.RecordPaymentResponse, error)
+}
+
+// UseCaseOrderPayment implementa IOrderPaymentUseCase
+type UseCaseOrderPayment struct {
+	repo           domain.IRepository
+	eventPublisher domain.IOrderEventPublisher
+	logger         log.ILogger
+}
+
+// New crea el caso de uso del libro de pagos. eventPublisher es opcional (nil = no se publican eventos).
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, logger log.ILogger) IOrderPaymentUseCase {
+	return &UseCaseOrderPayment{
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
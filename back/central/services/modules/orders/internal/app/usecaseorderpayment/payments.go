@@ -0,0 +1,146 @@
+package usecaseorderpayment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+)
+
+// GetLedger obtiene los pagos de la orden con el saldo calculado sobre todos ellos
+func (uc *UseCaseOrderPayment) GetLedger(ctx context.Context, orderID string) (*domain.OrderPaymentLedger, error) {
+	order, err := uc.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := uc.repo.ListOrderPayments(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OrderPaymentLedger{
+		Summary:  domain.SummarizePayments(order.ID, order.TotalAmount, order.Currency, payments),
+		Payments: payments,
+	}, nil
+}
+
+// RecordPayment registra un pago adicional de la orden, deriva IsPaid/PaidAt del libro completo
+// y publica order.payment_received cuando el pago está completado
+func (uc *UseCaseOrderPayment) RecordPayment(ctx context.Context, orderID string, req *domain.RecordPaymentRequest) (*domain.RecordPaymentResponse, error) {
+	order, err := uc.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment := &domain.Payment{
+		OrderID:          order.ID,
+		PaymentMethodID:  req.PaymentMethodID,
+		Amount:           paymentledger.RoundAmount(req.Amount),
+		Currency:         req.Currency,
+		Status:           req.Status,
+		PaidAt:           req.PaidAt,
+		TransactionID:    req.TransactionID,
+		PaymentReference: req.PaymentReference,
+		Gateway:          req.Gateway,
+		Metadata:         req.Metadata,
+	}
+	if payment.Currency == "" {
+		payment.Currency = order.Currency
+	}
+	if payment.Status == "" {
+		payment.Status = domain.PaymentStatusCompleted
+	}
+	if payment.Status == domain.PaymentStatusCompleted {
+		now := time.Now()
+		payment.ProcessedAt = &now
+		if payment.PaidAt == nil {
+			payment.PaidAt = &now
+		}
+	}
+
+	summary, err := uc.repo.RecordPayment(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info(ctx).
+		Str("order_id", order.ID).
+		Uint("payment_id", payment.ID).
+		Float64("amount", payment.Amount).
+		Str("status", payment.Status).
+		Float64("balance_due", summary.BalanceDue).
+		Bool("is_paid", summary.IsPaid).
+		Msg("Pago de la orden registrado")
+
+	if payment.Status == domain.PaymentStatusCompleted {
+		uc.publishPaymentReceived(ctx, order, payment, summary)
+	}
+
+	return &domain.RecordPaymentResponse{
+		Payment: *payment,
+		Summary: *summary,
+	}, nil
+}
+
+// getOrder obtiene la orden y descarta las eliminadas
+func (uc *UseCaseOrderPayment) getOrder(ctx context.Context, orderID string) (*domain.Order, error) {
+	order, err := uc.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting order: %w", err)
+	}
+	if order.DeletedAt != nil {
+		return nil, domain.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// publishPaymentReceived publica order.payment_received de forma asíncrona (no bloquea si falla)
+func (uc *UseCaseOrderPayment) publishPaymentReceived(ctx context.Context, order *domain.Order, payment *domain.Payment, summary *domain.OrderPaymentSummary) {
+	if uc.eventPublisher == nil {
+		return
+	}
+
+	amount := payment.Amount
+	eventData := domain.OrderEventData{
+		OrderNumber:    order.OrderNumber,
+		InternalNumber: order.InternalNumber,
+		ExternalID:     order.ExternalID,
+		CurrentStatus:  order.Status,
+		CustomerEmail:  order.CustomerEmail,
+		TotalAmount:    &amount,
+		Currency:       payment.Currency,
+		Platform:       order.Platform,
+		Extra: map[string]interface{}{
+			"payment_id":        payment.ID,
+			"payment_method_id": payment.PaymentMethodID,
+			"order_total":       summary.TotalAmount,
+			"paid_amount":       summary.PaidAmount,
+			"pending_amount":    summary.PendingAmount,
+			"balance_due":       summary.BalanceDue,
+			"is_paid":           summary.IsPaid,
+		},
+	}
+	event := domain.NewOrderEvent(domain.OrderEventTypePaymentReceived, order.ID, eventData)
+	event.BusinessID = order.BusinessID
+	if order.IntegrationID > 0 {
+		integrationID := order.IntegrationID
+		event.IntegrationID = &integrationID
+	}
+
+	publishCtx := context.WithoutCancel(ctx)
+	go func() {
+		if err := uc.eventPublisher.PublishOrderEvent(publishCtx, event); err != nil {
+			uc.logger.Error(publishCtx).
+				Err(err).
+				Str("order_id", order.ID).
+				Msg("Error al publicar evento de pago recibido")
+		}
+	}()
+}
@@ -0,0 +1,38 @@
+package usecaseshipmentsync
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// IShipmentSyncUseCase define la propagación del estado de los envíos a su orden
+type IShipmentSyncUseCase interface {
+	// SyncOrder recalcula el estado, tracking y fecha de entrega de la orden a partir de sus envíos
+	SyncOrder(ctx context.Context, orderID string) (*domain.ShipmentSyncResult, error)
+	// HandleShipmentEvent sincroniza la orden del envío; los errores se registran y no se retornan
+	HandleShipmentEvent(ctx context.Context, event *domain.ShipmentEvent)
+}
+
+// UseCaseShipmentSync implementa IShipmentSyncUseCase
+type UseCaseShipmentSync struct {
+	repo           domain.IRepository
+	eventPublisher domain.IOrderEventPublisher
+	logger         log.ILogger
+	rules          []domain.ShipmentStatusRule
+}
+
+// New crea el caso de uso de sincronización envío → orden. rules vacío usa las reglas por defecto;
+// eventPublisher es opcional.
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, logger log.ILogger, rules []domain.ShipmentStatusRule) IShipmentSyncUseCase {
+	if len(rules) == 0 {
+		rules = domain.DefaultShipmentStatusRules
+	}
+	return &UseCaseShipmentSync{
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+		rules:          rules,
+	}
+}
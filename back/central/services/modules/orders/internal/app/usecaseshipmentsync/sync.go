@@ -0,0 +1,203 @@
+package usecaseshipmentsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// publishTimeout límite para publicar los eventos de la orden sincronizada
+const publishTimeout = 10 * time.Second
+
+// orderEventsByStatus evento específico publicado cuando los envíos llevan la orden a cada estado
+var orderEventsByStatus = map[domain.OrderStatus]domain.OrderEventType{
+	domain.OrderStatusShipped:   domain.OrderEventTypeShipped,
+	domain.OrderStatusDelivered: domain.OrderEventTypeDelivered,
+	domain.OrderStatusOnHold:    domain.OrderEventTypeOnHold,
+	domain.OrderStatusFailed:    domain.OrderEventTypeFailed,
+}
+
+// HandleShipmentEvent sincroniza la orden a la que pertenece el envío del evento
+func (uc *UseCaseShipmentSync) HandleShipmentEvent(ctx context.Context, event *domain.ShipmentEvent) {
+	if event.OrderID == "" {
+		return
+	}
+	if _, err := uc.SyncOrder(ctx, event.OrderID); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("order_id", event.OrderID).
+			Uint("shipment_id", event.ShipmentID).
+			Str("shipment_status", event.Data.CurrentStatus).
+			Msg("Error al sincronizar la orden con el estado de sus envíos")
+	}
+}
+
+// SyncOrder aplica las reglas configuradas sobre los envíos de la orden, copia a la orden el tracking
+// y la guía del envío más reciente y, si cambió el estado, publica los eventos correspondientes
+func (uc *UseCaseShipmentSync) SyncOrder(ctx context.Context, orderID string) (*domain.ShipmentSyncResult, error) {
+	order, err := uc.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting order: %w", err)
+	}
+	shipments, err := uc.repo.ListShipmentsByOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing order shipments: %w", err)
+	}
+
+	previousStatus := order.Status
+	changed := applyShipmentData(order, shipments)
+
+	// Las reglas solo aplican transiciones válidas: un segundo envío en tránsito no regresa a "shipped"
+	// una orden entregada ni una orden pendiente salta directo a "delivered"
+	current := domain.OrderStatus(order.Status)
+	if !slices.Contains(domain.OrderStatusesLockedByShipments, current) {
+		if status, ok := domain.ResolveOrderStatusFromShipments(uc.rules, shipments); ok && status != current && current.CanTransitionTo(status) {
+			order.Status = status.String()
+			changed = true
+		}
+	}
+	if order.Status == domain.OrderStatusDelivered.String() && order.DeliveredAt == nil {
+		deliveredAt := latestDeliveredAt(shipments)
+		order.DeliveredAt = &deliveredAt
+		changed = true
+	}
+
+	result := &domain.ShipmentSyncResult{
+		OrderID:        order.ID,
+		PreviousStatus: previousStatus,
+		CurrentStatus:  order.Status,
+		StatusChanged:  previousStatus != order.Status,
+		TrackingNumber: order.TrackingNumber,
+		TrackingLink:   order.TrackingLink,
+		DeliveredAt:    order.DeliveredAt,
+	}
+	if !changed {
+		return result, nil
+	}
+
+	// Cada réplica recibe el evento del envío: solo la que aplica el cambio publica los eventos
+	updated, err := uc.repo.UpdateOrderShipmentData(ctx, order, previousStatus)
+	if err != nil {
+		return nil, fmt.Errorf("error updating order shipment data: %w", err)
+	}
+	if !updated {
+		result.StatusChanged = false
+		return result, nil
+	}
+
+	if result.StatusChanged {
+		uc.logger.Info(ctx).
+			Str("order_id", order.ID).
+			Str("previous_status", previousStatus).
+			Str("current_status", order.Status).
+			Msg("Estado de la orden actualizado por sus envíos")
+		uc.publishStatusEvents(ctx, order, previousStatus)
+	}
+	return result, nil
+}
+
+// applyShipmentData copia a la orden el tracking y la guía del envío más reciente que los tenga.
+// Retorna si algún campo cambió.
+func applyShipmentData(order *domain.Order, shipments []domain.Shipment) bool {
+	changed := false
+	for i := len(shipments) - 1; i >= 0; i-- {
+		if shipments[i].TrackingNumber == nil || *shipments[i].TrackingNumber == "" {
+			continue
+		}
+		changed = setString(&order.TrackingNumber, shipments[i].TrackingNumber) || changed
+		changed = setString(&order.TrackingLink, shipments[i].TrackingURL) || changed
+		break
+	}
+	for i := len(shipments) - 1; i >= 0; i-- {
+		if shipments[i].GuideID == nil || *shipments[i].GuideID == "" {
+			continue
+		}
+		changed = setString(&order.GuideID, shipments[i].GuideID) || changed
+		changed = setString(&order.GuideLink, shipments[i].GuideURL) || changed
+		break
+	}
+	return changed
+}
+
+// setString asigna value a target si es distinto (un valor nil no borra el existente)
+func setString(target **string, value *string) bool {
+	if value == nil || (*target != nil && **target == *value) {
+		return false
+	}
+	v := *value
+	*target = &v
+	return true
+}
+
+// latestDeliveredAt retorna la entrega más reciente de los envíos, o la hora actual si no la reportan
+func latestDeliveredAt(shipments []domain.Shipment) time.Time {
+	var latest time.Time
+	for _, shipment := range shipments {
+		if shipment.DeliveredAt != nil && shipment.DeliveredAt.After(latest) {
+			latest = *shipment.DeliveredAt
+		}
+	}
+	if latest.IsZero() {
+		latest = time.Now()
+	}
+	return latest
+}
+
+// publishStatusEvents publica, en ese orden, order.status_changed y el evento del nuevo estado
+// (order.shipped, order.delivered, ...) con el tracking copiado de los envíos
+func (uc *UseCaseShipmentSync) publishStatusEvents(ctx context.Context, order *domain.Order, previousStatus string) {
+	if uc.eventPublisher == nil {
+		return
+	}
+
+	data := domain.OrderEventData{
+		OrderNumber:    order.OrderNumber,
+		InternalNumber: order.InternalNumber,
+		ExternalID:     order.ExternalID,
+		PreviousStatus: previousStatus,
+		CurrentStatus:  order.Status,
+		CustomerEmail:  order.CustomerEmail,
+		TotalAmount:    &order.TotalAmount,
+		Currency:       order.Currency,
+		Platform:       order.Platform,
+		Extra: map[string]interface{}{
+			"source": "shipment",
+		},
+	}
+	if order.TrackingNumber != nil {
+		data.Extra["tracking_number"] = *order.TrackingNumber
+	}
+	if order.TrackingLink != nil {
+		data.Extra["tracking_link"] = *order.TrackingLink
+	}
+	if order.DeliveredAt != nil {
+		data.Extra["delivered_at"] = order.DeliveredAt.Format(time.RFC3339)
+	}
+
+	eventTypes := []domain.OrderEventType{domain.OrderEventTypeStatusChanged}
+	if eventType, ok := orderEventsByStatus[domain.OrderStatus(order.Status)]; ok {
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+
+	for _, eventType := range eventTypes {
+		event := domain.NewOrderEvent(eventType, order.ID, data)
+		event.BusinessID = order.BusinessID
+		if order.IntegrationID > 0 {
+			integrationID := order.IntegrationID
+			event.IntegrationID = &integrationID
+		}
+		if err := uc.eventPublisher.PublishOrderEvent(publishCtx, event); err != nil {
+			uc.logger.Error(publishCtx).
+				Err(err).
+				Str("order_id", event.OrderID).
+				Str("event_type", string(event.Type)).
+				Msg("Error al publicar evento de la orden sincronizada con envíos")
+		}
+	}
+}
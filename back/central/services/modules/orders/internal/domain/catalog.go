@@ -16,6 +16,23 @@ type Product struct {
 	ExternalID string     `json:"external_id"`
 }
 
+// ProductVariant representa una variante de producto del catálogo (talla, color, ...)
+type ProductVariant struct {
+	ID         string `json:"id"`
+	ProductID  string `json:"product_id"`
+	BusinessID uint   `json:"business_id"`
+	SKU        string `json:"sku"`
+	Title      string `json:"title"`
+	ExternalID string `json:"external_id"`
+}
+
+// CatalogItem es el producto (y la variante, si aplica) al que se resolvió un item de orden
+type CatalogItem struct {
+	ProductID string
+	VariantID *string // nil si el item no corresponde a una variante
+	SKU       string
+}
+
 // Client representa un cliente en el dominio
 type Client struct {
 	ID         uint       `json:"id"`
@@ -26,6 +43,7 @@ type Client struct {
 	Name       string     `json:"name"`
 	Email      string     `json:"email"`
 	Phone      string     `json:"phone"`
+	PhoneE164  string     `json:"phone_e164"`
 	Dni        *string    `json:"dni"`
 }
 
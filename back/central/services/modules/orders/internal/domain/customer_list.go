@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/shared/normalize"
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// Tipos de lista de clientes (gestionadas en el módulo customers)
+const (
+	CustomerListBlocklist = "blocklist"
+	CustomerListAllowlist = "allowlist"
+)
+
+// CustomerListMetadataKey es la clave de Order.Metadata donde se etiqueta la coincidencia con una lista
+const CustomerListMetadataKey = "customer_list"
+
+// CustomerListEntry es una entrada vigente de blocklist/allowlist que coincide con una orden
+type CustomerListEntry struct {
+	ID        uint       `json:"id"`
+	ListType  string     `json:"list_type"`
+	MatchType string     `json:"match_type"`
+	Value     string     `json:"value"`
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CustomerListMatch resume el resultado de comparar una orden contra las listas del negocio
+type CustomerListMatch struct {
+	ListType string              `json:"list_type"`
+	Entries  []CustomerListEntry `json:"entries"`
+}
+
+// CustomerListKeys calcula los valores normalizados de la orden que se comparan contra las listas,
+// agrupados por campo ("phone", "email", "dni", "address"). Los teléfonos se comparan en E.164
+// con el país por defecto del negocio. La normalización debe coincidir con la del módulo customers
+// al registrar las entradas.
+func CustomerListKeys(dto *CanonicalOrderDTO, defaultCountry string) map[string][]string {
+	keys := make(map[string][]string)
+	add := func(matchType, value string) {
+		if value == "" {
+			return
+		}
+		for _, existing := range keys[matchType] {
+			if existing == value {
+				return
+			}
+		}
+		keys[matchType] = append(keys[matchType], value)
+	}
+
+	add("phone", phone.Normalize(dto.CustomerPhone, defaultCountry).E164)
+	add("email", normalize.EmailAliasKey(dto.CustomerEmail))
+	add("dni", normalize.DocumentID(dto.CustomerDNI))
+
+	for _, addr := range dto.Addresses {
+		add("phone", phone.Normalize(addr.Phone, defaultCountry).E164)
+		if addr.Type != "" && addr.Type != "shipping" {
+			continue
+		}
+		street := strings.TrimSpace(addr.Street + " " + addr.Street2)
+		add("address", normalize.Text(street))
+		add("address", normalize.Text(street+" "+addr.City))
+	}
+
+	return keys
+}
+
+// ResolveCustomerListMatch decide qué lista aplica a la orden. La blocklist tiene prioridad
+// sobre la allowlist. Retorna nil si ninguna entrada coincide.
+func ResolveCustomerListMatch(entries []CustomerListEntry) *CustomerListMatch {
+	var blocked, allowed []CustomerListEntry
+	for _, entry := range entries {
+		switch entry.ListType {
+		case CustomerListBlocklist:
+			blocked = append(blocked, entry)
+		case CustomerListAllowlist:
+			allowed = append(allowed, entry)
+		}
+	}
+
+	if len(blocked) > 0 {
+		return &CustomerListMatch{ListType: CustomerListBlocklist, Entries: blocked}
+	}
+	if len(allowed) > 0 {
+		return &CustomerListMatch{ListType: CustomerListAllowlist, Entries: allowed}
+	}
+	return nil
+}
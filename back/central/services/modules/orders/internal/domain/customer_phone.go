@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/secamc93/probability/back/central/shared/phone"
+)
+
+// SetCustomerPhone normaliza el teléfono del cliente con el país por defecto del negocio
+// y lo asigna a la orden conservando el valor crudo y su forma E.164
+func (o *Order) SetCustomerPhone(raw, defaultCountry string) phone.Number {
+	number := phone.Normalize(raw, defaultCountry)
+	o.ApplyCustomerPhone(number)
+	return number
+}
+
+// ApplyCustomerPhone asigna a la orden un teléfono ya normalizado.
+// Los números inválidos quedan marcados con CustomerPhoneValid=false y sin E.164.
+func (o *Order) ApplyCustomerPhone(number phone.Number) {
+	o.CustomerPhone = number.Raw
+	if strings.TrimSpace(number.Raw) == "" {
+		o.CustomerPhoneE164 = ""
+		o.CustomerPhoneValid = nil
+		o.CustomerPhoneType = ""
+		return
+	}
+
+	valid := number.Valid
+	o.CustomerPhoneE164 = number.E164
+	o.CustomerPhoneValid = &valid
+	o.CustomerPhoneType = string(number.Type)
+}
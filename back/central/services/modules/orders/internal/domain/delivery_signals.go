@@ -0,0 +1,33 @@
+package domain
+
+import "github.com/secamc93/probability/back/central/shared/phone"
+
+// DeliverySignals agrupa las señales de calidad de datos de la orden que alimentan
+// el scoring de probabilidad de entrega
+type DeliverySignals struct {
+	HasPhone      bool   `json:"has_phone"`
+	PhoneValid    bool   `json:"phone_valid"`
+	PhoneIsMobile bool   `json:"phone_is_mobile"`
+	PhoneLineType string `json:"phone_line_type,omitempty"`
+
+	AddressCompleteness *float64 `json:"address_completeness,omitempty"`
+	AddressCityMatched  bool     `json:"address_city_matched"`
+	GeocodePrecision    string   `json:"geocode_precision,omitempty"`
+}
+
+// DeliverySignals calcula las señales de entrega de la orden
+func (o *Order) DeliverySignals() DeliverySignals {
+	signals := DeliverySignals{
+		HasPhone:      o.CustomerPhoneValid != nil,
+		PhoneLineType: o.CustomerPhoneType,
+
+		AddressCompleteness: o.AddressCompleteness,
+		AddressCityMatched:  o.ShippingCityCode != "",
+		GeocodePrecision:    o.GeocodePrecision,
+	}
+	if o.CustomerPhoneValid != nil && *o.CustomerPhoneValid {
+		signals.PhoneValid = true
+		signals.PhoneIsMobile = o.CustomerPhoneType != string(phone.LineTypeLandline)
+	}
+	return signals
+}
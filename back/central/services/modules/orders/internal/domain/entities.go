@@ -224,6 +224,10 @@ type OrderResponse struct {
 	CustomerPhone string `json:"customer_phone"`
 	CustomerDNI   string `json:"customer_dni"`
 
+	CustomerPhoneE164  string `json:"customer_phone_e164,omitempty"`
+	CustomerPhoneValid *bool  `json:"customer_phone_valid,omitempty"`
+	CustomerPhoneType  string `json:"customer_phone_type,omitempty"`
+
 	// Dirección de envío
 	ShippingStreet     string   `json:"shipping_street"`
 	ShippingCity       string   `json:"shipping_city"`
@@ -233,6 +237,15 @@ type OrderResponse struct {
 	ShippingLat        *float64 `json:"shipping_lat,omitempty"`
 	ShippingLng        *float64 `json:"shipping_lng,omitempty"`
 
+	ShippingCityCode    string   `json:"shipping_city_code,omitempty"`
+	ShippingStateCode   string   `json:"shipping_state_code,omitempty"`
+	AddressCompleteness *float64 `json:"address_completeness,omitempty"`
+	GeocodePrecision    string   `json:"geocode_precision,omitempty"`
+	GeocodeSource       string   `json:"geocode_source,omitempty"`
+
+	// Estado del inventario reservado para la orden
+	InventoryStatus string `json:"inventory_status,omitempty"`
+
 	// Información de pago
 	PaymentMethodID uint       `json:"payment_method_id"`
 	IsPaid          bool       `json:"is_paid"`
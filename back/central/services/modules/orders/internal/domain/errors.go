@@ -5,4 +5,31 @@ import "errors"
 var (
 	// ErrOrderAlreadyExists indicates that an order with the same external ID already exists for the integration
 	ErrOrderAlreadyExists = errors.New("order with this external_id already exists for this integration")
+
+	// ErrInsufficientStock indicates that a product without backorders has not enough available stock for the order
+	ErrInsufficientStock = errors.New("insufficient stock for one or more products")
+
+	// ErrExportJobNotFound indicates that the order export job does not exist
+	ErrExportJobNotFound = errors.New("order export job not found")
+
+	// ErrExportUnavailable indicates that there is no file storage configured for async exports
+	ErrExportUnavailable = errors.New("async order export is not available")
+
+	// ErrUnsupportedExportFormat indicates that the requested export format is not csv or xlsx
+	ErrUnsupportedExportFormat = errors.New("unsupported export format, expected csv or xlsx")
+
+	// ErrInvalidExportColumns indicates that the requested columns are unknown or item columns were requested without item lines
+	ErrInvalidExportColumns = errors.New("invalid export columns")
+
+	// ErrExportTooLarge indicates that the export exceeds the rows allowed for a synchronous download
+	ErrExportTooLarge = errors.New("too many orders for a direct export, create an export job instead")
+
+	// ErrInvalidShipmentStatusRule indicates that a shipment to order status rule is malformed
+	ErrInvalidShipmentStatusRule = errors.New("invalid shipment status rule")
+
+	// ErrOrderNotFound indicates that the order does not exist or was deleted
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrPaymentExceedsBalance indicates that a recorded payment is greater than the balance due of the order
+	ErrPaymentExceedsBalance = errors.New("payment amount exceeds the balance due of the order")
 )
@@ -0,0 +1,195 @@
+package domain
+
+import "time"
+
+// ───────────────────────────────────────────
+//
+//	ORDER EXPORT - Exportación de órdenes a CSV/XLSX
+//
+// ───────────────────────────────────────────
+
+// Formatos de exportación
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// Estados de un trabajo de exportación
+const (
+	ExportJobStatusPending    = "pending"
+	ExportJobStatusProcessing = "processing"
+	ExportJobStatusCompleted  = "completed"
+	ExportJobStatusFailed     = "failed"
+)
+
+// MaxSyncExportOrders es el máximo de órdenes que se exportan en línea; rangos mayores van por trabajo asíncrono
+const MaxSyncExportOrders = 10000
+
+// Columnas de orden exportables
+const (
+	ExportColumnID              = "id"
+	ExportColumnOrderNumber     = "order_number"
+	ExportColumnInternalNumber  = "internal_number"
+	ExportColumnExternalID      = "external_id"
+	ExportColumnPlatform        = "platform"
+	ExportColumnIntegrationID   = "integration_id"
+	ExportColumnBusinessID      = "business_id"
+	ExportColumnStatus          = "status"
+	ExportColumnOriginalStatus  = "original_status"
+	ExportColumnCreatedAt       = "created_at"
+	ExportColumnOccurredAt      = "occurred_at"
+	ExportColumnCustomerName    = "customer_name"
+	ExportColumnCustomerEmail   = "customer_email"
+	ExportColumnCustomerPhone   = "customer_phone"
+	ExportColumnCustomerDNI     = "customer_dni"
+	ExportColumnShippingStreet  = "shipping_street"
+	ExportColumnShippingCity    = "shipping_city"
+	ExportColumnShippingState   = "shipping_state"
+	ExportColumnShippingCountry = "shipping_country"
+	ExportColumnShippingPostal  = "shipping_postal_code"
+	ExportColumnSubtotal        = "subtotal"
+	ExportColumnTax             = "tax"
+	ExportColumnDiscount        = "discount"
+	ExportColumnShippingCost    = "shipping_cost"
+	ExportColumnTotalAmount     = "total_amount"
+	ExportColumnCurrency        = "currency"
+	ExportColumnCodTotal        = "cod_total"
+	ExportColumnIsPaid          = "is_paid"
+	ExportColumnPaidAt          = "paid_at"
+	ExportColumnTrackingNumber  = "tracking_number"
+	ExportColumnGuideID         = "guide_id"
+	ExportColumnDeliveredAt     = "delivered_at"
+	ExportColumnWarehouseID     = "warehouse_id"
+	ExportColumnWarehouseName   = "warehouse_name"
+	ExportColumnDriverID        = "driver_id"
+	ExportColumnDriverName      = "driver_name"
+	ExportColumnItemsCount      = "items_count"
+	ExportColumnNotes           = "notes"
+)
+
+// Columnas de item exportables (solo con ItemLines)
+const (
+	ExportColumnItemSKU        = "item_sku"
+	ExportColumnItemName       = "item_name"
+	ExportColumnItemVariantID  = "item_variant_id"
+	ExportColumnItemQuantity   = "item_quantity"
+	ExportColumnItemUnitPrice  = "item_unit_price"
+	ExportColumnItemDiscount   = "item_discount"
+	ExportColumnItemTax        = "item_tax"
+	ExportColumnItemTotalPrice = "item_total_price"
+)
+
+// DefaultOrderExportColumns columnas exportadas cuando no se indican
+var DefaultOrderExportColumns = []string{
+	ExportColumnOrderNumber, ExportColumnInternalNumber, ExportColumnPlatform, ExportColumnStatus,
+	ExportColumnCreatedAt, ExportColumnCustomerName, ExportColumnCustomerEmail, ExportColumnCustomerPhone,
+	ExportColumnShippingCity, ExportColumnShippingState, ExportColumnTotalAmount, ExportColumnCurrency,
+	ExportColumnIsPaid, ExportColumnTrackingNumber, ExportColumnWarehouseName, ExportColumnDriverName,
+}
+
+// DefaultItemExportColumns columnas de item agregadas a las por defecto cuando se exporta una fila por item
+var DefaultItemExportColumns = []string{
+	ExportColumnItemSKU, ExportColumnItemName, ExportColumnItemQuantity,
+	ExportColumnItemUnitPrice, ExportColumnItemTotalPrice,
+}
+
+// OrderListFilters son los filtros de ListOrders, con tipo para poder guardarlos en los trabajos de exportación
+type OrderListFilters struct {
+	BusinessID      *uint  `json:"business_id,omitempty"`
+	IntegrationID   *uint  `json:"integration_id,omitempty"`
+	IntegrationType string `json:"integration_type,omitempty"`
+	Status          string `json:"status,omitempty"`
+	CustomerEmail   string `json:"customer_email,omitempty"`
+	CustomerPhone   string `json:"customer_phone,omitempty"`
+	OrderNumber     string `json:"order_number,omitempty"`
+	InternalNumber  string `json:"internal_number,omitempty"`
+	Platform        string `json:"platform,omitempty"`
+	IsPaid          *bool  `json:"is_paid,omitempty"`
+	WarehouseID     *uint  `json:"warehouse_id,omitempty"`
+	DriverID        *uint  `json:"driver_id,omitempty"`
+	StartDate       string `json:"start_date,omitempty"`
+	EndDate         string `json:"end_date,omitempty"`
+	SortBy          string `json:"sort_by,omitempty"`
+	SortOrder       string `json:"sort_order,omitempty"`
+}
+
+// ToMap convierte los filtros al formato usado por el repositorio en ListOrders
+func (f OrderListFilters) ToMap() map[string]interface{} {
+	filters := make(map[string]interface{})
+
+	set := func(key, value string) {
+		if value != "" {
+			filters[key] = value
+		}
+	}
+	setID := func(key string, value *uint) {
+		if value != nil {
+			filters[key] = *value
+		}
+	}
+
+	setID("business_id", f.BusinessID)
+	setID("integration_id", f.IntegrationID)
+	set("integration_type", f.IntegrationType)
+	set("status", f.Status)
+	set("customer_email", f.CustomerEmail)
+	set("customer_phone", f.CustomerPhone)
+	set("order_number", f.OrderNumber)
+	set("internal_number", f.InternalNumber)
+	set("platform", f.Platform)
+	if f.IsPaid != nil {
+		filters["is_paid"] = *f.IsPaid
+	}
+	setID("warehouse_id", f.WarehouseID)
+	setID("driver_id", f.DriverID)
+	set("start_date", f.StartDate)
+	set("end_date", f.EndDate)
+	set("sort_by", f.SortBy)
+	set("sort_order", f.SortOrder)
+	return filters
+}
+
+// OrderExportRequest representa una exportación de órdenes
+type OrderExportRequest struct {
+	Format    string           `json:"format" binding:"omitempty,oneof=csv xlsx"` // Por defecto xlsx en trabajos asíncronos
+	Columns   []string         `json:"columns"`                                   // Vacío = columnas por defecto
+	ItemLines bool             `json:"item_lines"`                                // Una fila por item de la orden
+	Filters   OrderListFilters `json:"filters"`
+	CreatedBy *uint            `json:"created_by"`
+}
+
+// OrderExportJob es una exportación de órdenes procesada en background
+type OrderExportJob struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID *uint     `json:"business_id,omitempty"`
+	Format     string    `json:"format"`
+	Status     string    `json:"status"`
+
+	Columns   []string         `json:"columns"`
+	ItemLines bool             `json:"item_lines"`
+	Filters   OrderListFilters `json:"filters"`
+
+	ResultFileKey string `json:"result_file_key,omitempty"`
+	ResultFileURL string `json:"result_file_url,omitempty"` // Link de descarga cuando el trabajo termina
+
+	TotalOrders     int     `json:"total_orders"`
+	ProcessedOrders int     `json:"processed_orders"`
+	RowCount        int     `json:"row_count"`
+	Progress        float64 `json:"progress"` // Porcentaje de órdenes procesadas (0-100)
+	ErrorMessage    string  `json:"error_message,omitempty"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedBy  *uint      `json:"created_by,omitempty"`
+}
+
+// ExportJobsListResponse representa la respuesta paginada de trabajos de exportación
+type ExportJobsListResponse struct {
+	Data       []OrderExportJob `json:"data"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"page_size"`
+	TotalPages int              `json:"total_pages"`
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/shared/address"
+)
+
+// Precisión de las coordenadas de envío
+const (
+	GeocodePrecisionAddress = "address" // Coordenadas de la placa
+	GeocodePrecisionStreet  = "street"  // Coordenadas de la vía
+	GeocodePrecisionCity    = "city"    // Centroide de la ciudad
+)
+
+// Origen de las coordenadas de envío
+const (
+	GeocodeSourceChannel   = "channel"   // Enviadas por el canal de venta
+	GeocodeSourceManual    = "manual"    // Ingresadas al crear o editar la orden
+	GeocodeSourceGazetteer = "gazetteer" // Centroide del gazetteer de referencia
+)
+
+// GeocodeQuery es la dirección normalizada que se envía al geocoder
+type GeocodeQuery struct {
+	Key        string // Clave estable de la dirección normalizada (usada para cache)
+	Street     string
+	City       string
+	CityCode   string
+	State      string
+	StateCode  string
+	Country    string
+	PostalCode string
+}
+
+// GeocodeResult son las coordenadas obtenidas para una dirección
+type GeocodeResult struct {
+	Lat       float64 `json:"lat"`
+	Lng       float64 `json:"lng"`
+	Precision string  `json:"precision"`
+	Source    string  `json:"source"`
+}
+
+// IGeocoder define el puerto de geocodificación de direcciones.
+// Retorna nil, nil cuando la dirección no se puede ubicar.
+type IGeocoder interface {
+	Geocode(ctx context.Context, query GeocodeQuery) (*GeocodeResult, error)
+}
+
+// NewGeocodeQuery construye la consulta de geocodificación a partir de una dirección normalizada
+func NewGeocodeQuery(n address.Normalized) GeocodeQuery {
+	return GeocodeQuery{
+		Key:        n.Key,
+		Street:     n.Street,
+		City:       n.City,
+		CityCode:   n.CityCode,
+		State:      n.State,
+		StateCode:  n.StateCode,
+		Country:    n.Country,
+		PostalCode: n.PostalCode,
+	}
+}
+
+// ShippingAddress retorna la dirección de envío desnormalizada de la orden
+func (o *Order) ShippingAddress() address.Address {
+	return address.Address{
+		Street:     o.ShippingStreet,
+		City:       o.ShippingCity,
+		State:      o.ShippingState,
+		Country:    o.ShippingCountry,
+		PostalCode: o.ShippingPostalCode,
+	}
+}
+
+// ApplyNormalizedAddress normaliza la dirección de envío de la orden contra el gazetteer:
+// reemplaza ciudad y departamento por sus nombres oficiales, asigna los códigos y el puntaje de completitud.
+func (o *Order) ApplyNormalizedAddress(defaultCountry string) address.Normalized {
+	normalized := address.Normalize(o.ShippingAddress(), defaultCountry)
+	if o.ShippingAddress().IsEmpty() {
+		o.ShippingCityCode = ""
+		o.ShippingStateCode = ""
+		o.AddressCompleteness = nil
+		return normalized
+	}
+
+	o.ShippingCity = normalized.City
+	o.ShippingState = normalized.State
+	o.ShippingCountry = normalized.Country
+	o.ShippingPostalCode = normalized.PostalCode
+	o.ShippingCityCode = normalized.CityCode
+	o.ShippingStateCode = normalized.StateCode
+	completeness := normalized.Completeness
+	o.AddressCompleteness = &completeness
+	return normalized
+}
+
+// ApplyGeocode asigna las coordenadas de envío
+func (o *Order) ApplyGeocode(result *GeocodeResult) {
+	if result == nil {
+		return
+	}
+	lat, lng := result.Lat, result.Lng
+	o.ShippingLat = &lat
+	o.ShippingLng = &lng
+	o.GeocodePrecision = result.Precision
+	o.GeocodeSource = result.Source
+}
+
+// CentroidGeocode retorna el centroide de la ciudad como resultado de geocodificación de baja precisión
+func CentroidGeocode(n address.Normalized) *GeocodeResult {
+	lat, lng, ok := n.Centroid()
+	if !ok {
+		return nil
+	}
+	return &GeocodeResult{Lat: lat, Lng: lng, Precision: GeocodePrecisionCity, Source: GeocodeSourceGazetteer}
+}
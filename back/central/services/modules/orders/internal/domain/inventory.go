@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/shared/orderevents"
+)
+
+// Tipos de movimiento de inventario
+const (
+	InventoryMovementReserve = "reserve" // Reserva al crear la orden
+	InventoryMovementCommit  = "commit"  // Descuento definitivo al enviar la orden
+	InventoryMovementRelease = "release" // Liberación de la reserva al cancelar la orden
+)
+
+// Motivos de los movimientos de inventario
+const (
+	InventoryReasonOrderCreated    = "order_created"
+	InventoryReasonOrderShipped    = "order_shipped"
+	InventoryReasonShipmentShipped = "shipment_shipped"
+	InventoryReasonOrderCancelled  = "order_cancelled"
+	InventoryReasonOrderFailed     = "order_failed"
+)
+
+// Estados del inventario de una orden
+const (
+	InventoryStatusReserved     = "reserved"     // Todo el stock quedó reservado
+	InventoryStatusBackordered  = "backordered"  // Se reservó sin stock suficiente porque el producto permite backorder
+	InventoryStatusInsufficient = "insufficient" // Faltó stock en productos que no permiten backorder
+	InventoryStatusCommitted    = "committed"    // El stock se descontó al enviar la orden
+	InventoryStatusReleased     = "released"     // La reserva se liberó al cancelar la orden
+)
+
+// Políticas ante stock insuficiente en productos sin backorder
+const (
+	InsufficientStockPolicyFlag   = "flag"   // La orden se crea y queda marcada como insufficient
+	InsufficientStockPolicyReject = "reject" // La orden se rechaza
+)
+
+// ShipmentStatusesCommittingStock son los estados de envío que descuentan el stock reservado
+var ShipmentStatusesCommittingStock = []string{"shipped", "in_transit", "delivered"}
+
+// ShipmentEvent es el evento publicado por el módulo de envíos (solo los campos usados por inventario y
+// por la sincronización del estado de la orden)
+type ShipmentEvent struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	ShipmentID uint   `json:"shipment_id"`
+	OrderID    string `json:"order_id"`
+	Data       struct {
+		PreviousStatus string `json:"previous_status,omitempty"`
+		CurrentStatus  string `json:"current_status"`
+	} `json:"data"`
+}
+
+// StockLine es la cantidad de un producto que requiere una orden
+type StockLine struct {
+	ProductID string
+	VariantID string // Vacío si el stock se controla en el producto
+	SKU       string
+	Quantity  int
+}
+
+// StockShortage describe un producto sin stock suficiente para la orden
+type StockShortage struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	SKU       string `json:"sku"`
+	Requested int    `json:"requested"`
+	Available int    `json:"available"`
+}
+
+// LowStockProduct describe un producto que cruzó su umbral de stock bajo
+type LowStockProduct struct {
+	ProductID  string `json:"product_id"`
+	VariantID  string `json:"variant_id,omitempty"`
+	BusinessID uint   `json:"business_id"`
+	SKU        string `json:"sku"`
+	Name       string `json:"name"`
+	Available  int    `json:"available"`
+	Threshold  int    `json:"threshold"`
+}
+
+// InventoryResult es el resultado de reservar el stock de una orden
+type InventoryResult struct {
+	Status    string            `json:"status"`
+	Shortages []StockShortage   `json:"shortages,omitempty"`
+	LowStock  []LowStockProduct `json:"-"`
+}
+
+// InventoryMovement es un registro del libro de movimientos de inventario
+type InventoryMovement struct {
+	ID             uint      `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	BusinessID     uint      `json:"business_id"`
+	ProductID      string    `json:"product_id"`
+	OrderID        *string   `json:"order_id,omitempty"`
+	VariantID      *string   `json:"variant_id,omitempty"`
+	Type           string    `json:"type"`
+	Quantity       int       `json:"quantity"`
+	Reason         string    `json:"reason"`
+	StockBefore    int       `json:"stock_before"`
+	StockAfter     int       `json:"stock_after"`
+	ReservedBefore int       `json:"reserved_before"`
+	ReservedAfter  int       `json:"reserved_after"`
+}
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT EVENTS
+//
+// ───────────────────────────────────────────
+
+// ProductEventTypeLowStock se emite cuando el stock disponible de un producto cruza su umbral
+const ProductEventTypeLowStock = "product.low_stock"
+
+// ProductEvent es un evento de producto publicado por el módulo de órdenes
+type ProductEvent struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	ProductID  string          `json:"product_id"`
+	BusinessID uint            `json:"business_id"`
+	OrderID    string          `json:"order_id,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+	Data       LowStockProduct `json:"data"`
+}
+
+// NewLowStockEvent crea el evento de stock bajo de un producto
+func NewLowStockEvent(product LowStockProduct, orderID string) *ProductEvent {
+	return &ProductEvent{
+		ID:         orderevents.NewEventID(),
+		Type:       ProductEventTypeLowStock,
+		ProductID:  product.ProductID,
+		BusinessID: product.BusinessID,
+		OrderID:    orderID,
+		Timestamp:  time.Now(),
+		Data:       product,
+	}
+}
+
+// IProductEventPublisher publica eventos de productos
+type IProductEventPublisher interface {
+	PublishProductEvent(ctx context.Context, event *ProductEvent) error
+}
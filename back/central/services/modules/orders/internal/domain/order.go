@@ -40,6 +40,10 @@ type Order struct {
 	CustomerPhone string `json:"customer_phone"`
 	CustomerDNI   string `json:"customer_dni"`
 
+	CustomerPhoneE164  string `json:"customer_phone_e164"`
+	CustomerPhoneValid *bool  `json:"customer_phone_valid"`
+	CustomerPhoneType  string `json:"customer_phone_type"`
+
 	// Dirección de envío
 	ShippingStreet     string   `json:"shipping_street"`
 	ShippingCity       string   `json:"shipping_city"`
@@ -49,6 +53,15 @@ type Order struct {
 	ShippingLat        *float64 `json:"shipping_lat"`
 	ShippingLng        *float64 `json:"shipping_lng"`
 
+	ShippingCityCode    string   `json:"shipping_city_code"`
+	ShippingStateCode   string   `json:"shipping_state_code"`
+	AddressCompleteness *float64 `json:"address_completeness"`
+	GeocodePrecision    string   `json:"geocode_precision"`
+	GeocodeSource       string   `json:"geocode_source"`
+
+	// Estado del inventario reservado para la orden
+	InventoryStatus string `json:"inventory_status"`
+
 	// Información de pago
 	PaymentMethodID uint       `json:"payment_method_id"`
 	IsPaid          bool       `json:"is_paid"`
@@ -131,6 +144,8 @@ type OrderItem struct {
 	ProductTitle string  `json:"product_title"`
 	VariantID    *string `json:"variant_id"`
 
+	ProductVariantID *string `json:"product_variant_id,omitempty"` // Variante del catálogo resuelta al ingerir la orden
+
 	Quantity   int     `json:"quantity"`
 	UnitPrice  float64 `json:"unit_price"`
 	TotalPrice float64 `json:"total_price"`
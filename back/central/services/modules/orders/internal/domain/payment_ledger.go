@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+	"gorm.io/datatypes"
+)
+
+// ───────────────────────────────────────────
+//
+//	PAYMENT LEDGER - Pagos múltiples y parciales de la orden
+//
+// ───────────────────────────────────────────
+
+// Estados del pago (deben coincidir con models.Payment.Status)
+const (
+	PaymentStatusPending   = paymentledger.StatusPending
+	PaymentStatusCompleted = paymentledger.StatusCompleted
+	PaymentStatusFailed    = paymentledger.StatusFailed
+	PaymentStatusRefunded  = paymentledger.StatusRefunded
+)
+
+// OrderPaymentSummary es el saldo de la orden calculado con todos sus pagos
+type OrderPaymentSummary struct {
+	OrderID         string     `json:"order_id"`
+	TotalAmount     float64    `json:"total_amount"`
+	Currency        string     `json:"currency"`
+	PaidAmount      float64    `json:"paid_amount"`     // Pagos completados, netos de reembolsos
+	PendingAmount   float64    `json:"pending_amount"`  // Pagos registrados aún sin confirmar
+	RefundedAmount  float64    `json:"refunded_amount"` // Reembolsado de los pagos
+	BalanceDue      float64    `json:"balance_due"`     // Lo que falta por pagar
+	IsPaid          bool       `json:"is_paid"`
+	PaidAt          *time.Time `json:"paid_at,omitempty"`
+	PaymentMethodID uint       `json:"payment_method_id"` // Método del pago principal (0 = sin pagos)
+	PaymentCount    int        `json:"payment_count"`
+}
+
+// SummarizePayments calcula el saldo de la orden con todos sus pagos usando el cálculo compartido
+// con reembolsos y conciliaciones contra entrega (ver paymentledger.Summarize)
+func SummarizePayments(orderID string, totalAmount float64, currency string, payments []Payment) OrderPaymentSummary {
+	entries := make([]paymentledger.Payment, len(payments))
+	for i := range payments {
+		entries[i] = paymentledger.Payment{
+			PaymentMethodID: payments[i].PaymentMethodID,
+			Amount:          payments[i].Amount,
+			Status:          payments[i].Status,
+			PaidAt:          payments[i].PaidAt,
+			RefundAmount:    payments[i].RefundAmount,
+		}
+	}
+	return NewOrderPaymentSummary(orderID, currency, paymentledger.Summarize(totalAmount, entries))
+}
+
+// NewOrderPaymentSummary arma el saldo de la orden a partir del resultado del cálculo compartido
+func NewOrderPaymentSummary(orderID, currency string, summary paymentledger.Summary) OrderPaymentSummary {
+	return OrderPaymentSummary{
+		OrderID:         orderID,
+		TotalAmount:     summary.TotalAmount,
+		Currency:        currency,
+		PaidAmount:      summary.PaidAmount,
+		PendingAmount:   summary.PendingAmount,
+		RefundedAmount:  summary.RefundedAmount,
+		BalanceDue:      summary.BalanceDue,
+		IsPaid:          summary.IsPaid,
+		PaidAt:          summary.PaidAt,
+		PaymentMethodID: summary.PaymentMethodID,
+		PaymentCount:    summary.PaymentCount,
+	}
+}
+
+// ───────────────────────────────────────────
+//
+//	PAYMENT LEDGER DTOs
+//
+// ───────────────────────────────────────────
+
+// RecordPaymentRequest representa un pago adicional de la orden (abono, saldo o pago mixto)
+type RecordPaymentRequest struct {
+	PaymentMethodID  uint           `json:"payment_method_id" binding:"required"`
+	Amount           float64        `json:"amount" binding:"required,gt=0"`
+	Currency         string         `json:"currency" binding:"omitempty,max=10"`                // Por defecto la moneda de la orden
+	Status           string         `json:"status" binding:"omitempty,oneof=pending completed"` // Por defecto completed
+	PaidAt           *time.Time     `json:"paid_at"`                                            // Por defecto ahora (si está completado)
+	TransactionID    *string        `json:"transaction_id" binding:"omitempty,max=255"`
+	PaymentReference *string        `json:"payment_reference" binding:"omitempty,max=255"`
+	Gateway          *string        `json:"gateway" binding:"omitempty,max=64"`
+	Metadata         datatypes.JSON `json:"metadata,omitempty"`
+}
+
+// OrderPaymentLedger representa los pagos de la orden con su saldo
+type OrderPaymentLedger struct {
+	Summary  OrderPaymentSummary `json:"summary"`
+	Payments []Payment           `json:"payments"`
+}
+
+// RecordPaymentResponse representa el pago registrado y el saldo resultante de la orden
+type RecordPaymentResponse struct {
+	Payment Payment             `json:"payment"`
+	Summary OrderPaymentSummary `json:"summary"`
+}
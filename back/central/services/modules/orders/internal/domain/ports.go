@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // ───────────────────────────────────────────
@@ -36,6 +37,8 @@ type IRepository interface {
 
 	// Payments
 	CreatePayments(ctx context.Context, payments []*Payment) error
+	ListOrderPayments(ctx context.Context, orderID string) ([]Payment, error)
+	RecordPayment(ctx context.Context, payment *Payment) (*OrderPaymentSummary, error)
 
 	// Shipments
 	CreateShipments(ctx context.Context, shipments []*Shipment) error
@@ -49,12 +52,46 @@ type IRepository interface {
 
 	// Products
 	GetProductBySKU(ctx context.Context, businessID uint, sku string) (*Product, error)
+	GetProductByExternalID(ctx context.Context, businessID uint, externalID string) (*Product, error)
 	CreateProduct(ctx context.Context, product *Product) error
 
+	// Product Variants
+	GetVariantByExternalID(ctx context.Context, businessID, integrationID uint, externalVariantID string) (*ProductVariant, error)
+	GetVariantBySKU(ctx context.Context, businessID uint, sku string) (*ProductVariant, error)
+	CreateVariant(ctx context.Context, variant *ProductVariant) error
+	LinkVariantIntegration(ctx context.Context, variant *ProductVariant, integrationID uint, externalVariantID string) error
+
 	// Clients
 	GetClientByEmail(ctx context.Context, businessID uint, email string) (*Client, error)
 	GetClientByDNI(ctx context.Context, businessID uint, dni string) (*Client, error)
+	GetClientByPhone(ctx context.Context, businessID uint, phoneE164 string) (*Client, error)
+
+	// Business
+	GetBusinessDefaultCountry(ctx context.Context, businessID uint) (string, error)
+
+	// Inventory
+	CreateOrderWithStock(ctx context.Context, order *Order, lines []StockLine, rejectShortages bool) (*InventoryResult, error)
+	CommitStock(ctx context.Context, orderID, reason string) (bool, error)
+	ReleaseStock(ctx context.Context, orderID, reason string) (bool, error)
+	ListInventoryMovementsByOrder(ctx context.Context, orderID string) ([]InventoryMovement, error)
+
+	// Customer Blocklist / Allowlist
+	FindActiveCustomerListEntries(ctx context.Context, businessID uint, keys map[string][]string) ([]CustomerListEntry, error)
 	CreateClient(ctx context.Context, client *Client) error
+
+	// Order Export Jobs
+	CreateExportJob(ctx context.Context, job *OrderExportJob) error
+	GetExportJob(ctx context.Context, id uint) (*OrderExportJob, error)
+	ListExportJobs(ctx context.Context, businessID *uint, page, pageSize int) ([]OrderExportJob, int64, error)
+	UpdateExportJob(ctx context.Context, job *OrderExportJob) error
+	UpdateExportJobProgress(ctx context.Context, id uint, processedOrders, rowCount int) error
+	ListPendingExportJobIDs(ctx context.Context, limit int) ([]uint, error)
+	ClaimExportJob(ctx context.Context, id uint) (bool, error)
+	ReleaseStaleExportJobs(ctx context.Context, staleAfter time.Duration) (int64, error)
+
+	// Shipment → Order Sync
+	ListShipmentsByOrder(ctx context.Context, orderID string) ([]Shipment, error)
+	UpdateOrderShipmentData(ctx context.Context, order *Order, previousStatus string) (bool, error)
 }
 
 // ───────────────────────────────────────────
@@ -68,3 +105,15 @@ type IOrderConsumer interface {
 	// Start inicia el consumidor de órdenes
 	Start(ctx context.Context) error
 }
+
+// ───────────────────────────────────────────
+//
+//	EXPORT FILE STORAGE
+//
+// ───────────────────────────────────────────
+
+// IExportStorage guarda los archivos generados por las exportaciones asíncronas
+type IExportStorage interface {
+	// Upload guarda el contenido bajo la llave dada y retorna su URL de descarga
+	Upload(ctx context.Context, key string, data []byte) (string, error)
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHIPMENT → ORDER SYNC - Estado de la orden a partir de sus envíos
+//
+// ───────────────────────────────────────────
+
+// Estados de envío publicados por el módulo de envíos
+const (
+	ShipmentStatusPending   = "pending"
+	ShipmentStatusInTransit = "in_transit"
+	ShipmentStatusDelivered = "delivered"
+	ShipmentStatusFailed    = "failed"
+)
+
+// Cuantificadores de las reglas de sincronización
+const (
+	ShipmentRuleMatchAll = "all" // Todos los envíos de la orden están en el estado
+	ShipmentRuleMatchAny = "any" // Al menos un envío de la orden está en el estado
+)
+
+// ShipmentStatusRule lleva la orden a OrderStatus cuando sus envíos cumplen Match sobre ShipmentStatus
+type ShipmentStatusRule struct {
+	Match          string      `json:"match"`
+	ShipmentStatus string      `json:"shipment_status"`
+	OrderStatus    OrderStatus `json:"order_status"`
+}
+
+// DefaultShipmentStatusRules reglas usadas cuando no se configuran otras. Se evalúan en orden y
+// gana la primera que se cumple; si ninguna se cumple el estado de la orden no cambia.
+var DefaultShipmentStatusRules = []ShipmentStatusRule{
+	{Match: ShipmentRuleMatchAll, ShipmentStatus: ShipmentStatusDelivered, OrderStatus: OrderStatusDelivered},
+	{Match: ShipmentRuleMatchAny, ShipmentStatus: ShipmentStatusFailed, OrderStatus: OrderStatusOnHold},
+	{Match: ShipmentRuleMatchAny, ShipmentStatus: ShipmentStatusInTransit, OrderStatus: OrderStatusShipped},
+	{Match: ShipmentRuleMatchAny, ShipmentStatus: ShipmentStatusDelivered, OrderStatus: OrderStatusShipped}, // Entrega parcial
+}
+
+// OrderStatusesLockedByShipments son los estados de orden que los envíos no modifican. En los demás
+// la regla solo se aplica si OrderStatus.CanTransitionTo permite pasar al estado que resuelve.
+var OrderStatusesLockedByShipments = []OrderStatus{
+	OrderStatusCancelled,
+	OrderStatusRefunded,
+	OrderStatusCompleted,
+	OrderStatusDelivered,
+}
+
+// ParseShipmentStatusRules interpreta reglas con el formato "all:delivered=delivered,any:failed=on_hold".
+// Un valor vacío retorna las reglas por defecto.
+func ParseShipmentStatusRules(raw string) ([]ShipmentStatusRule, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return DefaultShipmentStatusRules, nil
+	}
+
+	var rules []ShipmentStatusRule
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		condition, orderStatus, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q missing '=<order_status>'", ErrInvalidShipmentStatusRule, part)
+		}
+		match, shipmentStatus, ok := strings.Cut(condition, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q missing '<all|any>:'", ErrInvalidShipmentStatusRule, part)
+		}
+
+		rule := ShipmentStatusRule{
+			Match:          strings.ToLower(strings.TrimSpace(match)),
+			ShipmentStatus: strings.ToLower(strings.TrimSpace(shipmentStatus)),
+			OrderStatus:    OrderStatus(strings.ToLower(strings.TrimSpace(orderStatus))),
+		}
+		if rule.Match != ShipmentRuleMatchAll && rule.Match != ShipmentRuleMatchAny {
+			return nil, fmt.Errorf("%w: unknown match %q", ErrInvalidShipmentStatusRule, rule.Match)
+		}
+		if rule.ShipmentStatus == "" {
+			return nil, fmt.Errorf("%w: %q missing shipment status", ErrInvalidShipmentStatusRule, part)
+		}
+		if !rule.OrderStatus.IsValid() {
+			return nil, fmt.Errorf("%w: unknown order status %q", ErrInvalidShipmentStatusRule, rule.OrderStatus)
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%w: no rules", ErrInvalidShipmentStatusRule)
+	}
+	return rules, nil
+}
+
+// Matches indica si los envíos cumplen la regla. Una orden sin envíos no cumple ninguna regla.
+func (r ShipmentStatusRule) Matches(shipments []Shipment) bool {
+	if len(shipments) == 0 {
+		return false
+	}
+	for _, shipment := range shipments {
+		matches := shipment.Status == r.ShipmentStatus
+		if r.Match == ShipmentRuleMatchAny && matches {
+			return true
+		}
+		if r.Match == ShipmentRuleMatchAll && !matches {
+			return false
+		}
+	}
+	return r.Match == ShipmentRuleMatchAll
+}
+
+// ResolveOrderStatusFromShipments retorna el estado de la primera regla que cumplen los envíos
+func ResolveOrderStatusFromShipments(rules []ShipmentStatusRule, shipments []Shipment) (OrderStatus, bool) {
+	for _, rule := range rules {
+		if rule.Matches(shipments) {
+			return rule.OrderStatus, true
+		}
+	}
+	return "", false
+}
+
+// ShipmentSyncResult describe los cambios aplicados a una orden a partir de sus envíos
+type ShipmentSyncResult struct {
+	OrderID        string     `json:"order_id"`
+	PreviousStatus string     `json:"previous_status"`
+	CurrentStatus  string     `json:"current_status"`
+	StatusChanged  bool       `json:"status_changed"`
+	TrackingNumber *string    `json:"tracking_number,omitempty"`
+	TrackingLink   *string    `json:"tracking_link,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
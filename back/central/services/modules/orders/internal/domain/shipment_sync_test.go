@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseShipmentStatusRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []ShipmentStatusRule
+		wantErr bool
+	}{
+		{name: "vacío usa las reglas por defecto", raw: "  ", want: DefaultShipmentStatusRules},
+		{
+			name: "varias reglas en orden",
+			raw:  "all:delivered=delivered,any:failed=on_hold",
+			want: []ShipmentStatusRule{
+				{Match: ShipmentRuleMatchAll, ShipmentStatus: "delivered", OrderStatus: OrderStatusDelivered},
+				{Match: ShipmentRuleMatchAny, ShipmentStatus: "failed", OrderStatus: OrderStatusOnHold},
+			},
+		},
+		{
+			name: "espacios, mayúsculas y partes vacías",
+			raw:  " ANY : In_Transit = Shipped ,, ",
+			want: []ShipmentStatusRule{
+				{Match: ShipmentRuleMatchAny, ShipmentStatus: "in_transit", OrderStatus: OrderStatusShipped},
+			},
+		},
+		{name: "sin estado de orden", raw: "all:delivered", wantErr: true},
+		{name: "sin tipo de coincidencia", raw: "delivered=delivered", wantErr: true},
+		{name: "coincidencia desconocida", raw: "some:delivered=delivered", wantErr: true},
+		{name: "sin estado de envío", raw: "all:=delivered", wantErr: true},
+		{name: "estado de orden desconocido", raw: "all:delivered=arrived", wantErr: true},
+		{name: "solo separadores", raw: ",,", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseShipmentStatusRules(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidShipmentStatusRule) {
+					t.Fatalf("err = %v, want ErrInvalidShipmentStatusRule", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rules = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
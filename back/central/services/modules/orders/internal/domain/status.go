@@ -10,6 +10,9 @@ const (
 	// OrderStatusProcessing - Orden en proceso de preparación
 	OrderStatusProcessing OrderStatus = "processing"
 
+	// OrderStatusReadyToShip - Orden empacada en el almacén, lista para despachar
+	OrderStatusReadyToShip OrderStatus = "ready_to_ship"
+
 	// OrderStatusCompleted - Orden completada exitosamente
 	OrderStatusCompleted OrderStatus = "completed"
 
@@ -35,7 +38,7 @@ const (
 // IsValid verifica si el estado es válido
 func (s OrderStatus) IsValid() bool {
 	switch s {
-	case OrderStatusPending, OrderStatusProcessing, OrderStatusCompleted,
+	case OrderStatusPending, OrderStatusProcessing, OrderStatusReadyToShip, OrderStatusCompleted,
 		OrderStatusCancelled, OrderStatusFailed, OrderStatusRefunded,
 		OrderStatusOnHold, OrderStatusShipped, OrderStatusDelivered:
 		return true
@@ -58,19 +61,27 @@ func (s OrderStatus) CanTransitionTo(target OrderStatus) bool {
 			OrderStatusOnHold,
 		},
 		OrderStatusProcessing: {
+			OrderStatusReadyToShip,
 			OrderStatusCompleted,
 			OrderStatusCancelled,
 			OrderStatusOnHold,
 			OrderStatusShipped,
 		},
+		OrderStatusReadyToShip: {
+			OrderStatusShipped,
+			OrderStatusCancelled,
+			OrderStatusOnHold,
+		},
 		OrderStatusOnHold: {
 			OrderStatusPending,
 			OrderStatusProcessing,
 			OrderStatusCancelled,
+			OrderStatusShipped, // El envío se reprogramó y volvió a ruta
 		},
 		OrderStatusShipped: {
 			OrderStatusDelivered,
 			OrderStatusFailed,
+			OrderStatusOnHold, // Intento de entrega fallido
 		},
 		OrderStatusDelivered: {
 			OrderStatusRefunded,
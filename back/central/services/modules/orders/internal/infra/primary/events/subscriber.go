@@ -0,0 +1,88 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseinventory"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseshipmentsync"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// handleTimeout límite para procesar un evento de envío
+const handleTimeout = 30 * time.Second
+
+// ShipmentSubscriber consume eventos de envíos desde Redis Pub/Sub para descontar el stock reservado
+// y llevar el estado de los envíos a su orden
+type ShipmentSubscriber struct {
+	redisClient  redisclient.IRedis
+	inventory    usecaseinventory.IInventoryUseCase
+	shipmentSync usecaseshipmentsync.IShipmentSyncUseCase
+	logger       log.ILogger
+	channel      string
+}
+
+// NewShipmentSubscriber crea el suscriptor de eventos de envíos de órdenes
+func NewShipmentSubscriber(redisClient redisclient.IRedis, inventory usecaseinventory.IInventoryUseCase, shipmentSync usecaseshipmentsync.IShipmentSyncUseCase, logger log.ILogger, channel string) *ShipmentSubscriber {
+	return &ShipmentSubscriber{
+		redisClient:  redisClient,
+		inventory:    inventory,
+		shipmentSync: shipmentSync,
+		logger:       logger,
+		channel:      channel,
+	}
+}
+
+// Start se suscribe al canal de envíos y procesa los eventos en background
+func (s *ShipmentSubscriber) Start(ctx context.Context) error {
+	client := s.redisClient.Client(ctx)
+	if client == nil {
+		return fmt.Errorf("redis client no disponible")
+	}
+
+	pubsub := client.Subscribe(ctx, s.channel)
+
+	s.logger.Info(ctx).
+		Str("channel", s.channel).
+		Msg("Suscriptor Redis iniciado para envíos de órdenes")
+
+	go func() {
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+
+		for {
+			select {
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				s.handleMessage(ctx, msg.Payload)
+			case <-ctx.Done():
+				s.logger.Info(ctx).Msg("Context cancelado, deteniendo suscriptor de envíos de órdenes")
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *ShipmentSubscriber) handleMessage(ctx context.Context, payload string) {
+	var event domain.ShipmentEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		s.logger.Error(ctx).
+			Err(err).
+			Str("channel", s.channel).
+			Msg("Error deserializando evento de envío")
+		return
+	}
+
+	handleCtx, cancel := context.WithTimeout(ctx, handleTimeout)
+	defer cancel()
+	s.inventory.HandleShipmentStatus(handleCtx, event.OrderID, event.Data.CurrentStatus)
+	s.shipmentSync.HandleShipmentEvent(handleCtx, &event)
+}
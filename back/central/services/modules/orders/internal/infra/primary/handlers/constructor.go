@@ -1,20 +1,29 @@
 package handlers
 
 import (
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseexport"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseinventory"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseorder"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseordermapping"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseorderpayment"
 )
 
 // Handlers contiene todos los handlers del módulo orders
 type Handlers struct {
 	orderCRUD    *usecaseorder.UseCaseOrder
 	orderMapping usecaseordermapping.IOrderMappingUseCase
+	inventory    usecaseinventory.IInventoryUseCase
+	export       *usecaseexport.UseCaseExport
+	payments     usecaseorderpayment.IOrderPaymentUseCase
 }
 
 // New crea una nueva instancia de Handlers
-func New(orderCRUD *usecaseorder.UseCaseOrder, orderMapping usecaseordermapping.IOrderMappingUseCase) *Handlers {
+func New(orderCRUD *usecaseorder.UseCaseOrder, orderMapping usecaseordermapping.IOrderMappingUseCase, inventory usecaseinventory.IInventoryUseCase, export *usecaseexport.UseCaseExport, payments usecaseorderpayment.IOrderPaymentUseCase) *Handlers {
 	return &Handlers{
 		orderCRUD:    orderCRUD,
 		orderMapping: orderMapping,
+		inventory:    inventory,
+		export:       export,
+		payments:     payments,
 	}
 }
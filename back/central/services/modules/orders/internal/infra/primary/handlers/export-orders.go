@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// ExportOrders godoc
+// @Summary      Exportar órdenes a CSV
+// @Description  Descarga en CSV las órdenes que cumplen los mismos filtros de GET /orders. Las columnas se eligen con 'columns'; con item_lines=true se genera una fila por item de la orden. Para más de 10.000 órdenes o para XLSX se debe usar POST /orders/export/jobs.
+// @Tags         Orders
+// @Produce      text/csv
+// @Param        columns           query     string  false  "Columnas separadas por coma (ej: order_number,status,total_amount,item_sku). Por defecto un set estándar"
+// @Param        item_lines        query     bool    false  "Una fila por item de la orden"
+// @Param        format            query     string  false  "Formato (solo csv en línea)"
+// @Param        business_id       query     int     false  "Filtrar por ID de negocio"
+// @Param        integration_id    query     int     false  "Filtrar por ID de integración"
+// @Param        integration_type  query     string  false  "Filtrar por tipo de integración"
+// @Param        status            query     string  false  "Filtrar por estado"
+// @Param        customer_email    query     string  false  "Filtrar por email del cliente"
+// @Param        customer_phone    query     string  false  "Filtrar por teléfono del cliente"
+// @Param        order_number      query     string  false  "Filtrar por número de orden"
+// @Param        internal_number   query     string  false  "Filtrar por número interno"
+// @Param        platform          query     string  false  "Filtrar por plataforma"
+// @Param        is_paid           query     bool    false  "Filtrar por estado de pago"
+// @Param        warehouse_id      query     int     false  "Filtrar por ID de almacén"
+// @Param        driver_id         query     int     false  "Filtrar por ID de conductor"
+// @Param        start_date        query     string  false  "Fecha de inicio (RFC3339)"
+// @Param        end_date          query     string  false  "Fecha de fin (RFC3339)"
+// @Param        sort_by           query     string  false  "Campo para ordenar (default: created_at)"
+// @Param        sort_order        query     string  false  "Orden (asc, desc) (default: desc)"
+// @Security     BearerAuth
+// @Success      200  {file}    file
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/export [get]
+func (h *Handlers) ExportOrders(c *gin.Context) {
+	if format := strings.ToLower(c.DefaultQuery("format", domain.ExportFormatCSV)); format != domain.ExportFormatCSV {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "La exportación en línea solo genera CSV. Para XLSX use POST /orders/export/jobs",
+			"error":   domain.ErrUnsupportedExportFormat.Error(),
+		})
+		return
+	}
+
+	req := domain.OrderExportRequest{
+		Format:  domain.ExportFormatCSV,
+		Filters: parseOrderListFilters(c),
+	}
+	if columns := c.Query("columns"); columns != "" {
+		req.Columns = strings.Split(columns, ",")
+	}
+	if raw := c.Query("item_lines"); raw != "" {
+		itemLines, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'item_lines' inválido",
+				"error":   err.Error(),
+			})
+			return
+		}
+		req.ItemLines = itemLines
+	}
+
+	started := false
+	err := h.export.ExportCSV(c.Request.Context(), &req, func() io.Writer {
+		started = true
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="orders.csv"`)
+		c.Status(http.StatusOK)
+		return c.Writer
+	})
+	if err == nil {
+		return
+	}
+
+	// Si ya se empezó a escribir el archivo no se puede cambiar la respuesta: el error queda en el contexto de gin
+	if started {
+		_ = c.Error(err)
+		return
+	}
+	respondExportError(c, err, "Error al exportar las órdenes")
+}
+
+// CreateExportJob godoc
+// @Summary      Encolar exportación de órdenes
+// @Description  Encola la exportación de las órdenes que cumplen los filtros (los mismos de GET /orders) a XLSX o CSV. Pensado para rangos grandes: el archivo se sube al almacenamiento y su link de descarga queda en el trabajo al completarse.
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        request  body      domain.OrderExportRequest  true  "Formato, columnas y filtros de la exportación"
+// @Security     BearerAuth
+// @Success      202  {object}  domain.OrderExportJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /orders/export/jobs [post]
+func (h *Handlers) CreateExportJob(c *gin.Context) {
+	var req domain.OrderExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	job, err := h.export.CreateExportJob(c.Request.Context(), &req)
+	if err != nil {
+		respondExportError(c, err, "Error al encolar la exportación de órdenes")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Exportación de órdenes encolada",
+		"data":    job,
+	})
+}
+
+// ListExportJobs godoc
+// @Summary      Listar exportaciones de órdenes
+// @Description  Lista los trabajos de exportación de órdenes, del más reciente al más antiguo
+// @Tags         Orders
+// @Produce      json
+// @Param        business_id  query  int  false  "Filtrar por ID de negocio"
+// @Param        page         query  int  false  "Número de página (default: 1)"
+// @Param        page_size    query  int  false  "Tamaño de página (default: 10, max: 100)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ExportJobsListResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/export/jobs [get]
+func (h *Handlers) ListExportJobs(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	response, err := h.export.ListExportJobs(c.Request.Context(), queryUint(c, "business_id"), page, pageSize)
+	if err != nil {
+		respondExportError(c, err, "Error al listar las exportaciones de órdenes")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetExportJob godoc
+// @Summary      Obtener exportación de órdenes
+// @Description  Obtiene el estado, avance y link de descarga de un trabajo de exportación de órdenes
+// @Tags         Orders
+// @Produce      json
+// @Param        job_id  path  int  true  "ID del trabajo"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.OrderExportJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/export/jobs/{job_id} [get]
+func (h *Handlers) GetExportJob(c *gin.Context) {
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 32)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'job_id' inválido",
+			"error":   "job_id debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	job, err := h.export.GetExportJob(c.Request.Context(), uint(jobID))
+	if err != nil {
+		respondExportError(c, err, "Error al obtener la exportación de órdenes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Exportación obtenida exitosamente",
+		"data":    job,
+	})
+}
+
+// respondExportError traduce los errores de dominio de la exportación a respuestas HTTP
+func respondExportError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrExportJobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrUnsupportedExportFormat),
+		errors.Is(err, domain.ErrInvalidExportColumns),
+		errors.Is(err, domain.ErrExportTooLarge):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrExportUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListInventoryMovements godoc
+// @Summary      Movimientos de inventario de una orden
+// @Description  Obtiene el libro de movimientos de inventario (reserva, descuento y liberación) de una orden
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "ID de la orden (UUID)"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.InventoryMovement
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/{id}/inventory-movements [get]
+func (h *Handlers) ListInventoryMovements(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de orden inválido",
+			"error":   "El ID de la orden es requerido",
+		})
+		return
+	}
+
+	movements, err := h.inventory.ListMovements(c.Request.Context(), id)
+	if err != nil {
+		if err.Error() == "order not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Orden no encontrada",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener movimientos de inventario",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Movimientos de inventario obtenidos exitosamente",
+		"data":    movements,
+	})
+}
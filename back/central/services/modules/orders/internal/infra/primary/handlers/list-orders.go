@@ -42,81 +42,7 @@ func (h *Handlers) ListOrders(c *gin.Context) {
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
 	// Construir filtros
-	filters := make(map[string]interface{})
-
-	if businessID := c.Query("business_id"); businessID != "" {
-		if id, err := strconv.ParseUint(businessID, 10, 32); err == nil {
-			filters["business_id"] = uint(id)
-		}
-	}
-
-	if integrationID := c.Query("integration_id"); integrationID != "" {
-		if id, err := strconv.ParseUint(integrationID, 10, 32); err == nil {
-			filters["integration_id"] = uint(id)
-		}
-	}
-
-	if integrationType := c.Query("integration_type"); integrationType != "" {
-		filters["integration_type"] = integrationType
-	}
-
-	if status := c.Query("status"); status != "" {
-		filters["status"] = status
-	}
-
-	if customerEmail := c.Query("customer_email"); customerEmail != "" {
-		filters["customer_email"] = customerEmail
-	}
-
-	if customerPhone := c.Query("customer_phone"); customerPhone != "" {
-		filters["customer_phone"] = customerPhone
-	}
-
-	if orderNumber := c.Query("order_number"); orderNumber != "" {
-		filters["order_number"] = orderNumber
-	}
-
-	if internalNumber := c.Query("internal_number"); internalNumber != "" {
-		filters["internal_number"] = internalNumber
-	}
-
-	if platform := c.Query("platform"); platform != "" {
-		filters["platform"] = platform
-	}
-
-	if isPaid := c.Query("is_paid"); isPaid != "" {
-		if paid, err := strconv.ParseBool(isPaid); err == nil {
-			filters["is_paid"] = paid
-		}
-	}
-
-	if warehouseID := c.Query("warehouse_id"); warehouseID != "" {
-		if id, err := strconv.ParseUint(warehouseID, 10, 32); err == nil {
-			filters["warehouse_id"] = uint(id)
-		}
-	}
-
-	if driverID := c.Query("driver_id"); driverID != "" {
-		if id, err := strconv.ParseUint(driverID, 10, 32); err == nil {
-			filters["driver_id"] = uint(id)
-		}
-	}
-
-	if startDate := c.Query("start_date"); startDate != "" {
-		filters["start_date"] = startDate
-	}
-
-	if endDate := c.Query("end_date"); endDate != "" {
-		filters["end_date"] = endDate
-	}
-
-	if sortBy := c.Query("sort_by"); sortBy != "" {
-		filters["sort_by"] = sortBy
-	}
-
-	if sortOrder := c.Query("sort_order"); sortOrder != "" {
-		filters["sort_order"] = sortOrder
-	}
+	filters := parseOrderListFilters(c).ToMap()
 
 	// Llamar al caso de uso
 	response, err := h.orderCRUD.ListOrders(c.Request.Context(), page, pageSize, filters)
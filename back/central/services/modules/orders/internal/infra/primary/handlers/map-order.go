@@ -19,6 +19,7 @@ import (
 // @Success      201  {object}  domain.OrderResponse
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      409  {object}  map[string]interface{}
+// @Failure      422  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Router       /orders/map [post]
 func (h *Handlers) MapAndSaveOrder(c *gin.Context) {
@@ -48,6 +49,15 @@ func (h *Handlers) MapAndSaveOrder(c *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"success": false,
+				"message": "Stock insuficiente para la orden",
+				"error":   err.Error(),
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"message": "Error al mapear y guardar orden",
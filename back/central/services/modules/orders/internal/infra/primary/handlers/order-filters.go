@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// parseOrderListFilters lee de la query los filtros de ListOrders. Lo comparten el listado
+// y la exportación para que ambos acepten exactamente los mismos filtros.
+func parseOrderListFilters(c *gin.Context) domain.OrderListFilters {
+	filters := domain.OrderListFilters{
+		IntegrationType: c.Query("integration_type"),
+		Status:          c.Query("status"),
+		CustomerEmail:   c.Query("customer_email"),
+		CustomerPhone:   c.Query("customer_phone"),
+		OrderNumber:     c.Query("order_number"),
+		InternalNumber:  c.Query("internal_number"),
+		Platform:        c.Query("platform"),
+		StartDate:       c.Query("start_date"),
+		EndDate:         c.Query("end_date"),
+		SortBy:          c.Query("sort_by"),
+		SortOrder:       c.Query("sort_order"),
+	}
+
+	filters.BusinessID = queryUint(c, "business_id")
+	filters.IntegrationID = queryUint(c, "integration_id")
+	filters.WarehouseID = queryUint(c, "warehouse_id")
+	filters.DriverID = queryUint(c, "driver_id")
+
+	if isPaid := c.Query("is_paid"); isPaid != "" {
+		if paid, err := strconv.ParseBool(isPaid); err == nil {
+			filters.IsPaid = &paid
+		}
+	}
+
+	return filters
+}
+
+// queryUint lee un ID opcional de la query (nil si falta o es inválido)
+func queryUint(c *gin.Context, key string) *uint {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil
+	}
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return nil
+	}
+	value := uint(id)
+	return &value
+}
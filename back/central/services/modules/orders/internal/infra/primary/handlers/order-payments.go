@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+)
+
+// GetOrderPayments godoc
+// @Summary      Pagos de una orden
+// @Description  Obtiene el libro de pagos de la orden con el monto pagado, pendiente, reembolsado y el saldo por pagar
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "ID de la orden (UUID)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.OrderPaymentLedger
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/{id}/payments [get]
+func (h *Handlers) GetOrderPayments(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de orden inválido",
+			"error":   "El ID de la orden es requerido",
+		})
+		return
+	}
+
+	ledger, err := h.payments.GetLedger(c.Request.Context(), id)
+	if err != nil {
+		respondPaymentError(c, err, "Error al obtener los pagos de la orden")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Pagos de la orden obtenidos exitosamente",
+		"data":    ledger,
+	})
+}
+
+// RecordOrderPayment godoc
+// @Summary      Registrar pago de una orden
+// @Description  Registra un pago adicional de la orden (pago mixto, abono o saldo). IsPaid y PaidAt se derivan de todos los pagos y se publica order.payment_received por cada pago completado
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                       true  "ID de la orden (UUID)"
+// @Param        payment  body      domain.RecordPaymentRequest  true  "Datos del pago"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.RecordPaymentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /orders/{id}/payments [post]
+func (h *Handlers) RecordOrderPayment(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de orden inválido",
+			"error":   "El ID de la orden es requerido",
+		})
+		return
+	}
+
+	var req domain.RecordPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.payments.RecordPayment(c.Request.Context(), id, &req)
+	if err != nil {
+		respondPaymentError(c, err, "Error al registrar el pago de la orden")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Pago registrado exitosamente",
+		"data":    result,
+	})
+}
+
+// respondPaymentError responde el error del libro de pagos con el status HTTP que le corresponde
+func respondPaymentError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"message": "Orden no encontrada",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrPaymentExceedsBalance):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": "El pago supera el saldo pendiente de la orden",
+			"error":   err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": message,
+			"error":   err.Error(),
+		})
+	}
+}
@@ -12,10 +12,19 @@ func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
 		orders.GET("", h.ListOrders)
 		orders.GET("/:id", h.GetOrderByID)
 		orders.GET("/:id/raw", h.GetOrderRaw)
+		orders.GET("/:id/inventory-movements", h.ListInventoryMovements)
+		orders.GET("/:id/payments", h.GetOrderPayments)
+		orders.POST("/:id/payments", h.RecordOrderPayment)
 		orders.POST("", h.CreateOrder)
 		orders.PUT("/:id", h.UpdateOrder)
 		orders.DELETE("/:id", h.DeleteOrder)
 
+		// Exportación (CSV en línea o trabajo asíncrono con link de descarga)
+		orders.GET("/export", h.ExportOrders)
+		orders.POST("/export/jobs", h.CreateExportJob)
+		orders.GET("/export/jobs", h.ListExportJobs)
+		orders.GET("/export/jobs/:job_id", h.GetExportJob)
+
 		// Mapeo de órdenes canónicas (para integraciones)
 		orders.POST("/map", h.MapAndSaveOrder)
 	}
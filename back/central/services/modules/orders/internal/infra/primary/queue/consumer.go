@@ -112,6 +112,16 @@ func (c *OrderConsumer) handleMessage(messageBody []byte) error {
 			return nil
 		}
 
+		// Rechazada por stock insuficiente (política reject): no se reintenta
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			c.logger.Warn().
+				Err(err).
+				Str("queue", OrdersCanonicalQueueName).
+				Str("external_id", orderDTO.ExternalID).
+				Msg("Order rejected due to insufficient stock, skipping")
+			return nil
+		}
+
 		c.logger.Error().
 			Err(err).
 			Str("queue", OrdersCanonicalQueueName).
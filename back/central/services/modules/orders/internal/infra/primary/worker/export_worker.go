@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/app/usecaseexport"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+const (
+	// tickInterval cada cuánto se revisan las exportaciones pendientes
+	tickInterval = 5 * time.Second
+	// batchSize máximo de exportaciones procesadas por ciclo
+	batchSize = 3
+)
+
+// ExportWorker genera en background los archivos de las exportaciones de órdenes
+type ExportWorker struct {
+	usecase *usecaseexport.UseCaseExport
+	logger  log.ILogger
+}
+
+// New crea el worker de exportaciones de órdenes
+func New(usecase *usecaseexport.UseCaseExport, logger log.ILogger) *ExportWorker {
+	return &ExportWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *ExportWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de exportación de órdenes iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				processed, err := w.usecase.ProcessPending(ctx, batchSize)
+				if err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al procesar exportaciones de órdenes")
+				}
+				if processed > 0 {
+					w.logger.Info(ctx).Int("processed", processed).Msg("Exportaciones de órdenes procesadas")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de exportación de órdenes")
+				return
+			}
+		}
+	}()
+}
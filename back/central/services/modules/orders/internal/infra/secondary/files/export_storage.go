@@ -0,0 +1,24 @@
+package files
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/storage"
+)
+
+// ExportStorage guarda en S3 los archivos de las exportaciones de órdenes
+type ExportStorage struct {
+	s3 storage.IS3Service
+}
+
+// New crea el almacenamiento de archivos de exportación
+func New(s3 storage.IS3Service) domain.IExportStorage {
+	return &ExportStorage{s3: s3}
+}
+
+// Upload sube el contenido a S3 bajo la llave dada y retorna su URL
+func (s *ExportStorage) Upload(ctx context.Context, key string, data []byte) (string, error) {
+	return s.s3.UploadFile(ctx, bytes.NewReader(data), key)
+}
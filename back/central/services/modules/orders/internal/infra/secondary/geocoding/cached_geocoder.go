@@ -0,0 +1,86 @@
+package geocoding
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+const (
+	cacheKeyPrefix = "probability:geocode:"
+	cacheMiss      = "miss" // Marca de dirección sin resultado, para no repetir la consulta al proveedor
+)
+
+// CachedGeocoder decora un geocoder con cache en Redis por dirección normalizada
+type CachedGeocoder struct {
+	inner   domain.IGeocoder
+	redis   redisclient.IRedis
+	logger  log.ILogger
+	ttl     time.Duration
+	missTTL time.Duration
+}
+
+// NewCachedGeocoder crea el decorador de cache. Las direcciones sin resultado se guardan
+// con missTTL para reintentarlas antes que las resueltas.
+func NewCachedGeocoder(inner domain.IGeocoder, redis redisclient.IRedis, logger log.ILogger, ttl, missTTL time.Duration) *CachedGeocoder {
+	return &CachedGeocoder{
+		inner:   inner,
+		redis:   redis,
+		logger:  logger,
+		ttl:     ttl,
+		missTTL: missTTL,
+	}
+}
+
+// Geocode retorna el resultado cacheado o consulta el geocoder interno y guarda la respuesta
+func (c *CachedGeocoder) Geocode(ctx context.Context, query domain.GeocodeQuery) (*domain.GeocodeResult, error) {
+	if query.Key == "" {
+		return c.inner.Geocode(ctx, query)
+	}
+	key := cacheKey(query.Key)
+
+	// Redis retorna error tanto si la clave no existe como si falla: en ambos casos se consulta el geocoder
+	if cached, err := c.redis.Get(ctx, key); err == nil {
+		if cached == cacheMiss {
+			return nil, nil
+		}
+		var result domain.GeocodeResult
+		if err := json.Unmarshal([]byte(cached), &result); err == nil {
+			return &result, nil
+		}
+	}
+
+	result, err := c.inner.Geocode(ctx, query)
+	if err != nil {
+		// Los errores del proveedor no se cachean
+		return nil, err
+	}
+
+	value, ttl := cacheMiss, c.missTTL
+	if result != nil {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			return result, nil
+		}
+		value, ttl = string(raw), c.ttl
+	}
+	if err := c.redis.Set(ctx, key, value, ttl); err != nil {
+		c.logger.Warn(ctx).
+			Err(err).
+			Str("address_key", query.Key).
+			Msg("Error al guardar geocodificación en cache")
+	}
+	return result, nil
+}
+
+// cacheKey construye la clave de Redis a partir de la clave de la dirección normalizada
+func cacheKey(addressKey string) string {
+	sum := sha1.Sum([]byte(addressKey))
+	return cacheKeyPrefix + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,63 @@
+package geocoding
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/address"
+	"github.com/secamc93/probability/back/central/shared/env"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// Proveedores de geocodificación soportados
+const (
+	ProviderNone = "none"
+	ProviderFile = "file"
+)
+
+const (
+	defaultCacheTTLHours = 720 // 30 días
+	defaultMissTTL       = 24 * time.Hour
+)
+
+// New crea el geocoder configurado en GEOCODER_PROVIDER, con cache en Redis si está disponible.
+// Retorna nil si no hay proveedor configurado o no se pudo inicializar: en ese caso las órdenes
+// sin coordenadas usan el centroide de la ciudad.
+func New(config env.IConfig, logger log.ILogger, redis redisclient.IRedis) domain.IGeocoder {
+	ctx := context.Background()
+
+	var geocoder domain.IGeocoder
+	switch provider := config.Get("GEOCODER_PROVIDER"); provider {
+	case "", ProviderNone:
+		return nil
+	case ProviderFile:
+		path := config.Get("GEOCODER_FILE_PATH")
+		fileGeocoder, err := NewFileGeocoder(path, address.DefaultCountry)
+		if err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Str("path", path).
+				Msg("Error al cargar el archivo de geocodificación, geocoder desactivado")
+			return nil
+		}
+		geocoder = fileGeocoder
+	default:
+		logger.Warn(ctx).
+			Str("provider", provider).
+			Msg("Proveedor de geocodificación no soportado, geocoder desactivado")
+		return nil
+	}
+
+	if redis == nil {
+		return geocoder
+	}
+
+	ttlHours := defaultCacheTTLHours
+	if value, err := strconv.Atoi(config.Get("GEOCODER_CACHE_TTL_HOURS")); err == nil && value > 0 {
+		ttlHours = value
+	}
+	return NewCachedGeocoder(geocoder, redis, logger, time.Duration(ttlHours)*time.Hour, defaultMissTTL)
+}
@@ -0,0 +1,81 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/address"
+)
+
+// SourceFile identifica las coordenadas obtenidas del archivo de geocodificación offline
+const SourceFile = "file"
+
+// fileEntry es un registro del archivo de geocodificación offline
+type fileEntry struct {
+	Street     string  `json:"street"`
+	City       string  `json:"city"`
+	State      string  `json:"state"`
+	Country    string  `json:"country"`
+	PostalCode string  `json:"postal_code"`
+	Lat        float64 `json:"lat"`
+	Lng        float64 `json:"lng"`
+	Precision  string  `json:"precision"`
+}
+
+// FileGeocoder es un geocoder offline respaldado por un archivo JSON con direcciones conocidas.
+// Las direcciones del archivo se normalizan igual que las de las órdenes, de modo que
+// "Cra. 7 # 72-41, BOGOTÁ D.C." y "carrera 7 no 72 41, bogota" resuelven al mismo registro.
+// Se usa en pruebas y en ambientes sin acceso a un proveedor externo.
+type FileGeocoder struct {
+	entries map[string]domain.GeocodeResult
+}
+
+// NewFileGeocoder carga el archivo de geocodificación. El archivo es un arreglo JSON de
+// objetos con street, city, state, country, postal_code, lat, lng y precision.
+func NewFileGeocoder(path string, defaultCountry string) (*FileGeocoder, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading geocoding file: %w", err)
+	}
+
+	var records []fileEntry
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, fmt.Errorf("error parsing geocoding file: %w", err)
+	}
+
+	entries := make(map[string]domain.GeocodeResult, len(records))
+	for _, record := range records {
+		normalized := address.Normalize(address.Address{
+			Street:     record.Street,
+			City:       record.City,
+			State:      record.State,
+			Country:    record.Country,
+			PostalCode: record.PostalCode,
+		}, defaultCountry)
+
+		precision := record.Precision
+		if precision == "" {
+			precision = domain.GeocodePrecisionAddress
+		}
+		entries[normalized.Key] = domain.GeocodeResult{
+			Lat:       record.Lat,
+			Lng:       record.Lng,
+			Precision: precision,
+			Source:    SourceFile,
+		}
+	}
+
+	return &FileGeocoder{entries: entries}, nil
+}
+
+// Geocode busca la dirección normalizada en el archivo
+func (g *FileGeocoder) Geocode(ctx context.Context, query domain.GeocodeQuery) (*domain.GeocodeResult, error) {
+	result, ok := g.entries[query.Key]
+	if !ok {
+		return nil, nil
+	}
+	return &result, nil
+}
@@ -0,0 +1,82 @@
+package geocoding
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/address"
+)
+
+func TestFileGeocoderGeocode(t *testing.T) {
+	geocoder, err := NewFileGeocoder(filepath.Join("testdata", "geocodes.json"), address.DefaultCountry)
+	if err != nil {
+		t.Fatalf("NewFileGeocoder: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		addr      address.Address
+		found     bool
+		lat, lng  float64
+		precision string
+	}{
+		{
+			name:      "misma dirección con otro formato",
+			addr:      address.Address{Street: "carrera 7 no 72 41", City: "bogota"},
+			found:     true,
+			lat:       4.6573,
+			lng:       -74.0551,
+			precision: domain.GeocodePrecisionAddress,
+		},
+		{
+			name:      "detalle de la unidad ignorado",
+			addr:      address.Address{Street: "Cl 10 # 43E-31 apto 502", City: "Medellín, Antioquia"},
+			found:     true,
+			lat:       6.2087,
+			lng:       -75.5676,
+			precision: domain.GeocodePrecisionAddress,
+		},
+		{
+			name:      "precisión de vía",
+			addr:      address.Address{Street: "CALLE 72", City: "Barranquilla"},
+			found:     true,
+			lat:       11.0053,
+			lng:       -74.8069,
+			precision: domain.GeocodePrecisionStreet,
+		},
+		{
+			name: "dirección desconocida",
+			addr: address.Address{Street: "Calle 1 # 1-1", City: "Cali"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := address.Normalize(tt.addr, address.DefaultCountry).Key
+			got, err := geocoder.Geocode(context.Background(), domain.GeocodeQuery{Key: key})
+			if err != nil {
+				t.Fatalf("Geocode: %v", err)
+			}
+			if !tt.found {
+				if got != nil {
+					t.Errorf("Geocode(%q) = %+v, want nil", key, got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("Geocode(%q) = nil, want resultado", key)
+			}
+			if got.Lat != tt.lat || got.Lng != tt.lng || got.Precision != tt.precision || got.Source != SourceFile {
+				t.Errorf("Geocode(%q) = %+v, want %v,%v %s", key, got, tt.lat, tt.lng, tt.precision)
+			}
+		})
+	}
+}
+
+func TestNewFileGeocoderMissingFile(t *testing.T) {
+	if _, err := NewFileGeocoder(filepath.Join("testdata", "missing.json"), address.DefaultCountry); err == nil {
+		t.Error("NewFileGeocoder con un archivo inexistente debería fallar")
+	}
+}
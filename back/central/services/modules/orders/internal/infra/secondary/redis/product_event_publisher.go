@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// ProductEventPublisher publica eventos de productos (ej: stock bajo) a Redis Pub/Sub
+type ProductEventPublisher struct {
+	redisClient redisclient.IRedis
+	logger      log.ILogger
+	channel     string
+}
+
+// NewProductEventPublisher crea un nuevo publicador de eventos de productos
+func NewProductEventPublisher(redisClient redisclient.IRedis, logger log.ILogger, channel string) domain.IProductEventPublisher {
+	return &ProductEventPublisher{
+		redisClient: redisClient,
+		logger:      logger,
+		channel:     channel,
+	}
+}
+
+// PublishProductEvent publica un evento de producto a Redis
+func (p *ProductEventPublisher) PublishProductEvent(ctx context.Context, event *domain.ProductEvent) error {
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("event_id", event.ID).
+			Str("event_type", event.Type).
+			Msg("Error al serializar evento de producto")
+		return err
+	}
+
+	if err := p.redisClient.Client(ctx).Publish(ctx, p.channel, eventJSON).Err(); err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("event_id", event.ID).
+			Str("event_type", event.Type).
+			Str("channel", p.channel).
+			Msg("Error al publicar evento de producto a Redis")
+		return err
+	}
+
+	p.logger.Debug(ctx).
+		Str("event_id", event.ID).
+		Str("event_type", event.Type).
+		Str("product_id", event.ProductID).
+		Str("channel", p.channel).
+		Msg("Evento de producto publicado a Redis")
+
+	return nil
+}
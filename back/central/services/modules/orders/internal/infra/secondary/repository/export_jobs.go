@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// CreateExportJob crea un trabajo de exportación de órdenes
+func (r *Repository) CreateExportJob(ctx context.Context, job *domain.OrderExportJob) error {
+	dbJob := mappers.ToDBExportJob(job)
+	if err := r.db.Conn(ctx).Create(dbJob).Error; err != nil {
+		return err
+	}
+	job.ID = dbJob.ID
+	job.CreatedAt = dbJob.CreatedAt
+	job.UpdatedAt = dbJob.UpdatedAt
+	return nil
+}
+
+// GetExportJob obtiene un trabajo de exportación por su ID
+func (r *Repository) GetExportJob(ctx context.Context, id uint) (*domain.OrderExportJob, error) {
+	var job models.OrderExportJob
+	if err := r.db.Conn(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrExportJobNotFound
+		}
+		return nil, err
+	}
+	return mappers.ToDomainExportJob(&job), nil
+}
+
+// ListExportJobs lista los trabajos de exportación, del más reciente al más antiguo
+func (r *Repository) ListExportJobs(ctx context.Context, businessID *uint, page, pageSize int) ([]domain.OrderExportJob, int64, error) {
+	var jobs []models.OrderExportJob
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.OrderExportJob{})
+	if businessID != nil {
+		query = query.Where("business_id = ?", *businessID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mappers.ToDomainExportJobs(jobs), total, nil
+}
+
+// UpdateExportJob guarda el estado completo de un trabajo de exportación
+func (r *Repository) UpdateExportJob(ctx context.Context, job *domain.OrderExportJob) error {
+	return r.db.Conn(ctx).Save(mappers.ToDBExportJob(job)).Error
+}
+
+// UpdateExportJobProgress actualiza el avance de un trabajo en proceso
+func (r *Repository) UpdateExportJobProgress(ctx context.Context, id uint, processedOrders, rowCount int) error {
+	return r.db.Conn(ctx).
+		Model(&models.OrderExportJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"processed_orders": processedOrders,
+			"row_count":        rowCount,
+			"updated_at":       time.Now(),
+		}).Error
+}
+
+// ListPendingExportJobIDs obtiene los IDs de los trabajos pendientes, del más antiguo al más reciente
+func (r *Repository) ListPendingExportJobIDs(ctx context.Context, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Conn(ctx).
+		Model(&models.OrderExportJob{}).
+		Where("status = ?", domain.ExportJobStatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ClaimExportJob marca un trabajo pendiente como en proceso. Retorna false si otra instancia ya lo tomó.
+func (r *Repository) ClaimExportJob(ctx context.Context, id uint) (bool, error) {
+	now := time.Now()
+	result := r.db.Conn(ctx).
+		Model(&models.OrderExportJob{}).
+		Where("id = ? AND status = ?", id, domain.ExportJobStatusPending).
+		Updates(map[string]interface{}{
+			"status":     domain.ExportJobStatusProcessing,
+			"started_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseStaleExportJobs devuelve a pendiente los trabajos en proceso sin avance reciente
+// (ej: la instancia que los procesaba se reinició)
+func (r *Repository) ReleaseStaleExportJobs(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	result := r.db.Conn(ctx).
+		Model(&models.OrderExportJob{}).
+		Where("status = ? AND updated_at < ?", domain.ExportJobStatusProcessing, time.Now().Add(-staleAfter)).
+		Updates(map[string]interface{}{
+			"status":           domain.ExportJobStatusPending,
+			"processed_orders": 0,
+			"row_count":        0,
+			"updated_at":       time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,470 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errOrderNotFound indica que la orden a la que pertenece el movimiento no existe
+var errOrderNotFound = errors.New("order not found")
+
+// CreateOrderWithStock crea la orden y reserva su stock en la misma transacción. Con
+// rejectShortages la transacción se revierte y retorna ErrInsufficientStock si algún producto sin
+// backorder no tiene stock suficiente, de modo que no queda ni la orden ni una reserva parcial.
+func (r *Repository) CreateOrderWithStock(ctx context.Context, order *domain.Order, lines []domain.StockLine, rejectShortages bool) (*domain.InventoryResult, error) {
+	result := &domain.InventoryResult{}
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		dbOrder := mappers.ToDBOrder(order)
+		if err := tx.Create(dbOrder).Error; err != nil {
+			return err
+		}
+		if order.BusinessID == nil {
+			order.ID = dbOrder.ID
+			return nil
+		}
+
+		reserved, err := reserveOrderStock(tx, *order.BusinessID, dbOrder.ID, lines)
+		if err != nil {
+			return err
+		}
+		if rejectShortages && len(reserved.Shortages) > 0 {
+			return fmt.Errorf("%w: %s", domain.ErrInsufficientStock, reserved.Shortages[0].SKU)
+		}
+		order.ID = dbOrder.ID
+		result = reserved
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// reserveOrderStock reserva el stock de la orden dentro de la transacción tx. La orden, los productos
+// y las variantes se bloquean (en ese orden y cada tabla por ID) para que reservas concurrentes no
+// sobrevendan el mismo stock. Los productos sin stock suficiente que no permiten backorder
+// no se reservan y se reportan como faltantes. La operación es idempotente por orden.
+func reserveOrderStock(tx *gorm.DB, businessID uint, orderID string, lines []domain.StockLine) (*domain.InventoryResult, error) {
+	quantities, keys := aggregateStockLines(lines)
+	if len(keys) == 0 {
+		return &domain.InventoryResult{}, nil
+	}
+
+	result := &domain.InventoryResult{}
+	order, err := lockOrder(tx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Idempotencia: si la orden ya tiene reservas no se vuelve a reservar
+	var reserved int64
+	if err := tx.Model(&models.InventoryMovement{}).
+		Where("order_id = ? AND type = ?", orderID, domain.InventoryMovementReserve).
+		Count(&reserved).Error; err != nil {
+		return nil, err
+	}
+	if reserved > 0 {
+		result.Status = order.InventoryStatus
+		return result, nil
+	}
+
+	holders, err := lockStockHolders(tx, businessID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	backordered := false
+	tracked := false
+	for _, holder := range holders {
+		if !holder.product.TrackInventory {
+			continue
+		}
+		tracked = true
+		quantity := quantities[holder.key]
+		availableBefore := holder.available()
+
+		if quantity > availableBefore {
+			if !holder.product.AllowBackorder {
+				result.Shortages = append(result.Shortages, domain.StockShortage{
+					ProductID: holder.product.ID,
+					VariantID: holder.variantID(),
+					SKU:       holder.sku(),
+					Requested: quantity,
+					Available: max(availableBefore, 0),
+				})
+				continue
+			}
+			backordered = true
+		}
+
+		movement := newMovement(holder, orderID, domain.InventoryMovementReserve, quantity, domain.InventoryReasonOrderCreated)
+		movement.ReservedAfter = holder.reserved() + quantity
+		if err := applyMovement(tx, holder, movement); err != nil {
+			return nil, err
+		}
+
+		if low, crossed := crossedLowStock(holder, availableBefore, availableBefore-quantity); crossed {
+			result.LowStock = append(result.LowStock, low)
+		}
+	}
+
+	switch {
+	case len(result.Shortages) > 0:
+		result.Status = domain.InventoryStatusInsufficient
+	case backordered:
+		result.Status = domain.InventoryStatusBackordered
+	case tracked:
+		result.Status = domain.InventoryStatusReserved
+	default:
+		return result, nil // Ningún producto controla inventario
+	}
+
+	if err := tx.Model(&models.Order{}).
+		Where("id = ?", orderID).
+		Update("inventory_status", result.Status).Error; err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CommitStock descuenta definitivamente del stock lo que la orden tiene reservado.
+// Retorna false si la orden no tenía reservas pendientes.
+func (r *Repository) CommitStock(ctx context.Context, orderID, reason string) (bool, error) {
+	return r.settleReservation(ctx, orderID, domain.InventoryMovementCommit, reason, domain.InventoryStatusCommitted)
+}
+
+// ReleaseStock libera lo que la orden tiene reservado sin tocar el stock.
+// Retorna false si la orden no tenía reservas pendientes.
+func (r *Repository) ReleaseStock(ctx context.Context, orderID, reason string) (bool, error) {
+	return r.settleReservation(ctx, orderID, domain.InventoryMovementRelease, reason, domain.InventoryStatusReleased)
+}
+
+// settleReservation cierra la reserva pendiente de la orden (commit o release) en una transacción.
+// La reserva pendiente por producto se calcula desde el libro de movimientos con la orden bloqueada,
+// de modo que dos eventos concurrentes no descuenten dos veces.
+func (r *Repository) settleReservation(ctx context.Context, orderID, movementType, reason, status string) (bool, error) {
+	settled := false
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		order, err := lockOrder(tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		var pending []struct {
+			ProductID string
+			VariantID *string
+			Quantity  int
+		}
+		if err := tx.Model(&models.InventoryMovement{}).
+			Select("product_id, variant_id, SUM(CASE WHEN type = ? THEN quantity ELSE -quantity END) AS quantity", domain.InventoryMovementReserve).
+			Where("order_id = ?", orderID).
+			Group("product_id, variant_id").
+			Having("SUM(CASE WHEN type = ? THEN quantity ELSE -quantity END) > 0", domain.InventoryMovementReserve).
+			Scan(&pending).Error; err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		lines := make([]domain.StockLine, 0, len(pending))
+		for _, p := range pending {
+			line := domain.StockLine{ProductID: p.ProductID, Quantity: p.Quantity}
+			if p.VariantID != nil {
+				line.VariantID = *p.VariantID
+			}
+			lines = append(lines, line)
+		}
+		quantities, keys := aggregateStockLines(lines)
+
+		var businessID uint
+		if order.BusinessID != nil {
+			businessID = *order.BusinessID
+		}
+		holders, err := lockStockHolders(tx, businessID, keys)
+		if err != nil {
+			return err
+		}
+
+		for _, holder := range holders {
+			quantity := quantities[holder.key]
+			movement := newMovement(holder, orderID, movementType, quantity, reason)
+			movement.ReservedAfter = max(holder.reserved()-quantity, 0)
+			if movementType == domain.InventoryMovementCommit {
+				movement.StockAfter = holder.stock() - quantity
+			}
+			if err := applyMovement(tx, holder, movement); err != nil {
+				return err
+			}
+		}
+
+		settled = true
+		return tx.Model(&models.Order{}).
+			Where("id = ?", orderID).
+			Update("inventory_status", status).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return settled, nil
+}
+
+// ListInventoryMovementsByOrder obtiene los movimientos de inventario de una orden
+func (r *Repository) ListInventoryMovementsByOrder(ctx context.Context, orderID string) ([]domain.InventoryMovement, error) {
+	var movements []models.InventoryMovement
+	if err := r.db.Conn(ctx).
+		Where("order_id = ?", orderID).
+		Order("id ASC").
+		Find(&movements).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.InventoryMovement, len(movements))
+	for i := range movements {
+		result[i] = *mappers.ToDomainInventoryMovement(&movements[i])
+	}
+	return result, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// stockKey identifica dónde se controla el stock de una línea: el producto o una de sus variantes
+type stockKey struct {
+	ProductID string
+	VariantID string // Vacío si el stock se controla en el producto
+}
+
+// stockHolder agrupa el producto (que define si se controla inventario, el backorder y el umbral
+// de stock bajo) y, si aplica, la variante que guarda el stock
+type stockHolder struct {
+	key     stockKey
+	product *models.Product
+	variant *models.ProductVariant
+}
+
+func (h *stockHolder) stock() int {
+	if h.variant != nil {
+		return h.variant.StockQuantity
+	}
+	return h.product.StockQuantity
+}
+
+func (h *stockHolder) reserved() int {
+	if h.variant != nil {
+		return h.variant.ReservedQuantity
+	}
+	return h.product.ReservedQuantity
+}
+
+func (h *stockHolder) available() int {
+	return h.stock() - h.reserved()
+}
+
+func (h *stockHolder) variantID() string {
+	if h.variant != nil {
+		return h.variant.ID
+	}
+	return ""
+}
+
+func (h *stockHolder) sku() string {
+	if h.variant != nil {
+		return h.variant.SKU
+	}
+	return h.product.SKU
+}
+
+// aggregateStockLines suma las cantidades por producto/variante y retorna las llaves ordenadas
+func aggregateStockLines(lines []domain.StockLine) (map[stockKey]int, []stockKey) {
+	quantities := make(map[stockKey]int, len(lines))
+	for _, line := range lines {
+		if line.ProductID == "" || line.Quantity <= 0 {
+			continue
+		}
+		quantities[stockKey{ProductID: line.ProductID, VariantID: line.VariantID}] += line.Quantity
+	}
+	keys := make([]stockKey, 0, len(quantities))
+	for key := range quantities {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ProductID != keys[j].ProductID {
+			return keys[i].ProductID < keys[j].ProductID
+		}
+		return keys[i].VariantID < keys[j].VariantID
+	})
+	return quantities, keys
+}
+
+// stockKeyIDs retorna los IDs distintos de productos y variantes de las llaves (ordenados)
+func stockKeyIDs(keys []stockKey) ([]string, []string) {
+	var productIDs, variantIDs []string
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key.ProductID] {
+			seen[key.ProductID] = true
+			productIDs = append(productIDs, key.ProductID)
+		}
+		if key.VariantID != "" {
+			variantIDs = append(variantIDs, key.VariantID)
+		}
+	}
+	sort.Strings(variantIDs)
+	return productIDs, variantIDs
+}
+
+// newStockHolders arma los holders en el orden de las llaves. Las llaves cuyo producto no existe
+// se omiten; si la variante no existe el stock se controla en el producto.
+func newStockHolders(keys []stockKey, products []models.Product, variants []models.ProductVariant) []*stockHolder {
+	productsByID := make(map[string]*models.Product, len(products))
+	for i := range products {
+		productsByID[products[i].ID] = &products[i]
+	}
+	variantsByID := make(map[string]*models.ProductVariant, len(variants))
+	for i := range variants {
+		variantsByID[variants[i].ID] = &variants[i]
+	}
+
+	holders := make([]*stockHolder, 0, len(keys))
+	for _, key := range keys {
+		product, ok := productsByID[key.ProductID]
+		if !ok {
+			continue
+		}
+		holder := &stockHolder{key: key, product: product}
+		if variant, ok := variantsByID[key.VariantID]; ok && variant.ProductID == product.ID {
+			holder.variant = variant
+		}
+		holders = append(holders, holder)
+	}
+	return holders
+}
+
+// lockStockHolders bloquea los productos y luego las variantes, cada tabla ordenada por ID
+// para evitar interbloqueos entre órdenes
+func lockStockHolders(tx *gorm.DB, businessID uint, keys []stockKey) ([]*stockHolder, error) {
+	productIDs, variantIDs := stockKeyIDs(keys)
+	products, err := lockProducts(tx, businessID, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	var variants []models.ProductVariant
+	if len(variantIDs) > 0 {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND business_id = ?", variantIDs, businessID).
+			Order("id ASC").
+			Find(&variants).Error; err != nil {
+			return nil, err
+		}
+	}
+	return newStockHolders(keys, products, variants), nil
+}
+
+// lockOrder bloquea la fila de la orden durante la transacción
+func lockOrder(tx *gorm.DB, orderID string) (*models.Order, error) {
+	var order models.Order
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "business_id", "inventory_status").
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// lockProducts bloquea los productos ordenados por ID para evitar interbloqueos entre órdenes
+func lockProducts(tx *gorm.DB, businessID uint, ids []string) ([]models.Product, error) {
+	var products []models.Product
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND business_id = ?", ids, businessID).
+		Order("id ASC").
+		Find(&products).Error
+	return products, err
+}
+
+// newMovement crea un movimiento con el estado actual del producto o variante como punto de partida
+func newMovement(holder *stockHolder, orderID, movementType string, quantity int, reason string) *models.InventoryMovement {
+	movement := &models.InventoryMovement{
+		BusinessID:     holder.product.BusinessID,
+		ProductID:      holder.product.ID,
+		OrderID:        &orderID,
+		Type:           movementType,
+		Quantity:       quantity,
+		Reason:         reason,
+		StockBefore:    holder.stock(),
+		StockAfter:     holder.stock(),
+		ReservedBefore: holder.reserved(),
+		ReservedAfter:  holder.reserved(),
+	}
+	if holder.variant != nil {
+		variantID := holder.variant.ID
+		movement.VariantID = &variantID
+	}
+	return movement
+}
+
+// applyMovement actualiza stock y reservas del producto (o de la variante) y registra el movimiento en el libro
+func applyMovement(tx *gorm.DB, holder *stockHolder, movement *models.InventoryMovement) error {
+	updates := map[string]interface{}{
+		"stock_quantity":    movement.StockAfter,
+		"reserved_quantity": movement.ReservedAfter,
+	}
+	if holder.variant != nil {
+		if err := tx.Model(&models.ProductVariant{}).
+			Where("id = ?", holder.variant.ID).
+			Updates(updates).Error; err != nil {
+			return err
+		}
+		holder.variant.StockQuantity = movement.StockAfter
+		holder.variant.ReservedQuantity = movement.ReservedAfter
+	} else {
+		if err := tx.Model(&models.Product{}).
+			Where("id = ?", holder.product.ID).
+			Updates(updates).Error; err != nil {
+			return err
+		}
+		holder.product.StockQuantity = movement.StockAfter
+		holder.product.ReservedQuantity = movement.ReservedAfter
+	}
+	return tx.Create(movement).Error
+}
+
+// crossedLowStock indica si el stock disponible cruzó el umbral de stock bajo del producto
+func crossedLowStock(holder *stockHolder, availableBefore, availableAfter int) (domain.LowStockProduct, bool) {
+	if holder.product.LowStockThreshold == nil {
+		return domain.LowStockProduct{}, false
+	}
+	threshold := *holder.product.LowStockThreshold
+	if availableBefore <= threshold || availableAfter > threshold {
+		return domain.LowStockProduct{}, false
+	}
+	name := holder.product.Name
+	if holder.variant != nil && holder.variant.Title != "" {
+		name = name + " - " + holder.variant.Title
+	}
+	return domain.LowStockProduct{
+		ProductID:  holder.product.ID,
+		VariantID:  holder.variantID(),
+		BusinessID: holder.product.BusinessID,
+		SKU:        holder.sku(),
+		Name:       name,
+		Available:  availableAfter,
+		Threshold:  threshold,
+	}, true
+}
@@ -58,6 +58,7 @@ func ToDBClient(c *domain.Client) *models.Client {
 		Name:       c.Name,
 		Email:      c.Email,
 		Phone:      c.Phone,
+		PhoneE164:  c.PhoneE164,
 		Dni:        c.Dni,
 	}
 }
@@ -80,6 +81,22 @@ func ToDomainClient(c *models.Client) *domain.Client {
 		Name:       c.Name,
 		Email:      c.Email,
 		Phone:      c.Phone,
+		PhoneE164:  c.PhoneE164,
 		Dni:        c.Dni,
 	}
 }
+
+// ToDomainProductVariant convierte una variante de base de datos a dominio
+func ToDomainProductVariant(v *models.ProductVariant) *domain.ProductVariant {
+	if v == nil {
+		return nil
+	}
+	return &domain.ProductVariant{
+		ID:         v.ID,
+		ProductID:  v.ProductID,
+		BusinessID: v.BusinessID,
+		SKU:        v.SKU,
+		Title:      v.Title,
+		ExternalID: v.ExternalID,
+	}
+}
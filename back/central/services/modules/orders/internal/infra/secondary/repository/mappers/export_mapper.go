@@ -0,0 +1,87 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ToDBExportJob convierte un trabajo de exportación de dominio a modelo de base de datos
+func ToDBExportJob(j *domain.OrderExportJob) *models.OrderExportJob {
+	if j == nil {
+		return nil
+	}
+	columns, _ := json.Marshal(j.Columns)
+	filters, _ := json.Marshal(j.Filters)
+	return &models.OrderExportJob{
+		Model: gorm.Model{
+			ID:        j.ID,
+			CreatedAt: j.CreatedAt,
+			UpdatedAt: j.UpdatedAt,
+		},
+		BusinessID:      j.BusinessID,
+		Format:          j.Format,
+		Status:          j.Status,
+		Columns:         datatypes.JSON(columns),
+		ItemLines:       j.ItemLines,
+		Filters:         datatypes.JSON(filters),
+		ResultFileKey:   j.ResultFileKey,
+		ResultFileURL:   j.ResultFileURL,
+		TotalOrders:     j.TotalOrders,
+		ProcessedOrders: j.ProcessedOrders,
+		RowCount:        j.RowCount,
+		ErrorMessage:    j.ErrorMessage,
+		StartedAt:       j.StartedAt,
+		FinishedAt:      j.FinishedAt,
+		CreatedBy:       j.CreatedBy,
+	}
+}
+
+// ToDomainExportJob convierte un trabajo de exportación de base de datos a dominio
+func ToDomainExportJob(j *models.OrderExportJob) *domain.OrderExportJob {
+	if j == nil {
+		return nil
+	}
+	result := &domain.OrderExportJob{
+		ID:              j.ID,
+		CreatedAt:       j.CreatedAt,
+		UpdatedAt:       j.UpdatedAt,
+		BusinessID:      j.BusinessID,
+		Format:          j.Format,
+		Status:          j.Status,
+		ItemLines:       j.ItemLines,
+		ResultFileKey:   j.ResultFileKey,
+		ResultFileURL:   j.ResultFileURL,
+		TotalOrders:     j.TotalOrders,
+		ProcessedOrders: j.ProcessedOrders,
+		RowCount:        j.RowCount,
+		ErrorMessage:    j.ErrorMessage,
+		StartedAt:       j.StartedAt,
+		FinishedAt:      j.FinishedAt,
+		CreatedBy:       j.CreatedBy,
+	}
+	if len(j.Columns) > 0 {
+		_ = json.Unmarshal(j.Columns, &result.Columns)
+	}
+	if len(j.Filters) > 0 {
+		_ = json.Unmarshal(j.Filters, &result.Filters)
+	}
+	if result.TotalOrders > 0 {
+		result.Progress = float64(result.ProcessedOrders) * 100 / float64(result.TotalOrders)
+	} else if result.Status == domain.ExportJobStatusCompleted {
+		result.Progress = 100
+	}
+	return result
+}
+
+// ToDomainExportJobs convierte una lista de trabajos de exportación de base de datos a dominio
+func ToDomainExportJobs(jobs []models.OrderExportJob) []domain.OrderExportJob {
+	result := make([]domain.OrderExportJob, len(jobs))
+	for i := range jobs {
+		result[i] = *ToDomainExportJob(&jobs[i])
+	}
+	return result
+}
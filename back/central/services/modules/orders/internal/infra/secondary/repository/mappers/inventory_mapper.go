@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ToDomainInventoryMovement convierte un movimiento de inventario de base de datos a dominio
+func ToDomainInventoryMovement(m *models.InventoryMovement) *domain.InventoryMovement {
+	if m == nil {
+		return nil
+	}
+	return &domain.InventoryMovement{
+		ID:             m.ID,
+		CreatedAt:      m.CreatedAt,
+		BusinessID:     m.BusinessID,
+		ProductID:      m.ProductID,
+		OrderID:        m.OrderID,
+		VariantID:      m.VariantID,
+		Type:           m.Type,
+		Quantity:       m.Quantity,
+		Reason:         m.Reason,
+		StockBefore:    m.StockBefore,
+		StockAfter:     m.StockAfter,
+		ReservedBefore: m.ReservedBefore,
+		ReservedAfter:  m.ReservedAfter,
+	}
+}
@@ -38,6 +38,9 @@ func ToDBOrder(o *domain.Order) *models.Order {
 		CustomerEmail:       o.CustomerEmail,
 		CustomerPhone:       o.CustomerPhone,
 		CustomerDNI:         o.CustomerDNI,
+		CustomerPhoneE164:   o.CustomerPhoneE164,
+		CustomerPhoneValid:  o.CustomerPhoneValid,
+		CustomerPhoneType:   o.CustomerPhoneType,
 		ShippingStreet:      o.ShippingStreet,
 		ShippingCity:        o.ShippingCity,
 		ShippingState:       o.ShippingState,
@@ -45,6 +48,12 @@ func ToDBOrder(o *domain.Order) *models.Order {
 		ShippingPostalCode:  o.ShippingPostalCode,
 		ShippingLat:         o.ShippingLat,
 		ShippingLng:         o.ShippingLng,
+		ShippingCityCode:    o.ShippingCityCode,
+		ShippingStateCode:   o.ShippingStateCode,
+		AddressCompleteness: o.AddressCompleteness,
+		GeocodePrecision:    o.GeocodePrecision,
+		GeocodeSource:       o.GeocodeSource,
+		InventoryStatus:     o.InventoryStatus,
 		PaymentMethodID:     o.PaymentMethodID,
 		IsPaid:              o.IsPaid,
 		PaidAt:              o.PaidAt,
@@ -124,6 +133,9 @@ func ToDomainOrder(o *models.Order) *domain.Order {
 		CustomerEmail:       o.CustomerEmail,
 		CustomerPhone:       o.CustomerPhone,
 		CustomerDNI:         o.CustomerDNI,
+		CustomerPhoneE164:   o.CustomerPhoneE164,
+		CustomerPhoneValid:  o.CustomerPhoneValid,
+		CustomerPhoneType:   o.CustomerPhoneType,
 		ShippingStreet:      o.ShippingStreet,
 		ShippingCity:        o.ShippingCity,
 		ShippingState:       o.ShippingState,
@@ -131,6 +143,12 @@ func ToDomainOrder(o *models.Order) *domain.Order {
 		ShippingPostalCode:  o.ShippingPostalCode,
 		ShippingLat:         o.ShippingLat,
 		ShippingLng:         o.ShippingLng,
+		ShippingCityCode:    o.ShippingCityCode,
+		ShippingStateCode:   o.ShippingStateCode,
+		AddressCompleteness: o.AddressCompleteness,
+		GeocodePrecision:    o.GeocodePrecision,
+		GeocodeSource:       o.GeocodeSource,
+		InventoryStatus:     o.InventoryStatus,
 		PaymentMethodID:     o.PaymentMethodID,
 		IsPaid:              o.IsPaid,
 		PaidAt:              o.PaidAt,
@@ -196,6 +214,7 @@ func ToDBOrderItems(items []domain.OrderItem) []models.OrderItem {
 			},
 			OrderID:           item.OrderID,
 			ProductID:         item.ProductID,
+			ProductVariantID:  item.ProductVariantID,
 			VariantID:         item.VariantID,
 			Quantity:          item.Quantity,
 			UnitPrice:         item.UnitPrice,
@@ -233,6 +252,7 @@ func ToDomainOrderItems(items []models.OrderItem) []domain.OrderItem {
 			DeletedAt:         deletedAt,
 			OrderID:           item.OrderID,
 			ProductID:         item.ProductID,
+			ProductVariantID:  item.ProductVariantID,
 			VariantID:         item.VariantID,
 			Quantity:          item.Quantity,
 			UnitPrice:         item.UnitPrice,
@@ -253,6 +273,12 @@ func ToDomainOrderItems(items []models.OrderItem) []domain.OrderItem {
 			// ProductTitle no existe en el modelo Product, se deja vacío
 		}
 
+		// Si la variante está preloaded, su SKU y título identifican el item
+		if item.ProductVariant.ID != "" {
+			domainItem.ProductSKU = item.ProductVariant.SKU
+			domainItem.ProductTitle = item.ProductVariant.Title
+		}
+
 		result[i] = domainItem
 	}
 	return result
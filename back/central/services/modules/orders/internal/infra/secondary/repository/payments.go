@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListOrderPayments obtiene los pagos de una orden, del más antiguo al más reciente
+func (r *Repository) ListOrderPayments(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	var payments []models.Payment
+	if err := r.db.Conn(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&payments).Error; err != nil {
+		return nil, err
+	}
+	return mappers.ToDomainPayments(payments), nil
+}
+
+// RecordPayment registra un pago de la orden en una transacción: bloquea la orden, valida que el pago
+// no supere el saldo pendiente, guarda el pago y actualiza is_paid, paid_at y el método de pago
+// principal con el saldo recalculado sobre todos los pagos
+func (r *Repository) RecordPayment(ctx context.Context, payment *domain.Payment) (*domain.OrderPaymentSummary, error) {
+	var summary domain.OrderPaymentSummary
+
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "total_amount", "currency", "payment_method_id", "is_paid", "paid_at").
+			Where("id = ? AND deleted_at IS NULL", payment.OrderID).
+			First(&order).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrOrderNotFound
+			}
+			return err
+		}
+
+		var dbPayments []models.Payment
+		if err := tx.Where("order_id = ?", order.ID).Order("created_at ASC").Find(&dbPayments).Error; err != nil {
+			return err
+		}
+		payments := mappers.ToDomainPayments(dbPayments)
+
+		before := domain.SummarizePayments(order.ID, order.TotalAmount, order.Currency, payments)
+		if payment.Amount > before.BalanceDue+paymentledger.AmountTolerance/2 {
+			return domain.ErrPaymentExceedsBalance
+		}
+
+		dbPayment := mappers.ToDBPayments([]domain.Payment{*payment})[0]
+		if err := tx.Create(&dbPayment).Error; err != nil {
+			return err
+		}
+		payment.ID = dbPayment.ID
+		payment.CreatedAt = dbPayment.CreatedAt
+		payment.UpdatedAt = dbPayment.UpdatedAt
+
+		recomputed, err := paymentledger.RecomputeOrder(tx, order.ID)
+		if err != nil {
+			return err
+		}
+		summary = domain.NewOrderPaymentSummary(order.ID, order.Currency, *recomputed)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
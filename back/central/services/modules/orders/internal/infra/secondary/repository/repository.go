@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
 	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
 	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/normalize"
 	"github.com/secamc93/probability/back/migration/shared/models"
 	"gorm.io/gorm"
 )
@@ -43,12 +46,13 @@ func (r *Repository) GetOrderByID(ctx context.Context, id string) (*domain.Order
 		Preload("Integration").
 		Preload("PaymentMethod").
 		Preload("OrderItems.Product"). // Precargar OrderItems con Product para obtener información del catálogo
+		Preload("OrderItems.ProductVariant").
 		Where("id = ?", id).
 		First(&order).Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("order not found")
+			return nil, domain.ErrOrderNotFound
 		}
 		return nil, err
 	}
@@ -64,6 +68,7 @@ func (r *Repository) GetOrderByInternalNumber(ctx context.Context, internalNumbe
 		Preload("Integration").
 		Preload("PaymentMethod").
 		Preload("OrderItems.Product"). // Precargar OrderItems con Product para obtener información del catálogo
+		Preload("OrderItems.ProductVariant").
 		Where("internal_number = ?", internalNumber).
 		First(&order).Error
 
@@ -77,6 +82,18 @@ func (r *Repository) GetOrderByInternalNumber(ctx context.Context, internalNumbe
 	return mappers.ToDomainOrder(&order), nil
 }
 
+// orderSortFields columnas por las que se puede ordenar el listado de órdenes
+var orderSortFields = map[string]bool{
+	"created_at":      true,
+	"updated_at":      true,
+	"occurred_at":     true,
+	"order_number":    true,
+	"internal_number": true,
+	"status":          true,
+	"total_amount":    true,
+	"customer_name":   true,
+}
+
 // ListOrders obtiene una lista paginada de órdenes con filtros
 func (r *Repository) ListOrders(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.Order, int64, error) {
 	var dbOrders []models.Order
@@ -85,6 +102,18 @@ func (r *Repository) ListOrders(ctx context.Context, page, pageSize int, filters
 	query := r.db.Conn(ctx).Model(&models.Order{})
 
 	// Aplicar filtros
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	if integrationID, ok := filters["integration_id"].(uint); ok && integrationID > 0 {
+		query = query.Where("integration_id = ?", integrationID)
+	}
+
+	if integrationType, ok := filters["integration_type"].(string); ok && integrationType != "" {
+		query = query.Where("integration_type = ?", integrationType)
+	}
+
 	if customerEmail, ok := filters["customer_email"].(string); ok && customerEmail != "" {
 		query = query.Where("customer_email ILIKE ?", "%"+customerEmail+"%")
 	}
@@ -130,23 +159,29 @@ func (r *Repository) ListOrders(ctx context.Context, page, pageSize int, filters
 		query = query.Where("created_at <= ?", endDate)
 	}
 
+	// Corte fijo de las exportaciones: las órdenes creadas mientras se recorren las páginas no las desplazan
+	if createdUntil, ok := filters["created_until"].(time.Time); ok && !createdUntil.IsZero() {
+		query = query.Where("created_at <= ?", createdUntil)
+	}
+
 	// Contar total
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// Aplicar ordenamiento
+	// Aplicar ordenamiento (solo columnas conocidas: el valor viene de la query o de exportaciones guardadas)
 	sortBy := "created_at"
-	if sort, ok := filters["sort_by"].(string); ok && sort != "" {
+	if sort, ok := filters["sort_by"].(string); ok && orderSortFields[sort] {
 		sortBy = sort
 	}
 
 	sortOrder := "desc"
-	if order, ok := filters["sort_order"].(string); ok && order != "" {
+	if order, ok := filters["sort_order"].(string); ok && (order == "asc" || order == "desc") {
 		sortOrder = order
 	}
 
-	query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+	// id desempata las órdenes con el mismo valor para que las páginas no se solapen
+	query = query.Order(fmt.Sprintf("%s %s, id %s", sortBy, sortOrder, sortOrder))
 
 	// Aplicar paginación
 	offset := (page - 1) * pageSize
@@ -155,7 +190,8 @@ func (r *Repository) ListOrders(ctx context.Context, page, pageSize int, filters
 	query = query.Preload("Business").
 		Preload("Integration").
 		Preload("PaymentMethod").
-		Preload("OrderItems.Product") // Precargar OrderItems con Product para obtener información del catálogo
+		Preload("OrderItems.Product"). // Precargar OrderItems con Product para obtener información del catálogo
+		Preload("OrderItems.ProductVariant")
 
 	// Paginación
 	offset = (page - 1) * pageSize
@@ -184,7 +220,8 @@ func (r *Repository) GetOrderRaw(ctx context.Context, id string) (*domain.OrderC
 // UpdateOrder actualiza una orden existente
 func (r *Repository) UpdateOrder(ctx context.Context, order *domain.Order) error {
 	dbOrder := mappers.ToDBOrder(order)
-	return r.db.Conn(ctx).Save(dbOrder).Error
+	// inventory_status solo lo modifican las operaciones de inventario (con la orden bloqueada)
+	return r.db.Conn(ctx).Omit("inventory_status").Save(dbOrder).Error
 }
 
 // DeleteOrder elimina (soft delete) una orden
@@ -401,6 +438,23 @@ func (r *Repository) GetProductBySKU(ctx context.Context, businessID uint, sku s
 	return mappers.ToDomainProduct(&product), nil
 }
 
+// GetProductByExternalID busca un producto por su ID externo dentro del negocio
+func (r *Repository) GetProductByExternalID(ctx context.Context, businessID uint, externalID string) (*domain.Product, error) {
+	var product models.Product
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND external_id = ?", businessID, externalID).
+		Order("created_at ASC").
+		First(&product).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Retornar nil si no existe, no error
+		}
+		return nil, err
+	}
+	return mappers.ToDomainProduct(&product), nil
+}
+
 // CreateProduct crea un nuevo producto
 func (r *Repository) CreateProduct(ctx context.Context, product *domain.Product) error {
 	dbProduct := mappers.ToDBProduct(product)
@@ -415,7 +469,7 @@ func (r *Repository) CreateProduct(ctx context.Context, product *domain.Product)
 func (r *Repository) GetClientByEmail(ctx context.Context, businessID uint, email string) (*domain.Client, error) {
 	var client models.Client
 	err := r.db.Conn(ctx).
-		Where("business_id = ? AND email = ?", businessID, email).
+		Where("business_id = ? AND LOWER(email) = ?", businessID, normalize.Email(email)).
 		First(&client).Error
 
 	if err != nil {
@@ -447,6 +501,85 @@ func (r *Repository) GetClientByDNI(ctx context.Context, businessID uint, dni st
 	return mappers.ToDomainClient(&client), nil
 }
 
+// GetClientByPhone busca un cliente por teléfono normalizado a E.164 y BusinessID
+func (r *Repository) GetClientByPhone(ctx context.Context, businessID uint, phoneE164 string) (*domain.Client, error) {
+	if phoneE164 == "" {
+		return nil, nil // No buscar si el teléfono no se pudo normalizar
+	}
+
+	var client models.Client
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND phone_e164 = ?", businessID, phoneE164).
+		Order("id ASC").
+		First(&client).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Retornar nil si no existe
+		}
+		return nil, err
+	}
+	return mappers.ToDomainClient(&client), nil
+}
+
+// GetBusinessDefaultCountry obtiene el país por defecto del negocio usado para normalizar teléfonos.
+// Retorna cadena vacía si el negocio no tiene país configurado.
+func (r *Repository) GetBusinessDefaultCountry(ctx context.Context, businessID uint) (string, error) {
+	var countries []string
+	err := r.db.Conn(ctx).
+		Model(&models.Business{}).
+		Where("id = ?", businessID).
+		Limit(1).
+		Pluck("default_country", &countries).Error
+	if err != nil {
+		return "", err
+	}
+	if len(countries) == 0 {
+		return "", nil
+	}
+	return strings.ToUpper(strings.TrimSpace(countries[0])), nil
+}
+
+// FindActiveCustomerListEntries busca las entradas vigentes de blocklist/allowlist del negocio
+// cuyo valor normalizado coincide con alguno de los valores de la orden, agrupados por campo
+func (r *Repository) FindActiveCustomerListEntries(ctx context.Context, businessID uint, keys map[string][]string) ([]domain.CustomerListEntry, error) {
+	conditions := make([]string, 0, len(keys))
+	args := make([]interface{}, 0, len(keys)*2)
+	for matchType, values := range keys {
+		if len(values) == 0 {
+			continue
+		}
+		conditions = append(conditions, "(match_type = ? AND normalized_value IN ?)")
+		args = append(args, matchType, values)
+	}
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	var entries []models.CustomerListEntry
+	err := r.db.Conn(ctx).
+		Where("business_id = ?", businessID).
+		Where("(expires_at IS NULL OR expires_at > ?)", time.Now()).
+		Where("("+strings.Join(conditions, " OR ")+")", args...).
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.CustomerListEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = domain.CustomerListEntry{
+			ID:        entry.ID,
+			ListType:  entry.ListType,
+			MatchType: entry.MatchType,
+			Value:     entry.Value,
+			Reason:    entry.Reason,
+			ExpiresAt: entry.ExpiresAt,
+		}
+	}
+	return result, nil
+}
+
 // CreateClient crea un nuevo cliente
 func (r *Repository) CreateClient(ctx context.Context, client *domain.Client) error {
 	dbClient := mappers.ToDBClient(client)
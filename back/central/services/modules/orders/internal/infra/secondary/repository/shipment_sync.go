@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListShipmentsByOrder obtiene los envíos vigentes de una orden, del más antiguo al más reciente
+func (r *Repository) ListShipmentsByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	var shipments []models.Shipment
+	if err := r.db.Conn(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC, id ASC").
+		Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+	return mappers.ToDomainShipments(shipments), nil
+}
+
+// UpdateOrderShipmentData actualiza solo el estado, tracking, guía y fecha de entrega de la orden,
+// sin pisar los demás campos que pueden estar cambiando en paralelo. La orden se bloquea y solo se
+// actualiza si sigue en previousStatus: retorna false si otra réplica (o un cambio manual) ya la movió.
+func (r *Repository) UpdateOrderShipmentData(ctx context.Context, order *domain.Order, previousStatus string) (bool, error) {
+	updated := false
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Where("id = ?", order.ID).
+			First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrOrderNotFound
+			}
+			return err
+		}
+		if current.Status != previousStatus {
+			return nil
+		}
+
+		result := tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, previousStatus).
+			Updates(map[string]interface{}{
+				"status":          order.Status,
+				"tracking_number": order.TrackingNumber,
+				"tracking_link":   order.TrackingLink,
+				"guide_id":        order.GuideID,
+				"guide_link":      order.GuideLink,
+				"delivered_at":    order.DeliveredAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		updated = result.RowsAffected == 1
+		return nil
+	})
+	return updated, err
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/orders/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetVariantByExternalID busca una variante por su ID en una integración del negocio
+func (r *Repository) GetVariantByExternalID(ctx context.Context, businessID, integrationID uint, externalVariantID string) (*domain.ProductVariant, error) {
+	var variant models.ProductVariant
+	err := r.db.Conn(ctx).
+		Joins("INNER JOIN product_variant_integrations pvi ON pvi.variant_id = product_variants.id AND pvi.deleted_at IS NULL").
+		Where("pvi.business_id = ? AND pvi.integration_id = ? AND pvi.external_variant_id = ?", businessID, integrationID, externalVariantID).
+		First(&variant).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Retornar nil si no existe, no error
+		}
+		return nil, err
+	}
+	return mappers.ToDomainProductVariant(&variant), nil
+}
+
+// GetVariantBySKU busca una variante por SKU dentro del negocio
+func (r *Repository) GetVariantBySKU(ctx context.Context, businessID uint, sku string) (*domain.ProductVariant, error) {
+	var variant models.ProductVariant
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND sku = ?", businessID, sku).
+		First(&variant).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Retornar nil si no existe, no error
+		}
+		return nil, err
+	}
+	return mappers.ToDomainProductVariant(&variant), nil
+}
+
+// CreateVariant crea una nueva variante de producto
+func (r *Repository) CreateVariant(ctx context.Context, variant *domain.ProductVariant) error {
+	dbVariant := &models.ProductVariant{
+		ProductID:  variant.ProductID,
+		BusinessID: variant.BusinessID,
+		SKU:        variant.SKU,
+		Title:      variant.Title,
+		ExternalID: variant.ExternalID,
+		IsActive:   true,
+	}
+	if err := r.db.Conn(ctx).Create(dbVariant).Error; err != nil {
+		return err
+	}
+	variant.ID = dbVariant.ID
+	return nil
+}
+
+// LinkVariantIntegration registra el ID de la variante en la integración; si ya existe no hace nada
+func (r *Repository) LinkVariantIntegration(ctx context.Context, variant *domain.ProductVariant, integrationID uint, externalVariantID string) error {
+	return r.db.Conn(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.ProductVariantIntegration{
+			VariantID:         variant.ID,
+			ProductID:         variant.ProductID,
+			BusinessID:        variant.BusinessID,
+			IntegrationID:     integrationID,
+			ExternalVariantID: externalVariantID,
+		}).Error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
 )
 
 // ═══════════════════════════════════════════
@@ -30,6 +31,10 @@ type IUseCase interface {
 	UpdatePaymentMapping(ctx context.Context, id uint, req *domain.UpdatePaymentMappingRequest) (*domain.PaymentMappingResponse, error)
 	DeletePaymentMapping(ctx context.Context, id uint) error
 	TogglePaymentMappingActive(ctx context.Context, id uint) (*domain.PaymentMappingResponse, error)
+
+	// Refunds
+	CreateRefund(ctx context.Context, paymentID uint, req *domain.CreateRefundRequest) (*domain.CreateRefundResponse, error)
+	ListPaymentRefunds(ctx context.Context, paymentID uint) (*domain.PaymentRefundsResponse, error)
 }
 
 // ═══════════════════════════════════════════
@@ -38,12 +43,19 @@ type IUseCase interface {
 
 // UseCase contiene todos los casos de uso del módulo payments
 type UseCase struct {
-	repo domain.IRepository
+	repo            domain.IRepository
+	eventPublisher  domain.IOrderEventPublisher
+	refundWriteBack domain.IRefundWriteBack
+	log             log.ILogger
 }
 
-// New crea una nueva instancia de todos los casos de uso
-func New(repo domain.IRepository) IUseCase {
+// New crea una nueva instancia de todos los casos de uso.
+// eventPublisher y refundWriteBack son opcionales (nil = no se publican eventos ni se hace write-back).
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, refundWriteBack domain.IRefundWriteBack, logger log.ILogger) IUseCase {
 	return &UseCase{
-		repo: repo,
+		repo:            repo,
+		eventPublisher:  eventPublisher,
+		refundWriteBack: refundWriteBack,
+		log:             logger,
 	}
 }
@@ -0,0 +1,202 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ═══════════════════════════════════════════
+// REFUNDS USE CASES
+// ═══════════════════════════════════════════
+
+// CreateRefund reembolsa total o parcialmente un pago, recalcula el saldo pagado de la orden,
+// registra el reembolso en la plataforma de origen si se pide y publica order.refunded
+func (uc *UseCase) CreateRefund(ctx context.Context, paymentID uint, req *domain.CreateRefundRequest) (*domain.CreateRefundResponse, error) {
+	payment, err := uc.repo.GetPaymentWithOrder(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != domain.PaymentStatusCompleted && payment.Status != domain.PaymentStatusRefunded {
+		return nil, domain.ErrPaymentNotRefundable
+	}
+
+	refund := &models.PaymentRefund{
+		PaymentID:       payment.ID,
+		OrderID:         payment.OrderID,
+		BusinessID:      payment.Order.BusinessID,
+		Currency:        payment.Currency,
+		Reason:          req.Reason,
+		Note:            req.Note,
+		CreatedBy:       req.CreatedBy,
+		WriteBackStatus: domain.RefundWriteBackNotRequested,
+	}
+
+	// Las líneas y el monto se arman en el repositorio con la orden y el pago bloqueados
+	updatedPayment, writeBackLines, balance, err := uc.repo.CreateRefund(ctx, refund, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SyncToPlatform {
+		uc.pushRefund(ctx, &payment.Order, refund, writeBackLines)
+	}
+
+	response := &domain.CreateRefundResponse{
+		Refund:  mapToRefundResponse(refund),
+		Payment: mapToPaymentRefundSummary(updatedPayment),
+		Order:   *balance,
+	}
+
+	uc.log.Info(ctx).
+		Uint("payment_id", payment.ID).
+		Uint("refund_id", refund.ID).
+		Str("order_id", payment.OrderID).
+		Float64("amount", refund.Amount).
+		Str("reason", refund.Reason).
+		Bool("is_paid", balance.IsPaid).
+		Msg("Reembolso registrado")
+
+	uc.publishEvent(ctx, domain.NewOrderRefundedEvent(&domain.RefundedOrder{
+		ID:            payment.Order.ID,
+		BusinessID:    payment.Order.BusinessID,
+		IntegrationID: payment.Order.IntegrationID,
+		OrderNumber:   payment.Order.OrderNumber,
+		ExternalID:    payment.Order.ExternalID,
+		Platform:      payment.Order.Platform,
+	}, &response.Refund, &response.Payment, &response.Order))
+
+	return response, nil
+}
+
+// ListPaymentRefunds obtiene los reembolsos de un pago
+func (uc *UseCase) ListPaymentRefunds(ctx context.Context, paymentID uint) (*domain.PaymentRefundsResponse, error) {
+	payment, err := uc.repo.GetPaymentWithOrder(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := uc.repo.ListPaymentRefunds(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]domain.RefundResponse, len(refunds))
+	for i := range refunds {
+		data[i] = mapToRefundResponse(&refunds[i])
+	}
+
+	return &domain.PaymentRefundsResponse{
+		Payment: mapToPaymentRefundSummary(payment),
+		Data:    data,
+	}, nil
+}
+
+// pushRefund registra el reembolso en la plataforma de origen de la orden y guarda el resultado.
+// Un fallo del write-back no revierte el reembolso: queda registrado en el reembolso.
+func (uc *UseCase) pushRefund(ctx context.Context, order *models.Order, refund *models.PaymentRefund, lines []domain.RefundWriteBackLine) {
+	now := time.Now()
+	refund.WriteBackAt = &now
+
+	if uc.refundWriteBack == nil || order.ExternalID == "" {
+		refund.WriteBackStatus = domain.RefundWriteBackNotSupported
+	} else {
+		note := ""
+		if refund.Note != nil {
+			note = *refund.Note
+		}
+		externalID, err := uc.refundWriteBack.PushRefund(ctx, &domain.RefundWriteBack{
+			IntegrationID:   order.IntegrationID,
+			ExternalOrderID: order.ExternalID,
+			Amount:          refund.Amount,
+			Currency:        refund.Currency,
+			Reason:          refund.Reason,
+			Note:            note,
+			Lines:           lines,
+		})
+		switch {
+		case errors.Is(err, domain.ErrRefundWriteBackNotSupported):
+			refund.WriteBackStatus = domain.RefundWriteBackNotSupported
+		case err != nil:
+			message := err.Error()
+			refund.WriteBackStatus = domain.RefundWriteBackFailed
+			refund.WriteBackError = &message
+			uc.log.Error(ctx).
+				Err(err).
+				Uint("refund_id", refund.ID).
+				Str("order_id", order.ID).
+				Msg("Error al registrar el reembolso en la plataforma de origen")
+		default:
+			refund.WriteBackStatus = domain.RefundWriteBackSynced
+			refund.ExternalRefundID = externalID
+		}
+	}
+
+	if err := uc.repo.UpdateRefundWriteBack(ctx, refund); err != nil {
+		uc.log.Error(ctx).
+			Err(err).
+			Uint("refund_id", refund.ID).
+			Msg("Error al guardar el resultado del write-back del reembolso")
+	}
+}
+
+// publishEvent publica un evento de orden de forma asíncrona (no bloquea ni falla la operación)
+func (uc *UseCase) publishEvent(ctx context.Context, event *domain.OrderEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	publishCtx := context.WithoutCancel(ctx)
+	go func() {
+		_ = uc.eventPublisher.PublishOrderEvent(publishCtx, event)
+	}()
+}
+
+// ═══════════════════════════════════════════
+// REFUND MAPPERS
+// ═══════════════════════════════════════════
+
+func mapToRefundResponse(refund *models.PaymentRefund) domain.RefundResponse {
+	items := make([]domain.RefundItemResponse, len(refund.Items))
+	for i, item := range refund.Items {
+		items[i] = domain.RefundItemResponse{
+			OrderItemID: item.OrderItemID,
+			SKU:         item.SKU,
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+		}
+	}
+
+	return domain.RefundResponse{
+		ID:               refund.ID,
+		PaymentID:        refund.PaymentID,
+		OrderID:          refund.OrderID,
+		Amount:           refund.Amount,
+		Currency:         refund.Currency,
+		Reason:           refund.Reason,
+		Note:             refund.Note,
+		Items:            items,
+		WriteBackStatus:  refund.WriteBackStatus,
+		ExternalRefundID: refund.ExternalRefundID,
+		WriteBackError:   refund.WriteBackError,
+		CreatedBy:        refund.CreatedBy,
+		CreatedAt:        refund.CreatedAt,
+	}
+}
+
+func mapToPaymentRefundSummary(payment *models.Payment) domain.PaymentRefundSummary {
+	refunded := 0.0
+	if payment.RefundAmount != nil {
+		refunded = *payment.RefundAmount
+	}
+	return domain.PaymentRefundSummary{
+		PaymentID:        payment.ID,
+		Status:           payment.Status,
+		Amount:           payment.Amount,
+		RefundedAmount:   refunded,
+		RefundableAmount: domain.RefundableAmount(payment),
+		RefundedAt:       payment.RefundedAt,
+	}
+}
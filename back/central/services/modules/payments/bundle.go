@@ -1,26 +1,52 @@
 package payments
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/core"
 	"github.com/secamc93/probability/back/central/services/modules/payments/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
 	"github.com/secamc93/probability/back/central/services/modules/payments/infra/primary/handlers"
 	"github.com/secamc93/probability/back/central/services/modules/payments/infra/secondary/repository"
+	"github.com/secamc93/probability/back/central/services/modules/payments/infra/secondary/writeback"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/orderevents"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
 )
 
 // New inicializa el módulo de payments
-func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig) {
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, redisClient redisclient.IRedis, integrationCore core.IIntegrationCore) {
 	// 1. Init Repositories
 	repo := repository.New(database)
 
-	// 2. Init Use Cases
-	uc := usecases.New(repo)
+	// 2. Init Event Publisher (si Redis está disponible). Los reembolsos se publican en el canal de órdenes
+	var eventPublisher domain.IOrderEventPublisher
+	if redisClient != nil {
+		redisChannel := environment.Get("REDIS_ORDER_EVENTS_CHANNEL")
+		if redisChannel == "" {
+			redisChannel = "probability:orders:events" // Valor por defecto
+		}
+		eventPublisher = orderevents.NewPublisher(redisClient, logger, redisChannel)
+		logger.Info(context.Background()).
+			Str("channel", redisChannel).
+			Msg("Payments order event publisher initialized")
+	}
+
+	// 3. Init Refund Write-back (reembolsos hacia la plataforma de origen de la orden)
+	var refundWriteBack domain.IRefundWriteBack
+	if integrationCore != nil {
+		refundWriteBack = writeback.New(integrationCore)
+	}
+
+	// 4. Init Use Cases
+	uc := usecases.New(repo, eventPublisher, refundWriteBack, logger)
 
-	// 3. Init Handlers
+	// 5. Init Handlers
 	h := handlers.New(uc)
 
-	// 4. Register Routes
+	// 6. Register Routes
 	h.RegisterRoutes(router)
 }
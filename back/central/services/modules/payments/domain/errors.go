@@ -0,0 +1,23 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrPaymentNotFound se retorna cuando el pago no existe
+	ErrPaymentNotFound = errors.New("payment not found")
+
+	// ErrPaymentNotRefundable se retorna cuando el pago no está completado y no se puede reembolsar
+	ErrPaymentNotRefundable = errors.New("only completed payments can be refunded")
+
+	// ErrRefundExceedsPaid se retorna cuando el reembolso supera lo que queda por reembolsar del pago
+	ErrRefundExceedsPaid = errors.New("refund amount exceeds the refundable amount of the payment")
+
+	// ErrInvalidRefundItems se retorna cuando las líneas del reembolso no pertenecen a la orden o superan lo comprado
+	ErrInvalidRefundItems = errors.New("invalid refund items")
+
+	// ErrInvalidRefundAmount se retorna cuando el monto del reembolso no es positivo
+	ErrInvalidRefundAmount = errors.New("refund amount must be greater than zero")
+
+	// ErrRefundWriteBackNotSupported se retorna cuando la integración de la orden no soporta el write-back de reembolsos
+	ErrRefundWriteBackNotSupported = errors.New("the order integration does not support refund write-back")
+)
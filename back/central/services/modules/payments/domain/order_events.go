@@ -0,0 +1,49 @@
+package domain
+
+import "github.com/secamc93/probability/back/central/shared/orderevents"
+
+// ───────────────────────────────────────────
+//
+//	ORDER EVENTS - Evento compartido del canal de órdenes (ver shared/orderevents)
+//
+// ───────────────────────────────────────────
+
+// OrderEvent es el evento de orden publicado en el canal de órdenes
+type OrderEvent = orderevents.OrderEvent
+
+// RefundedOrder son los datos de la orden reembolsada que viajan en el evento
+type RefundedOrder struct {
+	ID            string
+	BusinessID    *uint
+	IntegrationID uint
+	OrderNumber   string
+	ExternalID    string
+	Platform      string
+}
+
+// NewOrderRefundedEvent crea el evento de reembolso de un pago de la orden
+func NewOrderRefundedEvent(order *RefundedOrder, refund *RefundResponse, payment *PaymentRefundSummary, balance *OrderPaymentBalance) *OrderEvent {
+	integrationID := order.IntegrationID
+	amount := refund.Amount
+	event := orderevents.NewOrderEvent(orderevents.OrderEventTypeRefunded, order.ID, orderevents.OrderEventData{
+		OrderNumber: order.OrderNumber,
+		ExternalID:  order.ExternalID,
+		TotalAmount: &amount,
+		Currency:    refund.Currency,
+		Platform:    order.Platform,
+		Extra: map[string]interface{}{
+			"refund_id":         refund.ID,
+			"payment_id":        payment.PaymentID,
+			"reason":            refund.Reason,
+			"is_full_refund":    payment.Status == PaymentStatusRefunded,
+			"write_back_status": refund.WriteBackStatus,
+			"paid_amount":       balance.PaidAmount,
+			"refunded_amount":   balance.RefundedAmount,
+			"balance_due":       balance.BalanceDue,
+			"is_paid":           balance.IsPaid,
+		},
+	})
+	event.BusinessID = order.BusinessID
+	event.IntegrationID = &integrationID
+	return event
+}
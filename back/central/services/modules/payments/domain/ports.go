@@ -37,4 +37,27 @@ type IRepository interface {
 	GetPaymentMappingsByIntegrationTypeWithMethods(ctx context.Context, integrationType string) ([]models.PaymentMethodMapping, error)
 	TogglePaymentMappingActive(ctx context.Context, id uint) (*models.PaymentMethodMapping, error)
 	PaymentMappingExists(ctx context.Context, integrationType, originalMethod string) (bool, error)
+
+	// Refunds
+	GetPaymentWithOrder(ctx context.Context, id uint) (*models.Payment, error)
+	CreateRefund(ctx context.Context, refund *models.PaymentRefund, req *CreateRefundRequest) (*models.Payment, []RefundWriteBackLine, *OrderPaymentBalance, error)
+	UpdateRefundWriteBack(ctx context.Context, refund *models.PaymentRefund) error
+	ListPaymentRefunds(ctx context.Context, paymentID uint) ([]models.PaymentRefund, error)
+}
+
+// ───────────────────────────────────────────
+//
+//	EXTERNAL PORTS
+//
+// ───────────────────────────────────────────
+
+// IOrderEventPublisher publica eventos de órdenes en el canal de órdenes
+type IOrderEventPublisher interface {
+	PublishOrderEvent(ctx context.Context, event *OrderEvent) error
+}
+
+// IRefundWriteBack registra los reembolsos en la plataforma de origen de la orden.
+// Retorna ErrRefundWriteBackNotSupported si la integración de la orden no lo soporta.
+type IRefundWriteBack interface {
+	PushRefund(ctx context.Context, refund *RefundWriteBack) (string, error)
 }
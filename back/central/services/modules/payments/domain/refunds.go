@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ───────────────────────────────────────────
+//
+//	REFUNDS - Reembolsos de pagos
+//
+// ───────────────────────────────────────────
+
+// Estados del pago (deben coincidir con models.Payment.Status)
+const (
+	PaymentStatusPending   = paymentledger.StatusPending
+	PaymentStatusCompleted = paymentledger.StatusCompleted
+	PaymentStatusFailed    = paymentledger.StatusFailed
+	PaymentStatusRefunded  = paymentledger.StatusRefunded
+)
+
+// Motivos de reembolso
+const (
+	RefundReasonCustomerRequest = "customer_request"
+	RefundReasonDamaged         = "damaged"
+	RefundReasonNotDelivered    = "not_delivered"
+	RefundReasonDuplicate       = "duplicate"
+	RefundReasonFraud           = "fraud"
+	RefundReasonOther           = "other"
+)
+
+// Estados del write-back del reembolso a la plataforma de origen
+const (
+	RefundWriteBackNotRequested = "not_requested"
+	RefundWriteBackSynced       = "synced"
+	RefundWriteBackFailed       = "failed"
+	RefundWriteBackNotSupported = "not_supported"
+)
+
+// AmountTolerance es la diferencia máxima que se considera redondeo al comparar montos
+const AmountTolerance = paymentledger.AmountTolerance
+
+// RoundAmount redondea un monto a dos decimales
+func RoundAmount(amount float64) float64 {
+	return paymentledger.RoundAmount(amount)
+}
+
+// RefundableOrderItem es una línea de la orden con lo que ya se reembolsó de ella
+type RefundableOrderItem struct {
+	ID                uint
+	SKU               string
+	ExternalVariantID string
+	Quantity          int
+	TotalPrice        float64
+	RefundedQuantity  int
+}
+
+// UnitAmount retorna el valor unitario de la línea
+func (i *RefundableOrderItem) UnitAmount() float64 {
+	if i.Quantity <= 0 {
+		return 0
+	}
+	return i.TotalPrice / float64(i.Quantity)
+}
+
+// RefundableQuantity retorna las unidades de la línea que aún se pueden reembolsar
+func (i *RefundableOrderItem) RefundableQuantity() int {
+	return max(i.Quantity-i.RefundedQuantity, 0)
+}
+
+// RefundableAmount retorna lo que queda por reembolsar del pago
+func RefundableAmount(payment *models.Payment) float64 {
+	refunded := 0.0
+	if payment.RefundAmount != nil {
+		refunded = *payment.RefundAmount
+	}
+	return RoundAmount(max(payment.Amount-refunded, 0))
+}
+
+// PrepareRefund arma las líneas y el monto del reembolso contra las líneas de la orden y lo que queda
+// por reembolsar del pago. Sin amount se reembolsa el valor de las líneas o, sin líneas, todo lo que
+// queda del pago. Retorna las líneas para registrar el reembolso en la plataforma de origen.
+func PrepareRefund(refund *models.PaymentRefund, req *CreateRefundRequest, orderItems []RefundableOrderItem, payment *models.Payment) ([]RefundWriteBackLine, error) {
+	refundable := RefundableAmount(payment)
+	if refundable <= 0 {
+		return nil, ErrRefundExceedsPaid
+	}
+
+	var lines []RefundWriteBackLine
+	itemsTotal := 0.0
+	if len(req.Items) > 0 {
+		var err error
+		refund.Items, lines, itemsTotal, err = buildRefundItems(req.Items, orderItems)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case req.Amount != nil:
+		refund.Amount = RoundAmount(*req.Amount)
+	case len(req.Items) > 0:
+		refund.Amount = RoundAmount(itemsTotal)
+	default:
+		refund.Amount = refundable
+	}
+	if refund.Amount <= 0 {
+		return nil, ErrInvalidRefundAmount
+	}
+	if refund.Amount > refundable+AmountTolerance/2 {
+		return nil, ErrRefundExceedsPaid
+	}
+	return lines, nil
+}
+
+// buildRefundItems valida las líneas del reembolso contra las líneas de la orden y calcula su valor
+func buildRefundItems(items []RefundItemRequest, orderItems []RefundableOrderItem) ([]models.PaymentRefundItem, []RefundWriteBackLine, float64, error) {
+	byID := make(map[uint]*RefundableOrderItem, len(orderItems))
+	for i := range orderItems {
+		byID[orderItems[i].ID] = &orderItems[i]
+	}
+
+	refundItems := make([]models.PaymentRefundItem, 0, len(items))
+	lines := make([]RefundWriteBackLine, 0, len(items))
+	total := 0.0
+	requested := make(map[uint]int, len(items))
+
+	for _, item := range items {
+		orderItem, ok := byID[item.OrderItemID]
+		if !ok {
+			return nil, nil, 0, fmt.Errorf("%w: order item %d does not belong to the order", ErrInvalidRefundItems, item.OrderItemID)
+		}
+		requested[item.OrderItemID] += item.Quantity
+		if requested[item.OrderItemID] > orderItem.RefundableQuantity() {
+			return nil, nil, 0, fmt.Errorf("%w: quantity exceeds the refundable quantity of order item %d", ErrInvalidRefundItems, item.OrderItemID)
+		}
+
+		amount := orderItem.UnitAmount() * float64(item.Quantity)
+		if item.Amount != nil {
+			amount = *item.Amount
+		}
+		amount = RoundAmount(amount)
+		total += amount
+
+		refundItems = append(refundItems, models.PaymentRefundItem{
+			OrderItemID: orderItem.ID,
+			SKU:         orderItem.SKU,
+			Quantity:    item.Quantity,
+			Amount:      amount,
+		})
+		lines = append(lines, RefundWriteBackLine{
+			ExternalVariantID: orderItem.ExternalVariantID,
+			SKU:               orderItem.SKU,
+			Quantity:          item.Quantity,
+		})
+	}
+	return refundItems, lines, total, nil
+}
+
+// OrderPaymentBalance es el saldo pagado de la orden recalculado a partir de sus pagos
+type OrderPaymentBalance struct {
+	OrderID        string     `json:"order_id"`
+	TotalAmount    float64    `json:"total_amount"`
+	Currency       string     `json:"currency"`
+	PaidAmount     float64    `json:"paid_amount"`     // Pagado neto de reembolsos
+	RefundedAmount float64    `json:"refunded_amount"` // Total reembolsado de la orden
+	BalanceDue     float64    `json:"balance_due"`     // Lo que falta por pagar
+	IsPaid         bool       `json:"is_paid"`
+	PaidAt         *time.Time `json:"paid_at,omitempty"`
+}
+
+// RefundWriteBack es el reembolso que se registra en la plataforma de origen de la orden
+type RefundWriteBack struct {
+	IntegrationID   uint
+	ExternalOrderID string
+	Amount          float64
+	Currency        string
+	Reason          string
+	Note            string
+	Lines           []RefundWriteBackLine
+}
+
+// RefundWriteBackLine es una línea incluida en el reembolso de la plataforma
+type RefundWriteBackLine struct {
+	ExternalVariantID string
+	SKU               string
+	Quantity          int
+}
+
+// ───────────────────────────────────────────
+//
+//	REFUND DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateRefundRequest representa la solicitud para reembolsar un pago.
+// Sin amount se reembolsa el valor de las líneas o, si no hay líneas, todo lo que queda del pago.
+type CreateRefundRequest struct {
+	Amount         *float64            `json:"amount" binding:"omitempty,gt=0"`
+	Reason         string              `json:"reason" binding:"required,oneof=customer_request damaged not_delivered duplicate fraud other"`
+	Note           *string             `json:"note" binding:"omitempty,max=1000"`
+	Items          []RefundItemRequest `json:"items" binding:"omitempty,max=200,dive"`
+	SyncToPlatform bool                `json:"sync_to_platform"` // Registrar el reembolso en la plataforma de origen
+	CreatedBy      *uint               `json:"-"`                // Usuario autenticado (lo asigna el handler)
+}
+
+// RefundItemRequest es una línea de la orden incluida en el reembolso.
+// Sin amount se toma el valor unitario de la línea por la cantidad.
+type RefundItemRequest struct {
+	OrderItemID uint     `json:"order_item_id" binding:"required"`
+	Quantity    int      `json:"quantity" binding:"required,min=1"`
+	Amount      *float64 `json:"amount" binding:"omitempty,gte=0"`
+}
+
+// RefundItemResponse representa una línea reembolsada
+type RefundItemResponse struct {
+	OrderItemID uint    `json:"order_item_id"`
+	SKU         string  `json:"sku,omitempty"`
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// RefundResponse representa un reembolso
+type RefundResponse struct {
+	ID               uint                 `json:"id"`
+	PaymentID        uint                 `json:"payment_id"`
+	OrderID          string               `json:"order_id"`
+	Amount           float64              `json:"amount"`
+	Currency         string               `json:"currency"`
+	Reason           string               `json:"reason"`
+	Note             *string              `json:"note,omitempty"`
+	Items            []RefundItemResponse `json:"items"`
+	WriteBackStatus  string               `json:"write_back_status"`
+	ExternalRefundID string               `json:"external_refund_id,omitempty"`
+	WriteBackError   *string              `json:"write_back_error,omitempty"`
+	CreatedBy        *uint                `json:"created_by,omitempty"`
+	CreatedAt        time.Time            `json:"created_at"`
+}
+
+// PaymentRefundSummary resume lo reembolsado de un pago
+type PaymentRefundSummary struct {
+	PaymentID        uint       `json:"payment_id"`
+	Status           string     `json:"status"`
+	Amount           float64    `json:"amount"`
+	RefundedAmount   float64    `json:"refunded_amount"`
+	RefundableAmount float64    `json:"refundable_amount"`
+	RefundedAt       *time.Time `json:"refunded_at,omitempty"`
+}
+
+// CreateRefundResponse representa el resultado de un reembolso
+type CreateRefundResponse struct {
+	Refund  RefundResponse       `json:"refund"`
+	Payment PaymentRefundSummary `json:"payment"`
+	Order   OrderPaymentBalance  `json:"order"`
+}
+
+// PaymentRefundsResponse representa los reembolsos de un pago
+type PaymentRefundsResponse struct {
+	Payment PaymentRefundSummary `json:"payment"`
+	Data    []RefundResponse     `json:"data"`
+}
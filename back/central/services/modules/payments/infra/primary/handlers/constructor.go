@@ -28,6 +28,10 @@ type IHandlers interface {
 	DeletePaymentMapping(c *gin.Context)
 	TogglePaymentMapping(c *gin.Context)
 
+	// Refunds
+	CreateRefund(c *gin.Context)
+	ListPaymentRefunds(c *gin.Context)
+
 	// Routes
 	RegisterRoutes(router *gin.RouterGroup)
 }
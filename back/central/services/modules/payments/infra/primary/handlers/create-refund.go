@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/auth/middleware"
+	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
+)
+
+// CreateRefund godoc
+// @Summary      Reembolsar pago
+// @Description  Reembolsa total o parcialmente un pago completado, con motivo y líneas opcionales. Recalcula el saldo pagado de la orden, publica order.refunded y, con sync_to_platform, registra el reembolso en la plataforma de origen
+// @Tags         Payment Refunds
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                         true  "ID del pago"
+// @Param        request  body      domain.CreateRefundRequest  true  "Datos del reembolso"
+// @Success      201      {object}  domain.CreateRefundResponse
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Failure      409      {object}  map[string]string
+// @Router       /payments/{id}/refunds [post]
+func (h *PaymentHandlers) CreateRefund(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req domain.CreateRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if userID, ok := middleware.GetUserID(c); ok && userID > 0 {
+		req.CreatedBy = &userID
+	}
+
+	response, err := h.uc.CreateRefund(c.Request.Context(), uint(id), &req)
+	if err != nil {
+		respondRefundError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// respondRefundError responde el error de reembolso con el status HTTP que le corresponde
+func respondRefundError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrPaymentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrPaymentNotRefundable),
+		errors.Is(err, domain.ErrRefundExceedsPaid):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidRefundItems),
+		errors.Is(err, domain.ErrInvalidRefundAmount):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
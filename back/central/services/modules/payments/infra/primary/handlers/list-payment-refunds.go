@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListPaymentRefunds godoc
+// @Summary      Listar reembolsos de un pago
+// @Description  Obtiene los reembolsos de un pago con sus líneas, lo reembolsado y lo que queda por reembolsar
+// @Tags         Payment Refunds
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del pago"
+// @Success      200  {object}  domain.PaymentRefundsResponse
+// @Failure      400  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /payments/{id}/refunds [get]
+func (h *PaymentHandlers) ListPaymentRefunds(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	response, err := h.uc.ListPaymentRefunds(c.Request.Context(), uint(id))
+	if err != nil {
+		respondRefundError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
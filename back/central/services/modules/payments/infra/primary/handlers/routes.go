@@ -28,5 +28,9 @@ func (h *PaymentHandlers) RegisterRoutes(router *gin.RouterGroup) {
 			mappings.DELETE("/:id", h.DeletePaymentMapping)                       // DELETE /api/v1/payments/mappings/:id
 			mappings.PATCH("/:id/toggle", h.TogglePaymentMapping)                 // PATCH /api/v1/payments/mappings/:id/toggle
 		}
+
+		// Refunds routes
+		payments.POST("/:id/refunds", h.CreateRefund)      // POST /api/v1/payments/:id/refunds
+		payments.GET("/:id/refunds", h.ListPaymentRefunds) // GET /api/v1/payments/:id/refunds
 	}
 }
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
+	"github.com/secamc93/probability/back/central/shared/paymentledger"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ═══════════════════════════════════════════
+// REFUND REPOSITORY
+// ═══════════════════════════════════════════
+
+// refundableItemRow es la línea de la orden con su SKU y las unidades ya reembolsadas
+type refundableItemRow struct {
+	ID               uint
+	SKU              string
+	VariantID        *string
+	Quantity         int
+	TotalPrice       float64
+	RefundedQuantity int
+}
+
+func (r *Repository) GetPaymentWithOrder(ctx context.Context, id uint) (*models.Payment, error) {
+	var payment models.Payment
+	err := r.db.Conn(ctx).Preload("Order").Where("id = ?", id).First(&payment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	if payment.Order.DeletedAt != nil {
+		return nil, domain.ErrPaymentNotFound
+	}
+	return &payment, nil
+}
+
+// listRefundableOrderItems obtiene, dentro de la transacción, las líneas de la orden con el SKU de su
+// variante o producto y las unidades ya incluidas en reembolsos anteriores
+func listRefundableOrderItems(tx *gorm.DB, orderID string) ([]domain.RefundableOrderItem, error) {
+	var rows []refundableItemRow
+	err := tx.
+		Table("order_items AS oi").
+		Select(`oi.id, COALESCE(pv.sku, p.sku, '') AS sku, oi.variant_id, oi.quantity, oi.total_price,
+			COALESCE((SELECT SUM(ri.quantity) FROM payment_refund_items ri
+				WHERE ri.order_item_id = oi.id AND ri.deleted_at IS NULL), 0) AS refunded_quantity`).
+		Joins("LEFT JOIN product_variants pv ON pv.id = oi.product_variant_id").
+		Joins("LEFT JOIN products p ON p.id = oi.product_id").
+		Where("oi.order_id = ? AND oi.deleted_at IS NULL", orderID).
+		Order("oi.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.RefundableOrderItem, len(rows))
+	for i, row := range rows {
+		items[i] = domain.RefundableOrderItem{
+			ID:               row.ID,
+			SKU:              row.SKU,
+			Quantity:         row.Quantity,
+			TotalPrice:       row.TotalPrice,
+			RefundedQuantity: row.RefundedQuantity,
+		}
+		if row.VariantID != nil {
+			items[i].ExternalVariantID = *row.VariantID
+		}
+	}
+	return items, nil
+}
+
+// CreateRefund registra el reembolso en una transacción: bloquea la orden y el pago, arma las líneas y
+// el monto con las unidades y el saldo vigentes (dos reembolsos simultáneos no pueden devolver las
+// mismas unidades ni más de lo pagado), acumula el reembolso en el pago y recalcula el saldo de la orden
+func (r *Repository) CreateRefund(ctx context.Context, refund *models.PaymentRefund, req *domain.CreateRefundRequest) (*models.Payment, []domain.RefundWriteBackLine, *domain.OrderPaymentBalance, error) {
+	var payment models.Payment
+	var lines []domain.RefundWriteBackLine
+	var balance *domain.OrderPaymentBalance
+
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", refund.OrderID).
+			First(&order).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrPaymentNotFound
+			}
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", refund.PaymentID).
+			First(&payment).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrPaymentNotFound
+			}
+			return err
+		}
+		if payment.Status != domain.PaymentStatusCompleted && payment.Status != domain.PaymentStatusRefunded {
+			return domain.ErrPaymentNotRefundable
+		}
+
+		var orderItems []domain.RefundableOrderItem
+		if len(req.Items) > 0 {
+			var err error
+			if orderItems, err = listRefundableOrderItems(tx, order.ID); err != nil {
+				return err
+			}
+		}
+		var err error
+		if lines, err = domain.PrepareRefund(refund, req, orderItems, &payment); err != nil {
+			return err
+		}
+
+		refunded := 0.0
+		if payment.RefundAmount != nil {
+			refunded = *payment.RefundAmount
+		}
+		newRefunded := domain.RoundAmount(refunded + refund.Amount)
+
+		now := time.Now()
+		payment.RefundAmount = &newRefunded
+		payment.RefundedAt = &now
+		if newRefunded >= payment.Amount-domain.AmountTolerance/2 {
+			payment.Status = domain.PaymentStatusRefunded
+		}
+		if err := tx.Model(&payment).Updates(map[string]interface{}{
+			"refund_amount": newRefunded,
+			"refunded_at":   now,
+			"status":        payment.Status,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(refund).Error; err != nil {
+			return err
+		}
+
+		balance, err = recomputeOrderBalance(tx, payment.OrderID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return &payment, lines, balance, nil
+}
+
+// recomputeOrderBalance recalcula el saldo de la orden con el cálculo compartido de pagos (que también
+// actualiza is_paid, paid_at y el método de pago principal) y lo retorna con la moneda de la orden
+func recomputeOrderBalance(tx *gorm.DB, orderID string) (*domain.OrderPaymentBalance, error) {
+	summary, err := paymentledger.RecomputeOrder(tx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var order models.Order
+	if err := tx.Select("id", "currency").Where("id = ?", orderID).First(&order).Error; err != nil {
+		return nil, err
+	}
+
+	return &domain.OrderPaymentBalance{
+		OrderID:        order.ID,
+		TotalAmount:    summary.TotalAmount,
+		Currency:       order.Currency,
+		PaidAmount:     summary.PaidAmount,
+		RefundedAmount: summary.RefundedAmount,
+		BalanceDue:     summary.BalanceDue,
+		IsPaid:         summary.IsPaid,
+		PaidAt:         summary.PaidAt,
+	}, nil
+}
+
+func (r *Repository) UpdateRefundWriteBack(ctx context.Context, refund *models.PaymentRefund) error {
+	return r.db.Conn(ctx).Model(&models.PaymentRefund{}).
+		Where("id = ?", refund.ID).
+		Updates(map[string]interface{}{
+			"write_back_status":  refund.WriteBackStatus,
+			"external_refund_id": refund.ExternalRefundID,
+			"write_back_error":   refund.WriteBackError,
+			"write_back_at":      refund.WriteBackAt,
+		}).Error
+}
+
+func (r *Repository) ListPaymentRefunds(ctx context.Context, paymentID uint) ([]models.PaymentRefund, error) {
+	var refunds []models.PaymentRefund
+	err := r.db.Conn(ctx).
+		Preload("Items").
+		Where("payment_id = ?", paymentID).
+		Order("created_at DESC").
+		Find(&refunds).Error
+	return refunds, err
+}
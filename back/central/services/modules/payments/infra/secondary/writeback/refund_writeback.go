@@ -0,0 +1,65 @@
+package writeback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/modules/payments/domain"
+)
+
+// RefundWriteBack registra los reembolsos en la plataforma de origen a través del core de
+// integraciones, que delega en el escritor de reembolsos registrado por cada tipo (Shopify, ...)
+type RefundWriteBack struct {
+	core core.IIntegrationCore
+}
+
+// New crea el write-back de reembolsos
+func New(integrationCore core.IIntegrationCore) domain.IRefundWriteBack {
+	return &RefundWriteBack{core: integrationCore}
+}
+
+// PushRefund registra el reembolso en la integración de la orden y retorna el ID externo del reembolso
+func (w *RefundWriteBack) PushRefund(ctx context.Context, refund *domain.RefundWriteBack) (string, error) {
+	integration, err := w.core.GetIntegrationByID(ctx, refund.IntegrationID)
+	if err != nil {
+		if errors.Is(err, core.ErrIntegrationNotFound) {
+			return "", fmt.Errorf("%w: integration %d not found", domain.ErrRefundWriteBackNotSupported, refund.IntegrationID)
+		}
+		return "", err
+	}
+	if integration.IntegrationType == nil {
+		return "", domain.ErrRefundWriteBackNotSupported
+	}
+
+	writer, err := w.core.GetRefundWriter(integration.IntegrationType.Code)
+	if err != nil {
+		if errors.Is(err, core.ErrRefundWriterNotFound) {
+			return "", fmt.Errorf("%w: %s", domain.ErrRefundWriteBackNotSupported, integration.IntegrationType.Code)
+		}
+		return "", err
+	}
+
+	lines := make([]core.RefundWriteBackLine, len(refund.Lines))
+	for i, line := range refund.Lines {
+		lines[i] = core.RefundWriteBackLine{
+			ExternalVariantID: line.ExternalVariantID,
+			SKU:               line.SKU,
+			Quantity:          line.Quantity,
+		}
+	}
+
+	result, err := writer.PushRefund(ctx, integration, &core.RefundWriteBack{
+		ExternalOrderID: refund.ExternalOrderID,
+		Amount:          refund.Amount,
+		Currency:        refund.Currency,
+		Reason:          refund.Reason,
+		Note:            refund.Note,
+		Lines:           lines,
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.ExternalRefundID, nil
+}
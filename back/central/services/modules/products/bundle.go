@@ -1,26 +1,57 @@
 package products
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/core"
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/primary/worker"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/catalog"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/files"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/images"
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/repository"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/storage"
 )
 
 // New inicializa el módulo de products
-func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig) {
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, integrationCore core.IIntegrationCore, s3Service storage.IS3Service) {
 	// 1. Init Repositories
 	repo := repository.New(database)
 
+	// 1.1. Init Catalog Import adapters (catálogo de integraciones e imágenes en S3)
+	var catalogSource domain.ICatalogSource
+	if integrationCore != nil {
+		catalogSource = catalog.New(integrationCore)
+	}
+	var imageStorage domain.IImageStorage
+	var fileStorage domain.IFileStorage
+	if s3Service != nil {
+		imageStorage = images.New(s3Service)
+		fileStorage = files.New(s3Service)
+	}
+
 	// 2. Init Use Cases
-	uc := usecases.New(repo)
+	uc := usecases.New(repo, catalogSource, imageStorage, fileStorage)
 
 	// 3. Init Handlers
 	h := handlers.New(uc)
 
 	// 4. Register Routes
 	h.RegisterRoutes(router)
+
+	// 5. Init Worker de importación/exportación masiva (requiere S3 para los archivos)
+	if fileStorage != nil {
+		worker.New(uc.Bulk, logger).Start(context.Background())
+	}
+
+	// 6. Init Worker de importación de catálogo (requiere acceso a las integraciones)
+	if catalogSource != nil {
+		worker.NewCatalogWorker(uc.ProductCRUD, logger).Start(context.Background())
+	}
 }
@@ -0,0 +1,115 @@
+package usecasebulk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// normalizeColumnMapping valida que el mapeo apunte a campos importables.
+// Las llaves (columnas del archivo) se guardan normalizadas para compararlas con el encabezado.
+func normalizeColumnMapping(mapping map[string]string) (map[string]string, error) {
+	if len(mapping) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]string, len(mapping))
+	targets := make(map[string]string, len(mapping))
+	for column, field := range mapping {
+		key := normalizeHeader(column)
+		field = strings.ToLower(strings.TrimSpace(field))
+		if key == "" {
+			return nil, fmt.Errorf("%w: empty column name", domain.ErrInvalidColumnMapping)
+		}
+		if !domain.IsBulkProductField(field) {
+			return nil, fmt.Errorf("%w: unknown field %q for column %q", domain.ErrInvalidColumnMapping, field, column)
+		}
+		if previous, exists := targets[field]; exists {
+			return nil, fmt.Errorf("%w: columns %q and %q map to field %q", domain.ErrInvalidColumnMapping, previous, column, field)
+		}
+		targets[field] = column
+		result[key] = field
+	}
+
+	if _, ok := targets[domain.BulkFieldSKU]; !ok {
+		return nil, fmt.Errorf("%w: sku column is required", domain.ErrInvalidColumnMapping)
+	}
+	return result, nil
+}
+
+// resolveColumns asocia cada columna del encabezado con su campo de producto.
+// Sin mapeo, las columnas cuyo encabezado coincide con el nombre de un campo se importan y el resto se ignora.
+func resolveColumns(header []string, mapping map[string]string) (map[int]string, error) {
+	columns := make(map[int]string)
+	seen := make(map[string]bool)
+	for i, cell := range header {
+		key := normalizeHeader(cell)
+		if key == "" {
+			continue
+		}
+
+		field := key
+		if mapping != nil {
+			var ok bool
+			if field, ok = mapping[key]; !ok {
+				continue
+			}
+		} else if !domain.IsBulkProductField(field) {
+			continue
+		}
+
+		if seen[field] {
+			return nil, fmt.Errorf("%w: field %q appears in more than one column", domain.ErrInvalidColumnMapping, field)
+		}
+		seen[field] = true
+		columns[i] = field
+	}
+
+	for column := range mapping {
+		if !seen[mapping[column]] {
+			return nil, fmt.Errorf("%w: column %q not found in file", domain.ErrInvalidColumnMapping, column)
+		}
+	}
+	if !seen[domain.BulkFieldSKU] {
+		return nil, fmt.Errorf("%w: sku column not found in file", domain.ErrInvalidColumnMapping)
+	}
+	return columns, nil
+}
+
+// rowValues extrae los valores de la fila por campo (sin espacios al inicio y al final)
+func rowValues(row []string, columns map[int]string) map[string]string {
+	values := make(map[string]string, len(columns))
+	for i, field := range columns {
+		if i < len(row) {
+			values[field] = strings.TrimSpace(row[i])
+		}
+	}
+	return values
+}
+
+// countDataRows cuenta las filas con datos después del encabezado
+func countDataRows(rows [][]string) int {
+	count := 0
+	for _, row := range rows[1:] {
+		if !isEmptyRow(row) {
+			count++
+		}
+	}
+	return count
+}
+
+func isEmptyRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeHeader normaliza un encabezado: minúsculas, sin espacios extremos y con "_" en lugar de espacios
+func normalizeHeader(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.Join(strings.Fields(value), "_")
+}
@@ -0,0 +1,19 @@
+package usecasebulk
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// UseCaseBulk contiene los casos de uso de importación y exportación masiva de productos
+type UseCaseBulk struct {
+	repo  domain.IRepository
+	files domain.IFileStorage // nil = importación/exportación masiva no disponible
+}
+
+// New crea una nueva instancia de UseCaseBulk
+func New(repo domain.IRepository, files domain.IFileStorage) *UseCaseBulk {
+	return &UseCaseBulk{
+		repo:  repo,
+		files: files,
+	}
+}
@@ -0,0 +1,112 @@
+package usecasebulk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// exportPageSize productos leídos por página al exportar
+const exportPageSize = 500
+
+// processExport recorre el listado filtrado de productos y lo escribe con las mismas columnas
+// que acepta la importación, de modo que el archivo exportado se puede editar y volver a importar
+func (uc *UseCaseBulk) processExport(ctx context.Context, job *domain.ProductBulkJob) error {
+	filters := job.Filters.ToMap(job.BusinessID)
+
+	rows := [][]string{domain.BulkProductFields}
+	job.ProcessedRows = 0
+	for page := 1; ; page++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		products, total, err := uc.repo.ListProducts(ctx, page, exportPageSize, filters)
+		if err != nil {
+			return fmt.Errorf("error listing products: %w", err)
+		}
+		job.TotalRows = int(total)
+
+		for i := range products {
+			rows = append(rows, productRow(&products[i]))
+		}
+		job.ProcessedRows += len(products)
+		if err := uc.saveProgress(ctx, job); err != nil {
+			return fmt.Errorf("error saving progress: %w", err)
+		}
+
+		if len(products) < exportPageSize || job.ProcessedRows >= job.TotalRows {
+			break
+		}
+	}
+
+	return uc.uploadResult(ctx, job, "export", rows)
+}
+
+// productRow convierte un producto en una fila con las columnas de domain.BulkProductFields
+func productRow(p *domain.Product) []string {
+	values := map[string]string{
+		domain.BulkFieldSKU:               p.SKU,
+		domain.BulkFieldName:              p.Name,
+		domain.BulkFieldTitle:             p.Title,
+		domain.BulkFieldDescription:       p.Description,
+		domain.BulkFieldShortDescription:  p.ShortDescription,
+		domain.BulkFieldExternalID:        p.ExternalID,
+		domain.BulkFieldPrice:             formatDecimal(&p.Price),
+		domain.BulkFieldCompareAtPrice:    formatDecimal(p.CompareAtPrice),
+		domain.BulkFieldCostPrice:         formatDecimal(p.CostPrice),
+		domain.BulkFieldCurrency:          p.Currency,
+		domain.BulkFieldStockQuantity:     strconv.Itoa(p.StockQuantity),
+		domain.BulkFieldTrackInventory:    strconv.FormatBool(p.TrackInventory),
+		domain.BulkFieldAllowBackorder:    strconv.FormatBool(p.AllowBackorder),
+		domain.BulkFieldLowStockThreshold: formatInt(p.LowStockThreshold),
+		domain.BulkFieldWeight:            formatDecimal(p.Weight),
+		domain.BulkFieldWeightUnit:        p.WeightUnit,
+		domain.BulkFieldLength:            formatDecimal(p.Length),
+		domain.BulkFieldWidth:             formatDecimal(p.Width),
+		domain.BulkFieldHeight:            formatDecimal(p.Height),
+		domain.BulkFieldDimensionUnit:     p.DimensionUnit,
+		domain.BulkFieldCategory:          p.Category,
+		domain.BulkFieldBrand:             p.Brand,
+		domain.BulkFieldTags:              formatTags(p.Tags),
+		domain.BulkFieldStatus:            p.Status,
+		domain.BulkFieldIsActive:          strconv.FormatBool(p.IsActive),
+		domain.BulkFieldImageURL:          p.ImageURL,
+	}
+
+	row := make([]string, len(domain.BulkProductFields))
+	for i, field := range domain.BulkProductFields {
+		row[i] = values[field]
+	}
+	return row
+}
+
+func formatDecimal(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func formatInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// formatTags convierte el arreglo JSON de tags en una lista separada por comas
+func formatTags(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	var tags []string
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return ""
+	}
+	return strings.Join(tags, ", ")
+}
@@ -0,0 +1,180 @@
+package usecasebulk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/spreadsheet"
+)
+
+// importReportHeader columnas del reporte de resultado de la importación
+var importReportHeader = []string{"row", "sku", "result", "error"}
+
+// processImport lee el archivo del trabajo y hace upsert por SKU de cada fila.
+// Las filas con errores de validación no se guardan y quedan en el reporte de resultado.
+func (uc *UseCaseBulk) processImport(ctx context.Context, job *domain.ProductBulkJob) error {
+	data, err := uc.files.Download(ctx, job.SourceFileKey)
+	if err != nil {
+		return fmt.Errorf("error downloading import file: %w", err)
+	}
+	rows, err := spreadsheet.Read(job.Format, data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidBulkFile, err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%w: file is empty", domain.ErrInvalidBulkFile)
+	}
+	columns, err := resolveColumns(rows[0], job.ColumnMapping)
+	if err != nil {
+		return err
+	}
+
+	job.TotalRows = countDataRows(rows)
+	job.ProcessedRows, job.CreatedCount, job.UpdatedCount, job.ErrorCount = 0, 0, 0, 0
+	job.RowErrors = nil
+
+	report := [][]string{importReportHeader}
+	seenSKUs := make(map[string]int)
+
+	for i, row := range rows[1:] {
+		if isEmptyRow(row) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		rowNumber := i + 2 // El encabezado es la fila 1
+		values := rowValues(row, columns)
+		sku := values[domain.BulkFieldSKU]
+
+		var result string
+		var issues []domain.BulkRowIssue
+		if firstRow, duplicated := seenSKUs[sku]; duplicated && sku != "" {
+			issues = []domain.BulkRowIssue{{
+				Field:   domain.BulkFieldSKU,
+				Message: fmt.Sprintf("duplicate sku, already imported in row %d", firstRow),
+			}}
+		} else {
+			seenSKUs[sku] = rowNumber
+			result, issues = uc.importRow(ctx, job, values)
+		}
+
+		job.ProcessedRows++
+		if len(issues) > 0 {
+			job.ErrorCount++
+			messages := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				issue.Row = rowNumber
+				issue.SKU = sku
+				if len(job.RowErrors) < domain.MaxBulkRowErrors {
+					job.RowErrors = append(job.RowErrors, issue)
+				}
+				messages = append(messages, issueText(issue))
+			}
+			report = append(report, []string{strconv.Itoa(rowNumber), sku, domain.BulkRowError, strings.Join(messages, "; ")})
+		} else {
+			switch result {
+			case domain.BulkRowCreated, domain.BulkRowWouldCreate:
+				job.CreatedCount++
+			case domain.BulkRowUpdated, domain.BulkRowWouldUpdate:
+				job.UpdatedCount++
+			}
+			report = append(report, []string{strconv.Itoa(rowNumber), sku, result, ""})
+		}
+
+		if job.ProcessedRows%progressEvery == 0 {
+			if err := uc.saveProgress(ctx, job); err != nil {
+				return fmt.Errorf("error saving progress: %w", err)
+			}
+		}
+	}
+
+	return uc.uploadResult(ctx, job, "result", report)
+}
+
+// importRow valida y guarda una fila. En dry-run solo valida e indica qué se haría.
+func (uc *UseCaseBulk) importRow(ctx context.Context, job *domain.ProductBulkJob, values map[string]string) (string, []domain.BulkRowIssue) {
+	sku := values[domain.BulkFieldSKU]
+	if sku == "" {
+		return "", []domain.BulkRowIssue{{Field: domain.BulkFieldSKU, Message: "sku is required"}}
+	}
+	if len(sku) > 128 {
+		return "", []domain.BulkRowIssue{{Field: domain.BulkFieldSKU, Message: "sku must be at most 128 characters"}}
+	}
+
+	product, err := uc.repo.GetProductBySKU(ctx, job.BusinessID, sku)
+	if err != nil && !errors.Is(err, domain.ErrProductNotFound) {
+		return "", []domain.BulkRowIssue{{Message: err.Error()}}
+	}
+
+	created := product == nil
+	if created {
+		// El SKU no puede estar en uso por una variante del negocio
+		inUse, err := uc.repo.VariantSKUExists(ctx, job.BusinessID, sku, "")
+		if err != nil {
+			return "", []domain.BulkRowIssue{{Message: err.Error()}}
+		}
+		if inUse {
+			return "", []domain.BulkRowIssue{{Field: domain.BulkFieldSKU, Message: "sku belongs to a product variant"}}
+		}
+		product = &domain.Product{
+			BusinessID: job.BusinessID,
+			SKU:        sku,
+		}
+	}
+
+	issues := applyRow(product, values)
+	if created && product.Name == "" {
+		issues = append(issues, domain.BulkRowIssue{Field: domain.BulkFieldName, Message: "name is required for new products"})
+	}
+	if len(issues) > 0 {
+		return "", issues
+	}
+
+	if job.DryRun {
+		if created {
+			return domain.BulkRowWouldCreate, nil
+		}
+		return domain.BulkRowWouldUpdate, nil
+	}
+
+	if created {
+		if err := uc.repo.CreateProduct(ctx, product); err != nil {
+			return "", []domain.BulkRowIssue{{Message: fmt.Sprintf("error creating product: %v", err)}}
+		}
+		return domain.BulkRowCreated, nil
+	}
+	if err := uc.repo.UpdateProduct(ctx, product); err != nil {
+		return "", []domain.BulkRowIssue{{Message: fmt.Sprintf("error updating product: %v", err)}}
+	}
+	return domain.BulkRowUpdated, nil
+}
+
+// uploadResult escribe las filas en el formato del trabajo y las sube como archivo de resultado
+func (uc *UseCaseBulk) uploadResult(ctx context.Context, job *domain.ProductBulkJob, name string, rows [][]string) error {
+	var buf bytes.Buffer
+	if err := spreadsheet.Write(job.Format, &buf, rows); err != nil {
+		return fmt.Errorf("error writing result file: %w", err)
+	}
+
+	job.ResultFileKey = bulkFileKey(job, name)
+	url, err := uc.files.Upload(ctx, job.ResultFileKey, buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("error uploading result file: %w", err)
+	}
+	job.ResultFileURL = url
+	return nil
+}
+
+func issueText(issue domain.BulkRowIssue) string {
+	if issue.Field == "" {
+		return issue.Message
+	}
+	return issue.Field + ": " + issue.Message
+}
@@ -0,0 +1,169 @@
+package usecasebulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/spreadsheet"
+)
+
+// CreateImportJob valida el archivo, lo guarda en el almacenamiento y encola su importación
+func (uc *UseCaseBulk) CreateImportJob(ctx context.Context, req *domain.ImportProductsRequest) (*domain.ProductBulkJob, error) {
+	if uc.files == nil {
+		return nil, domain.ErrBulkUnavailable
+	}
+
+	format, err := resolveFormat(req.Format, req.FileName)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := normalizeColumnMapping(req.ColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	// El encabezado y el mapeo se validan al recibir el archivo para no encolar trabajos que fallarán
+	rows, err := spreadsheet.Read(format, req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidBulkFile, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", domain.ErrInvalidBulkFile)
+	}
+	if _, err := resolveColumns(rows[0], mapping); err != nil {
+		return nil, err
+	}
+	totalRows := countDataRows(rows)
+	if totalRows == 0 {
+		return nil, fmt.Errorf("%w: file has no data rows", domain.ErrInvalidBulkFile)
+	}
+	if totalRows > domain.MaxBulkRows {
+		return nil, fmt.Errorf("%w: file has %d rows, max %d", domain.ErrInvalidBulkFile, totalRows, domain.MaxBulkRows)
+	}
+
+	job := &domain.ProductBulkJob{
+		BusinessID:     req.BusinessID,
+		Type:           domain.BulkJobTypeImport,
+		Format:         format,
+		Status:         domain.BulkJobStatusPending,
+		DryRun:         req.DryRun,
+		ColumnMapping:  mapping,
+		SourceFileName: req.FileName,
+		TotalRows:      totalRows,
+		CreatedBy:      req.CreatedBy,
+	}
+	if err := uc.repo.CreateBulkJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("error creating bulk job: %w", err)
+	}
+
+	// La llave del archivo incluye el ID del trabajo, por eso se sube después de crearlo
+	job.SourceFileKey = bulkFileKey(job, "source")
+	if _, err := uc.files.Upload(ctx, job.SourceFileKey, req.Data); err != nil {
+		uploadErr := fmt.Errorf("error uploading import file: %w", err)
+		_ = uc.failJob(ctx, job, uploadErr)
+		return nil, uploadErr
+	}
+	if err := uc.repo.UpdateBulkJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("error updating bulk job: %w", err)
+	}
+
+	return job, nil
+}
+
+// CreateExportJob encola la exportación del listado de productos filtrado
+func (uc *UseCaseBulk) CreateExportJob(ctx context.Context, req *domain.ExportProductsRequest) (*domain.ProductBulkJob, error) {
+	if uc.files == nil {
+		return nil, domain.ErrBulkUnavailable
+	}
+
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if !spreadsheet.IsSupportedFormat(format) {
+		return nil, domain.ErrUnsupportedFileFormat
+	}
+
+	job := &domain.ProductBulkJob{
+		BusinessID: req.BusinessID,
+		Type:       domain.BulkJobTypeExport,
+		Format:     format,
+		Status:     domain.BulkJobStatusPending,
+		Filters:    req.Filters,
+		CreatedBy:  req.CreatedBy,
+	}
+	if err := uc.repo.CreateBulkJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("error creating bulk job: %w", err)
+	}
+	return job, nil
+}
+
+// GetJob obtiene un trabajo masivo con su avance
+func (uc *UseCaseBulk) GetJob(ctx context.Context, id uint) (*domain.ProductBulkJob, error) {
+	job, err := uc.repo.GetBulkJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkJobNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting bulk job: %w", err)
+	}
+	return job, nil
+}
+
+// ListJobs lista los trabajos masivos de un negocio
+func (uc *UseCaseBulk) ListJobs(ctx context.Context, businessID uint, page, pageSize int) (*domain.BulkJobsListResponse, error) {
+	jobs, total, err := uc.repo.ListBulkJobs(ctx, businessID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bulk jobs: %w", err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &domain.BulkJobsListResponse{
+		Data:       jobs,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// failJob marca el trabajo como fallido con el error dado
+func (uc *UseCaseBulk) failJob(ctx context.Context, job *domain.ProductBulkJob, cause error) error {
+	now := time.Now()
+	job.Status = domain.BulkJobStatusFailed
+	job.ErrorMessage = truncate(cause.Error(), 1000)
+	job.FinishedAt = &now
+	if err := uc.repo.UpdateBulkJob(ctx, job); err != nil {
+		return fmt.Errorf("error marking bulk job %d as failed: %w", job.ID, err)
+	}
+	return nil
+}
+
+// resolveFormat toma el formato indicado o lo deduce de la extensión del archivo
+func resolveFormat(format, fileName string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = spreadsheet.FormatFromFilename(fileName)
+	}
+	if !spreadsheet.IsSupportedFormat(format) {
+		return "", domain.ErrUnsupportedFileFormat
+	}
+	return format, nil
+}
+
+// bulkFileKey construye la llave de un archivo del trabajo en el almacenamiento
+func bulkFileKey(job *domain.ProductBulkJob, name string) string {
+	return fmt.Sprintf("products/bulk/%d/%d/%s.%s", job.BusinessID, job.ID, name, job.Format)
+}
+
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+	return value[:max]
+}
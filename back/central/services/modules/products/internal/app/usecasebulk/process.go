@@ -0,0 +1,98 @@
+package usecasebulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// progressEvery cada cuántas filas se guarda el avance de un trabajo
+const progressEvery = 50
+
+// staleJobTimeout tiempo sin avance tras el cual un trabajo en proceso se considera abandonado
+const staleJobTimeout = 15 * time.Minute
+
+// ProcessPending toma y procesa hasta limit trabajos pendientes. Retorna cuántos procesó.
+// Los errores de cada trabajo quedan registrados en el propio trabajo; solo se retornan
+// los errores al consultar o actualizar los trabajos.
+func (uc *UseCaseBulk) ProcessPending(ctx context.Context, limit int) (int, error) {
+	if uc.files == nil {
+		return 0, nil
+	}
+
+	// Recuperar trabajos de instancias que se detuvieron a mitad de proceso
+	if _, err := uc.repo.ReleaseStaleBulkJobs(ctx, staleJobTimeout); err != nil {
+		return 0, fmt.Errorf("error releasing stale bulk jobs: %w", err)
+	}
+
+	ids, err := uc.repo.ListPendingBulkJobIDs(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error listing pending bulk jobs: %w", err)
+	}
+
+	processed := 0
+	var errs []error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Varias instancias pueden ver el mismo trabajo pendiente: solo una lo toma
+		claimed, err := uc.repo.ClaimBulkJob(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error claiming bulk job %d: %w", id, err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := uc.processJob(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+		processed++
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// processJob ejecuta un trabajo ya tomado y registra su resultado
+func (uc *UseCaseBulk) processJob(ctx context.Context, id uint) error {
+	job, err := uc.repo.GetBulkJob(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error getting bulk job %d: %w", id, err)
+	}
+
+	switch job.Type {
+	case domain.BulkJobTypeImport:
+		err = uc.processImport(ctx, job)
+	case domain.BulkJobTypeExport:
+		err = uc.processExport(ctx, job)
+	default:
+		err = fmt.Errorf("unknown bulk job type %q", job.Type)
+	}
+	if err != nil {
+		return uc.failJob(ctx, job, err)
+	}
+
+	now := time.Now()
+	job.Status = domain.BulkJobStatusCompleted
+	job.FinishedAt = &now
+	if err := uc.repo.UpdateBulkJob(ctx, job); err != nil {
+		return fmt.Errorf("error completing bulk job %d: %w", job.ID, err)
+	}
+	return nil
+}
+
+// saveProgress guarda los contadores del trabajo en curso
+func (uc *UseCaseBulk) saveProgress(ctx context.Context, job *domain.ProductBulkJob) error {
+	return uc.repo.UpdateBulkJobProgress(ctx, job.ID, domain.BulkProgress{
+		ProcessedRows: job.ProcessedRows,
+		CreatedCount:  job.CreatedCount,
+		UpdatedCount:  job.UpdatedCount,
+		ErrorCount:    job.ErrorCount,
+	})
+}
@@ -0,0 +1,178 @@
+package usecasebulk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// Estados de producto válidos (igual que la validación de CreateProductRequest)
+var productStatuses = map[string]bool{"active": true, "draft": true, "archived": true}
+
+// rowParser aplica los valores de una fila al producto acumulando los errores por campo.
+// Las celdas vacías no modifican el producto.
+type rowParser struct {
+	values map[string]string
+	issues []domain.BulkRowIssue
+}
+
+func (p *rowParser) fail(field, format string, args ...interface{}) {
+	p.issues = append(p.issues, domain.BulkRowIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *rowParser) text(field string, max int, dst *string) {
+	value := p.values[field]
+	if value == "" {
+		return
+	}
+	if max > 0 && len(value) > max {
+		p.fail(field, "must be at most %d characters", max)
+		return
+	}
+	*dst = value
+}
+
+func (p *rowParser) number(field string) (float64, bool) {
+	value := p.values[field]
+	if value == "" {
+		return 0, false
+	}
+	n, err := parseDecimal(value)
+	if err != nil {
+		p.fail(field, "invalid number %q", value)
+		return 0, false
+	}
+	if n < 0 {
+		p.fail(field, "must be greater than or equal to 0")
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *rowParser) decimal(field string, dst *float64) {
+	if n, ok := p.number(field); ok {
+		*dst = n
+	}
+}
+
+func (p *rowParser) optionalDecimal(field string, dst **float64) {
+	if n, ok := p.number(field); ok {
+		*dst = &n
+	}
+}
+
+func (p *rowParser) integer(field string) (int, bool) {
+	value := p.values[field]
+	if value == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		// Las hojas de cálculo suelen exportar los enteros como "10.0"
+		f, ferr := parseDecimal(value)
+		if ferr != nil || f != float64(int(f)) {
+			p.fail(field, "invalid integer %q", value)
+			return 0, false
+		}
+		n = int(f)
+	}
+	if n < 0 {
+		p.fail(field, "must be greater than or equal to 0")
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *rowParser) boolean(field string, dst *bool) bool {
+	value := strings.ToLower(p.values[field])
+	switch value {
+	case "":
+		return false
+	case "true", "1", "yes", "y", "si", "sí", "s", "verdadero":
+		*dst = true
+	case "false", "0", "no", "n", "falso":
+		*dst = false
+	default:
+		p.fail(field, "invalid boolean %q", p.values[field])
+		return false
+	}
+	return true
+}
+
+// applyRow copia al producto los valores no vacíos de la fila y retorna los errores de validación
+func applyRow(product *domain.Product, values map[string]string) []domain.BulkRowIssue {
+	p := &rowParser{values: values}
+
+	p.text(domain.BulkFieldName, 255, &product.Name)
+	p.text(domain.BulkFieldTitle, 500, &product.Title)
+	p.text(domain.BulkFieldDescription, 0, &product.Description)
+	p.text(domain.BulkFieldShortDescription, 500, &product.ShortDescription)
+	p.text(domain.BulkFieldExternalID, 255, &product.ExternalID)
+	p.text(domain.BulkFieldCurrency, 10, &product.Currency)
+	p.text(domain.BulkFieldWeightUnit, 10, &product.WeightUnit)
+	p.text(domain.BulkFieldDimensionUnit, 10, &product.DimensionUnit)
+	p.text(domain.BulkFieldCategory, 255, &product.Category)
+	p.text(domain.BulkFieldBrand, 255, &product.Brand)
+	p.text(domain.BulkFieldImageURL, 500, &product.ImageURL)
+	product.Currency = strings.ToUpper(product.Currency)
+
+	p.decimal(domain.BulkFieldPrice, &product.Price)
+	p.optionalDecimal(domain.BulkFieldCompareAtPrice, &product.CompareAtPrice)
+	p.optionalDecimal(domain.BulkFieldCostPrice, &product.CostPrice)
+	p.optionalDecimal(domain.BulkFieldWeight, &product.Weight)
+	p.optionalDecimal(domain.BulkFieldLength, &product.Length)
+	p.optionalDecimal(domain.BulkFieldWidth, &product.Width)
+	p.optionalDecimal(domain.BulkFieldHeight, &product.Height)
+
+	if n, ok := p.integer(domain.BulkFieldStockQuantity); ok {
+		product.StockQuantity = n
+	}
+	if n, ok := p.integer(domain.BulkFieldLowStockThreshold); ok {
+		product.LowStockThreshold = &n
+	}
+
+	p.boolean(domain.BulkFieldTrackInventory, &product.TrackInventory)
+	p.boolean(domain.BulkFieldAllowBackorder, &product.AllowBackorder)
+	isActiveSet := p.boolean(domain.BulkFieldIsActive, &product.IsActive)
+
+	if status := strings.ToLower(values[domain.BulkFieldStatus]); status != "" {
+		if !productStatuses[status] {
+			p.fail(domain.BulkFieldStatus, "must be one of active, draft, archived")
+		} else {
+			product.Status = status
+			// Sin columna is_active, el estado define si el producto está activo
+			if !isActiveSet {
+				product.IsActive = status == "active"
+			}
+		}
+	}
+
+	if tags := values[domain.BulkFieldTags]; tags != "" {
+		if data, err := json.Marshal(splitTags(tags)); err == nil {
+			product.Tags = data
+		}
+	}
+
+	return p.issues
+}
+
+// parseDecimal acepta punto o coma como separador decimal (ej: "19.90" o "19,90")
+func parseDecimal(value string) (float64, error) {
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func splitTags(value string) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
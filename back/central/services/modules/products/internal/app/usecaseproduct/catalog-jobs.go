@@ -0,0 +1,125 @@
+package usecaseproduct
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// staleCatalogJobTimeout tiempo sin avance tras el cual una importación en proceso se considera abandonada
+const staleCatalogJobTimeout = 15 * time.Minute
+
+// ProcessPendingCatalogImports toma y procesa hasta limit importaciones de catálogo pendientes. Retorna
+// cuántas procesó. Los errores de cada importación quedan registrados en el propio trabajo; solo se
+// retornan los errores al consultar o actualizar los trabajos.
+func (uc *UseCaseProduct) ProcessPendingCatalogImports(ctx context.Context, limit int) (int, error) {
+	if uc.catalog == nil {
+		return 0, nil
+	}
+
+	// Recuperar importaciones de instancias que se detuvieron a mitad de proceso
+	if _, err := uc.repo.ReleaseStaleCatalogImportJobs(ctx, staleCatalogJobTimeout); err != nil {
+		return 0, fmt.Errorf("error releasing stale catalog import jobs: %w", err)
+	}
+
+	ids, err := uc.repo.ListPendingCatalogImportJobIDs(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error listing pending catalog import jobs: %w", err)
+	}
+
+	processed := 0
+	var errs []error
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Varias instancias pueden ver la misma importación pendiente: solo una la toma
+		claimed, err := uc.repo.ClaimCatalogImportJob(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error claiming catalog import job %d: %w", id, err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := uc.processCatalogImportJob(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+		processed++
+	}
+
+	return processed, errors.Join(errs...)
+}
+
+// processCatalogImportJob ejecuta una importación ya tomada y registra su resultado
+func (uc *UseCaseProduct) processCatalogImportJob(ctx context.Context, id uint) error {
+	job, err := uc.repo.GetCatalogImportJob(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error getting catalog import job %d: %w", id, err)
+	}
+
+	if err := uc.importCatalog(ctx, job); err != nil {
+		job.Status = domain.BulkJobStatusFailed
+		job.ErrorMessage = truncateRunes(err.Error(), 1000)
+	} else {
+		job.Status = domain.BulkJobStatusCompleted
+	}
+	if job.Report != nil {
+		job.Pages = job.Report.Pages
+		job.ProductsRead = job.Report.ProductsRead
+	}
+	now := time.Now()
+	job.FinishedAt = &now
+
+	if err := uc.repo.UpdateCatalogImportJob(ctx, job); err != nil {
+		return fmt.Errorf("error finishing catalog import job %d: %w", job.ID, err)
+	}
+	return nil
+}
+
+// GetCatalogImportJob obtiene una importación de catálogo con su avance y reporte
+func (uc *UseCaseProduct) GetCatalogImportJob(ctx context.Context, id uint) (*domain.CatalogImportJob, error) {
+	job, err := uc.repo.GetCatalogImportJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCatalogImportJobNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting catalog import job: %w", err)
+	}
+	return job, nil
+}
+
+// ListCatalogImportJobs lista las importaciones de catálogo de un negocio
+func (uc *UseCaseProduct) ListCatalogImportJobs(ctx context.Context, businessID uint, page, pageSize int) (*domain.CatalogImportJobsListResponse, error) {
+	jobs, total, err := uc.repo.ListCatalogImportJobs(ctx, businessID, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("error listing catalog import jobs: %w", err)
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &domain.CatalogImportJobsListResponse{
+		Data:       jobs,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// truncateRunes recorta el texto a max caracteres sin partir caracteres multibyte
+func truncateRunes(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
@@ -6,13 +6,17 @@ import (
 
 // UseCaseProduct contiene los casos de uso CRUD básicos de productos
 type UseCaseProduct struct {
-	repo domain.IRepository
+	repo    domain.IRepository
+	catalog domain.ICatalogSource // nil = importación de catálogo no disponible
+	images  domain.IImageStorage  // nil = las imágenes del catálogo no se descargan
 }
 
 // New crea una nueva instancia de UseCaseProduct
-func New(repo domain.IRepository) *UseCaseProduct {
+func New(repo domain.IRepository, catalog domain.ICatalogSource, images domain.IImageStorage) *UseCaseProduct {
 	return &UseCaseProduct{
-		repo: repo,
+		repo:    repo,
+		catalog: catalog,
+		images:  images,
 	}
 }
 
@@ -0,0 +1,411 @@
+package usecaseproduct
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// maxCatalogImages es la cantidad máxima de imágenes que se descargan por producto
+const maxCatalogImages = 10
+
+// catalogProgressEvery cada cuántos productos leídos se guarda el avance de la importación
+const catalogProgressEvery = 25
+
+// catalogImportRun es el estado de una corrida de importación
+type catalogImportRun struct {
+	integrationID  uint
+	businessID     uint
+	downloadImages bool
+	seenSKUs       map[string]bool
+	result         *domain.CatalogImportResult
+}
+
+// CreateCatalogImportJob valida la integración y encola la importación de su catálogo. El worker la
+// procesa en background y deja el reporte de la corrida en el trabajo.
+func (uc *UseCaseProduct) CreateCatalogImportJob(ctx context.Context, req *domain.ImportCatalogRequest) (*domain.CatalogImportJob, error) {
+	if uc.catalog == nil {
+		return nil, domain.ErrCatalogImportUnavailable
+	}
+
+	integration, err := uc.catalog.GetIntegration(ctx, req.IntegrationID)
+	if err != nil {
+		return nil, err
+	}
+	// Los productos pertenecen a un negocio: las integraciones globales no tienen catálogo propio
+	if integration.BusinessID == nil || (req.BusinessID != nil && *req.BusinessID != *integration.BusinessID) {
+		return nil, domain.ErrCatalogIntegrationForbidden
+	}
+	if !integration.IsActive {
+		return nil, domain.ErrCatalogIntegrationInactive
+	}
+
+	job := &domain.CatalogImportJob{
+		BusinessID:     *integration.BusinessID,
+		IntegrationID:  integration.ID,
+		Status:         domain.BulkJobStatusPending,
+		DownloadImages: req.DownloadImages == nil || *req.DownloadImages,
+		CreatedBy:      req.CreatedBy,
+	}
+	if err := uc.repo.CreateCatalogImportJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("error creating catalog import job: %w", err)
+	}
+	return job, nil
+}
+
+// importCatalog recorre el catálogo de la integración del trabajo página por página y hace upsert por
+// SKU de sus productos y variantes, asociándolos con la integración por su ID externo. Los SKUs que no
+// se pueden importar se reportan como conflictos sin detener la corrida. El reporte se guarda en el
+// trabajo a medida que avanza.
+func (uc *UseCaseProduct) importCatalog(ctx context.Context, job *domain.CatalogImportJob) error {
+	run := &catalogImportRun{
+		integrationID:  job.IntegrationID,
+		businessID:     job.BusinessID,
+		downloadImages: uc.images != nil && job.DownloadImages,
+		seenSKUs:       make(map[string]bool),
+		result: &domain.CatalogImportResult{
+			IntegrationID: job.IntegrationID,
+			BusinessID:    job.BusinessID,
+			StartedAt:     time.Now(),
+			Created:       []string{},
+			Updated:       []string{},
+			Conflicts:     []domain.CatalogConflict{},
+		},
+	}
+	job.Report = run.result
+
+	cursor := ""
+	for {
+		page, err := uc.catalog.FetchPage(ctx, job.IntegrationID, cursor)
+		if err != nil {
+			if run.result.Pages == 0 {
+				return err
+			}
+			// Lo importado en las páginas anteriores se conserva; la siguiente corrida retoma el resto
+			run.result.Error = err.Error()
+			break
+		}
+		run.result.Pages++
+
+		for i := range page.Products {
+			run.result.ProductsRead++
+			uc.importCatalogProduct(ctx, run, &page.Products[i])
+			if run.result.ProductsRead%catalogProgressEvery == 0 {
+				if err := uc.saveCatalogProgress(ctx, job); err != nil {
+					return err
+				}
+			}
+		}
+		if err := uc.saveCatalogProgress(ctx, job); err != nil {
+			return err
+		}
+
+		if page.NextCursor == "" || page.NextCursor == cursor {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	run.result.FinishedAt = time.Now()
+	return nil
+}
+
+// saveCatalogProgress guarda el avance y el reporte parcial de la importación en curso
+func (uc *UseCaseProduct) saveCatalogProgress(ctx context.Context, job *domain.CatalogImportJob) error {
+	job.Pages = job.Report.Pages
+	job.ProductsRead = job.Report.ProductsRead
+	if err := uc.repo.UpdateCatalogImportJob(ctx, job); err != nil {
+		return fmt.Errorf("error saving catalog import progress: %w", err)
+	}
+	return nil
+}
+
+// importCatalogProduct crea o actualiza el producto del SKU, lo asocia con la integración e importa sus variantes
+func (uc *UseCaseProduct) importCatalogProduct(ctx context.Context, run *catalogImportRun, item *domain.CatalogProduct) {
+	sku := strings.TrimSpace(item.SKU)
+	if !run.claimSKU(sku, item.ExternalID) {
+		return
+	}
+
+	product, err := uc.repo.GetProductBySKU(ctx, run.businessID, sku)
+	if err != nil && !errors.Is(err, domain.ErrProductNotFound) {
+		run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+		return
+	}
+
+	// El producto externo no puede quedar asociado a dos productos (ej: cambió su SKU en la plataforma)
+	linked, err := uc.repo.GetProductIntegrationByExternalID(ctx, run.integrationID, item.ExternalID)
+	if err != nil {
+		run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+		return
+	}
+	if linked != nil && (product == nil || linked.ProductID != product.ID) {
+		run.conflict(sku, item.ExternalID, domain.CatalogConflictLinkedElsewhere,
+			fmt.Sprintf("external product is linked to product %s", linked.ProductID))
+		return
+	}
+
+	created := false
+	if product == nil {
+		inUse, err := uc.repo.VariantSKUExists(ctx, run.businessID, sku, "")
+		if err != nil {
+			run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			return
+		}
+		if inUse {
+			run.conflict(sku, item.ExternalID, domain.CatalogConflictSKUInUse, "sku belongs to a product variant")
+			return
+		}
+
+		product = &domain.Product{
+			BusinessID:     run.businessID,
+			SKU:            sku,
+			ExternalID:     item.ExternalID,
+			StockQuantity:  item.StockQuantity,
+			TrackInventory: item.TrackInventory,
+		}
+		applyCatalogProduct(product, item)
+		if err := uc.repo.CreateProduct(ctx, product); err != nil {
+			run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			return
+		}
+		created = true
+		run.result.Created = append(run.result.Created, sku)
+	} else {
+		if linked == nil {
+			// El SKU existe pero está asociado en esta integración a otro producto externo
+			links, err := uc.repo.GetProductIntegrations(ctx, product.ID)
+			if err != nil {
+				run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+				return
+			}
+			for _, link := range links {
+				if link.IntegrationID == run.integrationID && link.ExternalProductID != item.ExternalID {
+					run.conflict(sku, item.ExternalID, domain.CatalogConflictLinkedElsewhere,
+						fmt.Sprintf("product is linked to external product %s", link.ExternalProductID))
+					return
+				}
+			}
+		}
+
+		// El stock no se sobrescribe: lo mueven las reservas de órdenes y los ajustes internos
+		applyCatalogProduct(product, item)
+		if err := uc.repo.UpdateProduct(ctx, product); err != nil {
+			run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			return
+		}
+		run.result.Updated = append(run.result.Updated, sku)
+	}
+
+	if linked == nil {
+		if _, err := uc.repo.AddProductIntegration(ctx, product.ID, run.integrationID, item.ExternalID); err != nil {
+			run.conflict(sku, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			return
+		}
+	}
+
+	// Las imágenes se descargan solo si el producto aún no tiene (evita descargarlas en cada corrida)
+	if run.downloadImages && (created || product.ImageURL == "") {
+		uc.storeCatalogImages(ctx, run, product, item.ImageURLs)
+	}
+
+	if len(item.Variants) > 0 {
+		uc.importCatalogVariants(ctx, run, product, item)
+	}
+}
+
+// importCatalogVariants crea o actualiza por SKU las variantes del producto y las asocia con la integración
+func (uc *UseCaseProduct) importCatalogVariants(ctx context.Context, run *catalogImportRun, product *domain.Product, item *domain.CatalogProduct) {
+	variants, err := uc.repo.ListVariants(ctx, product.ID)
+	if err != nil {
+		run.conflict(product.SKU, item.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+		return
+	}
+	product.Variants = variants
+
+	for i := range item.Variants {
+		source := &item.Variants[i]
+		sku := strings.TrimSpace(source.SKU)
+		if !run.claimSKU(sku, source.ExternalID) {
+			continue
+		}
+
+		variant, err := uc.repo.GetVariantBySKU(ctx, run.businessID, sku)
+		if err != nil && !errors.Is(err, domain.ErrVariantNotFound) {
+			run.conflict(sku, source.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			continue
+		}
+		if variant != nil && variant.ProductID != product.ID {
+			run.conflict(sku, source.ExternalID, domain.CatalogConflictSKUInUse, "sku belongs to a variant of another product")
+			continue
+		}
+
+		excludeID := ""
+		if variant != nil {
+			excludeID = variant.ID
+		}
+		options, err := validateVariantOptions(product.OptionAxes, source.Options, product.Variants, excludeID)
+		if err != nil {
+			run.conflict(sku, source.ExternalID, domain.CatalogConflictInvalidOptions, err.Error())
+			continue
+		}
+
+		if variant == nil {
+			if err := uc.validateVariantSKU(ctx, product, sku, ""); err != nil {
+				run.conflict(sku, source.ExternalID, domain.CatalogConflictSKUInUse, err.Error())
+				continue
+			}
+			variant = &domain.ProductVariant{
+				ProductID:     product.ID,
+				BusinessID:    product.BusinessID,
+				SKU:           sku,
+				StockQuantity: source.StockQuantity,
+				IsActive:      true,
+			}
+			applyCatalogVariant(variant, source, product.OptionAxes, options)
+			if err := uc.repo.CreateVariant(ctx, variant); err != nil {
+				run.conflict(sku, source.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+				continue
+			}
+			product.Variants = append(product.Variants, *variant)
+			run.result.Created = append(run.result.Created, sku)
+		} else {
+			applyCatalogVariant(variant, source, product.OptionAxes, options)
+			if err := uc.repo.UpdateVariant(ctx, variant); err != nil {
+				run.conflict(sku, source.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+				continue
+			}
+			for j := range product.Variants {
+				if product.Variants[j].ID == variant.ID {
+					product.Variants[j] = *variant
+				}
+			}
+			run.result.Updated = append(run.result.Updated, sku)
+		}
+
+		if !variantLinkedTo(variant, run.integrationID) {
+			if _, err := uc.repo.AddVariantIntegration(ctx, variant, run.integrationID, source.ExternalID); err != nil &&
+				!errors.Is(err, domain.ErrVariantIntegrationExists) {
+				run.conflict(sku, source.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+			}
+		}
+	}
+}
+
+// storeCatalogImages copia las imágenes del producto al almacenamiento propio; la primera queda como principal
+func (uc *UseCaseProduct) storeCatalogImages(ctx context.Context, run *catalogImportRun, product *domain.Product, urls []string) {
+	stored := make([]string, 0, len(urls))
+	for i, url := range urls {
+		if i >= maxCatalogImages {
+			break
+		}
+		key := fmt.Sprintf("products/%d/%s/%d", product.BusinessID, product.ID, i+1)
+		storedURL, err := uc.images.StoreImage(ctx, url, key)
+		if err != nil {
+			run.result.ImageErrors++
+			continue
+		}
+		run.result.ImagesStored++
+		stored = append(stored, storedURL)
+	}
+	if len(stored) == 0 {
+		return
+	}
+
+	product.ImageURL = stored[0]
+	if images, err := json.Marshal(stored); err == nil {
+		product.Images = images
+	}
+	if err := uc.repo.UpdateProduct(ctx, product); err != nil {
+		run.conflict(product.SKU, product.ExternalID, domain.CatalogConflictSaveFailed, err.Error())
+	}
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// claimSKU registra el SKU en la corrida; reporta conflicto si está vacío o repetido en el catálogo
+func (run *catalogImportRun) claimSKU(sku, externalID string) bool {
+	if sku == "" {
+		run.conflict(sku, externalID, domain.CatalogConflictMissingSKU, "")
+		return false
+	}
+	if run.seenSKUs[sku] {
+		run.conflict(sku, externalID, domain.CatalogConflictDuplicateSKU, "")
+		return false
+	}
+	run.seenSKUs[sku] = true
+	return true
+}
+
+func (run *catalogImportRun) conflict(sku, externalID, reason, detail string) {
+	run.result.Conflicts = append(run.result.Conflicts, domain.CatalogConflict{
+		SKU:        sku,
+		ExternalID: externalID,
+		Reason:     reason,
+		Detail:     detail,
+	})
+}
+
+// applyCatalogProduct copia al producto los datos de catálogo (no el stock ni el control de inventario)
+func applyCatalogProduct(product *domain.Product, item *domain.CatalogProduct) {
+	product.Name = item.Name
+	product.Title = item.Name
+	product.Description = item.Description
+	product.Brand = item.Brand
+	product.Category = item.Category
+	product.Price = item.Price
+	product.CompareAtPrice = item.CompareAtPrice
+	if item.Currency != "" {
+		product.Currency = item.Currency
+	}
+	if item.Weight != nil {
+		product.Weight = item.Weight
+		product.WeightUnit = item.WeightUnit
+	}
+	if item.Status != "" {
+		product.Status = item.Status
+		product.IsActive = item.Status == "active"
+	}
+	if len(item.Tags) > 0 {
+		if tags, err := json.Marshal(item.Tags); err == nil {
+			product.Tags = tags
+		}
+	}
+	if axes := normalizeOptionAxes(item.OptionAxes); len(axes) > 0 {
+		product.OptionAxes = axes
+	}
+}
+
+// applyCatalogVariant copia a la variante los datos de catálogo (no el stock)
+func applyCatalogVariant(variant *domain.ProductVariant, source *domain.CatalogVariant, axes []string, options map[string]string) {
+	variant.Options = options
+	variant.Title = source.Title
+	if variant.Title == "" {
+		variant.Title = variantTitle(axes, options, variant.SKU)
+	}
+	variant.Barcode = source.Barcode
+	variant.ExternalID = source.ExternalID
+	variant.Position = source.Position
+	variant.Price = source.Price
+	variant.CompareAtPrice = source.CompareAtPrice
+	variant.Weight = source.Weight
+}
+
+// variantLinkedTo indica si la variante ya está asociada con la integración
+func variantLinkedTo(variant *domain.ProductVariant, integrationID uint) bool {
+	for _, link := range variant.Integrations {
+		if link.IntegrationID == integrationID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+package usecaseproduct
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	VARIANT CRUD
+//
+// ───────────────────────────────────────────
+
+// CreateVariant crea una variante de un producto
+func (uc *UseCaseProduct) CreateVariant(ctx context.Context, productID string, req *domain.CreateVariantRequest) (*domain.VariantResponse, error) {
+	product, err := uc.repo.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := uc.createVariant(ctx, product, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapVariantToResponse(product, variant), nil
+}
+
+// ListVariants obtiene las variantes de un producto
+func (uc *UseCaseProduct) ListVariants(ctx context.Context, productID string) ([]domain.VariantResponse, error) {
+	product, err := uc.repo.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]domain.VariantResponse, len(product.Variants))
+	for i := range product.Variants {
+		variants[i] = *mapVariantToResponse(product, &product.Variants[i])
+	}
+	return variants, nil
+}
+
+// GetVariant obtiene una variante de un producto
+func (uc *UseCaseProduct) GetVariant(ctx context.Context, productID, variantID string) (*domain.VariantResponse, error) {
+	product, err := uc.repo.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := uc.repo.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapVariantToResponse(product, variant), nil
+}
+
+// UpdateVariant actualiza una variante de un producto
+func (uc *UseCaseProduct) UpdateVariant(ctx context.Context, productID, variantID string, req *domain.UpdateVariantRequest) (*domain.VariantResponse, error) {
+	product, err := uc.repo.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := uc.repo.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SKU != nil && *req.SKU != variant.SKU {
+		if err := uc.validateVariantSKU(ctx, product, *req.SKU, variant.ID); err != nil {
+			return nil, err
+		}
+		variant.SKU = *req.SKU
+	}
+	if req.Options != nil {
+		options, err := validateVariantOptions(product.OptionAxes, req.Options, product.Variants, variant.ID)
+		if err != nil {
+			return nil, err
+		}
+		variant.Options = options
+		if req.Title == nil {
+			variant.Title = variantTitle(product.OptionAxes, options, variant.SKU)
+		}
+	}
+	if req.Title != nil {
+		variant.Title = *req.Title
+	}
+	if req.Barcode != nil {
+		variant.Barcode = *req.Barcode
+	}
+	if req.ExternalID != nil {
+		variant.ExternalID = *req.ExternalID
+	}
+	if req.Position != nil {
+		variant.Position = *req.Position
+	}
+	if req.Price != nil {
+		variant.Price = req.Price
+	}
+	if req.CompareAtPrice != nil {
+		variant.CompareAtPrice = req.CompareAtPrice
+	}
+	if req.CostPrice != nil {
+		variant.CostPrice = req.CostPrice
+	}
+	if req.StockQuantity != nil {
+		variant.StockQuantity = *req.StockQuantity
+	}
+	if req.Weight != nil {
+		variant.Weight = req.Weight
+	}
+	if req.IsActive != nil {
+		variant.IsActive = *req.IsActive
+	}
+	if req.Metadata != nil {
+		variant.Metadata = req.Metadata
+	}
+
+	if err := uc.repo.UpdateVariant(ctx, variant); err != nil {
+		return nil, fmt.Errorf("error updating variant: %w", err)
+	}
+
+	return mapVariantToResponse(product, variant), nil
+}
+
+// DeleteVariant elimina una variante de un producto
+func (uc *UseCaseProduct) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	if _, err := uc.repo.GetProductByID(ctx, productID); err != nil {
+		return err
+	}
+	return uc.repo.DeleteVariant(ctx, productID, variantID)
+}
+
+// ───────────────────────────────────────────
+//
+//	VARIANT INTEGRATIONS
+//
+// ───────────────────────────────────────────
+
+// AddVariantIntegration asocia una variante con su ID en una integración (ej: variant_id de Shopify)
+func (uc *UseCaseProduct) AddVariantIntegration(ctx context.Context, productID, variantID string, req *domain.AddVariantIntegrationRequest) (*domain.ProductVariantIntegration, error) {
+	variant, err := uc.repo.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.AddVariantIntegration(ctx, variant, req.IntegrationID, req.ExternalVariantID)
+}
+
+// RemoveVariantIntegration remueve la asociación entre una variante y una integración
+func (uc *UseCaseProduct) RemoveVariantIntegration(ctx context.Context, productID, variantID string, integrationID uint) error {
+	if _, err := uc.repo.GetVariant(ctx, productID, variantID); err != nil {
+		return err
+	}
+	return uc.repo.RemoveVariantIntegration(ctx, variantID, integrationID)
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// createVariant valida y crea una variante; la agrega a product.Variants para validar las siguientes
+func (uc *UseCaseProduct) createVariant(ctx context.Context, product *domain.Product, req *domain.CreateVariantRequest) (*domain.ProductVariant, error) {
+	if err := uc.validateVariantSKU(ctx, product, req.SKU, ""); err != nil {
+		return nil, err
+	}
+
+	options, err := validateVariantOptions(product.OptionAxes, req.Options, product.Variants, "")
+	if err != nil {
+		return nil, err
+	}
+
+	title := req.Title
+	if title == "" {
+		title = variantTitle(product.OptionAxes, options, req.SKU)
+	}
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	variant := &domain.ProductVariant{
+		ProductID:      product.ID,
+		BusinessID:     product.BusinessID,
+		SKU:            req.SKU,
+		Title:          title,
+		Options:        options,
+		Barcode:        req.Barcode,
+		ExternalID:     req.ExternalID,
+		Position:       req.Position,
+		Price:          req.Price,
+		CompareAtPrice: req.CompareAtPrice,
+		CostPrice:      req.CostPrice,
+		StockQuantity:  req.StockQuantity,
+		Weight:         req.Weight,
+		IsActive:       isActive,
+		Metadata:       req.Metadata,
+	}
+
+	if err := uc.repo.CreateVariant(ctx, variant); err != nil {
+		return nil, fmt.Errorf("error creating variant: %w", err)
+	}
+
+	product.Variants = append(product.Variants, *variant)
+	return variant, nil
+}
+
+// validateVariantSKU verifica que el SKU no lo use otra variante ni otro producto del negocio.
+// Una variante puede compartir el SKU de su propio producto (variante por defecto).
+func (uc *UseCaseProduct) validateVariantSKU(ctx context.Context, product *domain.Product, sku, excludeVariantID string) error {
+	exists, err := uc.repo.VariantSKUExists(ctx, product.BusinessID, sku, excludeVariantID)
+	if err != nil {
+		return fmt.Errorf("error checking if variant exists: %w", err)
+	}
+	if exists {
+		return domain.ErrVariantAlreadyExists
+	}
+
+	other, err := uc.repo.GetProductBySKU(ctx, product.BusinessID, sku)
+	if err != nil && !errors.Is(err, domain.ErrProductNotFound) {
+		return fmt.Errorf("error checking if product exists: %w", err)
+	}
+	if other != nil && other.ID != product.ID {
+		return domain.ErrVariantAlreadyExists
+	}
+	return nil
+}
+
+// validateVariantOptions verifica que las opciones tengan un valor por cada eje del producto
+// (y solo esos ejes) y que ninguna otra variante tenga la misma combinación
+func validateVariantOptions(axes []string, options map[string]string, siblings []domain.ProductVariant, excludeVariantID string) (map[string]string, error) {
+	cleaned := make(map[string]string, len(options))
+	for axis, value := range options {
+		axis = strings.TrimSpace(axis)
+		value = strings.TrimSpace(value)
+		if axis == "" || value == "" {
+			return nil, domain.ErrInvalidVariantOptions
+		}
+		cleaned[axis] = value
+	}
+
+	if len(cleaned) != len(axes) {
+		return nil, domain.ErrInvalidVariantOptions
+	}
+	for _, axis := range axes {
+		if _, ok := cleaned[axis]; !ok {
+			return nil, domain.ErrInvalidVariantOptions
+		}
+	}
+
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+	for _, sibling := range siblings {
+		if sibling.ID != excludeVariantID && sameOptions(sibling.Options, cleaned) {
+			return nil, domain.ErrDuplicateVariantOptions
+		}
+	}
+	return cleaned, nil
+}
+
+// sameOptions compara dos combinaciones de opciones sin distinguir mayúsculas
+func sameOptions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for axis, value := range a {
+		if !strings.EqualFold(b[axis], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// variantTitle arma el título de la variante con los valores en el orden de los ejes (ej: "M / Rojo")
+func variantTitle(axes []string, options map[string]string, fallback string) string {
+	values := make([]string, 0, len(axes))
+	for _, axis := range axes {
+		if value := options[axis]; value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return fallback
+	}
+	return strings.Join(values, " / ")
+}
+
+// mapVariantToResponse convierte una variante a VariantResponse con precio y peso efectivos
+func mapVariantToResponse(product *domain.Product, variant *domain.ProductVariant) *domain.VariantResponse {
+	price := product.Price
+	if variant.Price != nil {
+		price = *variant.Price
+	}
+	compareAtPrice := variant.CompareAtPrice
+	if compareAtPrice == nil {
+		compareAtPrice = product.CompareAtPrice
+	}
+	costPrice := variant.CostPrice
+	if costPrice == nil {
+		costPrice = product.CostPrice
+	}
+	weight := variant.Weight
+	if weight == nil {
+		weight = product.Weight
+	}
+
+	return &domain.VariantResponse{
+		ID:                variant.ID,
+		CreatedAt:         variant.CreatedAt,
+		UpdatedAt:         variant.UpdatedAt,
+		ProductID:         variant.ProductID,
+		BusinessID:        variant.BusinessID,
+		SKU:               variant.SKU,
+		Title:             variant.Title,
+		Options:           maps.Clone(variant.Options),
+		Barcode:           variant.Barcode,
+		ExternalID:        variant.ExternalID,
+		Position:          variant.Position,
+		Price:             price,
+		CompareAtPrice:    compareAtPrice,
+		CostPrice:         costPrice,
+		StockQuantity:     variant.StockQuantity,
+		ReservedQuantity:  variant.ReservedQuantity,
+		AvailableQuantity: variant.StockQuantity - variant.ReservedQuantity,
+		Weight:            weight,
+		IsActive:          variant.IsActive,
+		Metadata:          variant.Metadata,
+		Integrations:      variant.Integrations,
+	}
+}
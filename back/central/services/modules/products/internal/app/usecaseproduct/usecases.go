@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
 )
@@ -26,6 +27,15 @@ func (uc *UseCaseProduct) CreateProduct(ctx context.Context, req *domain.CreateP
 		return nil, domain.ErrProductAlreadyExists
 	}
 
+	// El SKU tampoco puede estar en uso por una variante del negocio
+	variantExists, err := uc.repo.VariantSKUExists(ctx, req.BusinessID, req.SKU, "")
+	if err != nil {
+		return nil, fmt.Errorf("error checking if variant exists: %w", err)
+	}
+	if variantExists {
+		return nil, domain.ErrProductAlreadyExists
+	}
+
 	// Crear el modelo de producto con todos los campos
 	product := &domain.Product{
 		// Identificadores
@@ -77,6 +87,9 @@ func (uc *UseCaseProduct) CreateProduct(ctx context.Context, req *domain.CreateP
 
 		// Metadata
 		Metadata: req.Metadata,
+
+		// Variantes
+		OptionAxes: normalizeOptionAxes(req.OptionAxes),
 	}
 
 	// Guardar en la base de datos
@@ -84,6 +97,13 @@ func (uc *UseCaseProduct) CreateProduct(ctx context.Context, req *domain.CreateP
 		return nil, fmt.Errorf("error creating product: %w", err)
 	}
 
+	// Crear las variantes enviadas junto con el producto
+	for i := range req.Variants {
+		if _, err := uc.createVariant(ctx, product, &req.Variants[i]); err != nil {
+			return nil, fmt.Errorf("error creating variant %s: %w", req.Variants[i].SKU, err)
+		}
+	}
+
 	// Retornar la respuesta
 	return mapProductToResponse(product), nil
 }
@@ -296,6 +316,17 @@ func (uc *UseCaseProduct) UpdateProduct(ctx context.Context, id string, req *dom
 		product.Metadata = req.Metadata
 	}
 
+	// Variantes: las variantes existentes deben seguir siendo válidas con los nuevos ejes
+	if req.OptionAxes != nil {
+		axes := normalizeOptionAxes(req.OptionAxes)
+		for _, variant := range product.Variants {
+			if _, err := validateVariantOptions(axes, variant.Options, nil, variant.ID); err != nil {
+				return nil, err
+			}
+		}
+		product.OptionAxes = axes
+	}
+
 	// Guardar cambios
 	if err := uc.repo.UpdateProduct(ctx, product); err != nil {
 		return nil, fmt.Errorf("error updating product: %w", err)
@@ -368,6 +399,8 @@ func mapProductToResponse(product *domain.Product) *domain.ProductResponse {
 		TrackInventory:    product.TrackInventory,
 		AllowBackorder:    product.AllowBackorder,
 		LowStockThreshold: product.LowStockThreshold,
+		ReservedQuantity:  product.ReservedQuantity,
+		AvailableQuantity: product.StockQuantity - product.ReservedQuantity,
 
 		// Media
 		ImageURL: product.ImageURL,
@@ -394,5 +427,36 @@ func mapProductToResponse(product *domain.Product) *domain.ProductResponse {
 
 		// Metadata
 		Metadata: product.Metadata,
+
+		// Variantes
+		OptionAxes: product.OptionAxes,
+		Variants:   mapVariantsToResponse(product),
+	}
+}
+
+// mapVariantsToResponse convierte las variantes del producto a VariantResponse
+func mapVariantsToResponse(product *domain.Product) []domain.VariantResponse {
+	if len(product.Variants) == 0 {
+		return nil
+	}
+	variants := make([]domain.VariantResponse, len(product.Variants))
+	for i := range product.Variants {
+		variants[i] = *mapVariantToResponse(product, &product.Variants[i])
+	}
+	return variants
+}
+
+// normalizeOptionAxes limpia los ejes de opción y descarta duplicados conservando el orden
+func normalizeOptionAxes(axes []string) []string {
+	seen := make(map[string]bool, len(axes))
+	result := make([]string, 0, len(axes))
+	for _, axis := range axes {
+		axis = strings.TrimSpace(axis)
+		if axis == "" || seen[axis] {
+			continue
+		}
+		seen[axis] = true
+		result = append(result, axis)
 	}
+	return result
 }
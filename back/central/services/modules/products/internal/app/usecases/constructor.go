@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/app/usecasebulk"
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/app/usecaseproduct"
 	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
 )
@@ -13,13 +14,15 @@ type UseCases struct {
 
 	// Casos de uso modulares
 	ProductCRUD *usecaseproduct.UseCaseProduct
+	Bulk        *usecasebulk.UseCaseBulk
 }
 
 // New crea una nueva instancia de UseCases
-func New(repo domain.IRepository) *UseCases {
+func New(repo domain.IRepository, catalog domain.ICatalogSource, images domain.IImageStorage, files domain.IFileStorage) *UseCases {
 	return &UseCases{
 		repo:        repo,
-		ProductCRUD: usecaseproduct.New(repo),
+		ProductCRUD: usecaseproduct.New(repo, catalog, images),
+		Bulk:        usecasebulk.New(repo, files),
 	}
 }
 
@@ -75,3 +78,85 @@ func (uc *UseCases) GetProductIntegrations(ctx context.Context, productID string
 func (uc *UseCases) GetProductsByIntegration(ctx context.Context, integrationID uint) ([]domain.Product, error) {
 	return uc.ProductCRUD.GetProductsByIntegration(ctx, integrationID)
 }
+
+// ───────────────────────────────────────────
+// MÉTODOS DE VARIANTES - Delegar al CRUD
+// ───────────────────────────────────────────
+
+// CreateVariant delega al caso de uso CRUD
+func (uc *UseCases) CreateVariant(ctx context.Context, productID string, req *domain.CreateVariantRequest) (*domain.VariantResponse, error) {
+	return uc.ProductCRUD.CreateVariant(ctx, productID, req)
+}
+
+// ListVariants delega al caso de uso CRUD
+func (uc *UseCases) ListVariants(ctx context.Context, productID string) ([]domain.VariantResponse, error) {
+	return uc.ProductCRUD.ListVariants(ctx, productID)
+}
+
+// GetVariant delega al caso de uso CRUD
+func (uc *UseCases) GetVariant(ctx context.Context, productID, variantID string) (*domain.VariantResponse, error) {
+	return uc.ProductCRUD.GetVariant(ctx, productID, variantID)
+}
+
+// UpdateVariant delega al caso de uso CRUD
+func (uc *UseCases) UpdateVariant(ctx context.Context, productID, variantID string, req *domain.UpdateVariantRequest) (*domain.VariantResponse, error) {
+	return uc.ProductCRUD.UpdateVariant(ctx, productID, variantID, req)
+}
+
+// DeleteVariant delega al caso de uso CRUD
+func (uc *UseCases) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	return uc.ProductCRUD.DeleteVariant(ctx, productID, variantID)
+}
+
+// AddVariantIntegration delega al caso de uso CRUD
+func (uc *UseCases) AddVariantIntegration(ctx context.Context, productID, variantID string, req *domain.AddVariantIntegrationRequest) (*domain.ProductVariantIntegration, error) {
+	return uc.ProductCRUD.AddVariantIntegration(ctx, productID, variantID, req)
+}
+
+// RemoveVariantIntegration delega al caso de uso CRUD
+func (uc *UseCases) RemoveVariantIntegration(ctx context.Context, productID, variantID string, integrationID uint) error {
+	return uc.ProductCRUD.RemoveVariantIntegration(ctx, productID, variantID, integrationID)
+}
+
+// ───────────────────────────────────────────
+// IMPORTACIÓN DE CATÁLOGO - Delegar al CRUD
+// ───────────────────────────────────────────
+
+// CreateCatalogImportJob delega al caso de uso CRUD
+func (uc *UseCases) CreateCatalogImportJob(ctx context.Context, req *domain.ImportCatalogRequest) (*domain.CatalogImportJob, error) {
+	return uc.ProductCRUD.CreateCatalogImportJob(ctx, req)
+}
+
+// GetCatalogImportJob delega al caso de uso CRUD
+func (uc *UseCases) GetCatalogImportJob(ctx context.Context, id uint) (*domain.CatalogImportJob, error) {
+	return uc.ProductCRUD.GetCatalogImportJob(ctx, id)
+}
+
+// ListCatalogImportJobs delega al caso de uso CRUD
+func (uc *UseCases) ListCatalogImportJobs(ctx context.Context, businessID uint, page, pageSize int) (*domain.CatalogImportJobsListResponse, error) {
+	return uc.ProductCRUD.ListCatalogImportJobs(ctx, businessID, page, pageSize)
+}
+
+// ───────────────────────────────────────────
+// IMPORTACIÓN/EXPORTACIÓN MASIVA - Delegar a Bulk
+// ───────────────────────────────────────────
+
+// CreateImportJob delega al caso de uso Bulk
+func (uc *UseCases) CreateImportJob(ctx context.Context, req *domain.ImportProductsRequest) (*domain.ProductBulkJob, error) {
+	return uc.Bulk.CreateImportJob(ctx, req)
+}
+
+// CreateExportJob delega al caso de uso Bulk
+func (uc *UseCases) CreateExportJob(ctx context.Context, req *domain.ExportProductsRequest) (*domain.ProductBulkJob, error) {
+	return uc.Bulk.CreateExportJob(ctx, req)
+}
+
+// GetBulkJob delega al caso de uso Bulk
+func (uc *UseCases) GetBulkJob(ctx context.Context, id uint) (*domain.ProductBulkJob, error) {
+	return uc.Bulk.GetJob(ctx, id)
+}
+
+// ListBulkJobs delega al caso de uso Bulk
+func (uc *UseCases) ListBulkJobs(ctx context.Context, businessID uint, page, pageSize int) (*domain.BulkJobsListResponse, error) {
+	return uc.Bulk.ListJobs(ctx, businessID, page, pageSize)
+}
@@ -0,0 +1,215 @@
+package domain
+
+import "time"
+
+// ───────────────────────────────────────────
+//
+//	BULK IMPORT / EXPORT - Importación y exportación masiva de productos
+//
+// ───────────────────────────────────────────
+
+// Tipos de trabajo masivo
+const (
+	BulkJobTypeImport = "import"
+	BulkJobTypeExport = "export"
+)
+
+// Estados de un trabajo masivo
+const (
+	BulkJobStatusPending    = "pending"
+	BulkJobStatusProcessing = "processing"
+	BulkJobStatusCompleted  = "completed"
+	BulkJobStatusFailed     = "failed"
+)
+
+// Resultado de cada fila en el reporte de importación
+const (
+	BulkRowCreated     = "created"
+	BulkRowUpdated     = "updated"
+	BulkRowWouldCreate = "would_create" // Dry-run
+	BulkRowWouldUpdate = "would_update" // Dry-run
+	BulkRowError       = "error"
+)
+
+// Límites de los trabajos masivos
+const (
+	MaxBulkFileSize  = 20 * 1024 * 1024 // Tamaño máximo del archivo a importar
+	MaxBulkRowErrors = 200              // Errores por fila guardados en el trabajo (el reporte los tiene todos)
+	MaxBulkRows      = 50000            // Filas máximas por archivo
+)
+
+// Campos de producto que se importan y exportan, en el orden de las columnas exportadas
+const (
+	BulkFieldSKU               = "sku"
+	BulkFieldName              = "name"
+	BulkFieldTitle             = "title"
+	BulkFieldDescription       = "description"
+	BulkFieldShortDescription  = "short_description"
+	BulkFieldExternalID        = "external_id"
+	BulkFieldPrice             = "price"
+	BulkFieldCompareAtPrice    = "compare_at_price"
+	BulkFieldCostPrice         = "cost_price"
+	BulkFieldCurrency          = "currency"
+	BulkFieldStockQuantity     = "stock_quantity"
+	BulkFieldTrackInventory    = "track_inventory"
+	BulkFieldAllowBackorder    = "allow_backorder"
+	BulkFieldLowStockThreshold = "low_stock_threshold"
+	BulkFieldWeight            = "weight"
+	BulkFieldWeightUnit        = "weight_unit"
+	BulkFieldLength            = "length"
+	BulkFieldWidth             = "width"
+	BulkFieldHeight            = "height"
+	BulkFieldDimensionUnit     = "dimension_unit"
+	BulkFieldCategory          = "category"
+	BulkFieldBrand             = "brand"
+	BulkFieldTags              = "tags"
+	BulkFieldStatus            = "status"
+	BulkFieldIsActive          = "is_active"
+	BulkFieldImageURL          = "image_url"
+)
+
+// BulkProductFields es la lista de campos soportados, en el orden de exportación
+var BulkProductFields = []string{
+	BulkFieldSKU, BulkFieldName, BulkFieldTitle, BulkFieldDescription, BulkFieldShortDescription,
+	BulkFieldExternalID, BulkFieldPrice, BulkFieldCompareAtPrice, BulkFieldCostPrice, BulkFieldCurrency,
+	BulkFieldStockQuantity, BulkFieldTrackInventory, BulkFieldAllowBackorder, BulkFieldLowStockThreshold,
+	BulkFieldWeight, BulkFieldWeightUnit, BulkFieldLength, BulkFieldWidth, BulkFieldHeight, BulkFieldDimensionUnit,
+	BulkFieldCategory, BulkFieldBrand, BulkFieldTags, BulkFieldStatus, BulkFieldIsActive, BulkFieldImageURL,
+}
+
+// IsBulkProductField indica si el campo es importable
+func IsBulkProductField(field string) bool {
+	for _, f := range BulkProductFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ProductBulkJob es un trabajo de importación o exportación masiva de productos
+type ProductBulkJob struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID uint      `json:"business_id"`
+	Type       string    `json:"type"`
+	Format     string    `json:"format"`
+	Status     string    `json:"status"`
+	DryRun     bool      `json:"dry_run"`
+
+	ColumnMapping map[string]string     `json:"column_mapping,omitempty"`
+	Filters       *ProductExportFilters `json:"filters,omitempty"`
+
+	SourceFileName string `json:"source_file_name,omitempty"`
+	SourceFileKey  string `json:"source_file_key,omitempty"`
+	ResultFileKey  string `json:"result_file_key,omitempty"`
+	ResultFileURL  string `json:"result_file_url,omitempty"`
+
+	TotalRows     int            `json:"total_rows"`
+	ProcessedRows int            `json:"processed_rows"`
+	CreatedCount  int            `json:"created_count"`
+	UpdatedCount  int            `json:"updated_count"`
+	ErrorCount    int            `json:"error_count"`
+	Progress      float64        `json:"progress"` // Porcentaje de filas procesadas (0-100)
+	RowErrors     []BulkRowIssue `json:"row_errors,omitempty"`
+	ErrorMessage  string         `json:"error_message,omitempty"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedBy  *uint      `json:"created_by,omitempty"`
+}
+
+// BulkRowIssue es un error de validación de una fila del archivo importado
+type BulkRowIssue struct {
+	Row     int    `json:"row"` // Número de fila en el archivo (el encabezado es la fila 1)
+	SKU     string `json:"sku,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// BulkProgress es el avance de un trabajo masivo
+type BulkProgress struct {
+	ProcessedRows int
+	CreatedCount  int
+	UpdatedCount  int
+	ErrorCount    int
+}
+
+// ProductExportFilters son los filtros del listado de productos aplicados a una exportación
+type ProductExportFilters struct {
+	SKU             string   `json:"sku,omitempty"`
+	SKUs            []string `json:"skus,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	ExternalID      string   `json:"external_id,omitempty"`
+	ExternalIDs     []string `json:"external_ids,omitempty"`
+	IntegrationID   uint     `json:"integration_id,omitempty"`
+	IntegrationType string   `json:"integration_type,omitempty"`
+	CreatedAfter    string   `json:"created_after,omitempty"`
+	CreatedBefore   string   `json:"created_before,omitempty"`
+	UpdatedAfter    string   `json:"updated_after,omitempty"`
+	UpdatedBefore   string   `json:"updated_before,omitempty"`
+}
+
+// ToMap convierte los filtros al formato usado por ListProducts
+func (f *ProductExportFilters) ToMap(businessID uint) map[string]interface{} {
+	filters := map[string]interface{}{
+		"business_id": businessID,
+		"sort_by":     "id",
+		"sort_order":  "asc",
+	}
+	if f == nil {
+		return filters
+	}
+	set := func(key, value string) {
+		if value != "" {
+			filters[key] = value
+		}
+	}
+	set("sku", f.SKU)
+	set("name", f.Name)
+	set("external_id", f.ExternalID)
+	set("integration_type", f.IntegrationType)
+	set("created_after", f.CreatedAfter)
+	set("created_before", f.CreatedBefore)
+	set("updated_after", f.UpdatedAfter)
+	set("updated_before", f.UpdatedBefore)
+	if len(f.SKUs) > 0 {
+		filters["skus"] = f.SKUs
+	}
+	if len(f.ExternalIDs) > 0 {
+		filters["external_ids"] = f.ExternalIDs
+	}
+	if f.IntegrationID > 0 {
+		filters["integration_id"] = f.IntegrationID
+	}
+	return filters
+}
+
+// ImportProductsRequest representa la solicitud de importación masiva (el archivo viaja aparte)
+type ImportProductsRequest struct {
+	BusinessID    uint
+	Format        string            // "csv" | "xlsx"
+	FileName      string            // Nombre original del archivo
+	Data          []byte            // Contenido del archivo
+	DryRun        bool              // Solo validar, sin guardar
+	ColumnMapping map[string]string // Columna del archivo -> campo del producto (vacío = encabezados con el nombre del campo)
+	CreatedBy     *uint
+}
+
+// ExportProductsRequest representa la solicitud de exportación del listado de productos
+type ExportProductsRequest struct {
+	BusinessID uint                  `json:"business_id" binding:"required,min=1"`
+	Format     string                `json:"format" binding:"required,oneof=csv xlsx"`
+	Filters    *ProductExportFilters `json:"filters"`
+	CreatedBy  *uint                 `json:"created_by"`
+}
+
+// BulkJobsListResponse representa la respuesta paginada de trabajos masivos
+type BulkJobsListResponse struct {
+	Data       []ProductBulkJob `json:"data"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"page_size"`
+	TotalPages int              `json:"total_pages"`
+}
@@ -0,0 +1,133 @@
+package domain
+
+import "time"
+
+// ───────────────────────────────────────────
+//
+//	CATALOG IMPORT - Importación de catálogo desde integraciones
+//
+// ───────────────────────────────────────────
+
+// Motivos por los que un SKU del catálogo externo no se importa
+const (
+	CatalogConflictMissingSKU      = "missing_sku"      // El producto o variante no tiene SKU
+	CatalogConflictDuplicateSKU    = "duplicate_sku"    // El SKU se repite dentro del catálogo importado
+	CatalogConflictLinkedElsewhere = "linked_elsewhere" // El producto del SKU ya está asociado a otro producto de la integración
+	CatalogConflictSKUInUse        = "sku_in_use"       // El SKU lo usa una variante u otro producto del negocio
+	CatalogConflictInvalidOptions  = "invalid_options"  // Las opciones de la variante no corresponden a los ejes del producto
+	CatalogConflictSaveFailed      = "save_failed"      // Error al guardar el producto o la variante
+)
+
+// CatalogIntegration es la información de la integración necesaria para importar su catálogo
+type CatalogIntegration struct {
+	ID         uint
+	Name       string
+	Type       string
+	BusinessID *uint
+	IsActive   bool
+}
+
+// CatalogPage es una página del catálogo externo
+type CatalogPage struct {
+	Products   []CatalogProduct
+	NextCursor string // Vacío si es la última página
+}
+
+// CatalogProduct es un producto del catálogo externo. Si no tiene variantes, el SKU,
+// precio y stock del producto son los de su única variante en la plataforma.
+type CatalogProduct struct {
+	ExternalID     string
+	SKU            string
+	Name           string
+	Description    string
+	Brand          string
+	Category       string
+	Tags           []string
+	Status         string
+	Price          float64
+	CompareAtPrice *float64
+	Currency       string
+	StockQuantity  int
+	TrackInventory bool
+	Weight         *float64
+	WeightUnit     string
+	ImageURLs      []string
+	OptionAxes     []string
+	Variants       []CatalogVariant
+}
+
+// CatalogVariant es una variante del catálogo externo
+type CatalogVariant struct {
+	ExternalID     string
+	SKU            string
+	Title          string
+	Barcode        string
+	Options        map[string]string
+	Position       int
+	Price          *float64
+	CompareAtPrice *float64
+	StockQuantity  int
+	Weight         *float64
+}
+
+// ImportCatalogRequest representa la solicitud para importar el catálogo de una integración
+type ImportCatalogRequest struct {
+	IntegrationID  uint  `json:"integration_id" binding:"required"`
+	BusinessID     *uint `json:"business_id"`     // Opcional: si se envía debe coincidir con el de la integración
+	DownloadImages *bool `json:"download_images"` // Por defecto true
+	CreatedBy      *uint `json:"created_by"`
+}
+
+// CatalogImportJob es una corrida en background de la importación del catálogo de una integración.
+// Usa los mismos estados que los trabajos masivos (BulkJobStatus*).
+type CatalogImportJob struct {
+	ID             uint      `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	BusinessID     uint      `json:"business_id"`
+	IntegrationID  uint      `json:"integration_id"`
+	Status         string    `json:"status"`
+	DownloadImages bool      `json:"download_images"`
+
+	Pages        int                  `json:"pages"`
+	ProductsRead int                  `json:"products_read"`
+	Report       *CatalogImportResult `json:"report,omitempty"` // Se actualiza página a página mientras corre
+	ErrorMessage string               `json:"error_message,omitempty"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedBy  *uint      `json:"created_by,omitempty"`
+}
+
+// CatalogImportJobsListResponse representa la respuesta paginada de importaciones de catálogo
+type CatalogImportJobsListResponse struct {
+	Data       []CatalogImportJob `json:"data"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"page_size"`
+	TotalPages int                `json:"total_pages"`
+}
+
+// CatalogConflict es un SKU del catálogo externo que no se pudo importar
+type CatalogConflict struct {
+	SKU        string `json:"sku"`
+	ExternalID string `json:"external_id"`
+	Reason     string `json:"reason"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// CatalogImportResult es el reporte de una corrida de importación de catálogo
+type CatalogImportResult struct {
+	IntegrationID uint              `json:"integration_id"`
+	BusinessID    uint              `json:"business_id"`
+	StartedAt     time.Time         `json:"started_at"`
+	FinishedAt    time.Time         `json:"finished_at"`
+	Pages         int               `json:"pages"`
+	ProductsRead  int               `json:"products_read"`
+	Created       []string          `json:"created"`   // SKUs creados (productos y variantes)
+	Updated       []string          `json:"updated"`   // SKUs actualizados (productos y variantes)
+	Conflicts     []CatalogConflict `json:"conflicts"` // SKUs no importados
+	ImagesStored  int               `json:"images_stored"`
+	ImageErrors   int               `json:"image_errors"`
+	Error         string            `json:"error,omitempty"` // Error que interrumpió la corrida (las páginas previas quedan importadas)
+}
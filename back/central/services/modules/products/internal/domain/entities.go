@@ -63,6 +63,10 @@ type CreateProductRequest struct {
 
 	// Metadata
 	Metadata datatypes.JSON `json:"metadata" binding:"omitempty"`
+
+	// Variantes
+	OptionAxes []string               `json:"option_axes" binding:"omitempty,max=3,dive,required,max=64"` // Ejes de opción (ej: ["size", "color"])
+	Variants   []CreateVariantRequest `json:"variants" binding:"omitempty,dive"`
 }
 
 // UpdateProductRequest representa la solicitud para actualizar un producto
@@ -115,6 +119,9 @@ type UpdateProductRequest struct {
 
 	// Metadata
 	Metadata datatypes.JSON `json:"metadata" binding:"omitempty"`
+
+	// Variantes
+	OptionAxes []string `json:"option_axes" binding:"omitempty,max=3,dive,required,max=64"`
 }
 
 // ProductResponse representa la respuesta de un producto
@@ -147,6 +154,8 @@ type ProductResponse struct {
 	TrackInventory    bool `json:"track_inventory"`
 	AllowBackorder    bool `json:"allow_backorder"`
 	LowStockThreshold *int `json:"low_stock_threshold,omitempty"`
+	ReservedQuantity  int  `json:"reserved_quantity"`
+	AvailableQuantity int  `json:"available_quantity"` // stock_quantity - reserved_quantity
 
 	// Media
 	ImageURL string         `json:"image_url"`
@@ -173,6 +182,10 @@ type ProductResponse struct {
 
 	// Metadata
 	Metadata datatypes.JSON `json:"metadata,omitempty"`
+
+	// Variantes
+	OptionAxes []string          `json:"option_axes,omitempty"`
+	Variants   []VariantResponse `json:"variants,omitempty"`
 }
 
 // ProductsListResponse representa la respuesta paginada de productos
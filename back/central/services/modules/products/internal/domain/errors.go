@@ -11,5 +11,53 @@ var (
 
 	// ErrInvalidProductData se retorna cuando los datos del producto son inválidos
 	ErrInvalidProductData = errors.New("invalid product data")
+
+	// ErrVariantNotFound se retorna cuando una variante no existe o no pertenece al producto
+	ErrVariantNotFound = errors.New("product variant not found")
+
+	// ErrVariantAlreadyExists se retorna cuando una variante con el mismo SKU ya existe en el negocio
+	ErrVariantAlreadyExists = errors.New("product variant with this SKU already exists for this business")
+
+	// ErrInvalidVariantOptions se retorna cuando las opciones no corresponden a los ejes del producto
+	ErrInvalidVariantOptions = errors.New("variant options do not match the product option axes")
+
+	// ErrDuplicateVariantOptions se retorna cuando otra variante del producto ya tiene la misma combinación de opciones
+	ErrDuplicateVariantOptions = errors.New("another variant of this product already has these options")
+
+	// ErrVariantIntegrationExists se retorna cuando la variante ya está asociada con la integración
+	ErrVariantIntegrationExists = errors.New("variant is already associated with this integration")
+
+	// ErrCatalogImportUnavailable se retorna cuando el módulo no tiene acceso a las integraciones
+	ErrCatalogImportUnavailable = errors.New("catalog import is not available")
+
+	// ErrCatalogIntegrationNotFound se retorna cuando la integración a importar no existe
+	ErrCatalogIntegrationNotFound = errors.New("integration not found")
+
+	// ErrCatalogIntegrationForbidden se retorna cuando la integración no pertenece al negocio indicado
+	ErrCatalogIntegrationForbidden = errors.New("integration belongs to another business")
+
+	// ErrCatalogIntegrationInactive se retorna cuando la integración está desactivada
+	ErrCatalogIntegrationInactive = errors.New("integration is not active")
+
+	// ErrCatalogNotSupported se retorna cuando el tipo de integración no expone su catálogo
+	ErrCatalogNotSupported = errors.New("integration type does not support catalog import")
+
+	// ErrCatalogImportJobNotFound se retorna cuando el trabajo de importación de catálogo no existe
+	ErrCatalogImportJobNotFound = errors.New("catalog import job not found")
+
+	// ErrBulkJobNotFound se retorna cuando el trabajo de importación/exportación no existe
+	ErrBulkJobNotFound = errors.New("product bulk job not found")
+
+	// ErrBulkUnavailable se retorna cuando no hay almacenamiento de archivos para los trabajos masivos
+	ErrBulkUnavailable = errors.New("bulk import/export is not available")
+
+	// ErrUnsupportedFileFormat se retorna cuando el archivo no es CSV ni XLSX
+	ErrUnsupportedFileFormat = errors.New("unsupported file format, expected csv or xlsx")
+
+	// ErrInvalidBulkFile se retorna cuando el archivo no se puede leer o no tiene filas
+	ErrInvalidBulkFile = errors.New("invalid import file")
+
+	// ErrInvalidColumnMapping se retorna cuando el mapeo de columnas apunta a campos inexistentes o no incluye el SKU
+	ErrInvalidColumnMapping = errors.New("invalid column mapping")
 )
 
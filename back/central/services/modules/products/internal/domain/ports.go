@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // ───────────────────────────────────────────
@@ -29,4 +30,68 @@ type IRepository interface {
 	GetProductIntegrations(ctx context.Context, productID string) ([]ProductBusinessIntegration, error)
 	GetProductsByIntegration(ctx context.Context, integrationID uint) ([]Product, error)
 	ProductIntegrationExists(ctx context.Context, productID string, integrationID uint) (bool, error)
+	GetProductIntegrationByExternalID(ctx context.Context, integrationID uint, externalProductID string) (*ProductBusinessIntegration, error)
+
+	// Variants
+	CreateVariant(ctx context.Context, variant *ProductVariant) error
+	GetVariant(ctx context.Context, productID, variantID string) (*ProductVariant, error)
+	ListVariants(ctx context.Context, productID string) ([]ProductVariant, error)
+	UpdateVariant(ctx context.Context, variant *ProductVariant) error
+	DeleteVariant(ctx context.Context, productID, variantID string) error
+	VariantSKUExists(ctx context.Context, businessID uint, sku, excludeVariantID string) (bool, error)
+	GetVariantBySKU(ctx context.Context, businessID uint, sku string) (*ProductVariant, error)
+
+	// Variant-Integration Management
+	AddVariantIntegration(ctx context.Context, variant *ProductVariant, integrationID uint, externalVariantID string) (*ProductVariantIntegration, error)
+	RemoveVariantIntegration(ctx context.Context, variantID string, integrationID uint) error
+
+	// Bulk Jobs (importación/exportación masiva)
+	CreateBulkJob(ctx context.Context, job *ProductBulkJob) error
+	GetBulkJob(ctx context.Context, id uint) (*ProductBulkJob, error)
+	ListBulkJobs(ctx context.Context, businessID uint, page, pageSize int) ([]ProductBulkJob, int64, error)
+	UpdateBulkJob(ctx context.Context, job *ProductBulkJob) error
+	UpdateBulkJobProgress(ctx context.Context, id uint, progress BulkProgress) error
+	ListPendingBulkJobIDs(ctx context.Context, limit int) ([]uint, error)
+	ClaimBulkJob(ctx context.Context, id uint) (bool, error)
+	ReleaseStaleBulkJobs(ctx context.Context, staleAfter time.Duration) (int64, error)
+
+	// Catalog Import Jobs (importación de catálogo desde integraciones)
+	CreateCatalogImportJob(ctx context.Context, job *CatalogImportJob) error
+	GetCatalogImportJob(ctx context.Context, id uint) (*CatalogImportJob, error)
+	ListCatalogImportJobs(ctx context.Context, businessID uint, page, pageSize int) ([]CatalogImportJob, int64, error)
+	UpdateCatalogImportJob(ctx context.Context, job *CatalogImportJob) error
+	ListPendingCatalogImportJobIDs(ctx context.Context, limit int) ([]uint, error)
+	ClaimCatalogImportJob(ctx context.Context, id uint) (bool, error)
+	ReleaseStaleCatalogImportJobs(ctx context.Context, staleAfter time.Duration) (int64, error)
+}
+
+// ───────────────────────────────────────────
+//
+//	CATALOG IMPORT
+//
+// ───────────────────────────────────────────
+
+// ICatalogSource obtiene el catálogo de productos de una integración externa
+type ICatalogSource interface {
+	GetIntegration(ctx context.Context, integrationID uint) (*CatalogIntegration, error)
+	FetchPage(ctx context.Context, integrationID uint, cursor string) (*CatalogPage, error)
+}
+
+// IImageStorage guarda en el almacenamiento propio una imagen descargada de una URL externa
+type IImageStorage interface {
+	// StoreImage descarga la imagen de sourceURL, la guarda con la llave dada y retorna su URL pública
+	StoreImage(ctx context.Context, sourceURL, key string) (string, error)
+}
+
+// ───────────────────────────────────────────
+//
+//	BULK IMPORT / EXPORT
+//
+// ───────────────────────────────────────────
+
+// IFileStorage guarda y recupera los archivos de los trabajos masivos
+type IFileStorage interface {
+	// Upload guarda el contenido bajo la llave dada y retorna su URL
+	Upload(ctx context.Context, key string, data []byte) (string, error)
+	Download(ctx context.Context, key string) ([]byte, error)
 }
@@ -36,6 +36,7 @@ type Product struct {
 	TrackInventory    bool `json:"track_inventory"`
 	AllowBackorder    bool `json:"allow_backorder"`
 	LowStockThreshold *int `json:"low_stock_threshold,omitempty"`
+	ReservedQuantity  int  `json:"reserved_quantity"` // Reservado por órdenes pendientes de envío (solo lectura)
 
 	// Media
 	ImageURL string         `json:"image_url"`
@@ -62,6 +63,10 @@ type Product struct {
 
 	// Metadata
 	Metadata datatypes.JSON `json:"metadata,omitempty"`
+
+	// Variantes
+	OptionAxes []string         `json:"option_axes,omitempty"` // Ejes de opción (ej: ["size", "color"])
+	Variants   []ProductVariant `json:"variants,omitempty"`
 }
 
 // ProductBusinessIntegration representa la asociación de un producto con una integración
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// ProductVariant representa una variante de un producto (ej: talla M, color Rojo)
+type ProductVariant struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	ProductID  string `json:"product_id"`
+	BusinessID uint   `json:"business_id"`
+	SKU        string `json:"sku"`
+
+	Title      string            `json:"title"`
+	Options    map[string]string `json:"options,omitempty"`
+	Barcode    string            `json:"barcode"`
+	ExternalID string            `json:"external_id"`
+	Position   int               `json:"position"`
+
+	// Pricing (nil = precio del producto)
+	Price          *float64 `json:"price,omitempty"`
+	CompareAtPrice *float64 `json:"compare_at_price,omitempty"`
+	CostPrice      *float64 `json:"cost_price,omitempty"`
+
+	// Inventory
+	StockQuantity    int `json:"stock_quantity"`
+	ReservedQuantity int `json:"reserved_quantity"` // Reservado por órdenes pendientes de envío (solo lectura)
+
+	Weight   *float64       `json:"weight,omitempty"`
+	IsActive bool           `json:"is_active"`
+	Metadata datatypes.JSON `json:"metadata,omitempty"`
+
+	Integrations []ProductVariantIntegration `json:"integrations,omitempty"`
+}
+
+// ProductVariantIntegration representa el ID de una variante en una integración
+type ProductVariantIntegration struct {
+	ID                uint      `json:"id"`
+	CreatedAt         time.Time `json:"created_at"`
+	VariantID         string    `json:"variant_id"`
+	ProductID         string    `json:"product_id"`
+	BusinessID        uint      `json:"business_id"`
+	IntegrationID     uint      `json:"integration_id"`
+	ExternalVariantID string    `json:"external_variant_id"`
+}
+
+// ───────────────────────────────────────────
+//
+//	VARIANT DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateVariantRequest representa la solicitud para crear una variante de un producto
+type CreateVariantRequest struct {
+	SKU        string            `json:"sku" binding:"required,max=128"`
+	Title      string            `json:"title" binding:"omitempty,max=255"`
+	Options    map[string]string `json:"options"`
+	Barcode    string            `json:"barcode" binding:"omitempty,max=128"`
+	ExternalID string            `json:"external_id" binding:"omitempty,max=255"`
+	Position   int               `json:"position" binding:"omitempty,min=0"`
+
+	Price          *float64 `json:"price" binding:"omitempty,min=0"`
+	CompareAtPrice *float64 `json:"compare_at_price" binding:"omitempty,min=0"`
+	CostPrice      *float64 `json:"cost_price" binding:"omitempty,min=0"`
+
+	StockQuantity int      `json:"stock_quantity" binding:"omitempty,min=0"`
+	Weight        *float64 `json:"weight" binding:"omitempty,min=0"`
+	IsActive      *bool    `json:"is_active"` // Por defecto true
+
+	Metadata datatypes.JSON `json:"metadata" binding:"omitempty"`
+}
+
+// UpdateVariantRequest representa la solicitud para actualizar una variante
+type UpdateVariantRequest struct {
+	SKU        *string           `json:"sku" binding:"omitempty,max=128"`
+	Title      *string           `json:"title" binding:"omitempty,max=255"`
+	Options    map[string]string `json:"options"`
+	Barcode    *string           `json:"barcode" binding:"omitempty,max=128"`
+	ExternalID *string           `json:"external_id" binding:"omitempty,max=255"`
+	Position   *int              `json:"position" binding:"omitempty,min=0"`
+
+	Price          *float64 `json:"price" binding:"omitempty,min=0"`
+	CompareAtPrice *float64 `json:"compare_at_price" binding:"omitempty,min=0"`
+	CostPrice      *float64 `json:"cost_price" binding:"omitempty,min=0"`
+
+	StockQuantity *int     `json:"stock_quantity" binding:"omitempty,min=0"`
+	Weight        *float64 `json:"weight" binding:"omitempty,min=0"`
+	IsActive      *bool    `json:"is_active"`
+
+	Metadata datatypes.JSON `json:"metadata" binding:"omitempty"`
+}
+
+// AddVariantIntegrationRequest representa la solicitud para asociar una variante con su ID en una integración
+type AddVariantIntegrationRequest struct {
+	IntegrationID     uint   `json:"integration_id" binding:"required"`
+	ExternalVariantID string `json:"external_variant_id" binding:"required,max=255"`
+}
+
+// VariantResponse representa la respuesta de una variante con sus valores efectivos
+// (precio y peso heredados del producto cuando la variante no los define)
+type VariantResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ProductID  string            `json:"product_id"`
+	BusinessID uint              `json:"business_id"`
+	SKU        string            `json:"sku"`
+	Title      string            `json:"title"`
+	Options    map[string]string `json:"options,omitempty"`
+	Barcode    string            `json:"barcode"`
+	ExternalID string            `json:"external_id"`
+	Position   int               `json:"position"`
+
+	Price          float64  `json:"price"`
+	CompareAtPrice *float64 `json:"compare_at_price,omitempty"`
+	CostPrice      *float64 `json:"cost_price,omitempty"`
+
+	StockQuantity     int `json:"stock_quantity"`
+	ReservedQuantity  int `json:"reserved_quantity"`
+	AvailableQuantity int `json:"available_quantity"` // stock_quantity - reserved_quantity
+
+	Weight   *float64       `json:"weight,omitempty"`
+	IsActive bool           `json:"is_active"`
+	Metadata datatypes.JSON `json:"metadata,omitempty"`
+
+	Integrations []ProductVariantIntegration `json:"integrations,omitempty"`
+}
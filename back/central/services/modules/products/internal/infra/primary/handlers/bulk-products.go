@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// ImportProducts godoc
+// @Summary      Importar productos desde CSV/XLSX
+// @Description  Encola la importación masiva de productos. Cada fila se guarda por SKU (crea o actualiza); las celdas vacías no modifican el producto. Sin column_mapping se importan las columnas cuyo encabezado coincide con un campo (sku, name, price, stock_quantity, ...). Con dry_run solo se valida. El avance y el reporte por fila se consultan en /products/bulk/jobs/{job_id}.
+// @Tags         Products
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        business_id     formData  int     true   "ID del negocio"
+// @Param        file            formData  file    true   "Archivo CSV o XLSX (máx. 20 MB)"
+// @Param        format          formData  string  false  "Formato (csv, xlsx). Por defecto se toma de la extensión del archivo"
+// @Param        dry_run         formData  bool    false  "Solo validar, sin guardar"
+// @Param        column_mapping  formData  string  false  "JSON columna del archivo -> campo del producto (ej: {\"Código\":\"sku\",\"Precio\":\"price\"})"
+// @Param        created_by      formData  int     false  "ID del usuario que importa"
+// @Security     BearerAuth
+// @Success      202  {object}  domain.ProductBulkJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /products/bulk/import [post]
+func (h *Handlers) ImportProducts(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.PostForm("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' inválido",
+			"error":   "business_id es requerido y debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	req := domain.ImportProductsRequest{
+		BusinessID: uint(businessID),
+		Format:     c.PostForm("format"),
+	}
+
+	if raw := c.PostForm("dry_run"); raw != "" {
+		dryRun, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'dry_run' inválido",
+				"error":   err.Error(),
+			})
+			return
+		}
+		req.DryRun = dryRun
+	}
+
+	if raw := c.PostForm("column_mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &req.ColumnMapping); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'column_mapping' inválido, debe ser un objeto JSON",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	if raw := c.PostForm("created_by"); raw != "" {
+		if id, err := strconv.ParseUint(raw, 10, 32); err == nil && id > 0 {
+			userID := uint(id)
+			req.CreatedBy = &userID
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Archivo CSV o XLSX requerido",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if fileHeader.Size > domain.MaxBulkFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "El archivo supera el tamaño máximo de 20 MB",
+			"error":   "file too large",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el archivo",
+			"error":   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	req.FileName = fileHeader.Filename
+	if req.Data, err = io.ReadAll(file); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el archivo",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	job, err := h.uc.CreateImportJob(c.Request.Context(), &req)
+	if err != nil {
+		respondBulkError(c, err, "Error al encolar la importación de productos")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Importación de productos encolada",
+		"data":    job,
+	})
+}
+
+// ExportProducts godoc
+// @Summary      Exportar productos a CSV/XLSX
+// @Description  Encola la exportación del listado de productos con los filtros indicados. El archivo tiene las mismas columnas que acepta la importación; su URL queda en el trabajo al completarse.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        request  body      domain.ExportProductsRequest  true  "Formato y filtros de la exportación"
+// @Security     BearerAuth
+// @Success      202  {object}  domain.ProductBulkJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /products/bulk/export [post]
+func (h *Handlers) ExportProducts(c *gin.Context) {
+	var req domain.ExportProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	job, err := h.uc.CreateExportJob(c.Request.Context(), &req)
+	if err != nil {
+		respondBulkError(c, err, "Error al encolar la exportación de productos")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Exportación de productos encolada",
+		"data":    job,
+	})
+}
+
+// ListBulkJobs godoc
+// @Summary      Listar trabajos de importación/exportación
+// @Description  Lista los trabajos masivos de productos del negocio, del más reciente al más antiguo
+// @Tags         Products
+// @Produce      json
+// @Param        business_id  query  int  true   "ID del negocio"
+// @Param        page         query  int  false  "Número de página (default: 1)"
+// @Param        page_size    query  int  false  "Tamaño de página (default: 10, max: 100)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.BulkJobsListResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/bulk/jobs [get]
+func (h *Handlers) ListBulkJobs(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' inválido",
+			"error":   "business_id es requerido y debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	response, err := h.uc.ListBulkJobs(c.Request.Context(), uint(businessID), page, pageSize)
+	if err != nil {
+		respondBulkError(c, err, "Error al listar los trabajos de productos")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetBulkJob godoc
+// @Summary      Obtener trabajo de importación/exportación
+// @Description  Obtiene el estado, avance, errores por fila y URL del archivo de resultado de un trabajo masivo
+// @Tags         Products
+// @Produce      json
+// @Param        job_id  path  int  true  "ID del trabajo"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ProductBulkJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/bulk/jobs/{job_id} [get]
+func (h *Handlers) GetBulkJob(c *gin.Context) {
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 32)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'job_id' inválido",
+			"error":   "job_id debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	job, err := h.uc.GetBulkJob(c.Request.Context(), uint(jobID))
+	if err != nil {
+		respondBulkError(c, err, "Error al obtener el trabajo de productos")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Trabajo obtenido exitosamente",
+		"data":    job,
+	})
+}
+
+// respondBulkError traduce los errores de dominio de los trabajos masivos a respuestas HTTP
+func respondBulkError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrBulkJobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrUnsupportedFileFormat),
+		errors.Is(err, domain.ErrInvalidBulkFile),
+		errors.Is(err, domain.ErrInvalidColumnMapping):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrBulkUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
@@ -46,6 +46,11 @@ func (h *Handlers) CreateProduct(c *gin.Context) {
 			return
 		}
 
+		// Errores de las variantes enviadas junto con el producto
+		if respondVariantError(c, err) {
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"message": "Error al crear producto",
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// ImportCatalog godoc
+// @Summary      Importar catálogo de una integración
+// @Description  Encola la importación del catálogo de productos y variantes de la integración (ej: Shopify). En background se hace upsert por SKU, se asocia cada producto con su ID externo y se descargan sus imágenes; el reporte con los SKUs creados, actualizados y en conflicto queda en el trabajo.
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        request  body      domain.ImportCatalogRequest  true  "Integración a importar"
+// @Security     BearerAuth
+// @Success      202  {object}  domain.CatalogImportJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /products/import/catalog [post]
+func (h *Handlers) ImportCatalog(c *gin.Context) {
+	var req domain.ImportCatalogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	job, err := h.uc.CreateCatalogImportJob(c.Request.Context(), &req)
+	if err != nil {
+		respondCatalogError(c, err, "Error al encolar la importación del catálogo")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Importación de catálogo encolada",
+		"data":    job,
+	})
+}
+
+// ListCatalogImportJobs godoc
+// @Summary      Listar importaciones de catálogo
+// @Description  Lista las importaciones de catálogo del negocio, de la más reciente a la más antigua (sin el reporte)
+// @Tags         Products
+// @Produce      json
+// @Param        business_id  query  int  true   "ID del negocio"
+// @Param        page         query  int  false  "Número de página (default: 1)"
+// @Param        page_size    query  int  false  "Tamaño de página (default: 10, max: 100)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CatalogImportJobsListResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/import/catalog/jobs [get]
+func (h *Handlers) ListCatalogImportJobs(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' inválido",
+			"error":   "business_id es requerido y debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page' inválido. Debe ser un número entero mayor a 0",
+			"error":   "invalid page parameter",
+		})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'page_size' inválido. Debe ser un número entero entre 1 y 100",
+			"error":   "invalid page_size parameter",
+		})
+		return
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	response, err := h.uc.ListCatalogImportJobs(c.Request.Context(), uint(businessID), page, pageSize)
+	if err != nil {
+		respondCatalogError(c, err, "Error al listar las importaciones de catálogo")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCatalogImportJob godoc
+// @Summary      Obtener importación de catálogo
+// @Description  Obtiene el estado, avance y reporte (SKUs creados, actualizados y en conflicto) de una importación de catálogo
+// @Tags         Products
+// @Produce      json
+// @Param        job_id  path  int  true  "ID de la importación"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.CatalogImportJob
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/import/catalog/jobs/{job_id} [get]
+func (h *Handlers) GetCatalogImportJob(c *gin.Context) {
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 32)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'job_id' inválido",
+			"error":   "job_id debe ser un número entero mayor a 0",
+		})
+		return
+	}
+
+	job, err := h.uc.GetCatalogImportJob(c.Request.Context(), uint(jobID))
+	if err != nil {
+		respondCatalogError(c, err, "Error al obtener la importación de catálogo")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Importación de catálogo obtenida exitosamente",
+		"data":    job,
+	})
+}
+
+// respondCatalogError traduce los errores de dominio de la importación de catálogo a respuestas HTTP
+func respondCatalogError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrCatalogIntegrationNotFound), errors.Is(err, domain.ErrCatalogImportJobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrCatalogIntegrationForbidden):
+		status = http.StatusForbidden
+	case errors.Is(err, domain.ErrCatalogIntegrationInactive), errors.Is(err, domain.ErrCatalogNotSupported):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrCatalogImportUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// ListVariants godoc
+// @Summary      Listar variantes de producto
+// @Description  Obtiene las variantes de un producto con su precio, peso y stock efectivos
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "ID del producto (hash alfanumérico)"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.VariantResponse
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants [get]
+func (h *Handlers) ListVariants(c *gin.Context) {
+	variants, err := h.uc.ListVariants(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if !respondVariantError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al obtener variantes",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Variantes obtenidas exitosamente",
+		"data":    variants,
+		"total":   len(variants),
+	})
+}
+
+// CreateVariant godoc
+// @Summary      Crear variante de producto
+// @Description  Crea una variante del producto. Las opciones deben tener un valor por cada eje del producto (option_axes)
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                       true  "ID del producto (hash alfanumérico)"
+// @Param        variant  body      domain.CreateVariantRequest  true  "Datos de la variante"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.VariantResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants [post]
+func (h *Handlers) CreateVariant(c *gin.Context) {
+	var req domain.CreateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	variant, err := h.uc.CreateVariant(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		if !respondVariantError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al crear variante",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Variante creada exitosamente",
+		"data":    variant,
+	})
+}
+
+// GetVariant godoc
+// @Summary      Obtener variante de producto
+// @Description  Obtiene una variante de un producto con sus integraciones
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true  "ID del producto (hash alfanumérico)"
+// @Param        variant_id  path      string  true  "ID de la variante"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.VariantResponse
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants/{variant_id} [get]
+func (h *Handlers) GetVariant(c *gin.Context) {
+	variant, err := h.uc.GetVariant(c.Request.Context(), c.Param("id"), c.Param("variant_id"))
+	if err != nil {
+		if !respondVariantError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al obtener variante",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Variante obtenida exitosamente",
+		"data":    variant,
+	})
+}
+
+// UpdateVariant godoc
+// @Summary      Actualizar variante de producto
+// @Description  Actualiza los campos enviados de una variante
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string                       true  "ID del producto (hash alfanumérico)"
+// @Param        variant_id  path      string                       true  "ID de la variante"
+// @Param        variant     body      domain.UpdateVariantRequest  true  "Campos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.VariantResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants/{variant_id} [put]
+func (h *Handlers) UpdateVariant(c *gin.Context) {
+	var req domain.UpdateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	variant, err := h.uc.UpdateVariant(c.Request.Context(), c.Param("id"), c.Param("variant_id"), &req)
+	if err != nil {
+		if !respondVariantError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al actualizar variante",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Variante actualizada exitosamente",
+		"data":    variant,
+	})
+}
+
+// DeleteVariant godoc
+// @Summary      Eliminar variante de producto
+// @Description  Elimina una variante de un producto
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true  "ID del producto (hash alfanumérico)"
+// @Param        variant_id  path      string  true  "ID de la variante"
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants/{variant_id} [delete]
+func (h *Handlers) DeleteVariant(c *gin.Context) {
+	if err := h.uc.DeleteVariant(c.Request.Context(), c.Param("id"), c.Param("variant_id")); err != nil {
+		if !respondVariantError(c, err) {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al eliminar variante",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Variante eliminada exitosamente",
+	})
+}
+
+// AddVariantIntegration godoc
+// @Summary      Asociar variante con integración
+// @Description  Registra el ID de la variante en una integración del mismo negocio (ej: variant_id de Shopify)
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string                               true  "ID del producto (hash alfanumérico)"
+// @Param        variant_id  path      string                               true  "ID de la variante"
+// @Param        body        body      domain.AddVariantIntegrationRequest  true  "Datos de la integración"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.ProductVariantIntegration
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants/{variant_id}/integrations [post]
+func (h *Handlers) AddVariantIntegration(c *gin.Context) {
+	var req domain.AddVariantIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	integration, err := h.uc.AddVariantIntegration(c.Request.Context(), c.Param("id"), c.Param("variant_id"), &req)
+	if err != nil {
+		if respondVariantError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case "integration does not belong to the same business as the product":
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "La integración no pertenece al mismo negocio que el producto",
+				"error":   err.Error(),
+			})
+		case "integration not found":
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Integración no encontrada",
+				"error":   err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"message": "Error al asociar variante con integración",
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Integración asociada exitosamente",
+		"data":    integration,
+	})
+}
+
+// RemoveVariantIntegration godoc
+// @Summary      Remover integración de variante
+// @Description  Remueve la asociación entre una variante y una integración
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Param        id              path      string  true  "ID del producto (hash alfanumérico)"
+// @Param        variant_id      path      string  true  "ID de la variante"
+// @Param        integration_id  path      int     true  "ID de la integración"
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /products/{id}/variants/{variant_id}/integrations/{integration_id} [delete]
+func (h *Handlers) RemoveVariantIntegration(c *gin.Context) {
+	integrationID, err := strconv.ParseUint(c.Param("integration_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de integración inválido",
+			"error":   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	err = h.uc.RemoveVariantIntegration(c.Request.Context(), c.Param("id"), c.Param("variant_id"), uint(integrationID))
+	if err != nil {
+		if respondVariantError(c, err) {
+			return
+		}
+		if err.Error() == "variant integration association not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Asociación no encontrada",
+				"error":   err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al remover integración",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Integración removida exitosamente",
+	})
+}
+
+// respondVariantError responde los errores conocidos de productos y variantes.
+// Retorna false si el error no es conocido y debe responderse como error interno.
+func respondVariantError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"message": "Producto no encontrado",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrVariantNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"message": "Variante no encontrada",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrVariantAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": "Ya existe una variante o producto con ese SKU",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrDuplicateVariantOptions):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": "Ya existe una variante con esas opciones",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrVariantIntegrationExists):
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": "La variante ya está asociada con esta integración",
+			"error":   err.Error(),
+		})
+	case errors.Is(err, domain.ErrInvalidVariantOptions):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Las opciones de la variante no corresponden a los ejes del producto",
+			"error":   err.Error(),
+		})
+	default:
+		return false
+	}
+	return true
+}
@@ -15,9 +15,29 @@ func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
 		products.PUT("/:id", h.UpdateProduct)
 		products.DELETE("/:id", h.DeleteProduct)
 
+		// Importación de catálogo desde integraciones en background
+		products.POST("/import/catalog", h.ImportCatalog)
+		products.GET("/import/catalog/jobs", h.ListCatalogImportJobs)
+		products.GET("/import/catalog/jobs/:job_id", h.GetCatalogImportJob)
+
+		// Importación/exportación masiva (CSV/XLSX) en background
+		products.POST("/bulk/import", h.ImportProducts)
+		products.POST("/bulk/export", h.ExportProducts)
+		products.GET("/bulk/jobs", h.ListBulkJobs)
+		products.GET("/bulk/jobs/:job_id", h.GetBulkJob)
+
 		// Gestión de integraciones
 		products.POST("/:id/integrations", h.AddProductIntegration)
 		products.GET("/:id/integrations", h.GetProductIntegrations)
 		products.DELETE("/:id/integrations/:integration_id", h.RemoveProductIntegration)
+
+		// Variantes
+		products.GET("/:id/variants", h.ListVariants)
+		products.POST("/:id/variants", h.CreateVariant)
+		products.GET("/:id/variants/:variant_id", h.GetVariant)
+		products.PUT("/:id/variants/:variant_id", h.UpdateVariant)
+		products.DELETE("/:id/variants/:variant_id", h.DeleteVariant)
+		products.POST("/:id/variants/:variant_id/integrations", h.AddVariantIntegration)
+		products.DELETE("/:id/variants/:variant_id/integrations/:integration_id", h.RemoveVariantIntegration)
 	}
 }
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -66,6 +67,16 @@ func (h *Handlers) UpdateProduct(c *gin.Context) {
 			return
 		}
 
+		// Los nuevos ejes de opción no son compatibles con las variantes existentes
+		if errors.Is(err, domain.ErrInvalidVariantOptions) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Los ejes de opción no son compatibles con las variantes existentes",
+				"error":   err.Error(),
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"message": "Error al actualizar producto",
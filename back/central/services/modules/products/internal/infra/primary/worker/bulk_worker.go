@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/app/usecasebulk"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+const (
+	// tickInterval cada cuánto se revisan los trabajos masivos pendientes
+	tickInterval = 5 * time.Second
+	// batchSize máximo de trabajos procesados por ciclo
+	batchSize = 5
+)
+
+// BulkWorker procesa en background los trabajos de importación y exportación masiva de productos
+type BulkWorker struct {
+	usecase *usecasebulk.UseCaseBulk
+	logger  log.ILogger
+}
+
+// New crea el worker de trabajos masivos
+func New(usecase *usecasebulk.UseCaseBulk, logger log.ILogger) *BulkWorker {
+	return &BulkWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *BulkWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de importación/exportación masiva de productos iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				processed, err := w.usecase.ProcessPending(ctx, batchSize)
+				if err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al procesar trabajos masivos de productos")
+				}
+				if processed > 0 {
+					w.logger.Info(ctx).Int("processed", processed).Msg("Trabajos masivos de productos procesados")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de trabajos masivos de productos")
+				return
+			}
+		}
+	}()
+}
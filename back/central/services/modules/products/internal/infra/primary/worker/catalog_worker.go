@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/app/usecaseproduct"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// CatalogWorker procesa en background las importaciones de catálogo desde integraciones
+type CatalogWorker struct {
+	usecase *usecaseproduct.UseCaseProduct
+	logger  log.ILogger
+}
+
+// NewCatalogWorker crea el worker de importaciones de catálogo
+func NewCatalogWorker(usecase *usecaseproduct.UseCaseProduct, logger log.ILogger) *CatalogWorker {
+	return &CatalogWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *CatalogWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de importación de catálogo de productos iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				processed, err := w.usecase.ProcessPendingCatalogImports(ctx, batchSize)
+				if err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al procesar importaciones de catálogo")
+				}
+				if processed > 0 {
+					w.logger.Info(ctx).Int("processed", processed).Msg("Importaciones de catálogo procesadas")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de importaciones de catálogo")
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,115 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+)
+
+// CatalogSource obtiene el catálogo de las integraciones a través del core de integraciones,
+// que delega en la fuente de catálogo registrada por cada tipo (Shopify, ...)
+type CatalogSource struct {
+	core core.IIntegrationCore
+}
+
+// New crea la fuente de catálogo
+func New(integrationCore core.IIntegrationCore) domain.ICatalogSource {
+	return &CatalogSource{core: integrationCore}
+}
+
+// GetIntegration obtiene la integración a importar
+func (s *CatalogSource) GetIntegration(ctx context.Context, integrationID uint) (*domain.CatalogIntegration, error) {
+	integration, err := s.core.GetIntegrationByID(ctx, integrationID)
+	if err != nil {
+		if errors.Is(err, core.ErrIntegrationNotFound) {
+			return nil, domain.ErrCatalogIntegrationNotFound
+		}
+		return nil, err
+	}
+
+	result := &domain.CatalogIntegration{
+		ID:         integration.ID,
+		Name:       integration.Name,
+		BusinessID: integration.BusinessID,
+		IsActive:   integration.IsActive,
+	}
+	if integration.IntegrationType != nil {
+		result.Type = integration.IntegrationType.Code
+	}
+	return result, nil
+}
+
+// FetchPage obtiene una página del catálogo de la integración
+func (s *CatalogSource) FetchPage(ctx context.Context, integrationID uint, cursor string) (*domain.CatalogPage, error) {
+	integration, err := s.core.GetIntegrationByID(ctx, integrationID)
+	if err != nil {
+		if errors.Is(err, core.ErrIntegrationNotFound) {
+			return nil, domain.ErrCatalogIntegrationNotFound
+		}
+		return nil, err
+	}
+	if integration.IntegrationType == nil {
+		return nil, domain.ErrCatalogNotSupported
+	}
+
+	source, err := s.core.GetCatalogSource(integration.IntegrationType.Code)
+	if err != nil {
+		if errors.Is(err, core.ErrCatalogSourceNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrCatalogNotSupported, integration.IntegrationType.Code)
+		}
+		return nil, err
+	}
+
+	page, err := source.FetchCatalogPage(ctx, integration, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPage(page), nil
+}
+
+func toDomainPage(page *core.CatalogPage) *domain.CatalogPage {
+	result := &domain.CatalogPage{
+		Products:   make([]domain.CatalogProduct, len(page.Products)),
+		NextCursor: page.NextCursor,
+	}
+	for i, p := range page.Products {
+		result.Products[i] = domain.CatalogProduct{
+			ExternalID:     p.ExternalID,
+			SKU:            p.SKU,
+			Name:           p.Name,
+			Description:    p.Description,
+			Brand:          p.Brand,
+			Category:       p.Category,
+			Tags:           p.Tags,
+			Status:         p.Status,
+			Price:          p.Price,
+			CompareAtPrice: p.CompareAtPrice,
+			Currency:       p.Currency,
+			StockQuantity:  p.StockQuantity,
+			TrackInventory: p.TrackInventory,
+			Weight:         p.Weight,
+			WeightUnit:     p.WeightUnit,
+			ImageURLs:      p.ImageURLs,
+			OptionAxes:     p.OptionAxes,
+			Variants:       make([]domain.CatalogVariant, len(p.Variants)),
+		}
+		for j, v := range p.Variants {
+			result.Products[i].Variants[j] = domain.CatalogVariant{
+				ExternalID:     v.ExternalID,
+				SKU:            v.SKU,
+				Title:          v.Title,
+				Barcode:        v.Barcode,
+				Options:        v.Options,
+				Position:       v.Position,
+				Price:          v.Price,
+				CompareAtPrice: v.CompareAtPrice,
+				StockQuantity:  v.StockQuantity,
+				Weight:         v.Weight,
+			}
+		}
+	}
+	return result
+}
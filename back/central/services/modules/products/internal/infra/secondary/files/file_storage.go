@@ -0,0 +1,34 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/storage"
+)
+
+// FileStorage guarda en S3 los archivos de importación y exportación masiva
+type FileStorage struct {
+	s3 storage.IS3Service
+}
+
+// New crea el almacenamiento de archivos de los trabajos masivos
+func New(s3 storage.IS3Service) domain.IFileStorage {
+	return &FileStorage{s3: s3}
+}
+
+// Upload sube el contenido a S3 bajo la llave dada
+func (s *FileStorage) Upload(ctx context.Context, key string, data []byte) (string, error) {
+	return s.s3.UploadFile(ctx, bytes.NewReader(data), key)
+}
+
+// Download descarga el contenido completo de la llave dada
+func (s *FileStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	reader, err := s.s3.DownloadFile(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,88 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/storage"
+)
+
+// maxImageSize es el tamaño máximo de imagen que se descarga (10MB, igual que las subidas a S3)
+const maxImageSize = 10 * 1024 * 1024
+
+// ImageStorage descarga imágenes externas y las guarda en S3
+type ImageStorage struct {
+	s3         storage.IS3Service
+	httpClient *http.Client
+}
+
+// New crea el almacenamiento de imágenes de productos
+func New(s3 storage.IS3Service) domain.IImageStorage {
+	return &ImageStorage{
+		s3: s3,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// StoreImage descarga la imagen y la sube a S3 bajo la llave dada (la extensión se toma del content type)
+func (s *ImageStorage) StoreImage(ctx context.Context, sourceURL, key string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("image download returned status: %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", fmt.Errorf("unexpected image content type: %s", contentType)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxImageSize {
+		return "", fmt.Errorf("image exceeds the maximum size of %d bytes", maxImageSize)
+	}
+
+	return s.s3.UploadFile(ctx, bytes.NewReader(data), key+imageExtension(contentType, sourceURL))
+}
+
+// imageExtension obtiene la extensión del archivo a partir del content type o, si no, de la URL
+func imageExtension(contentType, sourceURL string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "image/jpeg", "image/jpg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	ext := path.Ext(strings.SplitN(sourceURL, "?", 2)[0])
+	if len(ext) > 1 && len(ext) <= 5 {
+		return ext
+	}
+	return ""
+}
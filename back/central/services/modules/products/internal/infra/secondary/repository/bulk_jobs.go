@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT BULK JOBS
+//
+// ───────────────────────────────────────────
+
+// CreateBulkJob crea un trabajo de importación/exportación masiva
+func (r *Repository) CreateBulkJob(ctx context.Context, job *domain.ProductBulkJob) error {
+	dbJob := mappers.ToDBBulkJob(job)
+	if err := r.db.Conn(ctx).Create(dbJob).Error; err != nil {
+		return err
+	}
+	job.ID = dbJob.ID
+	job.CreatedAt = dbJob.CreatedAt
+	job.UpdatedAt = dbJob.UpdatedAt
+	return nil
+}
+
+// GetBulkJob obtiene un trabajo masivo por su ID
+func (r *Repository) GetBulkJob(ctx context.Context, id uint) (*domain.ProductBulkJob, error) {
+	var job models.ProductBulkJob
+	err := r.db.Conn(ctx).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrBulkJobNotFound
+		}
+		return nil, err
+	}
+	return mappers.ToDomainBulkJob(&job), nil
+}
+
+// ListBulkJobs lista los trabajos masivos de un negocio, del más reciente al más antiguo
+func (r *Repository) ListBulkJobs(ctx context.Context, businessID uint, page, pageSize int) ([]domain.ProductBulkJob, int64, error) {
+	var jobs []models.ProductBulkJob
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.ProductBulkJob{}).Where("business_id = ?", businessID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// row_errors puede ser grande: el detalle se consulta con GetBulkJob
+	err := query.
+		Omit("row_errors").
+		Order("created_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mappers.ToDomainBulkJobs(jobs), total, nil
+}
+
+// UpdateBulkJob guarda el estado completo de un trabajo masivo
+func (r *Repository) UpdateBulkJob(ctx context.Context, job *domain.ProductBulkJob) error {
+	return r.db.Conn(ctx).Save(mappers.ToDBBulkJob(job)).Error
+}
+
+// UpdateBulkJobProgress actualiza solo los contadores de avance de un trabajo en proceso
+func (r *Repository) UpdateBulkJobProgress(ctx context.Context, id uint, progress domain.BulkProgress) error {
+	return r.db.Conn(ctx).
+		Model(&models.ProductBulkJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"processed_rows": progress.ProcessedRows,
+			"created_count":  progress.CreatedCount,
+			"updated_count":  progress.UpdatedCount,
+			"error_count":    progress.ErrorCount,
+			"updated_at":     time.Now(),
+		}).Error
+}
+
+// ListPendingBulkJobIDs obtiene los IDs de los trabajos pendientes, del más antiguo al más reciente
+func (r *Repository) ListPendingBulkJobIDs(ctx context.Context, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Conn(ctx).
+		Model(&models.ProductBulkJob{}).
+		Where("status = ?", domain.BulkJobStatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ClaimBulkJob marca un trabajo pendiente como en proceso. Retorna false si otra instancia ya lo tomó.
+func (r *Repository) ClaimBulkJob(ctx context.Context, id uint) (bool, error) {
+	now := time.Now()
+	result := r.db.Conn(ctx).
+		Model(&models.ProductBulkJob{}).
+		Where("id = ? AND status = ?", id, domain.BulkJobStatusPending).
+		Updates(map[string]interface{}{
+			"status":     domain.BulkJobStatusProcessing,
+			"started_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseStaleBulkJobs devuelve a pendiente los trabajos en proceso sin avance reciente
+// (ej: la instancia que los procesaba se reinició), reiniciando sus contadores
+func (r *Repository) ReleaseStaleBulkJobs(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	result := r.db.Conn(ctx).
+		Model(&models.ProductBulkJob{}).
+		Where("status = ? AND updated_at < ?", domain.BulkJobStatusProcessing, time.Now().Add(-staleAfter)).
+		Updates(map[string]interface{}{
+			"status":         domain.BulkJobStatusPending,
+			"processed_rows": 0,
+			"created_count":  0,
+			"updated_count":  0,
+			"error_count":    0,
+			"updated_at":     time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
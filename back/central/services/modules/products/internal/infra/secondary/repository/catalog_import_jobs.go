@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT CATALOG IMPORT JOBS
+//
+// ───────────────────────────────────────────
+
+// CreateCatalogImportJob crea una importación de catálogo pendiente
+func (r *Repository) CreateCatalogImportJob(ctx context.Context, job *domain.CatalogImportJob) error {
+	dbJob := mappers.ToDBCatalogImportJob(job)
+	if err := r.db.Conn(ctx).Create(dbJob).Error; err != nil {
+		return err
+	}
+	job.ID = dbJob.ID
+	job.CreatedAt = dbJob.CreatedAt
+	job.UpdatedAt = dbJob.UpdatedAt
+	return nil
+}
+
+// GetCatalogImportJob obtiene una importación de catálogo por su ID
+func (r *Repository) GetCatalogImportJob(ctx context.Context, id uint) (*domain.CatalogImportJob, error) {
+	var job models.ProductCatalogImportJob
+	err := r.db.Conn(ctx).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCatalogImportJobNotFound
+		}
+		return nil, err
+	}
+	return mappers.ToDomainCatalogImportJob(&job), nil
+}
+
+// ListCatalogImportJobs lista las importaciones de catálogo de un negocio, de la más reciente a la más antigua
+func (r *Repository) ListCatalogImportJobs(ctx context.Context, businessID uint, page, pageSize int) ([]domain.CatalogImportJob, int64, error) {
+	var jobs []models.ProductCatalogImportJob
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.ProductCatalogImportJob{}).Where("business_id = ?", businessID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// El reporte puede ser grande: el detalle se consulta con GetCatalogImportJob
+	err := query.
+		Omit("report").
+		Order("created_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mappers.ToDomainCatalogImportJobs(jobs), total, nil
+}
+
+// UpdateCatalogImportJob guarda el estado completo de una importación de catálogo
+func (r *Repository) UpdateCatalogImportJob(ctx context.Context, job *domain.CatalogImportJob) error {
+	return r.db.Conn(ctx).Save(mappers.ToDBCatalogImportJob(job)).Error
+}
+
+// ListPendingCatalogImportJobIDs obtiene los IDs de las importaciones pendientes, de la más antigua a la más reciente
+func (r *Repository) ListPendingCatalogImportJobIDs(ctx context.Context, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Conn(ctx).
+		Model(&models.ProductCatalogImportJob{}).
+		Where("status = ?", domain.BulkJobStatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ClaimCatalogImportJob marca una importación pendiente como en proceso. Retorna false si otra instancia ya la tomó.
+func (r *Repository) ClaimCatalogImportJob(ctx context.Context, id uint) (bool, error) {
+	now := time.Now()
+	result := r.db.Conn(ctx).
+		Model(&models.ProductCatalogImportJob{}).
+		Where("id = ? AND status = ?", id, domain.BulkJobStatusPending).
+		Updates(map[string]interface{}{
+			"status":     domain.BulkJobStatusProcessing,
+			"started_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseStaleCatalogImportJobs devuelve a pendiente las importaciones en proceso sin avance reciente
+// (ej: la instancia que las procesaba se reinició). La nueva corrida vuelve a recorrer el catálogo:
+// el upsert por SKU hace que repetir las páginas ya importadas no duplique productos.
+func (r *Repository) ReleaseStaleCatalogImportJobs(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	result := r.db.Conn(ctx).
+		Model(&models.ProductCatalogImportJob{}).
+		Where("status = ? AND updated_at < ?", domain.BulkJobStatusProcessing, time.Now().Add(-staleAfter)).
+		Updates(map[string]interface{}{
+			"status":        domain.BulkJobStatusPending,
+			"pages":         0,
+			"products_read": 0,
+			"report":        nil,
+			"updated_at":    time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,108 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ToDBBulkJob convierte un trabajo masivo de dominio a modelo de base de datos
+func ToDBBulkJob(j *domain.ProductBulkJob) *models.ProductBulkJob {
+	if j == nil {
+		return nil
+	}
+	return &models.ProductBulkJob{
+		Model: gorm.Model{
+			ID:        j.ID,
+			CreatedAt: j.CreatedAt,
+			UpdatedAt: j.UpdatedAt,
+		},
+		BusinessID:     j.BusinessID,
+		Type:           j.Type,
+		Format:         j.Format,
+		Status:         j.Status,
+		DryRun:         j.DryRun,
+		ColumnMapping:  toJSON(j.ColumnMapping),
+		Filters:        toJSON(j.Filters),
+		SourceFileName: j.SourceFileName,
+		SourceFileKey:  j.SourceFileKey,
+		ResultFileKey:  j.ResultFileKey,
+		ResultFileURL:  j.ResultFileURL,
+		TotalRows:      j.TotalRows,
+		ProcessedRows:  j.ProcessedRows,
+		CreatedCount:   j.CreatedCount,
+		UpdatedCount:   j.UpdatedCount,
+		ErrorCount:     j.ErrorCount,
+		RowErrors:      toJSON(j.RowErrors),
+		ErrorMessage:   j.ErrorMessage,
+		StartedAt:      j.StartedAt,
+		FinishedAt:     j.FinishedAt,
+		CreatedBy:      j.CreatedBy,
+	}
+}
+
+// ToDomainBulkJob convierte un trabajo masivo de base de datos a dominio
+func ToDomainBulkJob(j *models.ProductBulkJob) *domain.ProductBulkJob {
+	if j == nil {
+		return nil
+	}
+	result := &domain.ProductBulkJob{
+		ID:             j.ID,
+		CreatedAt:      j.CreatedAt,
+		UpdatedAt:      j.UpdatedAt,
+		BusinessID:     j.BusinessID,
+		Type:           j.Type,
+		Format:         j.Format,
+		Status:         j.Status,
+		DryRun:         j.DryRun,
+		SourceFileName: j.SourceFileName,
+		SourceFileKey:  j.SourceFileKey,
+		ResultFileKey:  j.ResultFileKey,
+		ResultFileURL:  j.ResultFileURL,
+		TotalRows:      j.TotalRows,
+		ProcessedRows:  j.ProcessedRows,
+		CreatedCount:   j.CreatedCount,
+		UpdatedCount:   j.UpdatedCount,
+		ErrorCount:     j.ErrorCount,
+		ErrorMessage:   j.ErrorMessage,
+		StartedAt:      j.StartedAt,
+		FinishedAt:     j.FinishedAt,
+		CreatedBy:      j.CreatedBy,
+	}
+	if len(j.ColumnMapping) > 0 {
+		_ = json.Unmarshal(j.ColumnMapping, &result.ColumnMapping)
+	}
+	if len(j.Filters) > 0 {
+		_ = json.Unmarshal(j.Filters, &result.Filters)
+	}
+	if len(j.RowErrors) > 0 {
+		_ = json.Unmarshal(j.RowErrors, &result.RowErrors)
+	}
+	if result.TotalRows > 0 {
+		result.Progress = float64(result.ProcessedRows) * 100 / float64(result.TotalRows)
+	} else if result.Status == domain.BulkJobStatusCompleted {
+		result.Progress = 100
+	}
+	return result
+}
+
+// ToDomainBulkJobs convierte una lista de trabajos masivos de base de datos a dominio
+func ToDomainBulkJobs(jobs []models.ProductBulkJob) []domain.ProductBulkJob {
+	result := make([]domain.ProductBulkJob, len(jobs))
+	for i := range jobs {
+		result[i] = *ToDomainBulkJob(&jobs[i])
+	}
+	return result
+}
+
+// toJSON serializa un valor opcional a JSON (nil si está vacío)
+func toJSON(value interface{}) datatypes.JSON {
+	data, err := json.Marshal(value)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+	return datatypes.JSON(data)
+}
@@ -0,0 +1,69 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ToDBCatalogImportJob convierte una importación de catálogo de dominio a modelo de base de datos
+func ToDBCatalogImportJob(j *domain.CatalogImportJob) *models.ProductCatalogImportJob {
+	if j == nil {
+		return nil
+	}
+	return &models.ProductCatalogImportJob{
+		Model: gorm.Model{
+			ID:        j.ID,
+			CreatedAt: j.CreatedAt,
+			UpdatedAt: j.UpdatedAt,
+		},
+		BusinessID:     j.BusinessID,
+		IntegrationID:  j.IntegrationID,
+		Status:         j.Status,
+		DownloadImages: j.DownloadImages,
+		Pages:          j.Pages,
+		ProductsRead:   j.ProductsRead,
+		Report:         toJSON(j.Report),
+		ErrorMessage:   j.ErrorMessage,
+		StartedAt:      j.StartedAt,
+		FinishedAt:     j.FinishedAt,
+		CreatedBy:      j.CreatedBy,
+	}
+}
+
+// ToDomainCatalogImportJob convierte una importación de catálogo de base de datos a dominio
+func ToDomainCatalogImportJob(j *models.ProductCatalogImportJob) *domain.CatalogImportJob {
+	if j == nil {
+		return nil
+	}
+	result := &domain.CatalogImportJob{
+		ID:             j.ID,
+		CreatedAt:      j.CreatedAt,
+		UpdatedAt:      j.UpdatedAt,
+		BusinessID:     j.BusinessID,
+		IntegrationID:  j.IntegrationID,
+		Status:         j.Status,
+		DownloadImages: j.DownloadImages,
+		Pages:          j.Pages,
+		ProductsRead:   j.ProductsRead,
+		ErrorMessage:   j.ErrorMessage,
+		StartedAt:      j.StartedAt,
+		FinishedAt:     j.FinishedAt,
+		CreatedBy:      j.CreatedBy,
+	}
+	if len(j.Report) > 0 {
+		_ = json.Unmarshal(j.Report, &result.Report)
+	}
+	return result
+}
+
+// ToDomainCatalogImportJobs convierte una lista de importaciones de catálogo de base de datos a dominio
+func ToDomainCatalogImportJobs(jobs []models.ProductCatalogImportJob) []domain.CatalogImportJob {
+	result := make([]domain.CatalogImportJob, len(jobs))
+	for i := range jobs {
+		result[i] = *ToDomainCatalogImportJob(&jobs[i])
+	}
+	return result
+}
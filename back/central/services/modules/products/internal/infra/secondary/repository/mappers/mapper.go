@@ -66,6 +66,9 @@ func ToDBProduct(p *domain.Product) *models.Product {
 
 		// Metadata
 		Metadata: p.Metadata,
+
+		// Variantes
+		OptionAxes: axesToJSON(p.OptionAxes),
 	}
 }
 
@@ -104,6 +107,7 @@ func ToDomainProduct(p *models.Product) *domain.Product {
 		TrackInventory:    p.TrackInventory,
 		AllowBackorder:    p.AllowBackorder,
 		LowStockThreshold: p.LowStockThreshold,
+		ReservedQuantity:  p.ReservedQuantity,
 
 		// Media
 		ImageURL: p.ImageURL,
@@ -130,5 +134,9 @@ func ToDomainProduct(p *models.Product) *domain.Product {
 
 		// Metadata
 		Metadata: p.Metadata,
+
+		// Variantes
+		OptionAxes: axesFromJSON(p.OptionAxes),
+		Variants:   ToDomainVariants(p.Variants),
 	}
 }
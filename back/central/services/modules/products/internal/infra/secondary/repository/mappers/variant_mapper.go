@@ -0,0 +1,130 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+)
+
+// ToDBVariant convierte una variante de dominio a modelo de base de datos
+func ToDBVariant(v *domain.ProductVariant) *models.ProductVariant {
+	if v == nil {
+		return nil
+	}
+	return &models.ProductVariant{
+		ID:             v.ID,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
+		DeletedAt:      v.DeletedAt,
+		ProductID:      v.ProductID,
+		BusinessID:     v.BusinessID,
+		SKU:            v.SKU,
+		Title:          v.Title,
+		Options:        optionsToJSON(v.Options),
+		Barcode:        v.Barcode,
+		ExternalID:     v.ExternalID,
+		Position:       v.Position,
+		Price:          v.Price,
+		CompareAtPrice: v.CompareAtPrice,
+		CostPrice:      v.CostPrice,
+		StockQuantity:  v.StockQuantity,
+		Weight:         v.Weight,
+		IsActive:       v.IsActive,
+		Metadata:       v.Metadata,
+	}
+}
+
+// ToDomainVariant convierte una variante de base de datos a dominio
+func ToDomainVariant(v *models.ProductVariant) *domain.ProductVariant {
+	if v == nil {
+		return nil
+	}
+	var options map[string]string
+	if len(v.Options) > 0 {
+		_ = json.Unmarshal(v.Options, &options)
+	}
+	result := &domain.ProductVariant{
+		ID:               v.ID,
+		CreatedAt:        v.CreatedAt,
+		UpdatedAt:        v.UpdatedAt,
+		DeletedAt:        v.DeletedAt,
+		ProductID:        v.ProductID,
+		BusinessID:       v.BusinessID,
+		SKU:              v.SKU,
+		Title:            v.Title,
+		Options:          options,
+		Barcode:          v.Barcode,
+		ExternalID:       v.ExternalID,
+		Position:         v.Position,
+		Price:            v.Price,
+		CompareAtPrice:   v.CompareAtPrice,
+		CostPrice:        v.CostPrice,
+		StockQuantity:    v.StockQuantity,
+		ReservedQuantity: v.ReservedQuantity,
+		Weight:           v.Weight,
+		IsActive:         v.IsActive,
+		Metadata:         v.Metadata,
+	}
+	for i := range v.Integrations {
+		result.Integrations = append(result.Integrations, *ToDomainVariantIntegration(&v.Integrations[i]))
+	}
+	return result
+}
+
+// ToDomainVariants convierte una lista de variantes de base de datos a dominio
+func ToDomainVariants(variants []models.ProductVariant) []domain.ProductVariant {
+	if variants == nil {
+		return nil
+	}
+	result := make([]domain.ProductVariant, len(variants))
+	for i := range variants {
+		result[i] = *ToDomainVariant(&variants[i])
+	}
+	return result
+}
+
+// ToDomainVariantIntegration convierte una asociación variante-integración de base de datos a dominio
+func ToDomainVariantIntegration(vi *models.ProductVariantIntegration) *domain.ProductVariantIntegration {
+	if vi == nil {
+		return nil
+	}
+	return &domain.ProductVariantIntegration{
+		ID:                vi.ID,
+		CreatedAt:         vi.CreatedAt,
+		VariantID:         vi.VariantID,
+		ProductID:         vi.ProductID,
+		BusinessID:        vi.BusinessID,
+		IntegrationID:     vi.IntegrationID,
+		ExternalVariantID: vi.ExternalVariantID,
+	}
+}
+
+// optionsToJSON serializa las opciones de una variante; retorna nil si no tiene
+func optionsToJSON(options map[string]string) datatypes.JSON {
+	if len(options) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(options)
+	return data
+}
+
+// axesToJSON serializa los ejes de opción de un producto; retorna nil si no tiene
+func axesToJSON(axes []string) datatypes.JSON {
+	if len(axes) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(axes)
+	return data
+}
+
+// axesFromJSON deserializa los ejes de opción de un producto
+func axesFromJSON(data datatypes.JSON) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	var axes []string
+	_ = json.Unmarshal(data, &axes)
+	return axes
+}
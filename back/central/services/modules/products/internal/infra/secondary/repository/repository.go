@@ -40,6 +40,10 @@ func (r *Repository) GetProductByID(ctx context.Context, id string) (*domain.Pro
 	var product models.Product
 	err := r.db.Conn(ctx).
 		Preload("Business").
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC, created_at ASC")
+		}).
+		Preload("Variants.Integrations").
 		Where("id = ?", id).
 		First(&product).Error
 
@@ -105,7 +109,8 @@ func (r *Repository) ListProducts(ctx context.Context, page, pageSize int, filte
 
 	// Filtro por SKU (búsqueda parcial, case-insensitive)
 	if sku, ok := filters["sku"].(string); ok && sku != "" {
-		query = query.Where("products.sku ILIKE ?", "%"+sku+"%")
+		// También encuentra productos cuyo SKU de variante coincide
+		query = query.Where("products.sku ILIKE ? OR EXISTS (SELECT 1 FROM product_variants pv WHERE pv.product_id = products.id AND pv.sku ILIKE ?)", "%"+sku+"%", "%"+sku+"%")
 	}
 
 	// Filtro por múltiples SKUs (búsqueda exacta con IN)
@@ -194,7 +199,10 @@ func (r *Repository) ListProducts(ctx context.Context, page, pageSize int, filte
 	query = query.Offset(offset).Limit(pageSize)
 
 	// Precargar relaciones
-	query = query.Preload("Business")
+	query = query.Preload("Business").
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC, created_at ASC")
+		})
 
 	// Ejecutar query
 	if err := query.Find(&products).Error; err != nil {
@@ -213,7 +221,8 @@ func (r *Repository) ListProducts(ctx context.Context, page, pageSize int, filte
 // UpdateProduct actualiza un producto existente
 func (r *Repository) UpdateProduct(ctx context.Context, product *domain.Product) error {
 	dbProduct := mappers.ToDBProduct(product)
-	return r.db.Conn(ctx).Save(dbProduct).Error
+	// reserved_quantity solo lo modifican las reservas de órdenes
+	return r.db.Conn(ctx).Omit("reserved_quantity").Save(dbProduct).Error
 }
 
 // DeleteProduct elimina (soft delete) un producto
@@ -364,3 +373,21 @@ func (r *Repository) ProductIntegrationExists(ctx context.Context, productID str
 
 	return count > 0, nil
 }
+
+// GetProductIntegrationByExternalID obtiene la asociación de una integración con el ID externo dado.
+// Retorna nil si ningún producto está asociado a ese ID externo.
+func (r *Repository) GetProductIntegrationByExternalID(ctx context.Context, integrationID uint, externalProductID string) (*domain.ProductBusinessIntegration, error) {
+	var pi models.ProductBusinessIntegration
+	err := r.db.Conn(ctx).
+		Where("integration_id = ? AND external_product_id = ?", integrationID, externalProductID).
+		First(&pi).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainProductIntegration(&pi), nil
+}
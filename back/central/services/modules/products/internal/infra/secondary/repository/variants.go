@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/products/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT VARIANTS
+//
+// ───────────────────────────────────────────
+
+// CreateVariant crea una nueva variante de producto
+func (r *Repository) CreateVariant(ctx context.Context, variant *domain.ProductVariant) error {
+	dbVariant := mappers.ToDBVariant(variant)
+	if err := r.db.Conn(ctx).Create(dbVariant).Error; err != nil {
+		return err
+	}
+	variant.ID = dbVariant.ID
+	variant.CreatedAt = dbVariant.CreatedAt
+	variant.UpdatedAt = dbVariant.UpdatedAt
+	return nil
+}
+
+// GetVariant obtiene una variante de un producto con sus integraciones
+func (r *Repository) GetVariant(ctx context.Context, productID, variantID string) (*domain.ProductVariant, error) {
+	var variant models.ProductVariant
+	err := r.db.Conn(ctx).
+		Preload("Integrations").
+		Where("id = ? AND product_id = ?", variantID, productID).
+		First(&variant).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrVariantNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainVariant(&variant), nil
+}
+
+// ListVariants obtiene las variantes de un producto ordenadas por posición
+func (r *Repository) ListVariants(ctx context.Context, productID string) ([]domain.ProductVariant, error) {
+	var variants []models.ProductVariant
+	err := r.db.Conn(ctx).
+		Preload("Integrations").
+		Where("product_id = ?", productID).
+		Order("position ASC, created_at ASC").
+		Find(&variants).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.ToDomainVariants(variants), nil
+}
+
+// UpdateVariant actualiza una variante existente
+func (r *Repository) UpdateVariant(ctx context.Context, variant *domain.ProductVariant) error {
+	dbVariant := mappers.ToDBVariant(variant)
+	// reserved_quantity solo lo modifican las reservas de órdenes
+	return r.db.Conn(ctx).Omit("reserved_quantity").Save(dbVariant).Error
+}
+
+// DeleteVariant elimina una variante de un producto
+func (r *Repository) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	result := r.db.Conn(ctx).
+		Where("id = ? AND product_id = ?", variantID, productID).
+		Delete(&models.ProductVariant{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVariantNotFound
+	}
+
+	return nil
+}
+
+// VariantSKUExists verifica si existe otra variante con el SKU en el negocio
+func (r *Repository) VariantSKUExists(ctx context.Context, businessID uint, sku, excludeVariantID string) (bool, error) {
+	var count int64
+	query := r.db.Conn(ctx).
+		Model(&models.ProductVariant{}).
+		Where("business_id = ? AND sku = ?", businessID, sku)
+	if excludeVariantID != "" {
+		query = query.Where("id <> ?", excludeVariantID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetVariantBySKU obtiene una variante del negocio por su SKU
+func (r *Repository) GetVariantBySKU(ctx context.Context, businessID uint, sku string) (*domain.ProductVariant, error) {
+	var variant models.ProductVariant
+	err := r.db.Conn(ctx).
+		Preload("Integrations").
+		Where("business_id = ? AND sku = ?", businessID, sku).
+		First(&variant).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrVariantNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainVariant(&variant), nil
+}
+
+// ───────────────────────────────────────────
+//
+//	VARIANT-INTEGRATION MANAGEMENT
+//
+// ───────────────────────────────────────────
+
+// AddVariantIntegration asocia una variante con su ID en una integración del mismo negocio
+func (r *Repository) AddVariantIntegration(ctx context.Context, variant *domain.ProductVariant, integrationID uint, externalVariantID string) (*domain.ProductVariantIntegration, error) {
+	// Verificar que la integración existe
+	var integration models.Integration
+	if err := r.db.Conn(ctx).Where("id = ?", integrationID).First(&integration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("integration not found")
+		}
+		return nil, err
+	}
+
+	// Validar que la integración pertenece al mismo negocio que la variante
+	if integration.BusinessID == nil || *integration.BusinessID != variant.BusinessID {
+		return nil, fmt.Errorf("integration does not belong to the same business as the product")
+	}
+
+	var existingCount int64
+	err := r.db.Conn(ctx).
+		Model(&models.ProductVariantIntegration{}).
+		Where("variant_id = ? AND integration_id = ?", variant.ID, integrationID).
+		Count(&existingCount).Error
+	if err != nil {
+		return nil, err
+	}
+	if existingCount > 0 {
+		return nil, domain.ErrVariantIntegrationExists
+	}
+
+	dbVI := &models.ProductVariantIntegration{
+		VariantID:         variant.ID,
+		ProductID:         variant.ProductID,
+		BusinessID:        variant.BusinessID,
+		IntegrationID:     integrationID,
+		ExternalVariantID: externalVariantID,
+	}
+
+	if err := r.db.Conn(ctx).Create(dbVI).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.ToDomainVariantIntegration(dbVI), nil
+}
+
+// RemoveVariantIntegration remueve la asociación entre una variante y una integración
+func (r *Repository) RemoveVariantIntegration(ctx context.Context, variantID string, integrationID uint) error {
+	result := r.db.Conn(ctx).
+		Where("variant_id = ? AND integration_id = ?", variantID, integrationID).
+		Delete(&models.ProductVariantIntegration{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("variant integration association not found")
+	}
+
+	return nil
+}
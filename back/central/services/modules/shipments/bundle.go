@@ -1,27 +1,130 @@
 package shipments
 
 import (
+	"context"
+	"strconv"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasecarrier"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasedelivery"
 	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasetracking"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
 	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/primary/worker"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/carrieraccounts"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/carriers/fake"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/carriers/mock"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/evidence"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/labels"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/redis"
 	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/repository"
 	"github.com/secamc93/probability/back/central/shared/db"
 	"github.com/secamc93/probability/back/central/shared/env"
 	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+	"github.com/secamc93/probability/back/central/shared/storage"
 )
 
 // New inicializa el módulo de shipments
-func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig) {
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, redisClient redisclient.IRedis, integrationCore core.IIntegrationCore, s3Service storage.IS3Service) {
 	// 1. Init Repositories
 	repo := repository.New(database)
 
-	// 2. Init Use Cases
-	uc := usecases.New(repo)
+	// 2. Init Event Publisher (si Redis está disponible)
+	var eventPublisher domain.IShipmentEventPublisher
+	if redisClient != nil {
+		redisChannel := environment.Get("REDIS_SHIPMENT_EVENTS_CHANNEL")
+		if redisChannel == "" {
+			redisChannel = "probability:shipments:events" // Valor por defecto
+		}
+		eventPublisher = redis.NewShipmentEventPublisher(redisClient, logger, redisChannel)
+		logger.Info(context.Background()).
+			Str("channel", redisChannel).
+			Msg("Shipment event publisher initialized")
+	}
+
+	// 3. Init Carriers (cuentas en core, etiquetas en S3)
+	carriers := usecasecarrier.NewCarrierRegistry()
+	if environment.Get("MOCK_CARRIER_ENABLED") == "true" {
+		if err := carriers.Register(mock.New()); err != nil {
+			logger.Error().Err(err).Msg("Failed to register mock carrier")
+		}
+	}
+	var labelStorage domain.ILabelStorage
+	if s3Service != nil {
+		labelStorage = labels.New(s3Service)
+	}
+	carrier := usecasecarrier.New(repo, carriers, carrieraccounts.New(integrationCore), labelStorage, eventPublisher, logger)
+
+	// 4. Init Carrier Trackers (adaptadores de tracking por transportadora)
+	trackers := usecasetracking.NewCarrierTrackerRegistry()
+	if environment.Get("FAKE_CARRIER_ENABLED") == "true" {
+		if token := environment.Get("FAKE_CARRIER_WEBHOOK_TOKEN"); token != "" {
+			trackers.Register(fake.New(token))
+		} else {
+			logger.Error().Msg("FAKE_CARRIER_WEBHOOK_TOKEN no configurado, transportadora simulada deshabilitada")
+		}
+	}
+	for _, code := range carriers.ListRegisteredCodes() {
+		if tracker, err := carrier.Tracker(code); err == nil {
+			trackers.Register(tracker)
+		}
+	}
+
+	pollInterval := usecasetracking.DefaultPollInterval
+	if raw := environment.Get("SHIPMENT_TRACKING_POLL_INTERVAL"); raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil {
+			pollInterval = parsed
+		} else {
+			logger.Warn(context.Background()).
+				Str("value", raw).
+				Msg("SHIPMENT_TRACKING_POLL_INTERVAL inválido, usando valor por defecto")
+		}
+	}
+	tracking := usecasetracking.New(repo, trackers, eventPublisher, logger, pollInterval)
 
-	// 3. Init Handlers
+	// 5. Init Delivery (última milla: escalamiento tras N intentos fallidos y evidencia de entrega en S3)
+	maxFailedAttempts := domain.DefaultMaxFailedAttempts
+	if raw := environment.Get("DELIVERY_MAX_FAILED_ATTEMPTS"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+			maxFailedAttempts = parsed
+		} else {
+			logger.Warn(context.Background()).
+				Str("value", raw).
+				Msg("DELIVERY_MAX_FAILED_ATTEMPTS inválido, usando valor por defecto")
+		}
+	}
+	maxDistanceMeters := domain.DefaultMaxDeliveryDistanceMeters
+	if raw := environment.Get("POD_MAX_DISTANCE_METERS"); raw != "" {
+		if parsed, err := strconv.ParseFloat(raw, 64); err == nil && parsed > 0 {
+			maxDistanceMeters = parsed
+		} else {
+			logger.Warn(context.Background()).
+				Str("value", raw).
+				Msg("POD_MAX_DISTANCE_METERS inválido, usando valor por defecto")
+		}
+	}
+	var evidenceStorage domain.IEvidenceStorage
+	if s3Service != nil {
+		evidenceStorage = evidence.New(s3Service, environment.Get("URL_BASE_DOMAIN_S3"))
+	}
+	delivery := usecasedelivery.New(repo, evidenceStorage, eventPublisher, logger, maxFailedAttempts, maxDistanceMeters)
+
+	// 6. Init Use Cases
+	uc := usecases.New(repo, eventPublisher, tracking, carrier, delivery)
+
+	// 7. Init Handlers
 	h := handlers.New(uc)
 
-	// 4. Register Routes
+	// 8. Register Routes
 	h.RegisterRoutes(router)
+
+	// 9. Init Tracking Worker (consulta periódica a las transportadoras registradas)
+	if len(trackers.Codes()) > 0 {
+		worker.New(tracking, logger).Start(context.Background())
+	}
 }
 
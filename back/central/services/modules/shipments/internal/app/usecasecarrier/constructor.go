@@ -0,0 +1,40 @@
+package usecasecarrier
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCaseCarrier cotiza, genera y cancela guías con las transportadoras registradas
+type UseCaseCarrier struct {
+	repo           domain.IRepository
+	carriers       *CarrierRegistry
+	accounts       domain.ICarrierAccounts
+	labels         domain.ILabelStorage
+	eventPublisher domain.IShipmentEventPublisher
+	logger         log.ILogger
+}
+
+// New crea el caso de uso de transportadoras.
+// labels es opcional (nil = no se pueden generar guías); eventPublisher es opcional.
+func New(repo domain.IRepository, carriers *CarrierRegistry, accounts domain.ICarrierAccounts, labels domain.ILabelStorage, eventPublisher domain.IShipmentEventPublisher, logger log.ILogger) *UseCaseCarrier {
+	return &UseCaseCarrier{
+		repo:           repo,
+		carriers:       carriers,
+		accounts:       accounts,
+		labels:         labels,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// publishEvent publica un evento de envío en línea para que la guía creada y su anulación lleguen en orden
+// (no falla la operación: el publicador registra los errores)
+func (uc *UseCaseCarrier) publishEvent(ctx context.Context, event *domain.ShipmentEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	_ = uc.eventPublisher.PublishShipmentEvent(context.WithoutCancel(ctx), event)
+}
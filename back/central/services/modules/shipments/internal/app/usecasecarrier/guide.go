@@ -0,0 +1,338 @@
+package usecasecarrier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// GenerateGuide genera la guía del envío con la transportadora, guarda la etiqueta PDF y
+// completa los datos de tracking del envío y la guía de la orden
+func (uc *UseCaseCarrier) GenerateGuide(ctx context.Context, shipmentID uint, req *domain.GenerateGuideRequest) (*domain.Shipment, error) {
+	if uc.labels == nil {
+		return nil, domain.ErrLabelStorageUnavailable
+	}
+
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.GuideID != nil && *shipment.GuideID != "" {
+		return nil, domain.ErrGuideAlreadyExists
+	}
+
+	carrierCode := strings.TrimSpace(req.CarrierCode)
+	if carrierCode == "" && shipment.CarrierCode != nil {
+		carrierCode = *shipment.CarrierCode
+	}
+	if carrierCode == "" {
+		return nil, domain.ErrCarrierRequired
+	}
+
+	carrier, err := uc.carriers.Get(carrierCode)
+	if err != nil {
+		return nil, err
+	}
+	order, account, err := uc.orderAndAccount(ctx, shipment, carrier)
+	if err != nil {
+		return nil, err
+	}
+
+	guideReq := &domain.CarrierGuideRequest{
+		Reference:     order.OrderNumber,
+		Service:       req.Service,
+		Recipient:     order.Recipient,
+		Parcel:        shipmentParcel(shipment, order),
+		DeclaredValue: order.TotalAmount,
+		Currency:      order.Currency,
+		Notes:         req.Notes,
+	}
+	if req.DeclaredValue != nil {
+		guideReq.DeclaredValue = *req.DeclaredValue
+	}
+	if order.CodTotal != nil {
+		guideReq.CodAmount = *order.CodTotal
+	}
+
+	// Reservar el envío antes de comprar la guía: dos solicitudes simultáneas no pagan dos guías
+	claimedAt := time.Now().UTC().Truncate(time.Microsecond)
+	claimed, err := uc.repo.ClaimGuideGeneration(ctx, shipment.ID, claimedAt, claimedAt.Add(-domain.GuideClaimTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("error claiming guide generation: %w", err)
+	}
+	if !claimed {
+		return nil, domain.ErrGuideGenerationInProgress
+	}
+
+	guide, err := carrier.CreateGuide(ctx, account, guideReq)
+	if err != nil {
+		uc.releaseGuideClaim(ctx, shipment.ID, claimedAt)
+		return nil, fmt.Errorf("error creating guide with %s: %w", carrier.Code(), err)
+	}
+
+	labelURL, err := uc.storeLabel(ctx, carrier, account, shipment.ID, guide.GuideID)
+	if err != nil {
+		// Sin etiqueta la guía no sirve: se anula para no dejarla huérfana en la transportadora
+		uc.cancelOrphanGuide(ctx, carrier, account, guide.GuideID, "No se pudo anular la guía tras fallar el guardado de la etiqueta")
+		uc.releaseGuideClaim(ctx, shipment.ID, claimedAt)
+		return nil, err
+	}
+
+	previousStatus := shipment.Status
+	name, code := carrier.Name(), carrier.Code()
+	shipment.Carrier = &name
+	shipment.CarrierCode = &code
+	shipment.GuideID = &guide.GuideID
+	shipment.GuideURL = &labelURL
+	if guide.TrackingNumber != "" {
+		shipment.TrackingNumber = &guide.TrackingNumber
+	}
+	if guide.TrackingURL != "" {
+		shipment.TrackingURL = &guide.TrackingURL
+	}
+	if guide.Cost != nil {
+		shipment.ShippingCost = guide.Cost
+	}
+	if guide.EstimatedDelivery != nil {
+		shipment.EstimatedDelivery = guide.EstimatedDelivery
+	}
+
+	saved, err := uc.repo.SaveGeneratedGuide(ctx, shipment, claimedAt)
+	if err != nil || !saved {
+		// La guía ya está pagada pero el envío no la referencia: se anula en la transportadora
+		uc.cancelOrphanGuide(ctx, carrier, account, guide.GuideID, "No se pudo anular la guía tras fallar su registro en el envío")
+		if err != nil {
+			uc.releaseGuideClaim(ctx, shipment.ID, claimedAt)
+			return nil, fmt.Errorf("error updating shipment: %w", err)
+		}
+		return nil, domain.ErrGuideGenerationInProgress
+	}
+	shipment.GuideRequestedAt = nil
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+
+	if err := uc.repo.UpdateOrderGuide(ctx, shipment.OrderID, shipment.GuideID, shipment.GuideURL); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("order_id", shipment.OrderID).
+			Str("guide_id", guide.GuideID).
+			Msg("Error al copiar la guía a la orden")
+	}
+
+	return shipment, nil
+}
+
+// cancelOrphanGuide anula en la transportadora una guía que no quedó registrada en el envío
+func (uc *UseCaseCarrier) cancelOrphanGuide(ctx context.Context, carrier domain.ICarrier, account *domain.CarrierAccount, guideID, message string) {
+	if err := carrier.CancelGuide(ctx, account, guideID); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("carrier", carrier.Code()).
+			Str("guide_id", guideID).
+			Msg(message)
+	}
+}
+
+// releaseGuideClaim libera la reserva de generación de guía para que se pueda reintentar de inmediato
+func (uc *UseCaseCarrier) releaseGuideClaim(ctx context.Context, shipmentID uint, claimedAt time.Time) {
+	if err := uc.repo.ReleaseGuideGeneration(context.WithoutCancel(ctx), shipmentID, claimedAt); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Uint("shipment_id", shipmentID).
+			Msg("Error al liberar la reserva de generación de guía")
+	}
+}
+
+// CancelGuide anula la guía del envío en la transportadora y limpia los datos de la guía
+func (uc *UseCaseCarrier) CancelGuide(ctx context.Context, shipmentID uint) (*domain.Shipment, error) {
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.GuideID == nil || *shipment.GuideID == "" {
+		return nil, domain.ErrGuideNotFound
+	}
+	if shipment.Status != domain.ShipmentStatusPending {
+		return nil, domain.ErrGuideNotCancellable
+	}
+	if shipment.CarrierCode == nil || *shipment.CarrierCode == "" {
+		return nil, domain.ErrCarrierRequired
+	}
+
+	carrier, err := uc.carriers.Get(*shipment.CarrierCode)
+	if err != nil {
+		return nil, err
+	}
+	_, account, err := uc.orderAndAccount(ctx, shipment, carrier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := carrier.CancelGuide(ctx, account, *shipment.GuideID); err != nil {
+		return nil, fmt.Errorf("error cancelling guide with %s: %w", carrier.Code(), err)
+	}
+
+	shipment.GuideID = nil
+	shipment.GuideURL = nil
+	shipment.TrackingNumber = nil
+	shipment.TrackingURL = nil
+	if err := uc.repo.UpdateShipment(ctx, shipment); err != nil {
+		return nil, fmt.Errorf("error updating shipment: %w", err)
+	}
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, shipment.Status))
+
+	if err := uc.repo.UpdateOrderGuide(ctx, shipment.OrderID, nil, nil); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Str("order_id", shipment.OrderID).
+			Msg("Error al limpiar la guía de la orden")
+	}
+
+	return shipment, nil
+}
+
+// QuoteShipment cotiza el envío con las transportadoras indicadas (o todas las registradas).
+// Las transportadoras sin cuenta o que fallan se reportan en Errors sin detener la cotización.
+func (uc *UseCaseCarrier) QuoteShipment(ctx context.Context, shipmentID uint, req *domain.QuoteShipmentRequest) (*domain.QuoteShipmentResponse, error) {
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	order, err := uc.repo.GetShipmentOrder(ctx, shipment.OrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting shipment order: %w", err)
+	}
+
+	codes := req.CarrierCodes
+	if len(codes) == 0 {
+		codes = uc.carriers.ListRegisteredCodes()
+	}
+
+	quoteReq := &domain.CarrierQuoteRequest{
+		Recipient:     order.Recipient,
+		Parcel:        shipmentParcel(shipment, order),
+		DeclaredValue: order.TotalAmount,
+		Currency:      order.Currency,
+	}
+	if req.DeclaredValue != nil {
+		quoteReq.DeclaredValue = *req.DeclaredValue
+	}
+	if order.CodTotal != nil {
+		quoteReq.CodAmount = *order.CodTotal
+	}
+
+	response := &domain.QuoteShipmentResponse{Quotes: []domain.CarrierQuote{}}
+	addError := func(code string, err error) {
+		if response.Errors == nil {
+			response.Errors = make(map[string]string)
+		}
+		response.Errors[code] = err.Error()
+	}
+
+	for _, code := range codes {
+		carrier, err := uc.carriers.Get(code)
+		if err != nil {
+			addError(code, err)
+			continue
+		}
+		account, err := uc.accounts.GetAccount(ctx, carrier.Code(), order.BusinessID)
+		if err != nil {
+			addError(code, err)
+			continue
+		}
+		quotes, err := carrier.Quote(ctx, account, quoteReq)
+		if err != nil {
+			addError(code, err)
+			continue
+		}
+		for _, quote := range quotes {
+			if quote.CarrierCode == "" {
+				quote.CarrierCode = carrier.Code()
+			}
+			response.Quotes = append(response.Quotes, quote)
+		}
+	}
+
+	sort.SliceStable(response.Quotes, func(i, j int) bool {
+		return response.Quotes[i].Price < response.Quotes[j].Price
+	})
+	return response, nil
+}
+
+// storeLabel descarga la etiqueta de la guía y la sube al almacenamiento
+func (uc *UseCaseCarrier) storeLabel(ctx context.Context, carrier domain.ICarrier, account *domain.CarrierAccount, shipmentID uint, guideID string) (string, error) {
+	label, err := carrier.FetchLabel(ctx, account, guideID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching label from %s: %w", carrier.Code(), err)
+	}
+
+	key := fmt.Sprintf("shipments/labels/%d/%s.pdf", shipmentID, sanitizeKey(guideID))
+	url, err := uc.labels.Upload(ctx, key, label)
+	if err != nil {
+		return "", fmt.Errorf("error uploading label: %w", err)
+	}
+	return url, nil
+}
+
+func (uc *UseCaseCarrier) getShipment(ctx context.Context, shipmentID uint) (*domain.Shipment, error) {
+	shipment, err := uc.repo.GetShipmentByID(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrShipmentNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting shipment: %w", err)
+	}
+	return shipment, nil
+}
+
+// orderAndAccount obtiene la orden del envío y la cuenta de su negocio en la transportadora
+func (uc *UseCaseCarrier) orderAndAccount(ctx context.Context, shipment *domain.Shipment, carrier domain.ICarrier) (*domain.ShipmentOrder, *domain.CarrierAccount, error) {
+	order, err := uc.repo.GetShipmentOrder(ctx, shipment.OrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("error getting shipment order: %w", err)
+	}
+
+	account, err := uc.accounts.GetAccount(ctx, carrier.Code(), order.BusinessID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, account, nil
+}
+
+// shipmentParcel usa las dimensiones del envío y completa las faltantes con las de la orden
+func shipmentParcel(shipment *domain.Shipment, order *domain.ShipmentOrder) domain.CarrierParcel {
+	parcel := order.Parcel
+	if shipment.Weight != nil {
+		parcel.Weight = *shipment.Weight
+	}
+	if shipment.Height != nil {
+		parcel.Height = *shipment.Height
+	}
+	if shipment.Width != nil {
+		parcel.Width = *shipment.Width
+	}
+	if shipment.Length != nil {
+		parcel.Length = *shipment.Length
+	}
+	return parcel
+}
+
+// sanitizeKey deja solo caracteres seguros para la llave del archivo
+func sanitizeKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, value)
+}
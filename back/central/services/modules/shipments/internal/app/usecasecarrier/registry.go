@@ -0,0 +1,78 @@
+package usecasecarrier
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CarrierRegistry mantiene los adaptadores de transportadora por código
+type CarrierRegistry struct {
+	carriers map[string]domain.ICarrier
+	mu       sync.RWMutex
+}
+
+// NewCarrierRegistry crea un registro vacío de transportadoras
+func NewCarrierRegistry() *CarrierRegistry {
+	return &CarrierRegistry{
+		carriers: make(map[string]domain.ICarrier),
+	}
+}
+
+// Register registra una transportadora bajo su código (sin distinguir mayúsculas)
+func (r *CarrierRegistry) Register(carrier domain.ICarrier) error {
+	if carrier == nil {
+		return fmt.Errorf("carrier is nil")
+	}
+	code := normalizeCarrierCode(carrier.Code())
+	if code == "" {
+		return fmt.Errorf("carrier code is empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.carriers[code] = carrier
+	return nil
+}
+
+// Get obtiene la transportadora registrada para un código
+func (r *CarrierRegistry) Get(carrierCode string) (domain.ICarrier, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	carrier, exists := r.carriers[normalizeCarrierCode(carrierCode)]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCarrierNotRegistered, carrierCode)
+	}
+	return carrier, nil
+}
+
+// IsRegistered verifica si hay una transportadora registrada para el código
+func (r *CarrierRegistry) IsRegistered(carrierCode string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.carriers[normalizeCarrierCode(carrierCode)]
+	return exists
+}
+
+// ListRegisteredCodes retorna los códigos registrados en orden alfabético
+func (r *CarrierRegistry) ListRegisteredCodes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codes := make([]string, 0, len(r.carriers))
+	for code := range r.carriers {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+func normalizeCarrierCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
@@ -0,0 +1,56 @@
+package usecasecarrier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// carrierTracker expone una transportadora como adaptador de tracking: resuelve la cuenta del
+// negocio a partir del envío para que el worker de tracking pueda consultarla
+type carrierTracker struct {
+	carrier  domain.ICarrier
+	repo     domain.IRepository
+	accounts domain.ICarrierAccounts
+}
+
+// Tracker retorna el adaptador de tracking de una transportadora registrada
+func (uc *UseCaseCarrier) Tracker(carrierCode string) (domain.ICarrierTracker, error) {
+	carrier, err := uc.carriers.Get(carrierCode)
+	if err != nil {
+		return nil, err
+	}
+	return &carrierTracker{carrier: carrier, repo: uc.repo, accounts: uc.accounts}, nil
+}
+
+// Code retorna el código de la transportadora
+func (t *carrierTracker) Code() string {
+	return t.carrier.Code()
+}
+
+// Track consulta el tracking con la cuenta del negocio dueño del envío
+func (t *carrierTracker) Track(ctx context.Context, trackingNumber string) ([]domain.TrackingCheckpoint, error) {
+	shipment, err := t.repo.GetShipmentByCarrierTracking(ctx, t.carrier.Code(), trackingNumber)
+	if err != nil {
+		return nil, err
+	}
+	order, err := t.repo.GetShipmentOrder(ctx, shipment.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := t.accounts.GetAccount(ctx, t.carrier.Code(), order.BusinessID)
+	if err != nil {
+		return nil, err
+	}
+	return t.carrier.Track(ctx, account, trackingNumber)
+}
+
+// ParseWebhook delega en la transportadora si recibe webhooks de tracking
+func (t *carrierTracker) ParseWebhook(ctx context.Context, headers map[string]string, body []byte) ([]domain.TrackingUpdate, error) {
+	webhook, ok := t.carrier.(domain.ICarrierWebhook)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support tracking webhooks", domain.ErrCarrierTrackerNotFound, t.carrier.Code())
+	}
+	return webhook.ParseWebhook(ctx, headers, body)
+}
@@ -0,0 +1,198 @@
+package usecasedelivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// LogAttempt registra un intento de entrega del envío. Un intento exitoso marca el envío como entregado;
+// uno fallido lo marca como fallido con el motivo del catálogo y, al llegar al máximo de intentos
+// fallidos, lo escala. Retorna el intento, el envío actualizado y si el intento lo escaló.
+func (uc *UseCaseDelivery) LogAttempt(ctx context.Context, shipmentID uint, req *domain.LogDeliveryAttemptRequest) (*domain.DeliveryAttempt, *domain.Shipment, bool, error) {
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !shipment.IsLastMile {
+		return nil, nil, false, domain.ErrShipmentNotLastMile
+	}
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return nil, nil, false, domain.ErrShipmentAlreadyDelivered
+	}
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return nil, nil, false, domain.ErrInvalidDeliveryLocation
+	}
+
+	attemptedAt := time.Now().UTC()
+	if req.AttemptedAt != nil {
+		attemptedAt = req.AttemptedAt.UTC()
+	}
+
+	attempt := &domain.DeliveryAttempt{
+		ShipmentID:  shipment.ID,
+		OrderID:     shipment.OrderID,
+		DriverID:    shipment.DriverID,
+		DriverName:  shipment.DriverName,
+		AttemptedAt: attemptedAt,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Outcome:     req.Outcome,
+		CreatedBy:   req.CreatedBy,
+	}
+	if req.DriverID != nil && (shipment.DriverID == nil || *req.DriverID != *shipment.DriverID) {
+		attempt.DriverID = req.DriverID
+		attempt.DriverName = ""
+	}
+	if name := strings.TrimSpace(req.DriverName); name != "" {
+		attempt.DriverName = name
+	}
+	if notes := strings.TrimSpace(req.Notes); notes != "" {
+		attempt.Notes = &notes
+	}
+
+	failureReasonName := ""
+	if req.Outcome == domain.DeliveryOutcomeFailed {
+		reason, err := uc.failureReason(ctx, shipment, req.FailureReasonCode)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		attempt.FailureReasonCode = &reason.Code
+		failureReasonName = reason.Name
+	}
+
+	// El estado, el contador de fallas y el escalamiento se calculan en el repositorio con el envío bloqueado
+	previousStatus := shipment.Status
+	escalated, err := uc.repo.CreateDeliveryAttempt(ctx, attempt, shipment, failureReasonName, uc.maxFailedAttempts)
+	if err != nil {
+		if errors.Is(err, domain.ErrShipmentAlreadyDelivered) {
+			return nil, nil, false, err
+		}
+		return nil, nil, false, fmt.Errorf("error creating delivery attempt: %w", err)
+	}
+
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+	if escalated {
+		uc.logger.Warn(ctx).
+			Uint("shipment_id", shipment.ID).
+			Str("order_id", shipment.OrderID).
+			Int("failed_attempts", shipment.FailedAttempts).
+			Msg("Envío escalado por intentos de entrega fallidos")
+		uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeEscalated, shipment, shipment.Status))
+	}
+
+	return attempt, shipment, escalated, nil
+}
+
+// ListAttempts obtiene los intentos de entrega de un envío
+func (uc *UseCaseDelivery) ListAttempts(ctx context.Context, shipmentID uint) ([]domain.DeliveryAttempt, error) {
+	if _, err := uc.getShipment(ctx, shipmentID); err != nil {
+		return nil, err
+	}
+	attempts, err := uc.repo.ListDeliveryAttempts(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing delivery attempts: %w", err)
+	}
+	return attempts, nil
+}
+
+// Reschedule acuerda una nueva fecha de entrega para un envío cuyo último intento falló y lo devuelve a ruta
+func (uc *UseCaseDelivery) Reschedule(ctx context.Context, shipmentID uint, req *domain.RescheduleDeliveryRequest) (*domain.Shipment, error) {
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return nil, domain.ErrShipmentAlreadyDelivered
+	}
+	if shipment.Status != domain.ShipmentStatusFailed {
+		return nil, domain.ErrRescheduleNotAllowed
+	}
+	if !req.ScheduledFor.After(time.Now()) {
+		return nil, domain.ErrInvalidRescheduleDate
+	}
+
+	scheduledFor := req.ScheduledFor.UTC()
+	previousStatus := shipment.Status
+	shipment.Status = domain.ShipmentStatusInTransit
+	shipment.EstimatedDelivery = &scheduledFor
+	if notes := strings.TrimSpace(req.Notes); notes != "" {
+		shipment.DeliveryNotes = &notes
+	}
+
+	if err := uc.repo.RescheduleDelivery(ctx, shipment, scheduledFor); err != nil {
+		if errors.Is(err, domain.ErrRescheduleNotAllowed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error rescheduling delivery: %w", err)
+	}
+
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+	return shipment, nil
+}
+
+// Escalate escala manualmente un envío pendiente de entrega para que lo atienda el equipo de operaciones
+func (uc *UseCaseDelivery) Escalate(ctx context.Context, shipmentID uint, req *domain.EscalateShipmentRequest) (*domain.Shipment, error) {
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return nil, domain.ErrShipmentAlreadyDelivered
+	}
+	if shipment.EscalatedAt != nil {
+		return nil, domain.ErrShipmentAlreadyEscalated
+	}
+
+	now := time.Now().UTC()
+	reason := strings.TrimSpace(req.Reason)
+	shipment.EscalatedAt = &now
+	shipment.EscalationReason = &reason
+
+	if err := uc.repo.UpdateShipment(ctx, shipment); err != nil {
+		return nil, fmt.Errorf("error escalating shipment: %w", err)
+	}
+
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeEscalated, shipment, shipment.Status))
+	return shipment, nil
+}
+
+// failureReason valida el motivo de un intento fallido contra el catálogo del negocio de la orden
+func (uc *UseCaseDelivery) failureReason(ctx context.Context, shipment *domain.Shipment, code string) (*domain.DeliveryFailureReason, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return nil, domain.ErrFailureReasonRequired
+	}
+
+	order, err := uc.repo.GetShipmentOrder(ctx, shipment.OrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting shipment order: %w", err)
+	}
+
+	reason, err := uc.repo.GetActiveDeliveryFailureReason(ctx, order.BusinessID, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrFailureReasonNotFound) {
+			return nil, fmt.Errorf("%w: %s", err, code)
+		}
+		return nil, fmt.Errorf("error getting delivery failure reason: %w", err)
+	}
+	return reason, nil
+}
+
+func (uc *UseCaseDelivery) getShipment(ctx context.Context, shipmentID uint) (*domain.Shipment, error) {
+	shipment, err := uc.repo.GetShipmentByID(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrShipmentNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting shipment: %w", err)
+	}
+	return shipment, nil
+}
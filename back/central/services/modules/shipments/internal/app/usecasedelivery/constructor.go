@@ -0,0 +1,48 @@
+package usecasedelivery
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCaseDelivery registra los intentos de entrega de última milla, reprograma y escala los envíos,
+// y guarda la evidencia de entrega
+type UseCaseDelivery struct {
+	repo              domain.IRepository
+	evidence          domain.IEvidenceStorage
+	eventPublisher    domain.IShipmentEventPublisher
+	logger            log.ILogger
+	maxFailedAttempts int
+	maxDistanceMeters float64
+}
+
+// New crea el caso de uso de entregas. maxFailedAttempts <= 0 usa domain.DefaultMaxFailedAttempts y
+// maxDistanceMeters <= 0 usa domain.DefaultMaxDeliveryDistanceMeters. evidence es opcional
+// (nil = no se puede capturar evidencia); eventPublisher es opcional.
+func New(repo domain.IRepository, evidence domain.IEvidenceStorage, eventPublisher domain.IShipmentEventPublisher, logger log.ILogger, maxFailedAttempts int, maxDistanceMeters float64) *UseCaseDelivery {
+	if maxFailedAttempts <= 0 {
+		maxFailedAttempts = domain.DefaultMaxFailedAttempts
+	}
+	if maxDistanceMeters <= 0 {
+		maxDistanceMeters = domain.DefaultMaxDeliveryDistanceMeters
+	}
+	return &UseCaseDelivery{
+		repo:              repo,
+		evidence:          evidence,
+		eventPublisher:    eventPublisher,
+		logger:            logger,
+		maxFailedAttempts: maxFailedAttempts,
+		maxDistanceMeters: maxDistanceMeters,
+	}
+}
+
+// publishEvent publica un evento de envío en línea: el "updated" del intento sale antes que su
+// "escalated" (no falla la operación, el publicador registra los errores)
+func (uc *UseCaseDelivery) publishEvent(ctx context.Context, event *domain.ShipmentEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	_ = uc.eventPublisher.PublishShipmentEvent(context.WithoutCancel(ctx), event)
+}
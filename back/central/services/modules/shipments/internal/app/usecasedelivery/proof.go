@@ -0,0 +1,202 @@
+package usecasedelivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CaptureProof guarda la evidencia de entrega (foto, firma, quién recibió y posición del dispositivo),
+// la compara con las coordenadas de la dirección de la orden y, si el envío aún no estaba entregado,
+// lo marca como entregado. Retorna la evidencia y el envío actualizado.
+func (uc *UseCaseDelivery) CaptureProof(ctx context.Context, shipmentID uint, req *domain.CaptureProofOfDeliveryRequest) (*domain.ProofOfDelivery, *domain.Shipment, error) {
+	if uc.evidence == nil {
+		return nil, nil, domain.ErrEvidenceStorageUnavailable
+	}
+
+	shipment, err := uc.getShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Photo == nil {
+		return nil, nil, domain.ErrProofPhotoRequired
+	}
+	receiverName := strings.TrimSpace(req.ReceiverName)
+	if receiverName == "" {
+		return nil, nil, domain.ErrReceiverNameRequired
+	}
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return nil, nil, domain.ErrInvalidDeliveryLocation
+	}
+
+	// Validar antes de subir las imágenes para no dejar archivos huérfanos
+	if _, err := uc.repo.GetProofOfDelivery(ctx, shipment.ID); err == nil {
+		return nil, nil, domain.ErrProofOfDeliveryAlreadyExists
+	} else if !errors.Is(err, domain.ErrProofOfDeliveryNotFound) {
+		return nil, nil, fmt.Errorf("error getting proof of delivery: %w", err)
+	}
+
+	order, err := uc.repo.GetShipmentOrder(ctx, shipment.OrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("error getting shipment order: %w", err)
+	}
+
+	capturedAt := time.Now().UTC()
+	if req.CapturedAt != nil {
+		capturedAt = req.CapturedAt.UTC()
+	}
+
+	proof := &domain.ProofOfDelivery{
+		ShipmentID:       shipment.ID,
+		OrderID:          shipment.OrderID,
+		DriverID:         shipment.DriverID,
+		ReceiverName:     receiverName,
+		ReceiverDocument: strings.TrimSpace(req.ReceiverDocument),
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		CapturedAt:       capturedAt,
+	}
+	if req.DriverID != nil {
+		proof.DriverID = req.DriverID
+	}
+	if notes := strings.TrimSpace(req.Notes); notes != "" {
+		proof.Notes = &notes
+	}
+	// Con el centroide de la ciudad casi toda entrega quedaría "lejos": solo se mide contra la placa o la vía
+	if req.Latitude != nil && order.HasPreciseLocation() {
+		distance := domain.DistanceMeters(*req.Latitude, *req.Longitude, *order.ShippingLat, *order.ShippingLng)
+		distance = math.Round(distance*100) / 100
+		proof.DistanceMeters = &distance
+		proof.IsFarFromAddress = distance > uc.maxDistanceMeters
+	}
+
+	if err := uc.uploadEvidence(ctx, proof, req); err != nil {
+		return nil, nil, err
+	}
+	if err := uc.repo.CreateProofOfDelivery(ctx, proof); err != nil {
+		uc.deleteEvidence(ctx, proof)
+		if errors.Is(err, domain.ErrProofOfDeliveryAlreadyExists) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("error creating proof of delivery: %w", err)
+	}
+
+	if proof.IsFarFromAddress {
+		uc.logger.Warn(ctx).
+			Uint("shipment_id", shipment.ID).
+			Str("order_id", shipment.OrderID).
+			Float64("distance_meters", *proof.DistanceMeters).
+			Msg("Entrega registrada lejos de la dirección de la orden")
+	}
+
+	shipment = uc.markDelivered(ctx, shipment, proof)
+	uc.fillEvidenceURLs(proof)
+	return proof, shipment, nil
+}
+
+// GetProof obtiene la evidencia de entrega de un envío con las URLs de sus imágenes
+func (uc *UseCaseDelivery) GetProof(ctx context.Context, shipmentID uint) (*domain.ProofOfDelivery, error) {
+	proof, err := uc.repo.GetProofOfDelivery(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProofOfDeliveryNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting proof of delivery: %w", err)
+	}
+	uc.fillEvidenceURLs(proof)
+	return proof, nil
+}
+
+// uploadEvidence sube la foto y la firma; si la firma falla, elimina la foto ya subida
+func (uc *UseCaseDelivery) uploadEvidence(ctx context.Context, proof *domain.ProofOfDelivery, req *domain.CaptureProofOfDeliveryRequest) error {
+	folder := fmt.Sprintf("shipments/pod/%d", proof.ShipmentID)
+
+	photoPath, err := uc.evidence.UploadImage(ctx, req.Photo, folder)
+	if err != nil {
+		return fmt.Errorf("%w: photo: %v", domain.ErrEvidenceUploadFailed, err)
+	}
+	proof.PhotoPath = photoPath
+
+	if req.Signature != nil {
+		signaturePath, err := uc.evidence.UploadImage(ctx, req.Signature, folder)
+		if err != nil {
+			uc.deleteEvidence(ctx, proof)
+			return fmt.Errorf("%w: signature: %v", domain.ErrEvidenceUploadFailed, err)
+		}
+		proof.SignaturePath = signaturePath
+	}
+	return nil
+}
+
+// deleteEvidence elimina las imágenes subidas de una evidencia que no se pudo guardar
+func (uc *UseCaseDelivery) deleteEvidence(ctx context.Context, proof *domain.ProofOfDelivery) {
+	for _, path := range []string{proof.PhotoPath, proof.SignaturePath} {
+		if path == "" {
+			continue
+		}
+		if err := uc.evidence.Delete(ctx, path); err != nil {
+			uc.logger.Error(ctx).
+				Err(err).
+				Str("path", path).
+				Msg("Error al eliminar imagen de evidencia huérfana")
+		}
+	}
+}
+
+// markDelivered marca como entregado un envío que aún no lo estaba. En última milla se registra como
+// intento exitoso para mantener completo el historial de intentos. Los errores se registran y no
+// invalidan la evidencia ya guardada.
+func (uc *UseCaseDelivery) markDelivered(ctx context.Context, shipment *domain.Shipment, proof *domain.ProofOfDelivery) *domain.Shipment {
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return shipment
+	}
+
+	if shipment.IsLastMile {
+		_, updated, _, err := uc.LogAttempt(ctx, shipment.ID, &domain.LogDeliveryAttemptRequest{
+			Outcome:     domain.DeliveryOutcomeDelivered,
+			DriverID:    proof.DriverID,
+			AttemptedAt: &proof.CapturedAt,
+			Latitude:    proof.Latitude,
+			Longitude:   proof.Longitude,
+		})
+		if err != nil {
+			uc.logger.Error(ctx).
+				Err(err).
+				Uint("shipment_id", shipment.ID).
+				Msg("Error al registrar el intento exitoso de la evidencia de entrega")
+			return shipment
+		}
+		return updated
+	}
+
+	previousStatus, previousDeliveredAt := shipment.Status, shipment.DeliveredAt
+	deliveredAt := proof.CapturedAt
+	shipment.Status = domain.ShipmentStatusDelivered
+	shipment.DeliveredAt = &deliveredAt
+	if err := uc.repo.UpdateShipment(ctx, shipment); err != nil {
+		uc.logger.Error(ctx).
+			Err(err).
+			Uint("shipment_id", shipment.ID).
+			Msg("Error al marcar como entregado el envío con evidencia de entrega")
+		shipment.Status, shipment.DeliveredAt = previousStatus, previousDeliveredAt
+		return shipment
+	}
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+	return shipment
+}
+
+func (uc *UseCaseDelivery) fillEvidenceURLs(proof *domain.ProofOfDelivery) {
+	if uc.evidence == nil {
+		return
+	}
+	proof.PhotoURL = uc.evidence.URL(proof.PhotoPath)
+	proof.SignatureURL = uc.evidence.URL(proof.SignaturePath)
+}
@@ -0,0 +1,77 @@
+package usecasedelivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// ListFailureReasons obtiene el catálogo de motivos de falla: los globales y los del negocio indicado
+func (uc *UseCaseDelivery) ListFailureReasons(ctx context.Context, businessID *uint, activeOnly bool) ([]domain.DeliveryFailureReason, error) {
+	reasons, err := uc.repo.ListDeliveryFailureReasons(ctx, businessID, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("error listing delivery failure reasons: %w", err)
+	}
+	return reasons, nil
+}
+
+// CreateFailureReason agrega un motivo de falla al catálogo del negocio
+func (uc *UseCaseDelivery) CreateFailureReason(ctx context.Context, req *domain.CreateDeliveryFailureReasonRequest) (*domain.DeliveryFailureReason, error) {
+	code := strings.ToLower(strings.TrimSpace(req.Code))
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, domain.ErrInvalidShipmentData
+	}
+
+	businessID := req.BusinessID
+	reason := &domain.DeliveryFailureReason{
+		BusinessID:  &businessID,
+		Code:        code,
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+		IsActive:    true,
+	}
+	if err := uc.repo.CreateDeliveryFailureReason(ctx, reason); err != nil {
+		if errors.Is(err, domain.ErrFailureReasonAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error creating delivery failure reason: %w", err)
+	}
+	return reason, nil
+}
+
+// UpdateFailureReason modifica un motivo de falla de un negocio (los globales son de solo lectura)
+func (uc *UseCaseDelivery) UpdateFailureReason(ctx context.Context, id uint, req *domain.UpdateDeliveryFailureReasonRequest) (*domain.DeliveryFailureReason, error) {
+	reason, err := uc.repo.GetDeliveryFailureReasonByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrFailureReasonNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting delivery failure reason: %w", err)
+	}
+	if reason.BusinessID == nil {
+		return nil, domain.ErrFailureReasonReadOnly
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, domain.ErrInvalidShipmentData
+		}
+		reason.Name = name
+	}
+	if req.Description != nil {
+		reason.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.IsActive != nil {
+		reason.IsActive = *req.IsActive
+	}
+
+	if err := uc.repo.UpdateDeliveryFailureReason(ctx, reason); err != nil {
+		return nil, fmt.Errorf("error updating delivery failure reason: %w", err)
+	}
+	return reason, nil
+}
@@ -2,8 +2,12 @@ package usecases
 
 import (
 	"context"
+	"errors"
 
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasecarrier"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasedelivery"
 	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecaseshipment"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasetracking"
 	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
 )
 
@@ -13,13 +17,19 @@ type UseCases struct {
 
 	// Casos de uso modulares
 	ShipmentCRUD *usecaseshipment.UseCaseShipment
+	Tracking     *usecasetracking.UseCaseTracking
+	Carrier      *usecasecarrier.UseCaseCarrier
+	Delivery     *usecasedelivery.UseCaseDelivery
 }
 
 // New crea una nueva instancia de UseCases
-func New(repo domain.IRepository) *UseCases {
+func New(repo domain.IRepository, eventPublisher domain.IShipmentEventPublisher, tracking *usecasetracking.UseCaseTracking, carrier *usecasecarrier.UseCaseCarrier, delivery *usecasedelivery.UseCaseDelivery) *UseCases {
 	return &UseCases{
 		repo:         repo,
-		ShipmentCRUD: usecaseshipment.New(repo),
+		ShipmentCRUD: usecaseshipment.New(repo, eventPublisher),
+		Tracking:     tracking,
+		Carrier:      carrier,
+		Delivery:     delivery,
 	}
 }
 
@@ -34,7 +44,18 @@ func (uc *UseCases) CreateShipment(ctx context.Context, req *domain.CreateShipme
 
 // GetShipmentByID delega al caso de uso CRUD
 func (uc *UseCases) GetShipmentByID(ctx context.Context, id uint) (*domain.ShipmentResponse, error) {
-	return uc.ShipmentCRUD.GetShipmentByID(ctx, id)
+	shipment, err := uc.ShipmentCRUD.GetShipmentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// El detalle incluye la evidencia de entrega, si se capturó
+	proof, err := uc.Delivery.GetProof(ctx, id)
+	if err != nil && !errors.Is(err, domain.ErrProofOfDeliveryNotFound) {
+		return nil, err
+	}
+	shipment.ProofOfDelivery = proof
+	return shipment, nil
 }
 
 // ListShipments delega al caso de uso CRUD
@@ -66,6 +87,118 @@ func (uc *UseCases) GetShipmentByTrackingNumber(ctx context.Context, trackingNum
 	return mapShipmentToResponse(shipment), nil
 }
 
+// ───────────────────────────────────────────
+// TRACKING - Delegar al caso de uso de tracking
+// ───────────────────────────────────────────
+
+// ListTrackingEvents delega al caso de uso de tracking
+func (uc *UseCases) ListTrackingEvents(ctx context.Context, shipmentID uint) ([]domain.ShipmentTrackingEvent, error) {
+	return uc.Tracking.ListTrackingEvents(ctx, shipmentID)
+}
+
+// IngestTrackingWebhook delega al caso de uso de tracking
+func (uc *UseCases) IngestTrackingWebhook(ctx context.Context, carrierCode string, headers map[string]string, body []byte) (*domain.TrackingIngestResult, error) {
+	return uc.Tracking.IngestWebhook(ctx, carrierCode, headers, body)
+}
+
+// ───────────────────────────────────────────
+// CARRIERS - Delegar al caso de uso de transportadoras
+// ───────────────────────────────────────────
+
+// GenerateGuide delega al caso de uso de transportadoras
+func (uc *UseCases) GenerateGuide(ctx context.Context, shipmentID uint, req *domain.GenerateGuideRequest) (*domain.ShipmentResponse, error) {
+	shipment, err := uc.Carrier.GenerateGuide(ctx, shipmentID, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapShipmentToResponse(shipment), nil
+}
+
+// CancelGuide delega al caso de uso de transportadoras
+func (uc *UseCases) CancelGuide(ctx context.Context, shipmentID uint) (*domain.ShipmentResponse, error) {
+	shipment, err := uc.Carrier.CancelGuide(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	return mapShipmentToResponse(shipment), nil
+}
+
+// QuoteShipment delega al caso de uso de transportadoras
+func (uc *UseCases) QuoteShipment(ctx context.Context, shipmentID uint, req *domain.QuoteShipmentRequest) (*domain.QuoteShipmentResponse, error) {
+	return uc.Carrier.QuoteShipment(ctx, shipmentID, req)
+}
+
+// ───────────────────────────────────────────
+// DELIVERY ATTEMPTS - Delegar al caso de uso de intentos de entrega
+// ───────────────────────────────────────────
+
+// LogDeliveryAttempt delega al caso de uso de intentos de entrega
+func (uc *UseCases) LogDeliveryAttempt(ctx context.Context, shipmentID uint, req *domain.LogDeliveryAttemptRequest) (*domain.DeliveryAttemptResponse, error) {
+	attempt, shipment, escalated, err := uc.Delivery.LogAttempt(ctx, shipmentID, req)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.DeliveryAttemptResponse{
+		Attempt:   attempt,
+		Shipment:  mapShipmentToResponse(shipment),
+		Escalated: escalated,
+	}, nil
+}
+
+// ListDeliveryAttempts delega al caso de uso de intentos de entrega
+func (uc *UseCases) ListDeliveryAttempts(ctx context.Context, shipmentID uint) ([]domain.DeliveryAttempt, error) {
+	return uc.Delivery.ListAttempts(ctx, shipmentID)
+}
+
+// RescheduleDelivery delega al caso de uso de intentos de entrega
+func (uc *UseCases) RescheduleDelivery(ctx context.Context, shipmentID uint, req *domain.RescheduleDeliveryRequest) (*domain.ShipmentResponse, error) {
+	shipment, err := uc.Delivery.Reschedule(ctx, shipmentID, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapShipmentToResponse(shipment), nil
+}
+
+// EscalateShipment delega al caso de uso de intentos de entrega
+func (uc *UseCases) EscalateShipment(ctx context.Context, shipmentID uint, req *domain.EscalateShipmentRequest) (*domain.ShipmentResponse, error) {
+	shipment, err := uc.Delivery.Escalate(ctx, shipmentID, req)
+	if err != nil {
+		return nil, err
+	}
+	return mapShipmentToResponse(shipment), nil
+}
+
+// CaptureProofOfDelivery delega al caso de uso de entregas y retorna el envío con su evidencia
+func (uc *UseCases) CaptureProofOfDelivery(ctx context.Context, shipmentID uint, req *domain.CaptureProofOfDeliveryRequest) (*domain.ShipmentResponse, error) {
+	proof, shipment, err := uc.Delivery.CaptureProof(ctx, shipmentID, req)
+	if err != nil {
+		return nil, err
+	}
+	response := mapShipmentToResponse(shipment)
+	response.ProofOfDelivery = proof
+	return response, nil
+}
+
+// GetProofOfDelivery delega al caso de uso de entregas
+func (uc *UseCases) GetProofOfDelivery(ctx context.Context, shipmentID uint) (*domain.ProofOfDelivery, error) {
+	return uc.Delivery.GetProof(ctx, shipmentID)
+}
+
+// ListDeliveryFailureReasons delega al caso de uso de intentos de entrega
+func (uc *UseCases) ListDeliveryFailureReasons(ctx context.Context, businessID *uint, activeOnly bool) ([]domain.DeliveryFailureReason, error) {
+	return uc.Delivery.ListFailureReasons(ctx, businessID, activeOnly)
+}
+
+// CreateDeliveryFailureReason delega al caso de uso de intentos de entrega
+func (uc *UseCases) CreateDeliveryFailureReason(ctx context.Context, req *domain.CreateDeliveryFailureReasonRequest) (*domain.DeliveryFailureReason, error) {
+	return uc.Delivery.CreateFailureReason(ctx, req)
+}
+
+// UpdateDeliveryFailureReason delega al caso de uso de intentos de entrega
+func (uc *UseCases) UpdateDeliveryFailureReason(ctx context.Context, id uint, req *domain.UpdateDeliveryFailureReasonRequest) (*domain.DeliveryFailureReason, error) {
+	return uc.Delivery.UpdateFailureReason(ctx, id, req)
+}
+
 // mapShipmentToResponse convierte un modelo Shipment a ShipmentResponse
 func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 	return &domain.ShipmentResponse{
@@ -83,6 +216,7 @@ func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 		Status:         shipment.Status,
 		ShippedAt:      shipment.ShippedAt,
 		DeliveredAt:    shipment.DeliveredAt,
+		LastTrackedAt:  shipment.LastTrackedAt,
 		ShippingAddressID: shipment.ShippingAddressID,
 		ShippingCost:   shipment.ShippingCost,
 		InsuranceCost:  shipment.InsuranceCost,
@@ -96,6 +230,9 @@ func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 		DriverID:       shipment.DriverID,
 		DriverName:     shipment.DriverName,
 		IsLastMile:    shipment.IsLastMile,
+		FailedAttempts:   shipment.FailedAttempts,
+		EscalatedAt:      shipment.EscalatedAt,
+		EscalationReason: shipment.EscalationReason,
 		EstimatedDelivery: shipment.EstimatedDelivery,
 		DeliveryNotes:     shipment.DeliveryNotes,
 		Metadata:          shipment.Metadata,
@@ -6,13 +6,16 @@ import (
 
 // UseCaseShipment contiene los casos de uso CRUD básicos de envíos
 type UseCaseShipment struct {
-	repo domain.IRepository
+	repo           domain.IRepository
+	eventPublisher domain.IShipmentEventPublisher
 }
 
 // New crea una nueva instancia de UseCaseShipment
-func New(repo domain.IRepository) *UseCaseShipment {
+// eventPublisher es opcional (nil = no se publican eventos de envíos)
+func New(repo domain.IRepository, eventPublisher domain.IShipmentEventPublisher) *UseCaseShipment {
 	return &UseCaseShipment{
-		repo: repo,
+		repo:           repo,
+		eventPublisher: eventPublisher,
 	}
 }
 
@@ -82,6 +82,9 @@ func (uc *UseCaseShipment) CreateShipment(ctx context.Context, req *domain.Creat
 		return nil, fmt.Errorf("error creating shipment: %w", err)
 	}
 
+	// Publicar evento de envío creado
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeCreated, shipment, ""))
+
 	// Retornar la respuesta
 	return mapShipmentToResponse(shipment), nil
 }
@@ -172,6 +175,8 @@ func (uc *UseCaseShipment) UpdateShipment(ctx context.Context, id uint, req *dom
 		return nil, domain.ErrShipmentNotFound
 	}
 
+	previousStatus := shipment.Status
+
 	// Actualizar solo los campos proporcionados
 	if req.TrackingNumber != nil {
 		// Si se cambia el tracking number, verificar que no exista otro envío con ese tracking para la misma orden
@@ -264,6 +269,9 @@ func (uc *UseCaseShipment) UpdateShipment(ctx context.Context, id uint, req *dom
 		return nil, fmt.Errorf("error updating shipment: %w", err)
 	}
 
+	// Publicar evento de envío actualizado
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+
 	return mapShipmentToResponse(shipment), nil
 }
 
@@ -294,6 +302,9 @@ func (uc *UseCaseShipment) DeleteShipment(ctx context.Context, id uint) error {
 		return fmt.Errorf("error deleting shipment: %w", err)
 	}
 
+	// Publicar evento de envío eliminado
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeDeleted, shipment, shipment.Status))
+
 	return nil
 }
 
@@ -347,6 +358,16 @@ func (uc *UseCaseShipment) GetShipmentByTrackingNumber(ctx context.Context, trac
 //
 // ───────────────────────────────────────────
 
+// publishEvent publica un evento de envío sin fallar la operación (el publicador registra los errores).
+// Se publica en línea para conservar el orden de los eventos de un mismo envío: en una goroutine un
+// "cancelled" podía adelantarse al "tracking agregado" y los consumidores actuaban sobre un estado viejo.
+func (uc *UseCaseShipment) publishEvent(ctx context.Context, event *domain.ShipmentEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	_ = uc.eventPublisher.PublishShipmentEvent(context.WithoutCancel(ctx), event)
+}
+
 // mapShipmentToResponse convierte un modelo Shipment a ShipmentResponse
 func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 	return &domain.ShipmentResponse{
@@ -364,6 +385,7 @@ func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 		Status:         shipment.Status,
 		ShippedAt:      shipment.ShippedAt,
 		DeliveredAt:    shipment.DeliveredAt,
+		LastTrackedAt:  shipment.LastTrackedAt,
 		ShippingAddressID: shipment.ShippingAddressID,
 		ShippingCost:   shipment.ShippingCost,
 		InsuranceCost:  shipment.InsuranceCost,
@@ -377,6 +399,9 @@ func mapShipmentToResponse(shipment *domain.Shipment) *domain.ShipmentResponse {
 		DriverID:       shipment.DriverID,
 		DriverName:     shipment.DriverName,
 		IsLastMile:    shipment.IsLastMile,
+		FailedAttempts:   shipment.FailedAttempts,
+		EscalatedAt:      shipment.EscalatedAt,
+		EscalationReason: shipment.EscalationReason,
 		EstimatedDelivery: shipment.EstimatedDelivery,
 		DeliveryNotes:     shipment.DeliveryNotes,
 		Metadata:          shipment.Metadata,
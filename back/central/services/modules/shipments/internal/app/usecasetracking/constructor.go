@@ -0,0 +1,35 @@
+package usecasetracking
+
+import (
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// DefaultPollInterval tiempo mínimo entre dos consultas de tracking del mismo envío
+const DefaultPollInterval = 30 * time.Minute
+
+// UseCaseTracking mantiene la línea de tiempo de tracking de los envíos y deriva su estado
+type UseCaseTracking struct {
+	repo           domain.IRepository
+	trackers       *CarrierTrackerRegistry
+	eventPublisher domain.IShipmentEventPublisher
+	logger         log.ILogger
+	pollInterval   time.Duration
+}
+
+// New crea el caso de uso de tracking.
+// eventPublisher es opcional (nil = no se publican eventos); pollInterval <= 0 usa DefaultPollInterval.
+func New(repo domain.IRepository, trackers *CarrierTrackerRegistry, eventPublisher domain.IShipmentEventPublisher, logger log.ILogger, pollInterval time.Duration) *UseCaseTracking {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &UseCaseTracking{
+		repo:           repo,
+		trackers:       trackers,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+		pollInterval:   pollInterval,
+	}
+}
@@ -0,0 +1,192 @@
+package usecasetracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// IngestWebhook procesa la notificación de tracking de una transportadora
+func (uc *UseCaseTracking) IngestWebhook(ctx context.Context, carrierCode string, headers map[string]string, body []byte) (*domain.TrackingIngestResult, error) {
+	tracker, err := uc.trackers.Get(carrierCode)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := tracker.ParseWebhook(ctx, headers, body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.TrackingIngestResult{}
+	for _, update := range updates {
+		result.Received += len(update.Checkpoints)
+
+		shipment, err := uc.repo.GetShipmentByCarrierTracking(ctx, tracker.Code(), update.TrackingNumber)
+		if err != nil {
+			if errors.Is(err, domain.ErrShipmentNotFound) {
+				result.UnknownTracking++
+				uc.logger.Warn(ctx).
+					Str("carrier", tracker.Code()).
+					Str("tracking_number", update.TrackingNumber).
+					Msg("Webhook de tracking para un número sin envío asociado")
+				continue
+			}
+			return result, fmt.Errorf("error getting shipment by tracking number: %w", err)
+		}
+
+		stored, updated, err := uc.ingestCheckpoints(ctx, shipment, tracker.Code(), domain.TrackingSourceWebhook, update.Checkpoints)
+		if err != nil {
+			return result, err
+		}
+		result.Stored += stored
+		if updated {
+			result.UpdatedShipments++
+		}
+	}
+
+	return result, nil
+}
+
+// ListTrackingEvents obtiene la línea de tiempo de un envío
+func (uc *UseCaseTracking) ListTrackingEvents(ctx context.Context, shipmentID uint) ([]domain.ShipmentTrackingEvent, error) {
+	if _, err := uc.repo.GetShipmentByID(ctx, shipmentID); err != nil {
+		if errors.Is(err, domain.ErrShipmentNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting shipment: %w", err)
+	}
+
+	events, err := uc.repo.ListTrackingEvents(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tracking events: %w", err)
+	}
+	return events, nil
+}
+
+// ingestCheckpoints guarda los checkpoints nuevos del envío y, si hubo alguno, recalcula su estado.
+// Retorna cuántos checkpoints eran nuevos y si el envío cambió.
+func (uc *UseCaseTracking) ingestCheckpoints(ctx context.Context, shipment *domain.Shipment, carrierCode, source string, checkpoints []domain.TrackingCheckpoint) (int, bool, error) {
+	if len(checkpoints) == 0 {
+		return 0, false, nil
+	}
+
+	events := make([]domain.ShipmentTrackingEvent, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		events = append(events, newTrackingEvent(shipment.ID, carrierCode, source, checkpoint))
+	}
+
+	stored, err := uc.repo.CreateTrackingEvents(ctx, events)
+	if err != nil {
+		return 0, false, fmt.Errorf("error storing tracking events: %w", err)
+	}
+	if stored == 0 {
+		return 0, false, nil
+	}
+
+	// Se recalcula con toda la línea de tiempo: los checkpoints pueden llegar desordenados
+	timeline, err := uc.repo.ListTrackingEvents(ctx, shipment.ID)
+	if err != nil {
+		return stored, false, fmt.Errorf("error listing tracking events: %w", err)
+	}
+
+	previousStatus := shipment.Status
+	if !applyTimeline(shipment, timeline) {
+		return stored, false, nil
+	}
+
+	if err := uc.repo.UpdateShipment(ctx, shipment); err != nil {
+		return stored, false, fmt.Errorf("error updating shipment: %w", err)
+	}
+	uc.publishEvent(ctx, domain.NewShipmentEvent(domain.ShipmentEventTypeUpdated, shipment, previousStatus))
+
+	return stored, true, nil
+}
+
+// applyTimeline deriva Status, ShippedAt y DeliveredAt de la línea de tiempo (ordenada por fecha).
+// El estado lo define el último checkpoint con estado reconocido. Retorna si el envío cambió.
+func applyTimeline(shipment *domain.Shipment, timeline []domain.ShipmentTrackingEvent) bool {
+	status := ""
+	var shippedAt, deliveredAt *time.Time
+	for i := range timeline {
+		current := domain.ShipmentStatusFromTracking(timeline[i].Status)
+		if current == "" {
+			continue
+		}
+		status = current
+
+		occurredAt := timeline[i].OccurredAt
+		if shippedAt == nil && (current == domain.ShipmentStatusInTransit || current == domain.ShipmentStatusDelivered) {
+			shippedAt = &occurredAt
+		}
+		if current == domain.ShipmentStatusDelivered {
+			deliveredAt = &occurredAt
+		}
+	}
+
+	changed := false
+	if status != "" && status != shipment.Status {
+		shipment.Status = status
+		changed = true
+	}
+	if shipment.ShippedAt == nil && shippedAt != nil {
+		shipment.ShippedAt = shippedAt
+		changed = true
+	}
+	if deliveredAt != nil && (shipment.DeliveredAt == nil || !shipment.DeliveredAt.Equal(*deliveredAt)) {
+		shipment.DeliveredAt = deliveredAt
+		changed = true
+	}
+	return changed
+}
+
+// newTrackingEvent normaliza un checkpoint del adaptador para guardarlo
+func newTrackingEvent(shipmentID uint, carrierCode, source string, checkpoint domain.TrackingCheckpoint) domain.ShipmentTrackingEvent {
+	occurredAt := checkpoint.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	status := checkpoint.Status
+	if domain.ShipmentStatusFromTracking(status) == "" {
+		status = domain.TrackingStatusUnknown
+	}
+
+	rawCode := strings.TrimSpace(checkpoint.RawCode)
+	if rawCode == "" {
+		rawCode = status
+	}
+
+	return domain.ShipmentTrackingEvent{
+		ShipmentID: shipmentID,
+		// Precisión de Postgres: así un mismo checkpoint recibido dos veces choca con el índice único
+		OccurredAt:  occurredAt.UTC().Truncate(time.Microsecond),
+		RawCode:     truncate(rawCode, 100),
+		Status:      status,
+		Location:    truncate(strings.TrimSpace(checkpoint.Location), 255),
+		Description: strings.TrimSpace(checkpoint.Description),
+		CarrierCode: strings.ToLower(carrierCode),
+		Source:      source,
+	}
+}
+
+// publishEvent publica un evento de envío en línea, en el mismo orden en que se ingestan los estados
+// del carrier (no falla la operación: el publicador registra los errores)
+func (uc *UseCaseTracking) publishEvent(ctx context.Context, event *domain.ShipmentEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	_ = uc.eventPublisher.PublishShipmentEvent(context.WithoutCancel(ctx), event)
+}
+
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,67 @@
+package usecasetracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// PollPending consulta a las transportadoras registradas hasta limit envíos activos cuyo tracking
+// no se revisa desde hace pollInterval. Retorna cuántos envíos se consultaron.
+func (uc *UseCaseTracking) PollPending(ctx context.Context, limit int) (int, error) {
+	codes := uc.trackers.Codes()
+	if len(codes) == 0 {
+		return 0, nil
+	}
+
+	shipments, err := uc.repo.ListShipmentsToTrack(ctx, codes, time.Now().Add(-uc.pollInterval), limit)
+	if err != nil {
+		return 0, fmt.Errorf("error listing shipments to track: %w", err)
+	}
+
+	polled := 0
+	var errs []error
+	for i := range shipments {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := uc.pollShipment(ctx, &shipments[i]); err != nil {
+			errs = append(errs, err)
+		}
+		polled++
+	}
+
+	return polled, errors.Join(errs...)
+}
+
+// pollShipment consulta el tracking de un envío y guarda los checkpoints nuevos
+func (uc *UseCaseTracking) pollShipment(ctx context.Context, shipment *domain.Shipment) error {
+	if shipment.CarrierCode == nil || shipment.TrackingNumber == nil {
+		return nil
+	}
+
+	tracker, err := uc.trackers.Get(*shipment.CarrierCode)
+	if err != nil {
+		return err
+	}
+
+	// Se marca aunque la consulta falle para no reintentar el mismo envío en cada ciclo
+	now := time.Now()
+	if err := uc.repo.MarkShipmentTracked(ctx, shipment.ID, now); err != nil {
+		return fmt.Errorf("error marking shipment %d as tracked: %w", shipment.ID, err)
+	}
+	shipment.LastTrackedAt = &now
+
+	checkpoints, err := tracker.Track(ctx, *shipment.TrackingNumber)
+	if err != nil {
+		return fmt.Errorf("error tracking shipment %d with %s: %w", shipment.ID, tracker.Code(), err)
+	}
+
+	if _, _, err := uc.ingestCheckpoints(ctx, shipment, tracker.Code(), domain.TrackingSourcePolling, checkpoints); err != nil {
+		return fmt.Errorf("error ingesting tracking for shipment %d: %w", shipment.ID, err)
+	}
+	return nil
+}
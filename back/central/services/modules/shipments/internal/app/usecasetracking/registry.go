@@ -0,0 +1,58 @@
+package usecasetracking
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CarrierTrackerRegistry mantiene los adaptadores de tracking por código de transportadora
+type CarrierTrackerRegistry struct {
+	trackers map[string]domain.ICarrierTracker
+	mu       sync.RWMutex
+}
+
+// NewCarrierTrackerRegistry crea un registro vacío de adaptadores de tracking
+func NewCarrierTrackerRegistry() *CarrierTrackerRegistry {
+	return &CarrierTrackerRegistry{
+		trackers: make(map[string]domain.ICarrierTracker),
+	}
+}
+
+// Register registra el adaptador bajo su código (sin distinguir mayúsculas)
+func (r *CarrierTrackerRegistry) Register(tracker domain.ICarrierTracker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.trackers[normalizeCarrierCode(tracker.Code())] = tracker
+}
+
+// Get obtiene el adaptador de una transportadora
+func (r *CarrierTrackerRegistry) Get(carrierCode string) (domain.ICarrierTracker, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tracker, exists := r.trackers[normalizeCarrierCode(carrierCode)]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCarrierTrackerNotFound, carrierCode)
+	}
+	return tracker, nil
+}
+
+// Codes retorna los códigos (en minúscula) de las transportadoras registradas
+func (r *CarrierTrackerRegistry) Codes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codes := make([]string, 0, len(r.trackers))
+	for code := range r.trackers {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func normalizeCarrierCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
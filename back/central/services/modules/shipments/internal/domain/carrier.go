@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	CARRIERS - Transportadoras: cotización, guías, etiquetas y tracking
+//
+// ───────────────────────────────────────────
+
+// GuideClaimTimeout es cuánto dura la reserva de la generación de una guía: pasado ese tiempo se
+// considera abandonada (la réplica que la tomó se cayó) y otra solicitud puede generar la guía
+const GuideClaimTimeout = 2 * time.Minute
+
+// CarrierAccount es la cuenta de un negocio en una transportadora, guardada como integración en core
+// (el código del tipo de integración es el código de la transportadora)
+type CarrierAccount struct {
+	IntegrationID uint
+	BusinessID    *uint
+	Config        map[string]interface{}
+	Credentials   map[string]interface{}
+}
+
+// CarrierAddress representa el destinatario (o remitente) de un envío
+type CarrierAddress struct {
+	Name       string `json:"name"`
+	Phone      string `json:"phone"`
+	Email      string `json:"email,omitempty"`
+	DNI        string `json:"dni,omitempty"`
+	Street     string `json:"street"`
+	City       string `json:"city"`
+	CityCode   string `json:"city_code,omitempty"` // Código oficial del municipio (DANE en Colombia)
+	State      string `json:"state"`
+	StateCode  string `json:"state_code,omitempty"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postal_code,omitempty"`
+}
+
+// CarrierParcel representa las dimensiones del paquete (kg y cm)
+type CarrierParcel struct {
+	Weight float64 `json:"weight"`
+	Height float64 `json:"height"`
+	Width  float64 `json:"width"`
+	Length float64 `json:"length"`
+}
+
+// CarrierQuoteRequest datos para cotizar un envío
+type CarrierQuoteRequest struct {
+	Recipient     CarrierAddress
+	Parcel        CarrierParcel
+	DeclaredValue float64
+	CodAmount     float64 // Valor a recaudar contra entrega (0 = sin recaudo)
+	Currency      string
+}
+
+// CarrierQuote es una tarifa ofrecida por una transportadora
+type CarrierQuote struct {
+	CarrierCode   string  `json:"carrier_code"`
+	Service       string  `json:"service"`
+	Price         float64 `json:"price"`
+	Currency      string  `json:"currency"`
+	EstimatedDays int     `json:"estimated_days,omitempty"`
+}
+
+// CarrierGuideRequest datos para generar la guía de un envío
+type CarrierGuideRequest struct {
+	Reference     string // Número de la orden
+	Service       string // Servicio elegido ("" = el por defecto de la transportadora)
+	Recipient     CarrierAddress
+	Parcel        CarrierParcel
+	DeclaredValue float64
+	CodAmount     float64
+	Currency      string
+	Notes         string
+}
+
+// CarrierGuide es la guía generada por la transportadora
+type CarrierGuide struct {
+	GuideID           string
+	TrackingNumber    string
+	TrackingURL       string
+	Service           string
+	Cost              *float64
+	EstimatedDelivery *time.Time
+}
+
+// ICarrier es el adaptador de una transportadora
+type ICarrier interface {
+	// Code retorna el código de la transportadora (Shipment.CarrierCode y tipo de integración en core)
+	Code() string
+	// Name retorna el nombre visible de la transportadora (Shipment.Carrier)
+	Name() string
+	Quote(ctx context.Context, account *CarrierAccount, req *CarrierQuoteRequest) ([]CarrierQuote, error)
+	CreateGuide(ctx context.Context, account *CarrierAccount, req *CarrierGuideRequest) (*CarrierGuide, error)
+	// FetchLabel retorna la etiqueta de la guía en PDF
+	FetchLabel(ctx context.Context, account *CarrierAccount, guideID string) ([]byte, error)
+	CancelGuide(ctx context.Context, account *CarrierAccount, guideID string) error
+	Track(ctx context.Context, account *CarrierAccount, trackingNumber string) ([]TrackingCheckpoint, error)
+}
+
+// ICarrierWebhook lo implementan las transportadoras que notifican el tracking por webhook
+type ICarrierWebhook interface {
+	// ParseWebhook autentica y traduce una notificación (headers con llaves en minúscula)
+	ParseWebhook(ctx context.Context, headers map[string]string, body []byte) ([]TrackingUpdate, error)
+}
+
+// ICarrierAccounts obtiene la cuenta de un negocio en una transportadora
+type ICarrierAccounts interface {
+	GetAccount(ctx context.Context, carrierCode string, businessID *uint) (*CarrierAccount, error)
+}
+
+// ILabelStorage guarda las etiquetas de las guías y retorna su URL
+type ILabelStorage interface {
+	Upload(ctx context.Context, key string, data []byte) (string, error)
+}
+
+// ShipmentOrder son los datos de la orden necesarios para cotizar y generar guías
+type ShipmentOrder struct {
+	ID          string
+	BusinessID  *uint
+	OrderNumber string
+	TotalAmount float64
+	CodTotal    *float64
+	Currency    string
+	Recipient   CarrierAddress
+	Parcel      CarrierParcel // Dimensiones registradas en la orden (si las hay)
+	ShippingLat *float64      // Coordenadas de la dirección de entrega (si se geocodificó)
+	ShippingLng *float64
+	// Precisión de las coordenadas (address, street o city; "city" es el centroide de la ciudad)
+	GeocodePrecision string
+}
+
+// HasPreciseLocation indica si las coordenadas de la orden ubican la dirección (placa o vía) y no
+// solo el centroide de la ciudad, es decir, si sirven para medir qué tan lejos se hizo la entrega
+func (o *ShipmentOrder) HasPreciseLocation() bool {
+	if o.ShippingLat == nil || o.ShippingLng == nil {
+		return false
+	}
+	return o.GeocodePrecision == GeocodePrecisionAddress || o.GeocodePrecision == GeocodePrecisionStreet
+}
+
+// GenerateGuideRequest representa la solicitud de generación de guía de un envío
+type GenerateGuideRequest struct {
+	CarrierCode   string   `json:"carrier_code" binding:"omitempty,max=50"` // Vacío = la transportadora ya asignada al envío
+	Service       string   `json:"service" binding:"omitempty,max=64"`
+	DeclaredValue *float64 `json:"declared_value" binding:"omitempty,min=0"` // Por defecto el total de la orden
+	Notes         string   `json:"notes" binding:"omitempty,max=500"`
+}
+
+// QuoteShipmentRequest representa la solicitud de cotización de un envío
+type QuoteShipmentRequest struct {
+	CarrierCodes  []string `json:"carrier_codes"` // Vacío = todas las transportadoras registradas con cuenta del negocio
+	DeclaredValue *float64 `json:"declared_value" binding:"omitempty,min=0"`
+}
+
+// QuoteShipmentResponse agrupa las tarifas y las transportadoras que no pudieron cotizar
+type QuoteShipmentResponse struct {
+	Quotes []CarrierQuote    `json:"quotes"`
+	Errors map[string]string `json:"errors,omitempty"` // Código de transportadora -> error
+}
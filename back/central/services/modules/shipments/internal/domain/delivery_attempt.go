@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	DELIVERY ATTEMPTS - Intentos de entrega de última milla
+//
+// ───────────────────────────────────────────
+
+// Resultados de un intento de entrega
+const (
+	DeliveryOutcomeDelivered = "delivered"
+	DeliveryOutcomeFailed    = "failed"
+)
+
+// DefaultMaxFailedAttempts intentos fallidos tras los cuales el envío se escala automáticamente
+const DefaultMaxFailedAttempts = 3
+
+// DeliveryFailureReason es un motivo del catálogo de fallas de entrega (global si BusinessID es nil)
+type DeliveryFailureReason struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	BusinessID  *uint     `json:"business_id,omitempty"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	IsActive    bool      `json:"is_active"`
+}
+
+// DeliveryAttempt es un intento de entrega registrado por el conductor
+type DeliveryAttempt struct {
+	ID            uint      `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	ShipmentID    uint      `json:"shipment_id"`
+	OrderID       string    `json:"order_id"`
+	AttemptNumber int       `json:"attempt_number"`
+
+	DriverID    *uint     `json:"driver_id,omitempty"`
+	DriverName  string    `json:"driver_name,omitempty"`
+	AttemptedAt time.Time `json:"attempted_at"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+
+	Outcome           string     `json:"outcome"`
+	FailureReasonCode *string    `json:"failure_reason_code,omitempty"`
+	Notes             *string    `json:"notes,omitempty"`
+	RescheduledFor    *time.Time `json:"rescheduled_for,omitempty"`
+	CreatedBy         *uint      `json:"created_by,omitempty"`
+}
+
+// ApplyDeliveryAttempt aplica al envío el resultado de un intento: lo marca entregado, o fallido sumando
+// el intento y escalándolo al llegar a maxFailedAttempts. Debe llamarse con el envío bloqueado para que
+// el contador y la decisión de escalar partan del valor vigente. Retorna si el intento escaló el envío.
+func (s *Shipment) ApplyDeliveryAttempt(attempt *DeliveryAttempt, failureReasonName string, maxFailedAttempts int) (bool, error) {
+	if s.Status == ShipmentStatusDelivered {
+		return false, ErrShipmentAlreadyDelivered
+	}
+
+	attemptedAt := attempt.AttemptedAt
+	switch attempt.Outcome {
+	case DeliveryOutcomeDelivered:
+		s.Status = ShipmentStatusDelivered
+		s.DeliveredAt = &attemptedAt
+	case DeliveryOutcomeFailed:
+		s.Status = ShipmentStatusFailed
+		s.FailedAttempts++
+		if s.FailedAttempts >= maxFailedAttempts && s.EscalatedAt == nil {
+			escalationReason := fmt.Sprintf("%d intentos de entrega fallidos (último: %s)", s.FailedAttempts, failureReasonName)
+			s.EscalatedAt = &attemptedAt
+			s.EscalationReason = &escalationReason
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// LogDeliveryAttemptRequest representa el registro de un intento de entrega
+type LogDeliveryAttemptRequest struct {
+	Outcome           string     `json:"outcome" binding:"required,oneof=delivered failed"`
+	FailureReasonCode string     `json:"failure_reason_code" binding:"omitempty,max=50"` // Requerido si el intento falló
+	DriverID          *uint      `json:"driver_id"`                                      // Por defecto el conductor asignado al envío
+	DriverName        string     `json:"driver_name" binding:"omitempty,max=255"`
+	AttemptedAt       *time.Time `json:"attempted_at"` // Por defecto ahora
+	Latitude          *float64   `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude         *float64   `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	Notes             string     `json:"notes" binding:"omitempty,max=1000"`
+	CreatedBy         *uint      `json:"created_by"`
+}
+
+// RescheduleDeliveryRequest representa la reprogramación de un envío tras un intento fallido
+type RescheduleDeliveryRequest struct {
+	ScheduledFor time.Time `json:"scheduled_for" binding:"required"`
+	Notes        string    `json:"notes" binding:"omitempty,max=1000"`
+}
+
+// EscalateShipmentRequest representa el escalamiento manual de un envío
+type EscalateShipmentRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
+
+// CreateDeliveryFailureReasonRequest representa un motivo de falla propio de un negocio
+type CreateDeliveryFailureReasonRequest struct {
+	BusinessID  uint   `json:"business_id" binding:"required,min=1"`
+	Code        string `json:"code" binding:"required,max=50"`
+	Name        string `json:"name" binding:"required,max=128"`
+	Description string `json:"description" binding:"omitempty,max=1000"`
+}
+
+// UpdateDeliveryFailureReasonRequest representa la actualización de un motivo de falla
+type UpdateDeliveryFailureReasonRequest struct {
+	Name        *string `json:"name" binding:"omitempty,max=128"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+// DeliveryAttemptResponse es el intento registrado junto con el envío actualizado
+type DeliveryAttemptResponse struct {
+	Attempt   *DeliveryAttempt  `json:"attempt"`
+	Shipment  *ShipmentResponse `json:"shipment"`
+	Escalated bool              `json:"escalated"` // El intento llevó al envío al máximo de fallas
+}
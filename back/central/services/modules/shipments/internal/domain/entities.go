@@ -107,6 +107,8 @@ type ShipmentResponse struct {
 	ShippedAt   *time.Time `json:"shipped_at,omitempty"`
 	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
 
+	LastTrackedAt *time.Time `json:"last_tracked_at,omitempty"`
+
 	ShippingAddressID *uint `json:"shipping_address_id,omitempty"`
 
 	ShippingCost  *float64 `json:"shipping_cost,omitempty"`
@@ -124,9 +126,15 @@ type ShipmentResponse struct {
 	DriverName    string `json:"driver_name"`
 	IsLastMile    bool   `json:"is_last_mile"`
 
+	FailedAttempts   int        `json:"failed_attempts"`
+	EscalatedAt      *time.Time `json:"escalated_at,omitempty"`
+	EscalationReason *string    `json:"escalation_reason,omitempty"`
+
 	EstimatedDelivery *time.Time     `json:"estimated_delivery,omitempty"`
 	DeliveryNotes     *string        `json:"delivery_notes,omitempty"`
 	Metadata          datatypes.JSON `json:"metadata,omitempty"`
+
+	ProofOfDelivery *ProofOfDelivery `json:"proof_of_delivery,omitempty"` // Solo en el detalle del envío
 }
 
 // ShipmentsListResponse representa la respuesta paginada de envíos
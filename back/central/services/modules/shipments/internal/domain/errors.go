@@ -14,5 +14,89 @@ var (
 
 	// ErrOrderIDRequired se retorna cuando el order_id es requerido
 	ErrOrderIDRequired = errors.New("order_id is required")
+
+	// ErrCarrierTrackerNotFound se retorna cuando no hay adaptador de tracking para la transportadora
+	ErrCarrierTrackerNotFound = errors.New("carrier tracker not registered")
+
+	// ErrInvalidTrackingWebhook se retorna cuando el cuerpo del webhook de tracking no se puede interpretar
+	ErrInvalidTrackingWebhook = errors.New("invalid tracking webhook payload")
+
+	// ErrTrackingWebhookUnauthorized se retorna cuando el webhook de tracking no pasa la autenticación
+	ErrTrackingWebhookUnauthorized = errors.New("tracking webhook unauthorized")
+
+	// ErrOrderNotFound se retorna cuando la orden del envío no existe
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrCarrierNotRegistered se retorna cuando no hay adaptador para la transportadora
+	ErrCarrierNotRegistered = errors.New("carrier not registered")
+
+	// ErrCarrierRequired se retorna cuando no se indica transportadora y el envío no tiene una asignada
+	ErrCarrierRequired = errors.New("carrier_code is required")
+
+	// ErrCarrierAccountNotFound se retorna cuando el negocio no tiene una integración activa con la transportadora
+	ErrCarrierAccountNotFound = errors.New("carrier account not found")
+
+	// ErrGuideAlreadyExists se retorna cuando el envío ya tiene una guía generada
+	ErrGuideAlreadyExists = errors.New("shipment already has a guide")
+
+	// ErrGuideGenerationInProgress se retorna cuando otra solicitud está generando la guía del envío
+	ErrGuideGenerationInProgress = errors.New("guide generation already in progress for this shipment")
+
+	// ErrGuideNotFound se retorna cuando el envío no tiene guía para cancelar
+	ErrGuideNotFound = errors.New("shipment has no guide")
+
+	// ErrGuideNotCancellable se retorna al cancelar la guía de un envío que ya salió a despacho
+	ErrGuideNotCancellable = errors.New("guide can only be cancelled before the shipment is dispatched")
+
+	// ErrLabelStorageUnavailable se retorna cuando no hay almacenamiento configurado para las etiquetas
+	ErrLabelStorageUnavailable = errors.New("label storage not configured")
+
+	// ErrShipmentNotLastMile se retorna al registrar intentos de entrega en un envío que no es de última milla
+	ErrShipmentNotLastMile = errors.New("delivery attempts are only tracked for last-mile shipments")
+
+	// ErrShipmentAlreadyDelivered se retorna al registrar intentos o reprogramar un envío ya entregado
+	ErrShipmentAlreadyDelivered = errors.New("shipment already delivered")
+
+	// ErrFailureReasonRequired se retorna cuando un intento fallido no indica el motivo
+	ErrFailureReasonRequired = errors.New("failure_reason_code is required for failed attempts")
+
+	// ErrFailureReasonNotFound se retorna cuando el motivo de falla no existe o está inactivo
+	ErrFailureReasonNotFound = errors.New("delivery failure reason not found")
+
+	// ErrFailureReasonAlreadyExists se retorna al crear un motivo con un código ya usado por el negocio
+	ErrFailureReasonAlreadyExists = errors.New("delivery failure reason code already exists")
+
+	// ErrFailureReasonReadOnly se retorna al modificar un motivo de falla del catálogo global
+	ErrFailureReasonReadOnly = errors.New("global delivery failure reasons cannot be modified")
+
+	// ErrInvalidDeliveryLocation se retorna cuando solo se envía una de las coordenadas del intento
+	ErrInvalidDeliveryLocation = errors.New("latitude and longitude must be sent together")
+
+	// ErrRescheduleNotAllowed se retorna al reprogramar un envío sin un intento fallido pendiente
+	ErrRescheduleNotAllowed = errors.New("only shipments with a failed delivery attempt can be rescheduled")
+
+	// ErrInvalidRescheduleDate se retorna cuando la nueva fecha de entrega no es futura
+	ErrInvalidRescheduleDate = errors.New("scheduled_for must be in the future")
+
+	// ErrShipmentAlreadyEscalated se retorna al escalar un envío que ya fue escalado
+	ErrShipmentAlreadyEscalated = errors.New("shipment already escalated")
+
+	// ErrProofOfDeliveryNotFound se retorna cuando el envío no tiene evidencia de entrega
+	ErrProofOfDeliveryNotFound = errors.New("proof of delivery not found")
+
+	// ErrProofOfDeliveryAlreadyExists se retorna al capturar evidencia de un envío que ya la tiene
+	ErrProofOfDeliveryAlreadyExists = errors.New("shipment already has a proof of delivery")
+
+	// ErrProofPhotoRequired se retorna cuando la evidencia no incluye la foto de la entrega
+	ErrProofPhotoRequired = errors.New("delivery photo is required")
+
+	// ErrReceiverNameRequired se retorna cuando la evidencia no indica quién recibió
+	ErrReceiverNameRequired = errors.New("receiver_name is required")
+
+	// ErrEvidenceStorageUnavailable se retorna cuando no hay almacenamiento configurado para la evidencia
+	ErrEvidenceStorageUnavailable = errors.New("evidence storage not configured")
+
+	// ErrEvidenceUploadFailed se retorna cuando el almacenamiento rechaza una imagen de la evidencia
+	ErrEvidenceUploadFailed = errors.New("evidence upload failed")
 )
 
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // ───────────────────────────────────────────
@@ -16,6 +17,7 @@ type IRepository interface {
 	CreateShipment(ctx context.Context, shipment *Shipment) error
 	GetShipmentByID(ctx context.Context, id uint) (*Shipment, error)
 	GetShipmentByTrackingNumber(ctx context.Context, trackingNumber string) (*Shipment, error)
+	GetShipmentByCarrierTracking(ctx context.Context, carrierCode, trackingNumber string) (*Shipment, error)
 	GetShipmentsByOrderID(ctx context.Context, orderID string) ([]Shipment, error)
 	ListShipments(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]Shipment, int64, error)
 	UpdateShipment(ctx context.Context, shipment *Shipment) error
@@ -23,5 +25,39 @@ type IRepository interface {
 
 	// Validation
 	ShipmentExists(ctx context.Context, orderID string, trackingNumber string) (bool, error)
+
+	// Tracking
+	CreateTrackingEvents(ctx context.Context, events []ShipmentTrackingEvent) (int, error)
+	ListTrackingEvents(ctx context.Context, shipmentID uint) ([]ShipmentTrackingEvent, error)
+	ListShipmentsToTrack(ctx context.Context, carrierCodes []string, trackedBefore time.Time, limit int) ([]Shipment, error)
+	MarkShipmentTracked(ctx context.Context, id uint, trackedAt time.Time) error
+
+	// Carriers
+	GetShipmentOrder(ctx context.Context, orderID string) (*ShipmentOrder, error)
+	UpdateOrderGuide(ctx context.Context, orderID string, guideID, guideLink *string) error
+
+	// ClaimGuideGeneration reserva el envío para generar su guía si no tiene una ni otra generación en
+	// curso (o la anterior es de antes de staleBefore). Retorna false si no pudo reservarlo.
+	ClaimGuideGeneration(ctx context.Context, shipmentID uint, claimedAt, staleBefore time.Time) (bool, error)
+	// ReleaseGuideGeneration libera la reserva cuando la guía no se pudo generar
+	ReleaseGuideGeneration(ctx context.Context, shipmentID uint, claimedAt time.Time) error
+	// SaveGeneratedGuide guarda la guía y libera la reserva solo si la reserva sigue siendo claimedAt
+	SaveGeneratedGuide(ctx context.Context, shipment *Shipment, claimedAt time.Time) (bool, error)
+
+	// Delivery Attempts
+	CreateDeliveryAttempt(ctx context.Context, attempt *DeliveryAttempt, shipment *Shipment, failureReasonName string, maxFailedAttempts int) (bool, error)
+	ListDeliveryAttempts(ctx context.Context, shipmentID uint) ([]DeliveryAttempt, error)
+	RescheduleDelivery(ctx context.Context, shipment *Shipment, scheduledFor time.Time) error
+
+	// Proof of Delivery
+	CreateProofOfDelivery(ctx context.Context, proof *ProofOfDelivery) error
+	GetProofOfDelivery(ctx context.Context, shipmentID uint) (*ProofOfDelivery, error)
+
+	// Delivery Failure Reasons
+	ListDeliveryFailureReasons(ctx context.Context, businessID *uint, activeOnly bool) ([]DeliveryFailureReason, error)
+	GetActiveDeliveryFailureReason(ctx context.Context, businessID *uint, code string) (*DeliveryFailureReason, error)
+	GetDeliveryFailureReasonByID(ctx context.Context, id uint) (*DeliveryFailureReason, error)
+	CreateDeliveryFailureReason(ctx context.Context, reason *DeliveryFailureReason) error
+	UpdateDeliveryFailureReason(ctx context.Context, reason *DeliveryFailureReason) error
 }
 
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"mime/multipart"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	PROOF OF DELIVERY - Evidencia de entrega
+//
+// ───────────────────────────────────────────
+
+// DefaultMaxDeliveryDistanceMeters distancia a la dirección de la orden a partir de la cual la entrega se marca como lejana
+const DefaultMaxDeliveryDistanceMeters = 500.0
+
+// Precisión de las coordenadas de la orden (deben coincidir con models.Order.GeocodePrecision)
+const (
+	GeocodePrecisionAddress = "address" // Coordenadas de la placa
+	GeocodePrecisionStreet  = "street"  // Coordenadas de la vía
+	GeocodePrecisionCity    = "city"    // Centroide de la ciudad
+)
+
+// earthRadiusMeters radio medio de la Tierra usado para calcular distancias
+const earthRadiusMeters = 6371000.0
+
+// ProofOfDelivery es la evidencia capturada por el conductor al entregar el envío
+type ProofOfDelivery struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	ShipmentID uint      `json:"shipment_id"`
+	OrderID    string    `json:"order_id"`
+	DriverID   *uint     `json:"driver_id,omitempty"`
+
+	PhotoPath     string `json:"-"`
+	SignaturePath string `json:"-"`
+	PhotoURL      string `json:"photo_url"`
+	SignatureURL  string `json:"signature_url,omitempty"`
+
+	ReceiverName     string `json:"receiver_name"`
+	ReceiverDocument string `json:"receiver_document,omitempty"`
+
+	Latitude   *float64  `json:"latitude,omitempty"`
+	Longitude  *float64  `json:"longitude,omitempty"`
+	CapturedAt time.Time `json:"captured_at"`
+
+	DistanceMeters   *float64 `json:"distance_meters,omitempty"` // Distancia a la dirección de la orden
+	IsFarFromAddress bool     `json:"is_far_from_address"`
+
+	Notes *string `json:"notes,omitempty"`
+}
+
+// CaptureProofOfDeliveryRequest representa la evidencia enviada por el conductor (multipart)
+type CaptureProofOfDeliveryRequest struct {
+	Photo            *multipart.FileHeader
+	Signature        *multipart.FileHeader // Opcional
+	ReceiverName     string
+	ReceiverDocument string
+	Latitude         *float64
+	Longitude        *float64
+	CapturedAt       *time.Time // Por defecto ahora
+	DriverID         *uint      // Por defecto el conductor asignado al envío
+	Notes            string
+}
+
+// IEvidenceStorage guarda las imágenes de la evidencia de entrega
+type IEvidenceStorage interface {
+	// UploadImage sube la imagen a la carpeta y retorna su path relativo
+	UploadImage(ctx context.Context, file *multipart.FileHeader, folder string) (string, error)
+	// Delete elimina una imagen subida (limpieza cuando no se pudo guardar la evidencia)
+	Delete(ctx context.Context, path string) error
+	// URL retorna la URL pública de un path relativo
+	URL(path string) string
+}
+
+// DistanceMeters calcula la distancia en metros entre dos coordenadas (fórmula de haversine)
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -20,13 +20,16 @@ type Shipment struct {
 	Carrier        *string `json:"carrier"`
 	CarrierCode    *string `json:"carrier_code"`
 
-	GuideID  *string `json:"guide_id"`
-	GuideURL *string `json:"guide_url"`
+	GuideID          *string    `json:"guide_id"`
+	GuideURL         *string    `json:"guide_url"`
+	GuideRequestedAt *time.Time `json:"-"` // Reserva de la generación de guía en curso
 
 	Status      string     `json:"status"`
 	ShippedAt   *time.Time `json:"shipped_at"`
 	DeliveredAt *time.Time `json:"delivered_at"`
 
+	LastTrackedAt *time.Time `json:"last_tracked_at"`
+
 	ShippingAddressID *uint `json:"shipping_address_id"`
 
 	ShippingCost  *float64 `json:"shipping_cost"`
@@ -44,6 +47,10 @@ type Shipment struct {
 	DriverName    string `json:"driver_name"`
 	IsLastMile    bool   `json:"is_last_mile"`
 
+	FailedAttempts   int        `json:"failed_attempts"`
+	EscalatedAt      *time.Time `json:"escalated_at"`
+	EscalationReason *string    `json:"escalation_reason"`
+
 	EstimatedDelivery *time.Time     `json:"estimated_delivery"`
 	DeliveryNotes     *string        `json:"delivery_notes"`
 	Metadata          datatypes.JSON `json:"metadata"`
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHIPMENT EVENT TYPES
+//
+// ───────────────────────────────────────────
+
+// ShipmentEventType define los tipos de eventos relacionados con envíos
+type ShipmentEventType string
+
+const (
+	ShipmentEventTypeCreated   ShipmentEventType = "shipment.created"
+	ShipmentEventTypeUpdated   ShipmentEventType = "shipment.updated"
+	ShipmentEventTypeDeleted   ShipmentEventType = "shipment.deleted"
+	ShipmentEventTypeEscalated ShipmentEventType = "shipment.escalated"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHIPMENT EVENT STRUCTURES
+//
+// ───────────────────────────────────────────
+
+// ShipmentEvent representa un evento relacionado con un envío
+type ShipmentEvent struct {
+	ID         string            `json:"id"`
+	Type       ShipmentEventType `json:"type"`
+	ShipmentID uint              `json:"shipment_id"`
+	OrderID    string            `json:"order_id"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Data       ShipmentEventData `json:"data"`
+}
+
+// ShipmentEventData contiene el estado del envío al momento del evento
+type ShipmentEventData struct {
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	TrackingURL    *string `json:"tracking_url,omitempty"`
+	Carrier        *string `json:"carrier,omitempty"`
+	CarrierCode    *string `json:"carrier_code,omitempty"`
+	PreviousStatus string  `json:"previous_status,omitempty"`
+	CurrentStatus  string  `json:"current_status"`
+}
+
+// IShipmentEventPublisher define la interfaz para publicar eventos de envíos
+type IShipmentEventPublisher interface {
+	PublishShipmentEvent(ctx context.Context, event *ShipmentEvent) error
+}
+
+// NewShipmentEvent crea un nuevo evento a partir del estado actual del envío
+func NewShipmentEvent(eventType ShipmentEventType, shipment *Shipment, previousStatus string) *ShipmentEvent {
+	return &ShipmentEvent{
+		ID:         time.Now().Format("20060102150405") + "-" + randomString(8),
+		Type:       eventType,
+		ShipmentID: shipment.ID,
+		OrderID:    shipment.OrderID,
+		Timestamp:  time.Now(),
+		Data: ShipmentEventData{
+			TrackingNumber: shipment.TrackingNumber,
+			TrackingURL:    shipment.TrackingURL,
+			Carrier:        shipment.Carrier,
+			CarrierCode:    shipment.CarrierCode,
+			PreviousStatus: previousStatus,
+			CurrentStatus:  shipment.Status,
+		},
+	}
+}
+
+// randomString genera una cadena aleatoria
+func randomString(length int) string {
+	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, length)
+	rand.Read(b)
+	for i := range b {
+		b[i] = charset[b[i]%byte(len(charset))]
+	}
+	return string(b)
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHIPMENT TRACKING - Línea de tiempo de checkpoints de la transportadora
+//
+// ───────────────────────────────────────────
+
+// Estados del envío (Shipment.Status)
+const (
+	ShipmentStatusPending   = "pending"
+	ShipmentStatusInTransit = "in_transit"
+	ShipmentStatusDelivered = "delivered"
+	ShipmentStatusFailed    = "failed"
+)
+
+// Estados normalizados de tracking. Los adaptadores de cada transportadora traducen sus códigos a estos.
+const (
+	TrackingStatusPending        = "pending"
+	TrackingStatusInTransit      = "in_transit"
+	TrackingStatusOutForDelivery = "out_for_delivery"
+	TrackingStatusDelivered      = "delivered"
+	TrackingStatusFailed         = "failed"
+	TrackingStatusReturned       = "returned"
+	TrackingStatusUnknown        = "unknown" // Código no reconocido: queda en la línea de tiempo pero no cambia el envío
+)
+
+// Origen de un evento de tracking
+const (
+	TrackingSourceWebhook = "webhook"
+	TrackingSourcePolling = "polling"
+)
+
+// ShipmentStatusFromTracking traduce un estado de tracking al estado del envío ("" si no lo afecta)
+func ShipmentStatusFromTracking(status string) string {
+	switch status {
+	case TrackingStatusPending:
+		return ShipmentStatusPending
+	case TrackingStatusInTransit, TrackingStatusOutForDelivery:
+		return ShipmentStatusInTransit
+	case TrackingStatusDelivered:
+		return ShipmentStatusDelivered
+	case TrackingStatusFailed, TrackingStatusReturned:
+		return ShipmentStatusFailed
+	default:
+		return ""
+	}
+}
+
+// TrackingCheckpoint es un checkpoint tal como lo reporta un adaptador de transportadora
+type TrackingCheckpoint struct {
+	OccurredAt  time.Time
+	RawCode     string // Código original de la transportadora
+	Status      string // Estado normalizado (TrackingStatus*)
+	Location    string
+	Description string
+}
+
+// TrackingUpdate agrupa los checkpoints recibidos para un número de tracking
+type TrackingUpdate struct {
+	TrackingNumber string
+	Checkpoints    []TrackingCheckpoint
+}
+
+// ShipmentTrackingEvent representa un checkpoint guardado en la línea de tiempo del envío
+type ShipmentTrackingEvent struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	ShipmentID  uint      `json:"shipment_id"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	RawCode     string    `json:"raw_code"`
+	Status      string    `json:"status"`
+	Location    string    `json:"location,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CarrierCode string    `json:"carrier_code,omitempty"`
+	Source      string    `json:"source"`
+}
+
+// TrackingIngestResult resume el procesamiento de checkpoints recibidos
+type TrackingIngestResult struct {
+	Received         int `json:"received"`          // Checkpoints recibidos
+	Stored           int `json:"stored"`            // Checkpoints nuevos guardados (los repetidos se ignoran)
+	UpdatedShipments int `json:"updated_shipments"` // Envíos cuyo estado o fechas cambiaron
+	UnknownTracking  int `json:"unknown_tracking"`  // Números de tracking sin envío asociado
+}
+
+// ICarrierTracker es el port de tracking de una transportadora: consulta periódica y webhooks entrantes
+type ICarrierTracker interface {
+	// Code retorna el código de la transportadora (Shipment.CarrierCode)
+	Code() string
+	// Track consulta los checkpoints de un número de tracking
+	Track(ctx context.Context, trackingNumber string) ([]TrackingCheckpoint, error)
+	// ParseWebhook autentica y traduce una notificación de la transportadora (headers con llaves en minúscula)
+	ParseWebhook(ctx context.Context, headers map[string]string, body []byte) ([]TrackingUpdate, error)
+}
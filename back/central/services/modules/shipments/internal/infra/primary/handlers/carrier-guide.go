@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// GenerateGuide godoc
+// @Summary      Generar guía del envío
+// @Description  Genera la guía con la transportadora indicada (o la ya asignada al envío) usando la cuenta del negocio registrada como integración. Guarda la etiqueta PDF en el almacenamiento y completa transportadora, tracking, guía, costo y entrega estimada del envío; la guía también se copia a la orden.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true  "ID del envío"
+// @Param        request  body      domain.GenerateGuideRequest  true  "Transportadora y servicio"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /shipments/{id}/guide [post]
+func (h *Handlers) GenerateGuide(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.GenerateGuideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	shipment, err := h.uc.GenerateGuide(c.Request.Context(), id, &req)
+	if err != nil {
+		respondCarrierError(c, err, "Error al generar la guía")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Guía generada exitosamente",
+		"data":    shipment,
+	})
+}
+
+// CancelGuide godoc
+// @Summary      Anular guía del envío
+// @Description  Anula la guía en la transportadora mientras el envío no haya salido a despacho, y limpia los datos de guía y tracking del envío y de la orden
+// @Tags         Shipments
+// @Produce      json
+// @Param        id   path      int  true  "ID del envío"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/guide [delete]
+func (h *Handlers) CancelGuide(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	shipment, err := h.uc.CancelGuide(c.Request.Context(), id)
+	if err != nil {
+		respondCarrierError(c, err, "Error al anular la guía")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Guía anulada exitosamente",
+		"data":    shipment,
+	})
+}
+
+// QuoteShipment godoc
+// @Summary      Cotizar envío
+// @Description  Cotiza el envío con las transportadoras indicadas (o todas las registradas) según el destino y dimensiones del envío, ordenando las tarifas de menor a mayor. Las transportadoras sin cuenta o que fallan se reportan en 'errors'.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                          true   "ID del envío"
+// @Param        request  body      domain.QuoteShipmentRequest  false  "Transportadoras a cotizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.QuoteShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/quotes [post]
+func (h *Handlers) QuoteShipment(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.QuoteShipmentRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Datos de entrada inválidos",
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
+
+	quotes, err := h.uc.QuoteShipment(c.Request.Context(), id, &req)
+	if err != nil {
+		respondCarrierError(c, err, "Error al cotizar el envío")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Cotización obtenida exitosamente",
+		"data":    quotes,
+	})
+}
+
+// parseShipmentID lee el ID del envío de la ruta y responde 400 si es inválido
+func parseShipmentID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de envío inválido",
+			"error":   "El ID debe ser un número válido",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondCarrierError traduce los errores de dominio de transportadoras a respuestas HTTP
+func respondCarrierError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrShipmentNotFound), errors.Is(err, domain.ErrOrderNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrCarrierNotRegistered),
+		errors.Is(err, domain.ErrCarrierRequired),
+		errors.Is(err, domain.ErrCarrierAccountNotFound),
+		errors.Is(err, domain.ErrGuideNotFound):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrGuideAlreadyExists),
+		errors.Is(err, domain.ErrGuideGenerationInProgress),
+		errors.Is(err, domain.ErrGuideNotCancellable):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrLabelStorageUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
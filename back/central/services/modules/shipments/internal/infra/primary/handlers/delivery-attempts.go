@@ -0,0 +1,346 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// LogDeliveryAttempt godoc
+// @Summary      Registrar intento de entrega
+// @Description  Registra un intento de entrega de un envío de última milla con conductor, fecha, punto GPS y resultado. Un intento fallido requiere un motivo del catálogo (ej: customer_absent, refused, wrong_address, no_money) y, al llegar al máximo de intentos fallidos, escala el envío.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                                true  "ID del envío"
+// @Param        request  body      domain.LogDeliveryAttemptRequest  true  "Datos del intento"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.DeliveryAttemptResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/attempts [post]
+func (h *Handlers) LogDeliveryAttempt(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.LogDeliveryAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	response, err := h.uc.LogDeliveryAttempt(c.Request.Context(), id, &req)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al registrar el intento de entrega")
+		return
+	}
+
+	message := "Intento de entrega registrado exitosamente"
+	if response.Escalated {
+		message = "Intento de entrega registrado; el envío fue escalado por intentos fallidos"
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": message,
+		"data":    response,
+	})
+}
+
+// ListDeliveryAttempts godoc
+// @Summary      Listar intentos de entrega
+// @Description  Obtiene los intentos de entrega de un envío, del primero al último
+// @Tags         Shipments
+// @Produce      json
+// @Param        id  path  int  true  "ID del envío"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.DeliveryAttempt
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/attempts [get]
+func (h *Handlers) ListDeliveryAttempts(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	attempts, err := h.uc.ListDeliveryAttempts(c.Request.Context(), id)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al obtener los intentos de entrega")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Intentos de entrega obtenidos exitosamente",
+		"data":    attempts,
+	})
+}
+
+// RescheduleDelivery godoc
+// @Summary      Reprogramar entrega
+// @Description  Acuerda una nueva fecha de entrega para un envío cuyo último intento falló; el envío vuelve a ruta (in_transit) con la nueva entrega estimada
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                                true  "ID del envío"
+// @Param        request  body      domain.RescheduleDeliveryRequest  true  "Nueva fecha de entrega"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/reschedule [post]
+func (h *Handlers) RescheduleDelivery(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.RescheduleDeliveryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	shipment, err := h.uc.RescheduleDelivery(c.Request.Context(), id, &req)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al reprogramar la entrega")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Entrega reprogramada exitosamente",
+		"data":    shipment,
+	})
+}
+
+// EscalateShipment godoc
+// @Summary      Escalar envío
+// @Description  Escala manualmente un envío pendiente de entrega para que lo atienda el equipo de operaciones. Los envíos también se escalan solos al llegar al máximo de intentos fallidos.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                              true  "ID del envío"
+// @Param        request  body      domain.EscalateShipmentRequest  true  "Motivo del escalamiento"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/escalate [post]
+func (h *Handlers) EscalateShipment(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.EscalateShipmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	shipment, err := h.uc.EscalateShipment(c.Request.Context(), id, &req)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al escalar el envío")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Envío escalado exitosamente",
+		"data":    shipment,
+	})
+}
+
+// ListDeliveryFailureReasons godoc
+// @Summary      Listar motivos de falla de entrega
+// @Description  Obtiene el catálogo de motivos de falla: los globales y, si se indica, los propios del negocio
+// @Tags         Shipments
+// @Produce      json
+// @Param        business_id  query  int   false  "ID del negocio"
+// @Param        active_only  query  bool  false  "Solo motivos activos (default: true)"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.DeliveryFailureReason
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/delivery-failure-reasons [get]
+func (h *Handlers) ListDeliveryFailureReasons(c *gin.Context) {
+	var businessID *uint
+	if raw := c.Query("business_id"); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil || id == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'business_id' inválido",
+				"error":   "business_id debe ser un número entero mayor a 0",
+			})
+			return
+		}
+		value := uint(id)
+		businessID = &value
+	}
+
+	activeOnly, err := strconv.ParseBool(c.DefaultQuery("active_only", "true"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'active_only' inválido",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	reasons, err := h.uc.ListDeliveryFailureReasons(c.Request.Context(), businessID, activeOnly)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al obtener los motivos de falla")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Motivos de falla obtenidos exitosamente",
+		"data":    reasons,
+	})
+}
+
+// CreateDeliveryFailureReason godoc
+// @Summary      Crear motivo de falla de entrega
+// @Description  Agrega un motivo de falla al catálogo del negocio, adicional a los motivos globales
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        request  body      domain.CreateDeliveryFailureReasonRequest  true  "Motivo de falla"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.DeliveryFailureReason
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/delivery-failure-reasons [post]
+func (h *Handlers) CreateDeliveryFailureReason(c *gin.Context) {
+	var req domain.CreateDeliveryFailureReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	reason, err := h.uc.CreateDeliveryFailureReason(c.Request.Context(), &req)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al crear el motivo de falla")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Motivo de falla creado exitosamente",
+		"data":    reason,
+	})
+}
+
+// UpdateDeliveryFailureReason godoc
+// @Summary      Actualizar motivo de falla de entrega
+// @Description  Modifica el nombre, la descripción o el estado de un motivo de falla del negocio. Los motivos globales son de solo lectura.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        reason_id  path      int                                        true  "ID del motivo"
+// @Param        request    body      domain.UpdateDeliveryFailureReasonRequest  true  "Campos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.DeliveryFailureReason
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/delivery-failure-reasons/{reason_id} [put]
+func (h *Handlers) UpdateDeliveryFailureReason(c *gin.Context) {
+	reasonID, err := strconv.ParseUint(c.Param("reason_id"), 10, 32)
+	if err != nil || reasonID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de motivo inválido",
+			"error":   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	var req domain.UpdateDeliveryFailureReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	reason, err := h.uc.UpdateDeliveryFailureReason(c.Request.Context(), uint(reasonID), &req)
+	if err != nil {
+		respondDeliveryError(c, err, "Error al actualizar el motivo de falla")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Motivo de falla actualizado exitosamente",
+		"data":    reason,
+	})
+}
+
+// respondDeliveryError traduce los errores de dominio de intentos de entrega a respuestas HTTP
+func respondDeliveryError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrShipmentNotFound), errors.Is(err, domain.ErrOrderNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrFailureReasonNotFound):
+		// En un intento es un código inválido; al actualizar el catálogo es un ID inexistente
+		status = http.StatusBadRequest
+		if c.Param("reason_id") != "" {
+			status = http.StatusNotFound
+		}
+	case errors.Is(err, domain.ErrShipmentNotLastMile),
+		errors.Is(err, domain.ErrFailureReasonRequired),
+		errors.Is(err, domain.ErrInvalidDeliveryLocation),
+		errors.Is(err, domain.ErrInvalidRescheduleDate),
+		errors.Is(err, domain.ErrInvalidShipmentData):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrShipmentAlreadyDelivered),
+		errors.Is(err, domain.ErrShipmentAlreadyEscalated),
+		errors.Is(err, domain.ErrRescheduleNotAllowed),
+		errors.Is(err, domain.ErrFailureReasonAlreadyExists),
+		errors.Is(err, domain.ErrFailureReasonReadOnly):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
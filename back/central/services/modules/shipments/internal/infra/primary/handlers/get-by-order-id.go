@@ -73,6 +73,9 @@ func (h *Handlers) GetShipmentsByOrderID(c *gin.Context) {
 			DriverID:       shipment.DriverID,
 			DriverName:     shipment.DriverName,
 			IsLastMile:    shipment.IsLastMile,
+			FailedAttempts:   shipment.FailedAttempts,
+			EscalatedAt:      shipment.EscalatedAt,
+			EscalationReason: shipment.EscalationReason,
 			EstimatedDelivery: shipment.EstimatedDelivery,
 			DeliveryNotes:     shipment.DeliveryNotes,
 			Metadata:          shipment.Metadata,
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// ListTrackingEvents godoc
+// @Summary      Línea de tiempo de tracking del envío
+// @Description  Obtiene los checkpoints reportados por la transportadora (webhook o consulta periódica), del más antiguo al más reciente
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del envío"
+// @Security     BearerAuth
+// @Success      200  {array}   domain.ShipmentTrackingEvent
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/events [get]
+func (h *Handlers) ListTrackingEvents(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de envío inválido",
+			"error":   "El ID debe ser un número válido",
+		})
+		return
+	}
+
+	events, err := h.uc.ListTrackingEvents(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, domain.ErrShipmentNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"message": "Envío no encontrado",
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Error al obtener los eventos de tracking",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Eventos de tracking obtenidos exitosamente",
+		"data":    events,
+	})
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CaptureProofOfDelivery godoc
+// @Summary      Capturar evidencia de entrega
+// @Description  Guarda la foto de la entrega, la firma (opcional), quién recibió y la posición GPS del dispositivo. La posición se compara con las coordenadas de la dirección de la orden y la entrega se marca como lejana si supera la distancia configurada. Si el envío aún no estaba entregado, se marca como entregado.
+// @Tags         Shipments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id                 path      int     true   "ID del envío"
+// @Param        photo              formData  file    true   "Foto de la entrega (jpeg, png, gif, webp; máx. 10 MB)"
+// @Param        signature          formData  file    false  "Imagen de la firma de quien recibe"
+// @Param        receiver_name      formData  string  true   "Nombre de quien recibe"
+// @Param        receiver_document  formData  string  false  "Documento de quien recibe"
+// @Param        latitude           formData  number  false  "Latitud del dispositivo"
+// @Param        longitude          formData  number  false  "Longitud del dispositivo"
+// @Param        captured_at        formData  string  false  "Fecha y hora de la captura (RFC3339). Por defecto ahora"
+// @Param        driver_id          formData  int     false  "ID del conductor. Por defecto el asignado al envío"
+// @Param        notes              formData  string  false  "Notas de la entrega"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.ShipmentResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Failure      503  {object}  map[string]interface{}
+// @Router       /shipments/{id}/proof-of-delivery [post]
+func (h *Handlers) CaptureProofOfDelivery(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	req := domain.CaptureProofOfDeliveryRequest{
+		ReceiverName:     c.PostForm("receiver_name"),
+		ReceiverDocument: c.PostForm("receiver_document"),
+		Notes:            c.PostForm("notes"),
+	}
+
+	photo, err := c.FormFile("photo")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "La foto de la entrega es requerida",
+			"error":   err.Error(),
+		})
+		return
+	}
+	req.Photo = photo
+	if signature, err := c.FormFile("signature"); err == nil {
+		req.Signature = signature
+	}
+
+	var invalid string
+	parseFloat := func(field string) *float64 {
+		raw := c.PostForm(field)
+		if raw == "" {
+			return nil
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			invalid = field
+			return nil
+		}
+		return &value
+	}
+	req.Latitude = parseFloat("latitude")
+	req.Longitude = parseFloat("longitude")
+	if req.Latitude != nil && (*req.Latitude < -90 || *req.Latitude > 90) {
+		invalid = "latitude"
+	}
+	if req.Longitude != nil && (*req.Longitude < -180 || *req.Longitude > 180) {
+		invalid = "longitude"
+	}
+	if raw := c.PostForm("captured_at"); raw != "" {
+		capturedAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			invalid = "captured_at"
+		} else {
+			req.CapturedAt = &capturedAt
+		}
+	}
+	if raw := c.PostForm("driver_id"); raw != "" {
+		driverID, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil || driverID == 0 {
+			invalid = "driver_id"
+		} else {
+			value := uint(driverID)
+			req.DriverID = &value
+		}
+	}
+	if invalid != "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro '" + invalid + "' inválido",
+			"error":   "invalid " + invalid,
+		})
+		return
+	}
+
+	shipment, err := h.uc.CaptureProofOfDelivery(c.Request.Context(), id, &req)
+	if err != nil {
+		respondProofError(c, err, "Error al guardar la evidencia de entrega")
+		return
+	}
+
+	message := "Evidencia de entrega guardada exitosamente"
+	if shipment.ProofOfDelivery != nil && shipment.ProofOfDelivery.IsFarFromAddress {
+		message = "Evidencia de entrega guardada; la entrega se registró lejos de la dirección de la orden"
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": message,
+		"data":    shipment,
+	})
+}
+
+// GetProofOfDelivery godoc
+// @Summary      Obtener evidencia de entrega
+// @Description  Obtiene la evidencia de entrega de un envío con las URLs de la foto y la firma
+// @Tags         Shipments
+// @Produce      json
+// @Param        id  path  int  true  "ID del envío"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.ProofOfDelivery
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/{id}/proof-of-delivery [get]
+func (h *Handlers) GetProofOfDelivery(c *gin.Context) {
+	id, ok := parseShipmentID(c)
+	if !ok {
+		return
+	}
+
+	proof, err := h.uc.GetProofOfDelivery(c.Request.Context(), id)
+	if err != nil {
+		respondProofError(c, err, "Error al obtener la evidencia de entrega")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Evidencia de entrega obtenida exitosamente",
+		"data":    proof,
+	})
+}
+
+// respondProofError traduce los errores de dominio de la evidencia de entrega a respuestas HTTP
+func respondProofError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrShipmentNotFound),
+		errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrProofOfDeliveryNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrProofPhotoRequired),
+		errors.Is(err, domain.ErrReceiverNameRequired),
+		errors.Is(err, domain.ErrInvalidDeliveryLocation),
+		errors.Is(err, domain.ErrEvidenceUploadFailed):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrProofOfDeliveryAlreadyExists):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrEvidenceStorageUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
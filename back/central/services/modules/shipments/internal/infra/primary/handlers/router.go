@@ -18,6 +18,28 @@ func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
 		// Rutas adicionales
 		shipments.GET("/order/:order_id", h.GetShipmentsByOrderID)
 		shipments.GET("/tracking/:tracking_number", h.GetShipmentByTrackingNumber)
+
+		// Tracking: línea de tiempo y webhooks de transportadoras
+		shipments.GET("/:id/events", h.ListTrackingEvents)
+		shipments.POST("/tracking/webhook/:carrier", h.ReceiveTrackingWebhook)
+
+		// Transportadoras: cotización y guías
+		shipments.POST("/:id/quotes", h.QuoteShipment)
+		shipments.POST("/:id/guide", h.GenerateGuide)
+		shipments.DELETE("/:id/guide", h.CancelGuide)
+
+		// Última milla: intentos de entrega, reprogramación, escalamiento y evidencia de entrega
+		shipments.GET("/:id/attempts", h.ListDeliveryAttempts)
+		shipments.POST("/:id/attempts", h.LogDeliveryAttempt)
+		shipments.POST("/:id/reschedule", h.RescheduleDelivery)
+		shipments.POST("/:id/escalate", h.EscalateShipment)
+		shipments.GET("/:id/proof-of-delivery", h.GetProofOfDelivery)
+		shipments.POST("/:id/proof-of-delivery", h.CaptureProofOfDelivery)
+
+		// Catálogo de motivos de falla de entrega
+		shipments.GET("/delivery-failure-reasons", h.ListDeliveryFailureReasons)
+		shipments.POST("/delivery-failure-reasons", h.CreateDeliveryFailureReason)
+		shipments.PUT("/delivery-failure-reasons/:reason_id", h.UpdateDeliveryFailureReason)
 	}
 }
 
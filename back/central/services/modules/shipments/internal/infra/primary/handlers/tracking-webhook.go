@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// maxTrackingWebhookBody tamaño máximo aceptado del cuerpo de un webhook de tracking (1 MB)
+const maxTrackingWebhookBody = 1 << 20
+
+// ReceiveTrackingWebhook godoc
+// @Summary      Webhook de tracking de transportadora
+// @Description  Recibe los checkpoints de tracking que envía una transportadora. El adaptador de la transportadora autentica y traduce la notificación; los checkpoints repetidos se ignoran y el estado del envío se recalcula.
+// @Tags         Shipments
+// @Accept       json
+// @Produce      json
+// @Param        carrier  path      string  true  "Código de la transportadora (ej: fake)"
+// @Success      200  {object}  domain.TrackingIngestResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /shipments/tracking/webhook/{carrier} [post]
+func (h *Handlers) ReceiveTrackingWebhook(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxTrackingWebhookBody))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "No se pudo leer el cuerpo del webhook",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	headers := make(map[string]string, len(c.Request.Header))
+	for key := range c.Request.Header {
+		headers[strings.ToLower(key)] = c.Request.Header.Get(key)
+	}
+
+	result, err := h.uc.IngestTrackingWebhook(c.Request.Context(), c.Param("carrier"), headers, body)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := "Error al procesar el webhook de tracking"
+		switch {
+		case errors.Is(err, domain.ErrCarrierTrackerNotFound):
+			status = http.StatusNotFound
+			message = "Transportadora no soportada"
+		case errors.Is(err, domain.ErrTrackingWebhookUnauthorized):
+			status = http.StatusUnauthorized
+			message = "Webhook no autorizado"
+		case errors.Is(err, domain.ErrInvalidTrackingWebhook):
+			status = http.StatusBadRequest
+			message = "Cuerpo del webhook inválido"
+		}
+		c.JSON(status, gin.H{
+			"success": false,
+			"message": message,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Webhook de tracking procesado",
+		"data":    result,
+	})
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/app/usecasetracking"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+const (
+	// tickInterval cada cuánto se buscan envíos con tracking por consultar
+	tickInterval = time.Minute
+	// batchSize máximo de envíos consultados por ciclo
+	batchSize = 50
+)
+
+// TrackingWorker consulta periódicamente a las transportadoras el tracking de los envíos activos
+type TrackingWorker struct {
+	usecase *usecasetracking.UseCaseTracking
+	logger  log.ILogger
+}
+
+// New crea el worker de consulta de tracking
+func New(usecase *usecasetracking.UseCaseTracking, logger log.ILogger) *TrackingWorker {
+	return &TrackingWorker{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// Start inicia el ciclo en background hasta que el contexto se cancele
+func (w *TrackingWorker) Start(ctx context.Context) {
+	w.logger.Info(ctx).
+		Str("tick_interval", tickInterval.String()).
+		Msg("Worker de tracking de envíos iniciado")
+
+	go func() {
+		ticker := time.NewTicker(tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				polled, err := w.usecase.PollPending(ctx, batchSize)
+				if err != nil {
+					w.logger.Error(ctx).Err(err).Msg("Error al consultar el tracking de envíos")
+				}
+				if polled > 0 {
+					w.logger.Info(ctx).Int("polled", polled).Msg("Tracking de envíos consultado")
+				}
+			case <-ctx.Done():
+				w.logger.Info(ctx).Msg("Context cancelado, deteniendo worker de tracking de envíos")
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+package carrieraccounts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/integrations/core"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CarrierAccounts obtiene las cuentas de transportadora desde el core de integraciones: cada
+// transportadora es un tipo de integración cuyo código coincide con el del adaptador
+type CarrierAccounts struct {
+	core core.IIntegrationCore
+}
+
+// New crea el proveedor de cuentas de transportadora
+func New(integrationCore core.IIntegrationCore) domain.ICarrierAccounts {
+	return &CarrierAccounts{core: integrationCore}
+}
+
+// GetAccount obtiene la integración activa del negocio con la transportadora;
+// si el negocio no tiene una propia se usa la global (sin negocio)
+func (a *CarrierAccounts) GetAccount(ctx context.Context, carrierCode string, businessID *uint) (*domain.CarrierAccount, error) {
+	integration, err := a.core.GetIntegrationByType(ctx, carrierCode, businessID)
+	if businessID != nil && errors.Is(err, core.ErrIntegrationNotFound) {
+		integration, err = a.core.GetIntegrationByType(ctx, carrierCode, nil)
+	}
+	if err != nil {
+		if errors.Is(err, core.ErrIntegrationNotFound) || errors.Is(err, core.ErrIntegrationTypeNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrCarrierAccountNotFound, carrierCode)
+		}
+		return nil, err
+	}
+
+	account := &domain.CarrierAccount{
+		IntegrationID: integration.ID,
+		BusinessID:    integration.BusinessID,
+		Credentials:   integration.DecryptedCredentials,
+	}
+	if len(integration.Config) > 0 {
+		if err := json.Unmarshal(integration.Config, &account.Config); err != nil {
+			return nil, fmt.Errorf("error decoding carrier config: %w", err)
+		}
+	}
+	return account, nil
+}
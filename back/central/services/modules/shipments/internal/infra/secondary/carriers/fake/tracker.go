@@ -0,0 +1,126 @@
+package fake
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CarrierCode código de la transportadora simulada (Shipment.CarrierCode)
+const CarrierCode = "fake"
+
+// tokenHeader header con el token compartido del webhook
+const tokenHeader = "x-fake-carrier-token"
+
+// statusByCode traduce los códigos de la transportadora simulada a estados normalizados
+var statusByCode = map[string]string{
+	"CREATED":          domain.TrackingStatusPending,
+	"PICKED_UP":        domain.TrackingStatusInTransit,
+	"IN_TRANSIT":       domain.TrackingStatusInTransit,
+	"OUT_FOR_DELIVERY": domain.TrackingStatusOutForDelivery,
+	"DELIVERED":        domain.TrackingStatusDelivered,
+	"DELIVERY_FAILED":  domain.TrackingStatusFailed,
+	"RETURNED":         domain.TrackingStatusReturned,
+}
+
+// Tracker es una transportadora simulada para desarrollo y pruebas: Track responde con los
+// checkpoints cargados con Push y el webhook acepta un JSON simple
+type Tracker struct {
+	webhookToken string
+	checkpoints  map[string][]domain.TrackingCheckpoint
+	mu           sync.RWMutex
+}
+
+// New crea la transportadora simulada. Con webhookToken vacío el webhook rechaza todo.
+func New(webhookToken string) *Tracker {
+	return &Tracker{
+		webhookToken: webhookToken,
+		checkpoints:  make(map[string][]domain.TrackingCheckpoint),
+	}
+}
+
+// Code retorna el código de la transportadora
+func (t *Tracker) Code() string {
+	return CarrierCode
+}
+
+// Push agrega checkpoints (con códigos de la transportadora simulada) que Track retornará
+func (t *Tracker) Push(trackingNumber string, code string, occurredAt time.Time, location string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.checkpoints[trackingNumber] = append(t.checkpoints[trackingNumber], checkpoint(code, occurredAt, location, ""))
+}
+
+// Track retorna los checkpoints cargados para el número de tracking
+func (t *Tracker) Track(_ context.Context, trackingNumber string) ([]domain.TrackingCheckpoint, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]domain.TrackingCheckpoint(nil), t.checkpoints[trackingNumber]...), nil
+}
+
+// webhookPayload formato del webhook simulado
+type webhookPayload struct {
+	TrackingNumber string `json:"tracking_number"`
+	Events         []struct {
+		Code        string    `json:"code"`
+		OccurredAt  time.Time `json:"occurred_at"`
+		Location    string    `json:"location"`
+		Description string    `json:"description"`
+	} `json:"events"`
+}
+
+// ParseWebhook valida el token y traduce el cuerpo (un objeto o un arreglo de objetos)
+func (t *Tracker) ParseWebhook(_ context.Context, headers map[string]string, body []byte) ([]domain.TrackingUpdate, error) {
+	if t.webhookToken == "" || subtle.ConstantTimeCompare([]byte(headers[tokenHeader]), []byte(t.webhookToken)) != 1 {
+		return nil, domain.ErrTrackingWebhookUnauthorized
+	}
+
+	var payloads []webhookPayload
+	if trimmed := strings.TrimSpace(string(body)); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(body, &payloads); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidTrackingWebhook, err)
+		}
+	} else {
+		var payload webhookPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidTrackingWebhook, err)
+		}
+		payloads = append(payloads, payload)
+	}
+
+	updates := make([]domain.TrackingUpdate, 0, len(payloads))
+	for _, payload := range payloads {
+		if payload.TrackingNumber == "" {
+			return nil, fmt.Errorf("%w: tracking_number is required", domain.ErrInvalidTrackingWebhook)
+		}
+		update := domain.TrackingUpdate{TrackingNumber: payload.TrackingNumber}
+		for _, event := range payload.Events {
+			update.Checkpoints = append(update.Checkpoints, checkpoint(event.Code, event.OccurredAt, event.Location, event.Description))
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+func checkpoint(code string, occurredAt time.Time, location, description string) domain.TrackingCheckpoint {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	status, ok := statusByCode[code]
+	if !ok {
+		status = domain.TrackingStatusUnknown
+	}
+	return domain.TrackingCheckpoint{
+		OccurredAt:  occurredAt,
+		RawCode:     code,
+		Status:      status,
+		Location:    location,
+		Description: description,
+	}
+}
@@ -0,0 +1,254 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+)
+
+// CarrierCode código de la transportadora simulada. Para usarla se crea en core un tipo de integración
+// con este código y una integración del negocio con la credencial "api_key" (cualquier valor).
+const CarrierCode = "mock"
+
+// Servicios ofrecidos
+const (
+	serviceStandard = "standard"
+	serviceExpress  = "express"
+)
+
+// timeline avance simulado de una guía desde su creación
+var timeline = []struct {
+	after       time.Duration
+	code        string
+	status      string
+	location    string
+	description string
+}{
+	{0, "CREATED", domain.TrackingStatusPending, "Bodega origen", "Guía generada"},
+	{2 * time.Minute, "PICKED_UP", domain.TrackingStatusInTransit, "Bodega origen", "Paquete recogido"},
+	{10 * time.Minute, "IN_TRANSIT", domain.TrackingStatusInTransit, "Centro de distribución", "En tránsito hacia la ciudad destino"},
+	{30 * time.Minute, "OUT_FOR_DELIVERY", domain.TrackingStatusOutForDelivery, "Ciudad destino", "En reparto"},
+	{60 * time.Minute, "DELIVERED", domain.TrackingStatusDelivered, "Ciudad destino", "Entregado al destinatario"},
+}
+
+// guide guía creada en memoria
+type guide struct {
+	id             string
+	trackingNumber string
+	service        string
+	request        domain.CarrierGuideRequest
+	cost           float64
+	createdAt      time.Time
+	cancelledAt    *time.Time
+}
+
+// Carrier es una transportadora local simulada para desarrollo y pruebas: cotiza con una tarifa fija,
+// guarda las guías en memoria, genera una etiqueta PDF simple y simula el avance del tracking
+type Carrier struct {
+	guides   map[string]*guide
+	byNumber map[string]*guide
+	sequence atomic.Uint64
+	mu       sync.RWMutex
+}
+
+// New crea la transportadora simulada
+func New() *Carrier {
+	return &Carrier{
+		guides:   make(map[string]*guide),
+		byNumber: make(map[string]*guide),
+	}
+}
+
+// Code retorna el código de la transportadora
+func (c *Carrier) Code() string {
+	return CarrierCode
+}
+
+// Name retorna el nombre visible de la transportadora
+func (c *Carrier) Name() string {
+	return "Mock Carrier"
+}
+
+// Quote cotiza los servicios estándar y express
+func (c *Carrier) Quote(_ context.Context, account *domain.CarrierAccount, req *domain.CarrierQuoteRequest) ([]domain.CarrierQuote, error) {
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+
+	currency := currencyOrDefault(req.Currency)
+	return []domain.CarrierQuote{
+		{CarrierCode: CarrierCode, Service: serviceStandard, Price: price(serviceStandard, req.Parcel, req.CodAmount), Currency: currency, EstimatedDays: 3},
+		{CarrierCode: CarrierCode, Service: serviceExpress, Price: price(serviceExpress, req.Parcel, req.CodAmount), Currency: currency, EstimatedDays: 1},
+	}, nil
+}
+
+// CreateGuide crea la guía en memoria
+func (c *Carrier) CreateGuide(_ context.Context, account *domain.CarrierAccount, req *domain.CarrierGuideRequest) (*domain.CarrierGuide, error) {
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+
+	service := req.Service
+	if service == "" {
+		service = serviceStandard
+	}
+	if service != serviceStandard && service != serviceExpress {
+		return nil, fmt.Errorf("mock carrier: unknown service %q", service)
+	}
+	if strings.TrimSpace(req.Recipient.Street) == "" || strings.TrimSpace(req.Recipient.City) == "" {
+		return nil, fmt.Errorf("mock carrier: recipient street and city are required")
+	}
+
+	now := time.Now()
+	seq := c.sequence.Add(1)
+	g := &guide{
+		id:             fmt.Sprintf("MOCK-%s-%04d", now.Format("20060102150405"), seq),
+		trackingNumber: fmt.Sprintf("MK%d%04d", now.Unix(), seq%10000),
+		service:        service,
+		request:        *req,
+		cost:           price(service, req.Parcel, req.CodAmount),
+		createdAt:      now,
+	}
+
+	c.mu.Lock()
+	c.guides[g.id] = g
+	c.byNumber[g.trackingNumber] = g
+	c.mu.Unlock()
+
+	days := 3
+	if service == serviceExpress {
+		days = 1
+	}
+	estimated := now.AddDate(0, 0, days)
+	cost := g.cost
+	return &domain.CarrierGuide{
+		GuideID:           g.id,
+		TrackingNumber:    g.trackingNumber,
+		TrackingURL:       "https://tracking.mock-carrier.local/" + g.trackingNumber,
+		Service:           service,
+		Cost:              &cost,
+		EstimatedDelivery: &estimated,
+	}, nil
+}
+
+// FetchLabel genera la etiqueta PDF de la guía
+func (c *Carrier) FetchLabel(_ context.Context, account *domain.CarrierAccount, guideID string) ([]byte, error) {
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	g, ok := c.guides[guideID]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("mock carrier: guide %s not found", guideID)
+	}
+
+	lines := []string{
+		"MOCK CARRIER - " + strings.ToUpper(g.service),
+		"Guía: " + g.id,
+		"Tracking: " + g.trackingNumber,
+		"Referencia: " + g.request.Reference,
+		"Destinatario: " + g.request.Recipient.Name,
+		"Teléfono: " + g.request.Recipient.Phone,
+		"Dirección: " + g.request.Recipient.Street,
+		"Ciudad: " + g.request.Recipient.City + ", " + g.request.Recipient.State,
+		fmt.Sprintf("Peso: %.2f kg", g.request.Parcel.Weight),
+	}
+	if g.request.CodAmount > 0 {
+		lines = append(lines, fmt.Sprintf("RECAUDO: %.2f %s", g.request.CodAmount, currencyOrDefault(g.request.Currency)))
+	}
+	return labelPDF(lines), nil
+}
+
+// CancelGuide anula la guía si aún no fue recogida
+func (c *Carrier) CancelGuide(_ context.Context, account *domain.CarrierAccount, guideID string) error {
+	if err := validateAccount(account); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.guides[guideID]
+	if !ok {
+		return fmt.Errorf("mock carrier: guide %s not found", guideID)
+	}
+	if g.cancelledAt == nil {
+		now := time.Now()
+		g.cancelledAt = &now
+	}
+	return nil
+}
+
+// Track retorna los checkpoints alcanzados según el tiempo transcurrido desde la creación de la guía
+func (c *Carrier) Track(_ context.Context, account *domain.CarrierAccount, trackingNumber string) ([]domain.TrackingCheckpoint, error) {
+	if err := validateAccount(account); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	g, ok := c.byNumber[trackingNumber]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	now := time.Now()
+	checkpoints := make([]domain.TrackingCheckpoint, 0, len(timeline))
+	for _, step := range timeline {
+		occurredAt := g.createdAt.Add(step.after)
+		if occurredAt.After(now) || (g.cancelledAt != nil && occurredAt.After(*g.cancelledAt)) {
+			break
+		}
+		checkpoints = append(checkpoints, domain.TrackingCheckpoint{
+			OccurredAt:  occurredAt,
+			RawCode:     step.code,
+			Status:      step.status,
+			Location:    step.location,
+			Description: step.description,
+		})
+	}
+	if g.cancelledAt != nil {
+		checkpoints = append(checkpoints, domain.TrackingCheckpoint{
+			OccurredAt:  *g.cancelledAt,
+			RawCode:     "CANCELLED",
+			Status:      domain.TrackingStatusUnknown,
+			Description: "Guía anulada",
+		})
+	}
+	return checkpoints, nil
+}
+
+func validateAccount(account *domain.CarrierAccount) error {
+	if account == nil {
+		return fmt.Errorf("mock carrier: account is required")
+	}
+	if apiKey, _ := account.Credentials["api_key"].(string); apiKey == "" {
+		return fmt.Errorf("mock carrier: api_key credential is required")
+	}
+	return nil
+}
+
+// price tarifa fija: base + kilo adicional + 1% del recaudo; express cuesta 60% más
+func price(service string, parcel domain.CarrierParcel, codAmount float64) float64 {
+	kilos := math.Max(1, math.Ceil(parcel.Weight))
+	total := 8000 + 1500*(kilos-1) + codAmount*0.01
+	if service == serviceExpress {
+		total *= 1.6
+	}
+	return math.Round(total)
+}
+
+func currencyOrDefault(currency string) string {
+	if currency == "" {
+		return "COP"
+	}
+	return currency
+}
@@ -0,0 +1,47 @@
+package evidence
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/storage"
+)
+
+// EvidenceStorage guarda en S3 las fotos y firmas de la evidencia de entrega
+type EvidenceStorage struct {
+	s3      storage.IS3Service
+	baseURL string
+}
+
+// New crea el almacenamiento de evidencia. baseURL es el dominio público de los archivos
+// (URL_BASE_DOMAIN_S3); vacío usa la URL por defecto del bucket.
+func New(s3 storage.IS3Service, baseURL string) domain.IEvidenceStorage {
+	return &EvidenceStorage{
+		s3:      s3,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// UploadImage sube la imagen validando tipo y tamaño, y retorna su path relativo
+func (s *EvidenceStorage) UploadImage(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+	return s.s3.UploadImage(ctx, file, folder)
+}
+
+// Delete elimina una imagen subida
+func (s *EvidenceStorage) Delete(ctx context.Context, path string) error {
+	return s.s3.DeleteImage(ctx, path)
+}
+
+// URL completa la URL pública de un path relativo
+func (s *EvidenceStorage) URL(path string) string {
+	if path == "" || strings.HasPrefix(path, "http") {
+		return path
+	}
+	if s.baseURL == "" {
+		return s.s3.GetImageURL(path)
+	}
+	return fmt.Sprintf("%s/%s", s.baseURL, strings.TrimLeft(path, "/"))
+}
@@ -0,0 +1,24 @@
+package labels
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/storage"
+)
+
+// LabelStorage guarda en S3 las etiquetas PDF de las guías
+type LabelStorage struct {
+	s3 storage.IS3Service
+}
+
+// New crea el almacenamiento de etiquetas
+func New(s3 storage.IS3Service) domain.ILabelStorage {
+	return &LabelStorage{s3: s3}
+}
+
+// Upload sube la etiqueta a S3 bajo la llave dada y retorna su URL
+func (s *LabelStorage) Upload(ctx context.Context, key string, data []byte) (string, error) {
+	return s.s3.UploadFile(ctx, bytes.NewReader(data), key)
+}
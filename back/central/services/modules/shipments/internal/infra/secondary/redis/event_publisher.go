@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// ShipmentEventPublisher publica eventos de envíos a Redis Pub/Sub
+type ShipmentEventPublisher struct {
+	redisClient redisclient.IRedis
+	logger      log.ILogger
+	channel     string
+}
+
+// NewShipmentEventPublisher crea un nuevo publicador de eventos de envíos
+func NewShipmentEventPublisher(redisClient redisclient.IRedis, logger log.ILogger, channel string) domain.IShipmentEventPublisher {
+	return &ShipmentEventPublisher{
+		redisClient: redisClient,
+		logger:      logger,
+		channel:     channel,
+	}
+}
+
+// PublishShipmentEvent publica un evento de envío a Redis
+func (p *ShipmentEventPublisher) PublishShipmentEvent(ctx context.Context, event *domain.ShipmentEvent) error {
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("event_id", event.ID).
+			Str("event_type", string(event.Type)).
+			Msg("Error al serializar evento de envío")
+		return err
+	}
+
+	client := p.redisClient.Client(ctx)
+	if client == nil {
+		p.logger.Warn(ctx).
+			Str("event_id", event.ID).
+			Str("event_type", string(event.Type)).
+			Msg("Redis no disponible, evento de envío no publicado")
+		return nil
+	}
+
+	if err := client.Publish(ctx, p.channel, eventJSON).Err(); err != nil {
+		p.logger.Error(ctx).
+			Err(err).
+			Str("event_id", event.ID).
+			Str("event_type", string(event.Type)).
+			Str("channel", p.channel).
+			Msg("Error al publicar evento de envío a Redis")
+		return err
+	}
+
+	p.logger.Debug(ctx).
+		Str("event_id", event.ID).
+		Str("event_type", string(event.Type)).
+		Uint("shipment_id", event.ShipmentID).
+		Str("channel", p.channel).
+		Msg("Evento de envío publicado a Redis")
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// GetShipmentOrder obtiene los datos de la orden necesarios para cotizar y generar guías
+func (r *Repository) GetShipmentOrder(ctx context.Context, orderID string) (*domain.ShipmentOrder, error) {
+	var order models.Order
+	err := r.db.Conn(ctx).
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	phone := order.CustomerPhoneE164
+	if phone == "" {
+		phone = order.CustomerPhone
+	}
+
+	return &domain.ShipmentOrder{
+		ID:          order.ID,
+		BusinessID:  order.BusinessID,
+		OrderNumber: order.OrderNumber,
+		TotalAmount: order.TotalAmount,
+		CodTotal:    order.CodTotal,
+		Currency:    order.Currency,
+		Recipient: domain.CarrierAddress{
+			Name:       order.CustomerName,
+			Phone:      phone,
+			Email:      order.CustomerEmail,
+			DNI:        order.CustomerDNI,
+			Street:     order.ShippingStreet,
+			City:       order.ShippingCity,
+			CityCode:   order.ShippingCityCode,
+			State:      order.ShippingState,
+			StateCode:  order.ShippingStateCode,
+			Country:    order.ShippingCountry,
+			PostalCode: order.ShippingPostalCode,
+		},
+		Parcel: domain.CarrierParcel{
+			Weight: valueOrZero(order.Weight),
+			Height: valueOrZero(order.Height),
+			Width:  valueOrZero(order.Width),
+			Length: valueOrZero(order.Length),
+		},
+		ShippingLat:      order.ShippingLat,
+		ShippingLng:      order.ShippingLng,
+		GeocodePrecision: order.GeocodePrecision,
+	}, nil
+}
+
+// UpdateOrderGuide copia a la orden la guía generada (o la limpia al cancelarla)
+func (r *Repository) UpdateOrderGuide(ctx context.Context, orderID string, guideID, guideLink *string) error {
+	return r.db.Conn(ctx).
+		Model(&models.Order{}).
+		Where("id = ?", orderID).
+		Updates(map[string]interface{}{
+			"guide_id":   guideID,
+			"guide_link": guideLink,
+		}).Error
+}
+
+// ClaimGuideGeneration marca guide_requested_at con una actualización condicional: solo una solicitud
+// concurrente la aplica y compra la guía
+func (r *Repository) ClaimGuideGeneration(ctx context.Context, shipmentID uint, claimedAt, staleBefore time.Time) (bool, error) {
+	result := r.db.Conn(ctx).
+		Model(&models.Shipment{}).
+		Where("id = ? AND (guide_id IS NULL OR guide_id = '')", shipmentID).
+		Where("guide_requested_at IS NULL OR guide_requested_at < ?", staleBefore).
+		Update("guide_requested_at", claimedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseGuideGeneration limpia la reserva si todavía es la de claimedAt
+func (r *Repository) ReleaseGuideGeneration(ctx context.Context, shipmentID uint, claimedAt time.Time) error {
+	return r.db.Conn(ctx).
+		Model(&models.Shipment{}).
+		Where("id = ? AND guide_requested_at = ?", shipmentID, claimedAt).
+		Update("guide_requested_at", nil).Error
+}
+
+// SaveGeneratedGuide guarda la transportadora, la guía y el tracking del envío y libera la reserva.
+// Retorna false si la reserva ya no era claimedAt (expiró y otra solicitud la tomó).
+func (r *Repository) SaveGeneratedGuide(ctx context.Context, shipment *domain.Shipment, claimedAt time.Time) (bool, error) {
+	result := r.db.Conn(ctx).
+		Model(&models.Shipment{}).
+		Where("id = ? AND guide_requested_at = ?", shipment.ID, claimedAt).
+		Updates(map[string]interface{}{
+			"carrier":            shipment.Carrier,
+			"carrier_code":       shipment.CarrierCode,
+			"guide_id":           shipment.GuideID,
+			"guide_url":          shipment.GuideURL,
+			"guide_requested_at": nil,
+			"tracking_number":    shipment.TrackingNumber,
+			"tracking_url":       shipment.TrackingURL,
+			"shipping_cost":      shipment.ShippingCost,
+			"estimated_delivery": shipment.EstimatedDelivery,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func valueOrZero(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
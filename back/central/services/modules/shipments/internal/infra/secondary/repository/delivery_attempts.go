@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateDeliveryAttempt guarda el intento con su número consecutivo y actualiza el estado de entrega
+// del envío en la misma transacción. Con el envío bloqueado se refrescan su estado y contadores y se
+// aplica el intento sobre ellos, para que intentos simultáneos no repitan números ni pierdan fallas
+// (y con ellas el escalamiento). Retorna si el intento escaló el envío.
+func (r *Repository) CreateDeliveryAttempt(ctx context.Context, attempt *domain.DeliveryAttempt, shipment *domain.Shipment, failureReasonName string, maxFailedAttempts int) (bool, error) {
+	escalated := false
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked models.Shipment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status", "delivered_at", "failed_attempts", "escalated_at", "escalation_reason").
+			Where("id = ?", shipment.ID).
+			First(&locked).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrShipmentNotFound
+			}
+			return err
+		}
+		shipment.Status = locked.Status
+		shipment.DeliveredAt = locked.DeliveredAt
+		shipment.FailedAttempts = locked.FailedAttempts
+		shipment.EscalatedAt = locked.EscalatedAt
+		shipment.EscalationReason = locked.EscalationReason
+
+		var err error
+		escalated, err = shipment.ApplyDeliveryAttempt(attempt, failureReasonName, maxFailedAttempts)
+		if err != nil {
+			return err
+		}
+
+		var previous int64
+		if err := tx.Model(&models.DeliveryAttempt{}).
+			Where("shipment_id = ?", shipment.ID).
+			Count(&previous).Error; err != nil {
+			return err
+		}
+		attempt.AttemptNumber = int(previous) + 1
+
+		dbAttempt := mappers.ToDBDeliveryAttempt(attempt)
+		if err := tx.Create(dbAttempt).Error; err != nil {
+			return err
+		}
+		attempt.ID = dbAttempt.ID
+		attempt.CreatedAt = dbAttempt.CreatedAt
+
+		return tx.Model(&models.Shipment{}).
+			Where("id = ?", shipment.ID).
+			Updates(map[string]interface{}{
+				"status":            shipment.Status,
+				"delivered_at":      shipment.DeliveredAt,
+				"failed_attempts":   shipment.FailedAttempts,
+				"escalated_at":      shipment.EscalatedAt,
+				"escalation_reason": shipment.EscalationReason,
+			}).Error
+	})
+	return escalated, err
+}
+
+// ListDeliveryAttempts obtiene los intentos de entrega de un envío, del primero al último
+func (r *Repository) ListDeliveryAttempts(ctx context.Context, shipmentID uint) ([]domain.DeliveryAttempt, error) {
+	var attempts []models.DeliveryAttempt
+	if err := r.db.Conn(ctx).
+		Where("shipment_id = ?", shipmentID).
+		Order("attempt_number ASC, id ASC").
+		Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.DeliveryAttempt, len(attempts))
+	for i := range attempts {
+		result[i] = mappers.ToDomainDeliveryAttempt(&attempts[i])
+	}
+	return result, nil
+}
+
+// RescheduleDelivery registra la nueva fecha en el último intento y devuelve el envío a ruta
+func (r *Repository) RescheduleDelivery(ctx context.Context, shipment *domain.Shipment, scheduledFor time.Time) error {
+	return r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var last models.DeliveryAttempt
+		if err := tx.Where("shipment_id = ?", shipment.ID).
+			Order("attempt_number DESC, id DESC").
+			First(&last).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrRescheduleNotAllowed
+			}
+			return err
+		}
+		if err := tx.Model(&last).Update("rescheduled_for", scheduledFor).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Shipment{}).
+			Where("id = ?", shipment.ID).
+			Updates(map[string]interface{}{
+				"status":             shipment.Status,
+				"estimated_delivery": shipment.EstimatedDelivery,
+				"delivery_notes":     shipment.DeliveryNotes,
+			}).Error
+	})
+}
+
+// ───────────────────────────────────────────
+//
+//	DELIVERY FAILURE REASONS - Catálogo de motivos de falla
+//
+// ───────────────────────────────────────────
+
+// ListDeliveryFailureReasons obtiene los motivos globales y, si se indica, los del negocio
+func (r *Repository) ListDeliveryFailureReasons(ctx context.Context, businessID *uint, activeOnly bool) ([]domain.DeliveryFailureReason, error) {
+	query := r.db.Conn(ctx).Model(&models.DeliveryFailureReason{})
+	if businessID != nil {
+		query = query.Where("business_id IS NULL OR business_id = ?", *businessID)
+	} else {
+		query = query.Where("business_id IS NULL")
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var reasons []models.DeliveryFailureReason
+	if err := query.Order("business_id NULLS FIRST, code ASC").Find(&reasons).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.DeliveryFailureReason, len(reasons))
+	for i := range reasons {
+		result[i] = mappers.ToDomainDeliveryFailureReason(&reasons[i])
+	}
+	return result, nil
+}
+
+// GetActiveDeliveryFailureReason busca un motivo activo por código; el del negocio tiene prioridad sobre el global
+func (r *Repository) GetActiveDeliveryFailureReason(ctx context.Context, businessID *uint, code string) (*domain.DeliveryFailureReason, error) {
+	query := r.db.Conn(ctx).Where("code = ? AND is_active = ?", code, true)
+	if businessID != nil {
+		query = query.Where("business_id IS NULL OR business_id = ?", *businessID)
+	} else {
+		query = query.Where("business_id IS NULL")
+	}
+
+	var reason models.DeliveryFailureReason
+	if err := query.Order("business_id NULLS LAST").First(&reason).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrFailureReasonNotFound
+		}
+		return nil, err
+	}
+
+	result := mappers.ToDomainDeliveryFailureReason(&reason)
+	return &result, nil
+}
+
+// GetDeliveryFailureReasonByID obtiene un motivo de falla por su ID
+func (r *Repository) GetDeliveryFailureReasonByID(ctx context.Context, id uint) (*domain.DeliveryFailureReason, error) {
+	var reason models.DeliveryFailureReason
+	if err := r.db.Conn(ctx).Where("id = ?", id).First(&reason).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrFailureReasonNotFound
+		}
+		return nil, err
+	}
+
+	result := mappers.ToDomainDeliveryFailureReason(&reason)
+	return &result, nil
+}
+
+// CreateDeliveryFailureReason crea un motivo de falla de un negocio
+func (r *Repository) CreateDeliveryFailureReason(ctx context.Context, reason *domain.DeliveryFailureReason) error {
+	var count int64
+	if err := r.db.Conn(ctx).
+		Unscoped().
+		Model(&models.DeliveryFailureReason{}).
+		Where("business_id = ? AND code = ?", reason.BusinessID, reason.Code).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrFailureReasonAlreadyExists
+	}
+
+	dbReason := mappers.ToDBDeliveryFailureReason(reason)
+	if err := r.db.Conn(ctx).Create(dbReason).Error; err != nil {
+		return err
+	}
+	reason.ID = dbReason.ID
+	reason.CreatedAt = dbReason.CreatedAt
+	reason.UpdatedAt = dbReason.UpdatedAt
+	return nil
+}
+
+// UpdateDeliveryFailureReason actualiza el nombre, descripción y estado de un motivo de falla
+func (r *Repository) UpdateDeliveryFailureReason(ctx context.Context, reason *domain.DeliveryFailureReason) error {
+	return r.db.Conn(ctx).
+		Model(&models.DeliveryFailureReason{}).
+		Where("id = ?", reason.ID).
+		Updates(map[string]interface{}{
+			"name":        reason.Name,
+			"description": reason.Description,
+			"is_active":   reason.IsActive,
+		}).Error
+}
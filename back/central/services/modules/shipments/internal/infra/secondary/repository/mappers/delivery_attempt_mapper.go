@@ -0,0 +1,74 @@
+package mappers
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ToDBDeliveryAttempt convierte un intento de entrega de dominio a modelo de base de datos
+func ToDBDeliveryAttempt(a *domain.DeliveryAttempt) *models.DeliveryAttempt {
+	return &models.DeliveryAttempt{
+		ShipmentID:        a.ShipmentID,
+		OrderID:           a.OrderID,
+		AttemptNumber:     a.AttemptNumber,
+		DriverID:          a.DriverID,
+		DriverName:        a.DriverName,
+		AttemptedAt:       a.AttemptedAt,
+		Latitude:          a.Latitude,
+		Longitude:         a.Longitude,
+		Outcome:           a.Outcome,
+		FailureReasonCode: a.FailureReasonCode,
+		Notes:             a.Notes,
+		RescheduledFor:    a.RescheduledFor,
+		CreatedBy:         a.CreatedBy,
+	}
+}
+
+// ToDomainDeliveryAttempt convierte un intento de entrega de base de datos a dominio
+func ToDomainDeliveryAttempt(a *models.DeliveryAttempt) domain.DeliveryAttempt {
+	return domain.DeliveryAttempt{
+		ID:                a.ID,
+		CreatedAt:         a.CreatedAt,
+		ShipmentID:        a.ShipmentID,
+		OrderID:           a.OrderID,
+		AttemptNumber:     a.AttemptNumber,
+		DriverID:          a.DriverID,
+		DriverName:        a.DriverName,
+		AttemptedAt:       a.AttemptedAt,
+		Latitude:          a.Latitude,
+		Longitude:         a.Longitude,
+		Outcome:           a.Outcome,
+		FailureReasonCode: a.FailureReasonCode,
+		Notes:             a.Notes,
+		RescheduledFor:    a.RescheduledFor,
+		CreatedBy:         a.CreatedBy,
+	}
+}
+
+// ToDBDeliveryFailureReason convierte un motivo de falla de dominio a modelo de base de datos
+func ToDBDeliveryFailureReason(r *domain.DeliveryFailureReason) *models.DeliveryFailureReason {
+	reason := &models.DeliveryFailureReason{
+		BusinessID:  r.BusinessID,
+		Code:        r.Code,
+		Name:        r.Name,
+		Description: r.Description,
+		IsActive:    r.IsActive,
+	}
+	reason.ID = r.ID
+	reason.CreatedAt = r.CreatedAt
+	return reason
+}
+
+// ToDomainDeliveryFailureReason convierte un motivo de falla de base de datos a dominio
+func ToDomainDeliveryFailureReason(r *models.DeliveryFailureReason) domain.DeliveryFailureReason {
+	return domain.DeliveryFailureReason{
+		ID:          r.ID,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		BusinessID:  r.BusinessID,
+		Code:        r.Code,
+		Name:        r.Name,
+		Description: r.Description,
+		IsActive:    r.IsActive,
+	}
+}
@@ -27,9 +27,11 @@ func ToDBShipment(s *domain.Shipment) *models.Shipment {
 		CarrierCode:      s.CarrierCode,
 		GuideID:          s.GuideID,
 		GuideURL:         s.GuideURL,
+		GuideRequestedAt: s.GuideRequestedAt,
 		Status:           s.Status,
 		ShippedAt:        s.ShippedAt,
 		DeliveredAt:      s.DeliveredAt,
+		LastTrackedAt:    s.LastTrackedAt,
 		ShippingAddressID: s.ShippingAddressID,
 		ShippingCost:     s.ShippingCost,
 		InsuranceCost:    s.InsuranceCost,
@@ -43,6 +45,9 @@ func ToDBShipment(s *domain.Shipment) *models.Shipment {
 		DriverID:         s.DriverID,
 		DriverName:       s.DriverName,
 		IsLastMile:       s.IsLastMile,
+		FailedAttempts:   s.FailedAttempts,
+		EscalatedAt:      s.EscalatedAt,
+		EscalationReason: s.EscalationReason,
 		EstimatedDelivery: s.EstimatedDelivery,
 		DeliveryNotes:     s.DeliveryNotes,
 		Metadata:          s.Metadata,
@@ -74,9 +79,11 @@ func ToDomainShipment(s *models.Shipment) *domain.Shipment {
 		CarrierCode:    s.CarrierCode,
 		GuideID:        s.GuideID,
 		GuideURL:       s.GuideURL,
+		GuideRequestedAt: s.GuideRequestedAt,
 		Status:         s.Status,
 		ShippedAt:      s.ShippedAt,
 		DeliveredAt:    s.DeliveredAt,
+		LastTrackedAt:  s.LastTrackedAt,
 		ShippingAddressID: s.ShippingAddressID,
 		ShippingCost:   s.ShippingCost,
 		InsuranceCost:  s.InsuranceCost,
@@ -90,6 +97,9 @@ func ToDomainShipment(s *models.Shipment) *domain.Shipment {
 		DriverID:       s.DriverID,
 		DriverName:     s.DriverName,
 		IsLastMile:    s.IsLastMile,
+		FailedAttempts:   s.FailedAttempts,
+		EscalatedAt:      s.EscalatedAt,
+		EscalationReason: s.EscalationReason,
 		EstimatedDelivery: s.EstimatedDelivery,
 		DeliveryNotes:     s.DeliveryNotes,
 		Metadata:          s.Metadata,
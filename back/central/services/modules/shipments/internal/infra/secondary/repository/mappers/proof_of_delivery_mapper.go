@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ToDBProofOfDelivery convierte una evidencia de entrega de dominio a modelo de base de datos
+func ToDBProofOfDelivery(p *domain.ProofOfDelivery) *models.ProofOfDelivery {
+	return &models.ProofOfDelivery{
+		ShipmentID:       p.ShipmentID,
+		OrderID:          p.OrderID,
+		DriverID:         p.DriverID,
+		PhotoPath:        p.PhotoPath,
+		SignaturePath:    p.SignaturePath,
+		ReceiverName:     p.ReceiverName,
+		ReceiverDocument: p.ReceiverDocument,
+		Latitude:         p.Latitude,
+		Longitude:        p.Longitude,
+		CapturedAt:       p.CapturedAt,
+		DistanceMeters:   p.DistanceMeters,
+		IsFarFromAddress: p.IsFarFromAddress,
+		Notes:            p.Notes,
+	}
+}
+
+// ToDomainProofOfDelivery convierte una evidencia de entrega de base de datos a dominio
+func ToDomainProofOfDelivery(p *models.ProofOfDelivery) *domain.ProofOfDelivery {
+	return &domain.ProofOfDelivery{
+		ID:               p.ID,
+		CreatedAt:        p.CreatedAt,
+		ShipmentID:       p.ShipmentID,
+		OrderID:          p.OrderID,
+		DriverID:         p.DriverID,
+		PhotoPath:        p.PhotoPath,
+		SignaturePath:    p.SignaturePath,
+		ReceiverName:     p.ReceiverName,
+		ReceiverDocument: p.ReceiverDocument,
+		Latitude:         p.Latitude,
+		Longitude:        p.Longitude,
+		CapturedAt:       p.CapturedAt,
+		DistanceMeters:   p.DistanceMeters,
+		IsFarFromAddress: p.IsFarFromAddress,
+		Notes:            p.Notes,
+	}
+}
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+)
+
+// ToDBTrackingEvent convierte un evento de tracking de dominio a modelo de base de datos
+func ToDBTrackingEvent(e *domain.ShipmentTrackingEvent) *models.ShipmentTrackingEvent {
+	return &models.ShipmentTrackingEvent{
+		ShipmentID:  e.ShipmentID,
+		OccurredAt:  e.OccurredAt,
+		RawCode:     e.RawCode,
+		Status:      e.Status,
+		Location:    e.Location,
+		Description: e.Description,
+		CarrierCode: e.CarrierCode,
+		Source:      e.Source,
+	}
+}
+
+// ToDomainTrackingEvent convierte un evento de tracking de base de datos a dominio
+func ToDomainTrackingEvent(e *models.ShipmentTrackingEvent) domain.ShipmentTrackingEvent {
+	return domain.ShipmentTrackingEvent{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		ShipmentID:  e.ShipmentID,
+		OccurredAt:  e.OccurredAt,
+		RawCode:     e.RawCode,
+		Status:      e.Status,
+		Location:    e.Location,
+		Description: e.Description,
+		CarrierCode: e.CarrierCode,
+		Source:      e.Source,
+	}
+}
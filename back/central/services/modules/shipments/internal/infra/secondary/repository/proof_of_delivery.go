@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// CreateProofOfDelivery guarda la evidencia de entrega de un envío (una por envío)
+func (r *Repository) CreateProofOfDelivery(ctx context.Context, proof *domain.ProofOfDelivery) error {
+	var count int64
+	if err := r.db.Conn(ctx).
+		Unscoped().
+		Model(&models.ProofOfDelivery{}).
+		Where("shipment_id = ?", proof.ShipmentID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrProofOfDeliveryAlreadyExists
+	}
+
+	dbProof := mappers.ToDBProofOfDelivery(proof)
+	if err := r.db.Conn(ctx).Create(dbProof).Error; err != nil {
+		return err
+	}
+	proof.ID = dbProof.ID
+	proof.CreatedAt = dbProof.CreatedAt
+	return nil
+}
+
+// GetProofOfDelivery obtiene la evidencia de entrega de un envío
+func (r *Repository) GetProofOfDelivery(ctx context.Context, shipmentID uint) (*domain.ProofOfDelivery, error) {
+	var proof models.ProofOfDelivery
+	if err := r.db.Conn(ctx).
+		Where("shipment_id = ?", shipmentID).
+		First(&proof).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrProofOfDeliveryNotFound
+		}
+		return nil, err
+	}
+	return mappers.ToDomainProofOfDelivery(&proof), nil
+}
@@ -73,6 +73,25 @@ func (r *Repository) GetShipmentByTrackingNumber(ctx context.Context, trackingNu
 	return mappers.ToDomainShipment(&shipment), nil
 }
 
+// GetShipmentByCarrierTracking obtiene un envío por transportadora y número de tracking
+func (r *Repository) GetShipmentByCarrierTracking(ctx context.Context, carrierCode, trackingNumber string) (*domain.Shipment, error) {
+	var shipment models.Shipment
+	err := r.db.Conn(ctx).
+		Preload("Order").
+		Preload("ShippingAddress").
+		Where("tracking_number = ? AND carrier_code = ?", trackingNumber, carrierCode).
+		First(&shipment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrShipmentNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainShipment(&shipment), nil
+}
+
 // GetShipmentsByOrderID obtiene todos los envíos de una orden
 func (r *Repository) GetShipmentsByOrderID(ctx context.Context, orderID string) ([]domain.Shipment, error) {
 	var shipments []models.Shipment
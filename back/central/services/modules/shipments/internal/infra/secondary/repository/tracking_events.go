@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/shipments/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm/clause"
+)
+
+// CreateTrackingEvents guarda los checkpoints ignorando los ya registrados (mismo envío, fecha y código)
+// y retorna cuántos eran nuevos
+func (r *Repository) CreateTrackingEvents(ctx context.Context, events []domain.ShipmentTrackingEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	dbEvents := make([]*models.ShipmentTrackingEvent, len(events))
+	for i := range events {
+		dbEvents[i] = mappers.ToDBTrackingEvent(&events[i])
+	}
+
+	result := r.db.Conn(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&dbEvents)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// ListTrackingEvents obtiene la línea de tiempo de un envío, del checkpoint más antiguo al más reciente
+func (r *Repository) ListTrackingEvents(ctx context.Context, shipmentID uint) ([]domain.ShipmentTrackingEvent, error) {
+	var events []models.ShipmentTrackingEvent
+	err := r.db.Conn(ctx).
+		Where("shipment_id = ?", shipmentID).
+		Order("occurred_at ASC, id ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.ShipmentTrackingEvent, len(events))
+	for i := range events {
+		result[i] = mappers.ToDomainTrackingEvent(&events[i])
+	}
+	return result, nil
+}
+
+// ListShipmentsToTrack obtiene envíos activos de las transportadoras indicadas que no se consultan
+// desde trackedBefore, empezando por los nunca consultados
+func (r *Repository) ListShipmentsToTrack(ctx context.Context, carrierCodes []string, trackedBefore time.Time, limit int) ([]domain.Shipment, error) {
+	if len(carrierCodes) == 0 {
+		return nil, nil
+	}
+
+	var shipments []models.Shipment
+	err := r.db.Conn(ctx).
+		Where("LOWER(carrier_code) IN ?", carrierCodes).
+		Where("tracking_number IS NOT NULL AND tracking_number <> ''").
+		Where("status IN ?", []string{domain.ShipmentStatusPending, domain.ShipmentStatusInTransit}).
+		Where("last_tracked_at IS NULL OR last_tracked_at < ?", trackedBefore).
+		Order("last_tracked_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&shipments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Shipment, len(shipments))
+	for i := range shipments {
+		result[i] = *mappers.ToDomainShipment(&shipments[i])
+	}
+	return result, nil
+}
+
+// MarkShipmentTracked registra la última consulta de tracking sin tocar el resto del envío
+func (r *Repository) MarkShipmentTracked(ctx context.Context, id uint, trackedAt time.Time) error {
+	return r.db.Conn(ctx).
+		Model(&models.Shipment{}).
+		Where("id = ?", id).
+		UpdateColumn("last_tracked_at", trackedAt).Error
+}
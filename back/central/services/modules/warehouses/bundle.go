@@ -0,0 +1,44 @@
+package warehouses
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/infra/primary/handlers"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/infra/secondary/repository"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/central/shared/env"
+	"github.com/secamc93/probability/back/central/shared/log"
+	"github.com/secamc93/probability/back/central/shared/orderevents"
+	redisclient "github.com/secamc93/probability/back/central/shared/redis"
+)
+
+// New inicializa el módulo de warehouses (almacenes y flujo de picking/packing)
+func New(router *gin.RouterGroup, database db.IDatabase, logger log.ILogger, environment env.IConfig, redisClient redisclient.IRedis) {
+	// 1. Init Repositories
+	repo := repository.New(database)
+
+	// 2. Init Event Publisher (si Redis está disponible). Las órdenes empacadas se publican en el canal de órdenes
+	var eventPublisher domain.IOrderEventPublisher
+	if redisClient != nil {
+		redisChannel := environment.Get("REDIS_ORDER_EVENTS_CHANNEL")
+		if redisChannel == "" {
+			redisChannel = "probability:orders:events" // Valor por defecto
+		}
+		eventPublisher = orderevents.NewPublisher(redisClient, logger, redisChannel)
+		logger.Info(context.Background()).
+			Str("channel", redisChannel).
+			Msg("Warehouse order event publisher initialized")
+	}
+
+	// 3. Init Use Cases
+	uc := usecases.New(repo, eventPublisher, logger)
+
+	// 4. Init Handlers
+	h := handlers.New(uc)
+
+	// 5. Register Routes
+	h.RegisterRoutes(router)
+}
@@ -0,0 +1,35 @@
+package usecasefulfillment
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCaseFulfillment genera las listas de picking por almacén y confirma el empaque de las órdenes
+type UseCaseFulfillment struct {
+	repo           domain.IRepository
+	eventPublisher domain.IOrderEventPublisher
+	logger         log.ILogger
+}
+
+// New crea el caso de uso de fulfillment. eventPublisher es opcional (nil = no se publican eventos).
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, logger log.ILogger) *UseCaseFulfillment {
+	return &UseCaseFulfillment{
+		repo:           repo,
+		eventPublisher: eventPublisher,
+		logger:         logger,
+	}
+}
+
+// publishEvent publica un evento de orden de forma asíncrona (no bloquea ni falla la operación)
+func (uc *UseCaseFulfillment) publishEvent(ctx context.Context, event *domain.OrderEvent) {
+	if uc.eventPublisher == nil {
+		return
+	}
+	publishCtx := context.WithoutCancel(ctx)
+	go func() {
+		_ = uc.eventPublisher.PublishOrderEvent(publishCtx, event)
+	}()
+}
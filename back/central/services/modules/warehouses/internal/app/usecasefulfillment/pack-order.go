@@ -0,0 +1,194 @@
+package usecasefulfillment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// GetPackingChecklist obtiene los items que el empacador debe escanear para una orden
+func (uc *UseCaseFulfillment) GetPackingChecklist(ctx context.Context, orderID string) (*domain.PackingChecklist, error) {
+	order, err := uc.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	checklist := &domain.PackingChecklist{
+		OrderID:     order.ID,
+		OrderNumber: order.OrderNumber,
+		Status:      order.Status,
+		Items:       domain.CheckPacking(order.Items, nil).Items,
+	}
+	if warehouse, err := uc.resolvePackingWarehouse(ctx, order, nil); err == nil {
+		checklist.Warehouse = toWarehouseRef(warehouse)
+	}
+	return checklist, nil
+}
+
+// PackOrder confirma el empaque de una orden: verifica que los escaneos coincidan item por item con
+// la orden, guarda el almacén, el peso y las medidas de las cajas y pasa la orden a lista para
+// despacho. Si los escaneos no coinciden retorna domain.ErrPackingMismatch junto con el resultado
+// de la verificación para mostrar las diferencias.
+func (uc *UseCaseFulfillment) PackOrder(ctx context.Context, orderID string, req *domain.PackOrderRequest) (*domain.PackingResult, error) {
+	order, err := uc.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(domain.PackableOrderStatuses, order.Status) {
+		return nil, fmt.Errorf("%w: current status is %s", domain.ErrOrderNotPackable, order.Status)
+	}
+	if len(order.Items) == 0 {
+		return nil, domain.ErrOrderWithoutItems
+	}
+
+	warehouse, err := uc.resolvePackingWarehouse(ctx, order, req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if !warehouse.IsActive {
+		return nil, domain.ErrWarehouseInactive
+	}
+
+	result := &domain.PackingResult{
+		OrderID:        order.ID,
+		OrderNumber:    order.OrderNumber,
+		PreviousStatus: order.Status,
+		Status:         order.Status,
+		Warehouse:      toWarehouseRef(warehouse),
+		Packages:       req.Packages,
+		Check:          domain.CheckPacking(order.Items, req.Scans),
+	}
+	if !result.Check.Complete {
+		return result, domain.ErrPackingMismatch
+	}
+
+	boxes, err := json.Marshal(req.Packages)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding packages: %w", err)
+	}
+
+	packing := &domain.OrderPacking{
+		OrderID:        order.ID,
+		PreviousStatus: order.Status,
+		Status:         domain.OrderStatusReadyToShip,
+		Warehouse:      *result.Warehouse,
+		Boxes:          string(boxes),
+		Packages:       req.Packages,
+		Scans:          req.Scans,
+		PackedAt:       time.Now().UTC(),
+		PackedBy:       req.PackedBy,
+		Notes:          strings.TrimSpace(req.Notes),
+		ItemIDs:        make([]uint, len(order.Items)),
+	}
+	for i := range order.Items {
+		packing.ItemIDs[i] = order.Items[i].ID
+	}
+	applyPackageDimensions(packing, req.Packages)
+
+	updatedShipments, err := uc.repo.MarkOrderPacked(ctx, packing)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) || errors.Is(err, domain.ErrOrderNotPackable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error saving order packing: %w", err)
+	}
+
+	uc.logger.Info(ctx).
+		Str("order_id", order.ID).
+		Uint("warehouse_id", warehouse.ID).
+		Int("packages", len(req.Packages)).
+		Int64("updated_shipments", updatedShipments).
+		Msg("Orden empacada y lista para despacho")
+
+	uc.publishEvent(ctx, domain.NewOrderStatusChangedEvent(order, packing))
+
+	result.Status = packing.Status
+	result.Weight = packing.Weight
+	result.Height = packing.Height
+	result.Width = packing.Width
+	result.Length = packing.Length
+	result.PackedAt = &packing.PackedAt
+	result.PackedBy = packing.PackedBy
+	result.UpdatedShipments = updatedShipments
+	return result, nil
+}
+
+// applyPackageDimensions calcula las medidas de la orden a partir de las cajas: el peso es la suma
+// de todas y el alto, ancho y largo son los de la caja más grande (la que define la tarifa mínima)
+func applyPackageDimensions(packing *domain.OrderPacking, packages []domain.PackageDimensions) {
+	var largest domain.PackageDimensions
+	weight := 0.0
+	for _, p := range packages {
+		weight += p.Weight
+		if p.Height*p.Width*p.Length > largest.Height*largest.Width*largest.Length {
+			largest = p
+		}
+	}
+	packing.Weight = math.Round(weight*100) / 100
+	packing.Height = largest.Height
+	packing.Width = largest.Width
+	packing.Length = largest.Length
+}
+
+// resolvePackingWarehouse determina el almacén del empaque: el indicado, el de la orden o el
+// almacén por defecto del negocio
+func (uc *UseCaseFulfillment) resolvePackingWarehouse(ctx context.Context, order *domain.FulfillmentOrder, warehouseID *uint) (*domain.Warehouse, error) {
+	if warehouseID == nil {
+		warehouseID = order.WarehouseID
+	}
+
+	var warehouse *domain.Warehouse
+	if warehouseID != nil {
+		found, err := uc.getWarehouse(ctx, *warehouseID)
+		if err != nil {
+			return nil, err
+		}
+		warehouse = found
+	} else {
+		if order.BusinessID == nil {
+			return nil, domain.ErrWarehouseRequired
+		}
+		found, err := uc.repo.GetDefaultWarehouse(ctx, *order.BusinessID)
+		if err != nil {
+			if errors.Is(err, domain.ErrWarehouseNotFound) {
+				return nil, domain.ErrWarehouseRequired
+			}
+			return nil, fmt.Errorf("error getting default warehouse: %w", err)
+		}
+		warehouse = found
+	}
+
+	if order.BusinessID != nil && warehouse.BusinessID != *order.BusinessID {
+		return nil, domain.ErrWarehouseBusinessMismatch
+	}
+	return warehouse, nil
+}
+
+func (uc *UseCaseFulfillment) getOrder(ctx context.Context, orderID string) (*domain.FulfillmentOrder, error) {
+	order, err := uc.repo.GetFulfillmentOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting order: %w", err)
+	}
+	return order, nil
+}
+
+func (uc *UseCaseFulfillment) getWarehouse(ctx context.Context, id uint) (*domain.Warehouse, error) {
+	warehouse, err := uc.repo.GetWarehouseByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrWarehouseNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error getting warehouse: %w", err)
+	}
+	return warehouse, nil
+}
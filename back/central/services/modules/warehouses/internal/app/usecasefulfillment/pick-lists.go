@@ -0,0 +1,219 @@
+package usecasefulfillment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// GeneratePickLists agrupa por almacén los productos a recoger de las órdenes pendientes de preparar.
+// Las órdenes sin almacén se asignan al almacén por defecto del negocio; si no tiene, quedan en una
+// lista sin almacén. Dentro de cada lista los productos se consolidan por variante o producto.
+func (uc *UseCaseFulfillment) GeneratePickLists(ctx context.Context, filters domain.PickListFilters) (*domain.PickListsResponse, error) {
+	if len(filters.Statuses) == 0 {
+		filters.Statuses = domain.DefaultPickListStatuses
+	}
+	if filters.Limit < 1 || filters.Limit > domain.MaxPickListOrders {
+		filters.Limit = domain.MaxPickListOrders
+	}
+
+	defaultWarehouse, err := uc.repo.GetDefaultWarehouse(ctx, filters.BusinessID)
+	if err != nil && !errors.Is(err, domain.ErrWarehouseNotFound) {
+		return nil, fmt.Errorf("error getting default warehouse: %w", err)
+	}
+
+	if filters.WarehouseID != nil {
+		warehouse, err := uc.getWarehouse(ctx, *filters.WarehouseID)
+		if err != nil {
+			return nil, err
+		}
+		if warehouse.BusinessID != filters.BusinessID {
+			return nil, domain.ErrWarehouseBusinessMismatch
+		}
+		filters.IncludeUnassigned = defaultWarehouse != nil && defaultWarehouse.ID == warehouse.ID
+	}
+
+	// Se pide una orden de más para saber si quedaron órdenes por fuera del límite
+	limit := filters.Limit
+	filters.Limit = limit + 1
+	orders, err := uc.repo.ListPickingOrders(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing picking orders: %w", err)
+	}
+	truncated := len(orders) > limit
+	if truncated {
+		orders = orders[:limit]
+	}
+
+	refs := make(map[uint]*domain.WarehouseRef)
+	if defaultWarehouse != nil {
+		refs[defaultWarehouse.ID] = toWarehouseRef(defaultWarehouse)
+	}
+
+	groups := make(map[uint]*pickListBuilder)
+	var keys []uint
+	for i := range orders {
+		order := &orders[i]
+
+		// 0 agrupa las órdenes sin almacén cuando el negocio no tiene almacén por defecto
+		var key uint
+		switch {
+		case order.WarehouseID != nil:
+			key = *order.WarehouseID
+		case defaultWarehouse != nil:
+			key = defaultWarehouse.ID
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			group = newPickListBuilder(uc.resolveWarehouseRef(ctx, refs, key, order.WarehouseName))
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.addOrder(order)
+	}
+
+	pickLists := make([]domain.PickList, 0, len(keys))
+	for _, key := range keys {
+		pickLists = append(pickLists, groups[key].build())
+	}
+	// Primero los almacenes por nombre y al final la lista sin almacén
+	sort.SliceStable(pickLists, func(i, j int) bool {
+		a, b := pickLists[i].Warehouse, pickLists[j].Warehouse
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.Name < b.Name
+	})
+
+	return &domain.PickListsResponse{
+		GeneratedAt: time.Now().UTC(),
+		OrderCount:  len(orders),
+		Truncated:   truncated,
+		PickLists:   pickLists,
+	}, nil
+}
+
+// resolveWarehouseRef obtiene (con caché) el almacén de una lista de picking. Si el almacén de la
+// orden no existe se usa el nombre desnormalizado de la orden.
+func (uc *UseCaseFulfillment) resolveWarehouseRef(ctx context.Context, refs map[uint]*domain.WarehouseRef, id uint, fallbackName string) *domain.WarehouseRef {
+	if id == 0 {
+		return nil
+	}
+	if ref, ok := refs[id]; ok {
+		return ref
+	}
+
+	ref := &domain.WarehouseRef{ID: id, Name: fallbackName}
+	warehouse, err := uc.repo.GetWarehouseByID(ctx, id)
+	if err == nil {
+		ref = toWarehouseRef(warehouse)
+	} else if !errors.Is(err, domain.ErrWarehouseNotFound) {
+		uc.logger.Error(ctx).
+			Err(err).
+			Uint("warehouse_id", id).
+			Msg("Error al obtener almacén para la lista de picking")
+	}
+	refs[id] = ref
+	return ref
+}
+
+// pickListBuilder consolida los items de las órdenes de un almacén en líneas de picking
+type pickListBuilder struct {
+	list  domain.PickList
+	lines map[string]*domain.PickListLine
+	order []string
+}
+
+func newPickListBuilder(warehouse *domain.WarehouseRef) *pickListBuilder {
+	return &pickListBuilder{
+		list: domain.PickList{
+			Warehouse: warehouse,
+			Lines:     []domain.PickListLine{},
+			Orders:    []domain.PickListOrder{},
+		},
+		lines: make(map[string]*domain.PickListLine),
+	}
+}
+
+func (b *pickListBuilder) addOrder(order *domain.FulfillmentOrder) {
+	units := 0
+	for i := range order.Items {
+		item := &order.Items[i]
+		units += item.Quantity
+
+		key := pickLineKey(item)
+		line, ok := b.lines[key]
+		if !ok {
+			line = &domain.PickListLine{
+				ProductID:        item.ProductID,
+				ProductVariantID: item.ProductVariantID,
+				SKU:              item.SKU,
+				Barcode:          item.Barcode,
+				Name:             item.Name,
+			}
+			b.lines[key] = line
+			b.order = append(b.order, key)
+		}
+		line.Quantity += item.Quantity
+
+		// Una orden puede traer el mismo producto en varios items
+		if n := len(line.Orders); n > 0 && line.Orders[n-1].OrderID == order.ID {
+			line.Orders[n-1].Quantity += item.Quantity
+		} else {
+			line.Orders = append(line.Orders, domain.PickListLineOrder{
+				OrderID:     order.ID,
+				OrderNumber: order.OrderNumber,
+				Quantity:    item.Quantity,
+			})
+		}
+	}
+
+	b.list.OrderCount++
+	b.list.TotalUnits += units
+	b.list.Orders = append(b.list.Orders, domain.PickListOrder{
+		OrderID:     order.ID,
+		OrderNumber: order.OrderNumber,
+		Status:      order.Status,
+		Units:       units,
+		CreatedAt:   order.CreatedAt,
+	})
+}
+
+// build retorna la lista con las líneas ordenadas por SKU para recorrer el almacén en orden
+func (b *pickListBuilder) build() domain.PickList {
+	for _, key := range b.order {
+		b.list.Lines = append(b.list.Lines, *b.lines[key])
+	}
+	sort.SliceStable(b.list.Lines, func(i, j int) bool {
+		if b.list.Lines[i].SKU != b.list.Lines[j].SKU {
+			return b.list.Lines[i].SKU < b.list.Lines[j].SKU
+		}
+		return b.list.Lines[i].Name < b.list.Lines[j].Name
+	})
+	return b.list
+}
+
+// pickLineKey identifica el producto físico a recoger: variante, producto o, sin catálogo, el nombre
+func pickLineKey(item *domain.FulfillmentItem) string {
+	switch {
+	case item.ProductVariantID != nil:
+		return "variant:" + *item.ProductVariantID
+	case item.ProductID != nil:
+		return "product:" + *item.ProductID
+	default:
+		return "item:" + item.Name
+	}
+}
+
+func toWarehouseRef(warehouse *domain.Warehouse) *domain.WarehouseRef {
+	return &domain.WarehouseRef{
+		ID:   warehouse.ID,
+		Code: warehouse.Code,
+		Name: warehouse.Name,
+	}
+}
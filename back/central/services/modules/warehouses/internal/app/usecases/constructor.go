@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/app/usecasefulfillment"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/app/usecasewarehouse"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/central/shared/log"
+)
+
+// UseCases contiene todos los casos de uso del módulo warehouses
+type UseCases struct {
+	repo domain.IRepository
+
+	// Casos de uso modulares
+	WarehouseCRUD *usecasewarehouse.UseCaseWarehouse
+	Fulfillment   *usecasefulfillment.UseCaseFulfillment
+}
+
+// New crea una nueva instancia de UseCases
+func New(repo domain.IRepository, eventPublisher domain.IOrderEventPublisher, logger log.ILogger) *UseCases {
+	return &UseCases{
+		repo:          repo,
+		WarehouseCRUD: usecasewarehouse.New(repo),
+		Fulfillment:   usecasefulfillment.New(repo, eventPublisher, logger),
+	}
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE ALMACENES - Delegar al CRUD
+// ───────────────────────────────────────────
+
+// CreateWarehouse delega al caso de uso CRUD
+func (uc *UseCases) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (*domain.Warehouse, error) {
+	return uc.WarehouseCRUD.CreateWarehouse(ctx, req)
+}
+
+// GetWarehouseByID delega al caso de uso CRUD
+func (uc *UseCases) GetWarehouseByID(ctx context.Context, id uint) (*domain.Warehouse, error) {
+	return uc.WarehouseCRUD.GetWarehouseByID(ctx, id)
+}
+
+// ListWarehouses delega al caso de uso CRUD
+func (uc *UseCases) ListWarehouses(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.WarehousesListResponse, error) {
+	return uc.WarehouseCRUD.ListWarehouses(ctx, page, pageSize, filters)
+}
+
+// UpdateWarehouse delega al caso de uso CRUD
+func (uc *UseCases) UpdateWarehouse(ctx context.Context, id uint, req *domain.UpdateWarehouseRequest) (*domain.Warehouse, error) {
+	return uc.WarehouseCRUD.UpdateWarehouse(ctx, id, req)
+}
+
+// DeleteWarehouse delega al caso de uso CRUD
+func (uc *UseCases) DeleteWarehouse(ctx context.Context, id uint) error {
+	return uc.WarehouseCRUD.DeleteWarehouse(ctx, id)
+}
+
+// ───────────────────────────────────────────
+// MÉTODOS DE FULFILLMENT - Delegar al caso de uso de fulfillment
+// ───────────────────────────────────────────
+
+// GeneratePickLists delega al caso de uso de fulfillment
+func (uc *UseCases) GeneratePickLists(ctx context.Context, filters domain.PickListFilters) (*domain.PickListsResponse, error) {
+	return uc.Fulfillment.GeneratePickLists(ctx, filters)
+}
+
+// GetPackingChecklist delega al caso de uso de fulfillment
+func (uc *UseCases) GetPackingChecklist(ctx context.Context, orderID string) (*domain.PackingChecklist, error) {
+	return uc.Fulfillment.GetPackingChecklist(ctx, orderID)
+}
+
+// PackOrder delega al caso de uso de fulfillment
+func (uc *UseCases) PackOrder(ctx context.Context, orderID string, req *domain.PackOrderRequest) (*domain.PackingResult, error) {
+	return uc.Fulfillment.PackOrder(ctx, orderID, req)
+}
@@ -0,0 +1,17 @@
+package usecasewarehouse
+
+import (
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// UseCaseWarehouse contiene los casos de uso CRUD de almacenes
+type UseCaseWarehouse struct {
+	repo domain.IRepository
+}
+
+// New crea una nueva instancia de UseCaseWarehouse
+func New(repo domain.IRepository) *UseCaseWarehouse {
+	return &UseCaseWarehouse{
+		repo: repo,
+	}
+}
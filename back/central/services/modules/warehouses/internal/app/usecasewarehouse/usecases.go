@@ -0,0 +1,222 @@
+package usecasewarehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// ───────────────────────────────────────────
+//
+//	CREATE WAREHOUSE
+//
+// ───────────────────────────────────────────
+
+// CreateWarehouse crea un nuevo almacén para un negocio
+func (uc *UseCaseWarehouse) CreateWarehouse(ctx context.Context, req *domain.CreateWarehouseRequest) (*domain.Warehouse, error) {
+	hours, err := domain.NormalizeOperatingHours(req.OperatingHours)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouse := &domain.Warehouse{
+		BusinessID:     req.BusinessID,
+		Code:           strings.ToUpper(strings.TrimSpace(req.Code)),
+		Name:           strings.TrimSpace(req.Name),
+		Address:        normalizeAddress(req.Address),
+		ContactName:    strings.TrimSpace(req.ContactName),
+		ContactPhone:   strings.TrimSpace(req.ContactPhone),
+		OperatingHours: hours,
+		IsActive:       true,
+		IsDefault:      req.IsDefault,
+	}
+
+	if warehouse.Code == "" || warehouse.Name == "" {
+		return nil, domain.ErrInvalidWarehouseData
+	}
+
+	if err := uc.ensureUniqueCode(ctx, warehouse); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateWarehouse(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("error creating warehouse: %w", err)
+	}
+
+	if err := uc.syncDefault(ctx, warehouse); err != nil {
+		return nil, err
+	}
+
+	return warehouse, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	GET WAREHOUSE BY ID
+//
+// ───────────────────────────────────────────
+
+// GetWarehouseByID obtiene un almacén por su ID
+func (uc *UseCaseWarehouse) GetWarehouseByID(ctx context.Context, id uint) (*domain.Warehouse, error) {
+	warehouse, err := uc.repo.GetWarehouseByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrWarehouseNotFound) {
+			return nil, domain.ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("error getting warehouse: %w", err)
+	}
+
+	return warehouse, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	LIST WAREHOUSES
+//
+// ───────────────────────────────────────────
+
+// ListWarehouses obtiene una lista paginada de almacenes con filtros
+func (uc *UseCaseWarehouse) ListWarehouses(ctx context.Context, page, pageSize int, filters map[string]interface{}) (*domain.WarehousesListResponse, error) {
+	// Validar paginación
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	warehouses, total, err := uc.repo.ListWarehouses(ctx, page, pageSize, filters)
+	if err != nil {
+		return nil, fmt.Errorf("error listing warehouses: %w", err)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	return &domain.WarehousesListResponse{
+		Data:       warehouses,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	UPDATE WAREHOUSE
+//
+// ───────────────────────────────────────────
+
+// UpdateWarehouse actualiza un almacén existente (el código y el negocio no cambian)
+func (uc *UseCaseWarehouse) UpdateWarehouse(ctx context.Context, id uint, req *domain.UpdateWarehouseRequest) (*domain.Warehouse, error) {
+	warehouse, err := uc.GetWarehouseByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		warehouse.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Address != nil {
+		warehouse.Address = normalizeAddress(*req.Address)
+	}
+	if req.ContactName != nil {
+		warehouse.ContactName = strings.TrimSpace(*req.ContactName)
+	}
+	if req.ContactPhone != nil {
+		warehouse.ContactPhone = strings.TrimSpace(*req.ContactPhone)
+	}
+	if req.OperatingHours != nil {
+		hours, err := domain.NormalizeOperatingHours(*req.OperatingHours)
+		if err != nil {
+			return nil, err
+		}
+		warehouse.OperatingHours = hours
+	}
+	if req.IsActive != nil {
+		warehouse.IsActive = *req.IsActive
+	}
+	if req.IsDefault != nil {
+		warehouse.IsDefault = *req.IsDefault
+	}
+
+	if warehouse.Name == "" {
+		return nil, domain.ErrInvalidWarehouseData
+	}
+
+	if err := uc.repo.UpdateWarehouse(ctx, warehouse); err != nil {
+		return nil, fmt.Errorf("error updating warehouse: %w", err)
+	}
+
+	if err := uc.syncDefault(ctx, warehouse); err != nil {
+		return nil, err
+	}
+
+	return warehouse, nil
+}
+
+// ───────────────────────────────────────────
+//
+//	DELETE WAREHOUSE
+//
+// ───────────────────────────────────────────
+
+// DeleteWarehouse elimina (soft delete) un almacén. Las órdenes y envíos conservan el
+// warehouse_id y el nombre desnormalizado.
+func (uc *UseCaseWarehouse) DeleteWarehouse(ctx context.Context, id uint) error {
+	if _, err := uc.GetWarehouseByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.repo.DeleteWarehouse(ctx, id); err != nil {
+		return fmt.Errorf("error deleting warehouse: %w", err)
+	}
+
+	return nil
+}
+
+// ───────────────────────────────────────────
+//
+//	HELPERS
+//
+// ───────────────────────────────────────────
+
+// ensureUniqueCode valida que no exista otro almacén del negocio con el mismo código
+func (uc *UseCaseWarehouse) ensureUniqueCode(ctx context.Context, warehouse *domain.Warehouse) error {
+	exists, err := uc.repo.WarehouseCodeExists(ctx, warehouse.BusinessID, warehouse.Code, warehouse.ID)
+	if err != nil {
+		return fmt.Errorf("error checking if warehouse exists: %w", err)
+	}
+	if exists {
+		return domain.ErrWarehouseAlreadyExists
+	}
+	return nil
+}
+
+// syncDefault deja un único almacén por defecto por negocio
+func (uc *UseCaseWarehouse) syncDefault(ctx context.Context, warehouse *domain.Warehouse) error {
+	if !warehouse.IsDefault {
+		return nil
+	}
+	if err := uc.repo.ClearDefaultWarehouse(ctx, warehouse.BusinessID, warehouse.ID); err != nil {
+		return fmt.Errorf("error updating default warehouse: %w", err)
+	}
+	return nil
+}
+
+// normalizeAddress limpia los campos de texto de la dirección
+func normalizeAddress(address domain.WarehouseAddress) domain.WarehouseAddress {
+	return domain.WarehouseAddress{
+		Street:     strings.TrimSpace(address.Street),
+		City:       strings.TrimSpace(address.City),
+		State:      strings.TrimSpace(address.State),
+		Country:    strings.TrimSpace(address.Country),
+		PostalCode: strings.TrimSpace(address.PostalCode),
+		Lat:        address.Lat,
+		Lng:        address.Lng,
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrWarehouseNotFound se retorna cuando un almacén no existe
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+
+	// ErrWarehouseAlreadyExists se retorna cuando ya existe un almacén con el mismo código en el negocio
+	ErrWarehouseAlreadyExists = errors.New("warehouse with this code already exists for this business")
+
+	// ErrInvalidWarehouseData se retorna cuando los datos del almacén son inválidos
+	ErrInvalidWarehouseData = errors.New("invalid warehouse data")
+
+	// ErrInvalidOperatingHours se retorna cuando el horario de operación es inválido
+	ErrInvalidOperatingHours = errors.New("invalid operating hours, each day must appear once with opens before closes (HH:MM)")
+
+	// ErrWarehouseInactive se retorna cuando se intenta operar con un almacén inactivo
+	ErrWarehouseInactive = errors.New("warehouse is inactive")
+)
+
+var (
+	// ErrOrderNotFound se retorna cuando la orden a empacar no existe
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrOrderNotPackable se retorna cuando el estado de la orden no permite empacarla
+	ErrOrderNotPackable = errors.New("order status does not allow packing")
+
+	// ErrOrderWithoutItems se retorna cuando la orden no tiene items para verificar
+	ErrOrderWithoutItems = errors.New("order has no items to pack")
+
+	// ErrWarehouseRequired se retorna cuando no se puede determinar el almacén de la orden
+	ErrWarehouseRequired = errors.New("warehouse is required: order has no warehouse and business has no default warehouse")
+
+	// ErrWarehouseBusinessMismatch se retorna cuando el almacén pertenece a otro negocio que la orden
+	ErrWarehouseBusinessMismatch = errors.New("warehouse belongs to a different business than the order")
+
+	// ErrPackingMismatch se retorna cuando los escaneos no coinciden con los items de la orden
+	ErrPackingMismatch = errors.New("scanned items do not match the order items")
+)
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	FULFILLMENT - Picking, packing y listo para despacho
+//
+// ───────────────────────────────────────────
+
+// Estados de orden usados por el flujo de fulfillment (deben coincidir con orders/domain/status.go)
+const (
+	OrderStatusProcessing  = "processing"
+	OrderStatusReadyToShip = "ready_to_ship"
+)
+
+// ItemFulfillmentStatusPacked es el estado de fulfillment de un item verificado en el empaque
+const ItemFulfillmentStatusPacked = "packed"
+
+// ShipmentStatusPending es el estado de los envíos que aún toman el almacén y las medidas del empaque
+const ShipmentStatusPending = "pending"
+
+// DefaultPickListStatuses son los estados de las órdenes que entran en las listas de picking
+var DefaultPickListStatuses = []string{OrderStatusProcessing}
+
+// PackableOrderStatuses son los estados desde los que una orden se puede empacar. Deben tener la
+// transición a ready_to_ship en orders/domain/status.go (las órdenes pendientes se aprueban antes).
+var PackableOrderStatuses = []string{OrderStatusProcessing}
+
+// MaxPickListOrders es el máximo de órdenes que se incluyen en una generación de listas de picking
+const MaxPickListOrders = 500
+
+// FulfillmentOrder es una orden con sus items, tal como la necesita el almacén
+type FulfillmentOrder struct {
+	ID            string
+	BusinessID    *uint
+	OrderNumber   string
+	Status        string
+	WarehouseID   *uint
+	WarehouseName string
+	CreatedAt     time.Time
+	Items         []FulfillmentItem
+}
+
+// FulfillmentItem es un item de la orden con los códigos que lo identifican en el almacén
+type FulfillmentItem struct {
+	ID               uint
+	ProductID        *string
+	ProductVariantID *string
+	Name             string
+	SKU              string // SKU de la variante o, si no tiene, del producto
+	ProductSKU       string
+	Barcode          string // Código de barras de la variante
+	Quantity         int
+}
+
+// Codes retorna los códigos (normalizados) con los que se puede escanear el item
+func (i *FulfillmentItem) Codes() []string {
+	codes := make([]string, 0, 3)
+	for _, code := range []string{i.SKU, i.ProductSKU, i.Barcode} {
+		normalized := NormalizeScanCode(code)
+		if normalized == "" || slices.Contains(codes, normalized) {
+			continue
+		}
+		codes = append(codes, normalized)
+	}
+	return codes
+}
+
+// NormalizeScanCode normaliza un SKU o código de barras para compararlo
+func NormalizeScanCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ───────────────────────────────────────────
+//
+//	PICK LISTS
+//
+// ───────────────────────────────────────────
+
+// PickListFilters define qué órdenes entran en las listas de picking
+type PickListFilters struct {
+	BusinessID        uint
+	WarehouseID       *uint
+	IncludeUnassigned bool // Con WarehouseID, incluir también las órdenes sin almacén (almacén por defecto)
+	Statuses          []string
+	Limit             int
+}
+
+// PickListsResponse agrupa las listas de picking generadas por almacén
+type PickListsResponse struct {
+	GeneratedAt time.Time  `json:"generated_at"`
+	OrderCount  int        `json:"order_count"`
+	Truncated   bool       `json:"truncated"` // Hay más órdenes pendientes que el límite
+	PickLists   []PickList `json:"pick_lists"`
+}
+
+// PickList es la lista de productos a recoger en un almacén. Warehouse es nil para
+// las órdenes sin almacén asignado cuando el negocio no tiene almacén por defecto.
+type PickList struct {
+	Warehouse  *WarehouseRef   `json:"warehouse"`
+	OrderCount int             `json:"order_count"`
+	TotalUnits int             `json:"total_units"`
+	Lines      []PickListLine  `json:"lines"`
+	Orders     []PickListOrder `json:"orders"`
+}
+
+// WarehouseRef identifica un almacén en respuestas de fulfillment
+type WarehouseRef struct {
+	ID   uint   `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// PickListLine es un producto a recoger con la cantidad total y su reparto por orden
+type PickListLine struct {
+	ProductID        *string             `json:"product_id,omitempty"`
+	ProductVariantID *string             `json:"product_variant_id,omitempty"`
+	SKU              string              `json:"sku"`
+	Barcode          string              `json:"barcode,omitempty"`
+	Name             string              `json:"name"`
+	Quantity         int                 `json:"quantity"`
+	Orders           []PickListLineOrder `json:"orders"`
+}
+
+// PickListLineOrder es la cantidad de una línea de picking que corresponde a una orden
+type PickListLineOrder struct {
+	OrderID     string `json:"order_id"`
+	OrderNumber string `json:"order_number"`
+	Quantity    int    `json:"quantity"`
+}
+
+// PickListOrder resume una orden incluida en la lista de picking
+type PickListOrder struct {
+	OrderID     string    `json:"order_id"`
+	OrderNumber string    `json:"order_number"`
+	Status      string    `json:"status"`
+	Units       int       `json:"units"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ───────────────────────────────────────────
+//
+//	PACKING
+//
+// ───────────────────────────────────────────
+
+// PackScan es la lectura de un SKU o código de barras durante el empaque
+type PackScan struct {
+	Code     string `json:"code" binding:"required,max=128"`
+	Quantity int    `json:"quantity" binding:"omitempty,min=1"` // Por defecto 1 (un escaneo por unidad)
+}
+
+// PackageDimensions son el peso (kg) y las medidas (cm) de una caja
+type PackageDimensions struct {
+	Weight float64 `json:"weight" binding:"required,gt=0"`
+	Height float64 `json:"height" binding:"required,gt=0"`
+	Width  float64 `json:"width" binding:"required,gt=0"`
+	Length float64 `json:"length" binding:"required,gt=0"`
+}
+
+// PackOrderRequest confirma el empaque de una orden con los escaneos y las cajas usadas
+type PackOrderRequest struct {
+	WarehouseID *uint               `json:"warehouse_id"` // Por defecto el de la orden o el almacén por defecto del negocio
+	Scans       []PackScan          `json:"scans" binding:"required,min=1,dive"`
+	Packages    []PackageDimensions `json:"packages" binding:"required,min=1,dive"`
+	PackedBy    *uint               `json:"packed_by"`
+	Notes       string              `json:"notes" binding:"max=1000"`
+}
+
+// PackingCheck es el resultado de comparar los escaneos con los items de la orden
+type PackingCheck struct {
+	Complete     bool               `json:"complete"`
+	Items        []PackingItemCheck `json:"items"`
+	UnknownCodes []string           `json:"unknown_codes,omitempty"`
+}
+
+// PackingItemCheck compara las unidades esperadas y escaneadas de un item
+type PackingItemCheck struct {
+	OrderItemID uint   `json:"order_item_id"`
+	SKU         string `json:"sku"`
+	Barcode     string `json:"barcode,omitempty"`
+	Name        string `json:"name"`
+	Expected    int    `json:"expected"`
+	Scanned     int    `json:"scanned"`
+}
+
+// CheckPacking compara los escaneos contra los items de la orden. Cada escaneo se asigna al
+// primer item con ese código que aún tenga unidades pendientes; si todos están completos, el
+// excedente queda en el primero para que se reporte. El empaque está completo cuando no hay
+// códigos desconocidos y cada item tiene exactamente las unidades esperadas.
+func CheckPacking(items []FulfillmentItem, scans []PackScan) PackingCheck {
+	check := PackingCheck{Items: make([]PackingItemCheck, len(items))}
+	byCode := make(map[string][]int)
+	for i := range items {
+		check.Items[i] = PackingItemCheck{
+			OrderItemID: items[i].ID,
+			SKU:         items[i].SKU,
+			Barcode:     items[i].Barcode,
+			Name:        items[i].Name,
+			Expected:    items[i].Quantity,
+		}
+		for _, code := range items[i].Codes() {
+			byCode[code] = append(byCode[code], i)
+		}
+	}
+
+	unknown := make(map[string]bool)
+	for _, scan := range scans {
+		code := NormalizeScanCode(scan.Code)
+		quantity := scan.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+
+		matches := byCode[code]
+		if len(matches) == 0 {
+			if !unknown[code] {
+				unknown[code] = true
+				check.UnknownCodes = append(check.UnknownCodes, scan.Code)
+			}
+			continue
+		}
+
+		for _, idx := range matches {
+			if quantity == 0 {
+				break
+			}
+			item := &check.Items[idx]
+			if pending := item.Expected - item.Scanned; pending > 0 {
+				take := min(pending, quantity)
+				item.Scanned += take
+				quantity -= take
+			}
+		}
+		check.Items[matches[0]].Scanned += quantity
+	}
+
+	check.Complete = len(check.UnknownCodes) == 0
+	for _, item := range check.Items {
+		if item.Scanned != item.Expected {
+			check.Complete = false
+		}
+	}
+	return check
+}
+
+// OrderPacking son los datos que se escriben en la orden al confirmar el empaque
+type OrderPacking struct {
+	OrderID        string
+	PreviousStatus string
+	Status         string
+	Warehouse      WarehouseRef
+	Weight         float64
+	Height         float64
+	Width          float64
+	Length         float64
+	Boxes          string // JSON con las cajas usadas
+	Packages       []PackageDimensions
+	Scans          []PackScan
+	PackedAt       time.Time
+	PackedBy       *uint
+	Notes          string
+	ItemIDs        []uint
+}
+
+// PackingResult es la respuesta de la confirmación de empaque
+type PackingResult struct {
+	OrderID          string              `json:"order_id"`
+	OrderNumber      string              `json:"order_number"`
+	PreviousStatus   string              `json:"previous_status"`
+	Status           string              `json:"status"`
+	Warehouse        *WarehouseRef       `json:"warehouse,omitempty"`
+	Weight           float64             `json:"weight"`
+	Height           float64             `json:"height"`
+	Width            float64             `json:"width"`
+	Length           float64             `json:"length"`
+	Packages         []PackageDimensions `json:"packages"`
+	PackedAt         *time.Time          `json:"packed_at,omitempty"`
+	PackedBy         *uint               `json:"packed_by,omitempty"`
+	UpdatedShipments int64               `json:"updated_shipments"`
+	Check            PackingCheck        `json:"check"`
+}
+
+// PackingChecklist es lo que el empacador debe escanear para una orden
+type PackingChecklist struct {
+	OrderID     string             `json:"order_id"`
+	OrderNumber string             `json:"order_number"`
+	Status      string             `json:"status"`
+	Warehouse   *WarehouseRef      `json:"warehouse,omitempty"`
+	Items       []PackingItemCheck `json:"items"`
+}
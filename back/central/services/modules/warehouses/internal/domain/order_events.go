@@ -0,0 +1,28 @@
+package domain
+
+import "github.com/secamc93/probability/back/central/shared/orderevents"
+
+// ───────────────────────────────────────────
+//
+//	ORDER EVENTS - Evento compartido del canal de órdenes (ver shared/orderevents)
+//
+// ───────────────────────────────────────────
+
+// OrderEvent es el evento de orden publicado en el canal de órdenes
+type OrderEvent = orderevents.OrderEvent
+
+// NewOrderStatusChangedEvent crea el evento de cambio de estado de una orden empacada
+func NewOrderStatusChangedEvent(order *FulfillmentOrder, packing *OrderPacking) *OrderEvent {
+	event := orderevents.NewOrderEvent(orderevents.OrderEventTypeStatusChanged, order.ID, orderevents.OrderEventData{
+		OrderNumber:    order.OrderNumber,
+		PreviousStatus: packing.PreviousStatus,
+		CurrentStatus:  packing.Status,
+		Extra: map[string]interface{}{
+			"source":         "warehouse_packing",
+			"warehouse_id":   packing.Warehouse.ID,
+			"warehouse_name": packing.Warehouse.Name,
+		},
+	})
+	event.BusinessID = order.BusinessID
+	return event
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+)
+
+// ───────────────────────────────────────────
+//
+//	REPOSITORY INTERFACE
+//
+// ───────────────────────────────────────────
+
+// IRepository define todos los métodos de repositorio del módulo warehouses
+type IRepository interface {
+	// CRUD Operations
+	CreateWarehouse(ctx context.Context, warehouse *Warehouse) error
+	GetWarehouseByID(ctx context.Context, id uint) (*Warehouse, error)
+	ListWarehouses(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]Warehouse, int64, error)
+	UpdateWarehouse(ctx context.Context, warehouse *Warehouse) error
+	DeleteWarehouse(ctx context.Context, id uint) error
+
+	// Validation (excludeID permite ignorar al propio almacén en actualizaciones)
+	WarehouseCodeExists(ctx context.Context, businessID uint, code string, excludeID uint) (bool, error)
+
+	// Default warehouse
+	GetDefaultWarehouse(ctx context.Context, businessID uint) (*Warehouse, error)
+	ClearDefaultWarehouse(ctx context.Context, businessID uint, exceptID uint) error
+
+	// Fulfillment
+	ListPickingOrders(ctx context.Context, filters PickListFilters) ([]FulfillmentOrder, error)
+	GetFulfillmentOrder(ctx context.Context, orderID string) (*FulfillmentOrder, error)
+	MarkOrderPacked(ctx context.Context, packing *OrderPacking) (int64, error)
+}
+
+// ───────────────────────────────────────────
+//
+//	EVENT PUBLISHER INTERFACE
+//
+// ───────────────────────────────────────────
+
+// IOrderEventPublisher publica los cambios de estado de las órdenes en el canal de órdenes
+type IOrderEventPublisher interface {
+	PublishOrderEvent(ctx context.Context, event *OrderEvent) error
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ───────────────────────────────────────────
+//
+//	WAREHOUSE - Almacenes del negocio
+//
+// ───────────────────────────────────────────
+
+// Días válidos del horario de operación
+var WeekDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// Warehouse representa un almacén desde el que se preparan y despachan órdenes
+type Warehouse struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	BusinessID uint      `json:"business_id"`
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+
+	Address WarehouseAddress `json:"address"`
+
+	ContactName  string `json:"contact_name,omitempty"`
+	ContactPhone string `json:"contact_phone,omitempty"`
+
+	OperatingHours []OperatingHours `json:"operating_hours"`
+
+	IsActive  bool `json:"is_active"`
+	IsDefault bool `json:"is_default"`
+}
+
+// WarehouseAddress es la dirección física del almacén
+type WarehouseAddress struct {
+	Street     string   `json:"street"`
+	City       string   `json:"city"`
+	State      string   `json:"state"`
+	Country    string   `json:"country"`
+	PostalCode string   `json:"postal_code"`
+	Lat        *float64 `json:"lat,omitempty" binding:"omitempty,min=-90,max=90"`
+	Lng        *float64 `json:"lng,omitempty" binding:"omitempty,min=-180,max=180"`
+}
+
+// OperatingHours es el horario de un día de la semana (hora local del negocio, formato HH:MM)
+type OperatingHours struct {
+	Day    string `json:"day"`
+	Opens  string `json:"opens"`
+	Closes string `json:"closes"`
+}
+
+// NormalizeOperatingHours valida el horario de operación: días conocidos sin repetir
+// y hora de apertura anterior a la de cierre
+func NormalizeOperatingHours(hours []OperatingHours) ([]OperatingHours, error) {
+	normalized := make([]OperatingHours, 0, len(hours))
+	seen := make(map[string]bool, len(hours))
+	for _, h := range hours {
+		day := strings.ToLower(strings.TrimSpace(h.Day))
+		if !isWeekDay(day) || seen[day] {
+			return nil, ErrInvalidOperatingHours
+		}
+		opens, errOpens := time.Parse("15:04", strings.TrimSpace(h.Opens))
+		closes, errCloses := time.Parse("15:04", strings.TrimSpace(h.Closes))
+		if errOpens != nil || errCloses != nil || !opens.Before(closes) {
+			return nil, ErrInvalidOperatingHours
+		}
+		seen[day] = true
+		normalized = append(normalized, OperatingHours{
+			Day:    day,
+			Opens:  opens.Format("15:04"),
+			Closes: closes.Format("15:04"),
+		})
+	}
+	return normalized, nil
+}
+
+func isWeekDay(day string) bool {
+	for _, d := range WeekDays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// ───────────────────────────────────────────
+//
+//	REQUEST / RESPONSE DTOs
+//
+// ───────────────────────────────────────────
+
+// CreateWarehouseRequest representa la solicitud para crear un almacén
+type CreateWarehouseRequest struct {
+	BusinessID     uint             `json:"business_id" binding:"required"`
+	Code           string           `json:"code" binding:"required,max=50"`
+	Name           string           `json:"name" binding:"required,max=128"`
+	Address        WarehouseAddress `json:"address"`
+	ContactName    string           `json:"contact_name" binding:"max=255"`
+	ContactPhone   string           `json:"contact_phone" binding:"max=32"`
+	OperatingHours []OperatingHours `json:"operating_hours"`
+	IsDefault      bool             `json:"is_default"`
+}
+
+// UpdateWarehouseRequest representa la solicitud para actualizar un almacén
+type UpdateWarehouseRequest struct {
+	Name           *string           `json:"name" binding:"omitempty,min=1,max=128"`
+	Address        *WarehouseAddress `json:"address"`
+	ContactName    *string           `json:"contact_name" binding:"omitempty,max=255"`
+	ContactPhone   *string           `json:"contact_phone" binding:"omitempty,max=32"`
+	OperatingHours *[]OperatingHours `json:"operating_hours"`
+	IsActive       *bool             `json:"is_active"`
+	IsDefault      *bool             `json:"is_default"`
+}
+
+// WarehousesListResponse representa la respuesta paginada de almacenes
+type WarehousesListResponse struct {
+	Data       []Warehouse `json:"data"`
+	Total      int64       `json:"total"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"page_size"`
+	TotalPages int         `json:"total_pages"`
+}
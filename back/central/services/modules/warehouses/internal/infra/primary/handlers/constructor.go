@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/app/usecases"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// Handlers contiene todos los handlers del módulo warehouses
+type Handlers struct {
+	uc *usecases.UseCases
+}
+
+// New crea una nueva instancia de Handlers
+func New(uc *usecases.UseCases) *Handlers {
+	return &Handlers{
+		uc: uc,
+	}
+}
+
+// parseWarehouseID convierte el parámetro de ruta en un ID de almacén válido
+func parseWarehouseID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "ID de almacén inválido",
+			"error":   "El ID debe ser un número entero mayor a 0",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondWarehouseError traduce los errores de dominio del módulo a respuestas HTTP
+func respondWarehouseError(c *gin.Context, err error, message string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrWarehouseNotFound),
+		errors.Is(err, domain.ErrOrderNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidWarehouseData),
+		errors.Is(err, domain.ErrInvalidOperatingHours),
+		errors.Is(err, domain.ErrWarehouseRequired),
+		errors.Is(err, domain.ErrWarehouseInactive),
+		errors.Is(err, domain.ErrWarehouseBusinessMismatch),
+		errors.Is(err, domain.ErrOrderWithoutItems):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrWarehouseAlreadyExists),
+		errors.Is(err, domain.ErrOrderNotPackable):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"message": message,
+		"error":   err.Error(),
+	})
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// CreateWarehouse godoc
+// @Summary      Crear almacén
+// @Description  Crea un almacén para un negocio con su dirección y horario de operación. Si se marca como almacén por defecto, los demás almacenes del negocio dejan de serlo.
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        warehouse  body      domain.CreateWarehouseRequest  true  "Datos del almacén"
+// @Security     BearerAuth
+// @Success      201  {object}  domain.Warehouse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /warehouses [post]
+func (h *Handlers) CreateWarehouse(c *gin.Context) {
+	var req domain.CreateWarehouseRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	warehouse, err := h.uc.CreateWarehouse(c.Request.Context(), &req)
+	if err != nil {
+		respondWarehouseError(c, err, "Error al crear almacén")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Almacén creado exitosamente",
+		"data":    warehouse,
+	})
+}
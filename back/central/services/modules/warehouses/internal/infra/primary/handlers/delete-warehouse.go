@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteWarehouse godoc
+// @Summary      Eliminar almacén
+// @Description  Elimina (soft delete) un almacén. Las órdenes y envíos conservan su almacén desnormalizado.
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del almacén"
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /warehouses/{id} [delete]
+func (h *Handlers) DeleteWarehouse(c *gin.Context) {
+	id, ok := parseWarehouseID(c)
+	if !ok {
+		return
+	}
+
+	// Llamar al caso de uso
+	if err := h.uc.DeleteWarehouse(c.Request.Context(), id); err != nil {
+		respondWarehouseError(c, err, "Error al eliminar almacén")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Almacén eliminado exitosamente",
+	})
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetWarehouseByID godoc
+// @Summary      Obtener almacén por ID
+// @Description  Obtiene un almacén específico por su ID
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID del almacén"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Warehouse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /warehouses/{id} [get]
+func (h *Handlers) GetWarehouseByID(c *gin.Context) {
+	id, ok := parseWarehouseID(c)
+	if !ok {
+		return
+	}
+
+	// Llamar al caso de uso
+	warehouse, err := h.uc.GetWarehouseByID(c.Request.Context(), id)
+	if err != nil {
+		respondWarehouseError(c, err, "Error al obtener almacén")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Almacén obtenido exitosamente",
+		"data":    warehouse,
+	})
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// GetPackingChecklist godoc
+// @Summary      Obtener checklist de empaque
+// @Description  Obtiene los items de la orden con su SKU, código de barras y unidades a escanear, y el almacén en el que se empacará
+// @Tags         Fulfillment
+// @Produce      json
+// @Param        order_id  path  string  true  "ID de la orden (UUID)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.PackingChecklist
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /fulfillment/orders/{order_id}/packing [get]
+func (h *Handlers) GetPackingChecklist(c *gin.Context) {
+	checklist, err := h.uc.GetPackingChecklist(c.Request.Context(), c.Param("order_id"))
+	if err != nil {
+		respondWarehouseError(c, err, "Error al obtener el checklist de empaque")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Checklist de empaque obtenido exitosamente",
+		"data":    checklist,
+	})
+}
+
+// PackOrder godoc
+// @Summary      Confirmar empaque de una orden
+// @Description  Verifica item por item los SKU o códigos de barras escaneados contra los items de la orden, guarda el peso y las medidas de las cajas en la orden (y en sus envíos pendientes) y pasa la orden a lista para despacho (ready_to_ship). Si los escaneos no coinciden responde 422 con las diferencias.
+// @Tags         Fulfillment
+// @Accept       json
+// @Produce      json
+// @Param        order_id  path      string                   true  "ID de la orden (UUID)"
+// @Param        packing   body      domain.PackOrderRequest  true  "Escaneos y cajas del empaque"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.PackingResult
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      422  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /fulfillment/orders/{order_id}/pack [post]
+func (h *Handlers) PackOrder(c *gin.Context) {
+	var req domain.PackOrderRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.uc.PackOrder(c.Request.Context(), c.Param("order_id"), &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrPackingMismatch) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"success": false,
+				"message": "Los items escaneados no coinciden con la orden",
+				"error":   err.Error(),
+				"data":    result,
+			})
+			return
+		}
+		respondWarehouseError(c, err, "Error al confirmar el empaque")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Orden empacada y lista para despacho",
+		"data":    result,
+	})
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// GeneratePickLists godoc
+// @Summary      Generar listas de picking
+// @Description  Agrupa por almacén los productos a recoger de las órdenes pendientes de preparar, consolidados por SKU y con el reparto por orden. Las órdenes sin almacén se asignan al almacén por defecto del negocio.
+// @Tags         Fulfillment
+// @Produce      json
+// @Param        business_id   query  int     true   "ID del negocio"
+// @Param        warehouse_id  query  int     false  "Generar solo la lista de este almacén"
+// @Param        statuses      query  string  false  "Estados de orden separados por coma (default: processing)"
+// @Param        limit         query  int     false  "Máximo de órdenes a incluir (default y máx: 500)"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.PickListsResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /fulfillment/pick-lists [get]
+func (h *Handlers) GeneratePickLists(c *gin.Context) {
+	businessID, err := strconv.ParseUint(c.Query("business_id"), 10, 32)
+	if err != nil || businessID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Parámetro 'business_id' requerido",
+			"error":   "invalid business_id parameter",
+		})
+		return
+	}
+
+	filters := domain.PickListFilters{BusinessID: uint(businessID)}
+
+	if raw := c.Query("warehouse_id"); raw != "" {
+		warehouseID, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil || warehouseID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'warehouse_id' inválido",
+				"error":   "invalid warehouse_id parameter",
+			})
+			return
+		}
+		id := uint(warehouseID)
+		filters.WarehouseID = &id
+	}
+
+	if raw := c.Query("statuses"); raw != "" {
+		for _, status := range strings.Split(raw, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filters.Statuses = append(filters.Statuses, status)
+			}
+		}
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": "Parámetro 'limit' inválido. Debe ser un número entero mayor a 0",
+				"error":   "invalid limit parameter",
+			})
+			return
+		}
+		filters.Limit = limit
+	}
+
+	response, err := h.uc.GeneratePickLists(c.Request.Context(), filters)
+	if err != nil {
+		respondWarehouseError(c, err, "Error al generar las listas de picking")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Listas de picking generadas exitosamente",
+		"data":    response,
+	})
+}
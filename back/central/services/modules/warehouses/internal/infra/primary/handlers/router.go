@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registra todas las rutas del módulo warehouses
+func (h *Handlers) RegisterRoutes(router *gin.RouterGroup) {
+	warehouses := router.Group("/warehouses")
+	{
+		// CRUD básico
+		warehouses.GET("", h.ListWarehouses)
+		warehouses.GET("/:id", h.GetWarehouseByID)
+		warehouses.POST("", h.CreateWarehouse)
+		warehouses.PUT("/:id", h.UpdateWarehouse)
+		warehouses.DELETE("/:id", h.DeleteWarehouse)
+	}
+
+	fulfillment := router.Group("/fulfillment")
+	{
+		// Picking
+		fulfillment.GET("/pick-lists", h.GeneratePickLists)
+
+		// Packing
+		fulfillment.GET("/orders/:order_id/packing", h.GetPackingChecklist)
+		fulfillment.POST("/orders/:order_id/pack", h.PackOrder)
+	}
+}
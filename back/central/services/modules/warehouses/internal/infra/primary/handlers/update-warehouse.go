@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+)
+
+// UpdateWarehouse godoc
+// @Summary      Actualizar almacén
+// @Description  Actualiza un almacén existente (el código y el negocio no se pueden cambiar)
+// @Tags         Warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                            true  "ID del almacén"
+// @Param        warehouse  body      domain.UpdateWarehouseRequest  true  "Datos a actualizar"
+// @Security     BearerAuth
+// @Success      200  {object}  domain.Warehouse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /warehouses/{id} [put]
+func (h *Handlers) UpdateWarehouse(c *gin.Context) {
+	id, ok := parseWarehouseID(c)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateWarehouseRequest
+
+	// Validar el request body
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Datos de entrada inválidos",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Llamar al caso de uso
+	warehouse, err := h.uc.UpdateWarehouse(c.Request.Context(), id, &req)
+	if err != nil {
+		respondWarehouseError(c, err, "Error al actualizar almacén")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Almacén actualizado exitosamente",
+		"data":    warehouse,
+	})
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// fulfillmentItemRow es el resultado crudo de los items de la orden con sus códigos de catálogo
+type fulfillmentItemRow struct {
+	ID               uint
+	OrderID          string
+	ProductID        *string
+	ProductVariantID *string
+	Quantity         int
+	ProductSKU       string
+	ProductName      string
+	VariantSKU       string
+	VariantTitle     string
+	Barcode          string
+}
+
+// ListPickingOrders obtiene las órdenes pendientes de preparar, de la más antigua a la más reciente
+func (r *Repository) ListPickingOrders(ctx context.Context, filters domain.PickListFilters) ([]domain.FulfillmentOrder, error) {
+	query := r.db.Conn(ctx).
+		Model(&models.Order{}).
+		Where("business_id = ? AND status IN ?", filters.BusinessID, filters.Statuses)
+
+	if filters.WarehouseID != nil {
+		if filters.IncludeUnassigned {
+			query = query.Where("(warehouse_id = ? OR warehouse_id IS NULL)", *filters.WarehouseID)
+		} else {
+			query = query.Where("warehouse_id = ?", *filters.WarehouseID)
+		}
+	}
+
+	var orders []models.Order
+	err := query.
+		Select("id", "business_id", "order_number", "status", "warehouse_id", "warehouse_name", "created_at").
+		Order("created_at ASC").
+		Limit(filters.Limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.FulfillmentOrder, len(orders))
+	orderIDs := make([]string, len(orders))
+	for i := range orders {
+		result[i] = toFulfillmentOrder(&orders[i])
+		orderIDs[i] = orders[i].ID
+	}
+
+	items, err := r.listFulfillmentItems(ctx, orderIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].Items = items[result[i].ID]
+	}
+
+	return result, nil
+}
+
+// GetFulfillmentOrder obtiene una orden con sus items para verificar el empaque
+func (r *Repository) GetFulfillmentOrder(ctx context.Context, orderID string) (*domain.FulfillmentOrder, error) {
+	var order models.Order
+	err := r.db.Conn(ctx).
+		Select("id", "business_id", "order_number", "status", "warehouse_id", "warehouse_name", "created_at").
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	items, err := r.listFulfillmentItems(ctx, []string{order.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := toFulfillmentOrder(&order)
+	result.Items = items[order.ID]
+	return &result, nil
+}
+
+// MarkOrderPacked guarda el empaque en una transacción: estado, almacén, medidas y detalle de
+// empaque de la orden, items marcados como empacados, historial de estado y almacén/medidas de los
+// envíos aún pendientes. Retorna el número de envíos actualizados.
+func (r *Repository) MarkOrderPacked(ctx context.Context, packing *domain.OrderPacking) (int64, error) {
+	var updatedShipments int64
+	err := r.db.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status", "fulfillment_details").
+			Where("id = ?", packing.OrderID).
+			First(&order).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrOrderNotFound
+			}
+			return err
+		}
+		// Otro proceso cambió la orden mientras se verificaba el empaque
+		if order.Status != packing.PreviousStatus {
+			return domain.ErrOrderNotPackable
+		}
+
+		details, err := mergePackingDetails(order.FulfillmentDetails, packing)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Order{}).
+			Where("id = ?", packing.OrderID).
+			Updates(map[string]interface{}{
+				"status":              packing.Status,
+				"warehouse_id":        packing.Warehouse.ID,
+				"warehouse_name":      packing.Warehouse.Name,
+				"weight":              packing.Weight,
+				"height":              packing.Height,
+				"width":               packing.Width,
+				"length":              packing.Length,
+				"boxes":               packing.Boxes,
+				"fulfillment_details": details,
+			}).Error; err != nil {
+			return err
+		}
+
+		if len(packing.ItemIDs) > 0 {
+			if err := tx.Model(&models.OrderItem{}).
+				Where("id IN ?", packing.ItemIDs).
+				Update("fulfillment_status", domain.ItemFulfillmentStatusPacked).Error; err != nil {
+				return err
+			}
+		}
+
+		reason := fmt.Sprintf("Empacada en el almacén %s", packing.Warehouse.Name)
+		if err := tx.Create(&models.OrderHistory{
+			OrderID:        packing.OrderID,
+			PreviousStatus: packing.PreviousStatus,
+			NewStatus:      packing.Status,
+			ChangedBy:      packing.PackedBy,
+			Reason:         &reason,
+		}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Shipment{}).
+			Where("order_id = ? AND status = ?", packing.OrderID, domain.ShipmentStatusPending).
+			Updates(map[string]interface{}{
+				"warehouse_id":   packing.Warehouse.ID,
+				"warehouse_name": packing.Warehouse.Name,
+				"weight":         packing.Weight,
+				"height":         packing.Height,
+				"width":          packing.Width,
+				"length":         packing.Length,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		updatedShipments = result.RowsAffected
+		return nil
+	})
+	return updatedShipments, err
+}
+
+// listFulfillmentItems obtiene los items de las órdenes con el SKU y código de barras del catálogo
+func (r *Repository) listFulfillmentItems(ctx context.Context, orderIDs []string) (map[string][]domain.FulfillmentItem, error) {
+	result := make(map[string][]domain.FulfillmentItem, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []fulfillmentItemRow
+	err := r.db.Conn(ctx).
+		Table("order_items AS oi").
+		Select(`oi.id, oi.order_id, oi.product_id, oi.product_variant_id, oi.quantity,
+			COALESCE(p.sku, '') AS product_sku, COALESCE(p.name, '') AS product_name,
+			COALESCE(pv.sku, '') AS variant_sku, COALESCE(pv.title, '') AS variant_title,
+			COALESCE(pv.barcode, '') AS barcode`).
+		Joins("LEFT JOIN products p ON p.id = oi.product_id").
+		Joins("LEFT JOIN product_variants pv ON pv.id = oi.product_variant_id").
+		Where("oi.order_id IN ? AND oi.deleted_at IS NULL", orderIDs).
+		Order("oi.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		item := domain.FulfillmentItem{
+			ID:               row.ID,
+			ProductID:        row.ProductID,
+			ProductVariantID: row.ProductVariantID,
+			Name:             row.ProductName,
+			SKU:              row.ProductSKU,
+			ProductSKU:       row.ProductSKU,
+			Barcode:          row.Barcode,
+			Quantity:         row.Quantity,
+		}
+		if row.VariantSKU != "" {
+			item.SKU = row.VariantSKU
+		}
+		if title := strings.TrimSpace(row.VariantTitle); title != "" {
+			item.Name = strings.TrimSpace(item.Name + " - " + title)
+		}
+		if item.Name == "" {
+			item.Name = fmt.Sprintf("Item %d", row.ID)
+		}
+		result[row.OrderID] = append(result[row.OrderID], item)
+	}
+	return result, nil
+}
+
+// mergePackingDetails agrega el detalle del empaque a los fulfillment_details existentes de la orden
+func mergePackingDetails(current datatypes.JSON, packing *domain.OrderPacking) (datatypes.JSON, error) {
+	details := make(map[string]interface{})
+	if len(current) > 0 {
+		// Si el canal guardó algo que no es un objeto se conserva bajo otra llave
+		if err := json.Unmarshal(current, &details); err != nil {
+			details = map[string]interface{}{"channel": json.RawMessage(current)}
+		}
+	}
+
+	details["packing"] = map[string]interface{}{
+		"warehouse_id":   packing.Warehouse.ID,
+		"warehouse_code": packing.Warehouse.Code,
+		"warehouse_name": packing.Warehouse.Name,
+		"packed_at":      packing.PackedAt.Format(time.RFC3339),
+		"packed_by":      packing.PackedBy,
+		"packages":       packing.Packages,
+		"scans":          packing.Scans,
+		"notes":          packing.Notes,
+	}
+
+	merged, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+	return datatypes.JSON(merged), nil
+}
+
+func toFulfillmentOrder(order *models.Order) domain.FulfillmentOrder {
+	return domain.FulfillmentOrder{
+		ID:            order.ID,
+		BusinessID:    order.BusinessID,
+		OrderNumber:   order.OrderNumber,
+		Status:        order.Status,
+		WarehouseID:   order.WarehouseID,
+		WarehouseName: order.WarehouseName,
+		CreatedAt:     order.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ToDBWarehouse convierte un almacén de dominio a modelo de base de datos
+func ToDBWarehouse(w *domain.Warehouse) *models.Warehouse {
+	if w == nil {
+		return nil
+	}
+	warehouse := &models.Warehouse{
+		Model: gorm.Model{
+			ID:        w.ID,
+			CreatedAt: w.CreatedAt,
+			UpdatedAt: w.UpdatedAt,
+		},
+		BusinessID:   w.BusinessID,
+		Code:         w.Code,
+		Name:         w.Name,
+		Street:       w.Address.Street,
+		City:         w.Address.City,
+		State:        w.Address.State,
+		Country:      w.Address.Country,
+		PostalCode:   w.Address.PostalCode,
+		Lat:          w.Address.Lat,
+		Lng:          w.Address.Lng,
+		ContactName:  w.ContactName,
+		ContactPhone: w.ContactPhone,
+		IsActive:     w.IsActive,
+		IsDefault:    w.IsDefault,
+	}
+	if w.OperatingHours != nil {
+		if hours, err := json.Marshal(w.OperatingHours); err == nil {
+			warehouse.OperatingHours = datatypes.JSON(hours)
+		}
+	}
+	return warehouse
+}
+
+// ToDomainWarehouse convierte un modelo de base de datos a almacén de dominio
+func ToDomainWarehouse(w *models.Warehouse) *domain.Warehouse {
+	if w == nil {
+		return nil
+	}
+	warehouse := &domain.Warehouse{
+		ID:         w.ID,
+		CreatedAt:  w.CreatedAt,
+		UpdatedAt:  w.UpdatedAt,
+		BusinessID: w.BusinessID,
+		Code:       w.Code,
+		Name:       w.Name,
+		Address: domain.WarehouseAddress{
+			Street:     w.Street,
+			City:       w.City,
+			State:      w.State,
+			Country:    w.Country,
+			PostalCode: w.PostalCode,
+			Lat:        w.Lat,
+			Lng:        w.Lng,
+		},
+		ContactName:    w.ContactName,
+		ContactPhone:   w.ContactPhone,
+		OperatingHours: []domain.OperatingHours{},
+		IsActive:       w.IsActive,
+		IsDefault:      w.IsDefault,
+	}
+	if len(w.OperatingHours) > 0 {
+		_ = json.Unmarshal(w.OperatingHours, &warehouse.OperatingHours)
+	}
+	return warehouse
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/domain"
+	"github.com/secamc93/probability/back/central/services/modules/warehouses/internal/infra/secondary/repository/mappers"
+	"github.com/secamc93/probability/back/central/shared/db"
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+)
+
+// Repository implementa el repositorio de almacenes
+type Repository struct {
+	db db.IDatabase
+}
+
+// New crea una nueva instancia del repositorio
+func New(database db.IDatabase) domain.IRepository {
+	return &Repository{
+		db: database,
+	}
+}
+
+// CreateWarehouse crea un nuevo almacén en la base de datos
+func (r *Repository) CreateWarehouse(ctx context.Context, warehouse *domain.Warehouse) error {
+	dbWarehouse := mappers.ToDBWarehouse(warehouse)
+	if err := r.db.Conn(ctx).Create(dbWarehouse).Error; err != nil {
+		return err
+	}
+	// Actualizar el modelo de dominio con los valores generados
+	warehouse.ID = dbWarehouse.ID
+	warehouse.CreatedAt = dbWarehouse.CreatedAt
+	warehouse.UpdatedAt = dbWarehouse.UpdatedAt
+	return nil
+}
+
+// GetWarehouseByID obtiene un almacén por su ID
+func (r *Repository) GetWarehouseByID(ctx context.Context, id uint) (*domain.Warehouse, error) {
+	var warehouse models.Warehouse
+	err := r.db.Conn(ctx).
+		Where("id = ?", id).
+		First(&warehouse).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWarehouseNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainWarehouse(&warehouse), nil
+}
+
+// ListWarehouses obtiene una lista paginada de almacenes con filtros
+func (r *Repository) ListWarehouses(ctx context.Context, page, pageSize int, filters map[string]interface{}) ([]domain.Warehouse, int64, error) {
+	var dbWarehouses []models.Warehouse
+	var total int64
+
+	query := r.db.Conn(ctx).Model(&models.Warehouse{})
+
+	// Filtro por business_id
+	if businessID, ok := filters["business_id"].(uint); ok && businessID > 0 {
+		query = query.Where("business_id = ?", businessID)
+	}
+
+	// Filtro por estado
+	if isActive, ok := filters["is_active"].(bool); ok {
+		query = query.Where("is_active = ?", isActive)
+	}
+
+	// Búsqueda por nombre, código o ciudad
+	if search, ok := filters["search"].(string); ok && search != "" {
+		like := "%" + search + "%"
+		query = query.Where("name ILIKE ? OR code ILIKE ? OR city ILIKE ?", like, like, like)
+	}
+
+	// Contar total (antes de aplicar paginación y ordenamiento)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Aplicar ordenamiento
+	sortBy := "name"
+	if sort, ok := filters["sort_by"].(string); ok && sort != "" {
+		sortFieldMap := map[string]string{
+			"id":         "id",
+			"name":       "name",
+			"code":       "code",
+			"city":       "city",
+			"created_at": "created_at",
+		}
+		if mappedField, exists := sortFieldMap[sort]; exists {
+			sortBy = mappedField
+		}
+	}
+
+	sortOrder := "asc"
+	if order, ok := filters["sort_order"].(string); ok && order == "desc" {
+		sortOrder = order
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+
+	// Aplicar paginación
+	offset := (page - 1) * pageSize
+	if err := query.Offset(offset).Limit(pageSize).Find(&dbWarehouses).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Convertir a dominio
+	warehouses := make([]domain.Warehouse, len(dbWarehouses))
+	for i := range dbWarehouses {
+		warehouses[i] = *mappers.ToDomainWarehouse(&dbWarehouses[i])
+	}
+
+	return warehouses, total, nil
+}
+
+// UpdateWarehouse actualiza un almacén existente
+func (r *Repository) UpdateWarehouse(ctx context.Context, warehouse *domain.Warehouse) error {
+	dbWarehouse := mappers.ToDBWarehouse(warehouse)
+	if err := r.db.Conn(ctx).Save(dbWarehouse).Error; err != nil {
+		return err
+	}
+	warehouse.UpdatedAt = dbWarehouse.UpdatedAt
+	return nil
+}
+
+// DeleteWarehouse elimina (soft delete) un almacén
+func (r *Repository) DeleteWarehouse(ctx context.Context, id uint) error {
+	return r.db.Conn(ctx).Where("id = ?", id).Delete(&models.Warehouse{}).Error
+}
+
+// WarehouseCodeExists verifica si existe otro almacén con el código en el negocio
+func (r *Repository) WarehouseCodeExists(ctx context.Context, businessID uint, code string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Conn(ctx).
+		Model(&models.Warehouse{}).
+		Where("business_id = ? AND LOWER(code) = LOWER(?) AND id <> ?", businessID, code, excludeID).
+		Count(&count).Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetDefaultWarehouse obtiene el almacén activo por defecto del negocio
+func (r *Repository) GetDefaultWarehouse(ctx context.Context, businessID uint) (*domain.Warehouse, error) {
+	var warehouse models.Warehouse
+	err := r.db.Conn(ctx).
+		Where("business_id = ? AND is_default = ? AND is_active = ?", businessID, true, true).
+		Order("id ASC").
+		First(&warehouse).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWarehouseNotFound
+		}
+		return nil, err
+	}
+
+	return mappers.ToDomainWarehouse(&warehouse), nil
+}
+
+// ClearDefaultWarehouse quita la marca de almacén por defecto a los demás almacenes del negocio
+func (r *Repository) ClearDefaultWarehouse(ctx context.Context, businessID uint, exceptID uint) error {
+	return r.db.Conn(ctx).
+		Model(&models.Warehouse{}).
+		Where("business_id = ? AND id <> ? AND is_default = ?", businessID, exceptID, true).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,364 @@
+package address
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/secamc93/probability/back/central/shared/normalize"
+)
+
+// Campos que pueden faltar en una dirección
+const (
+	FieldStreet       = "street"
+	FieldStreetNumber = "street_number"
+	FieldCity         = "city"
+	FieldState        = "state"
+	FieldCountry      = "country"
+	FieldPostalCode   = "postal_code"
+)
+
+// Peso de cada campo en el puntaje de completitud (suman 100)
+const (
+	weightStreet         = 35.0
+	weightStreetNumber   = 15.0
+	weightCity           = 25.0
+	weightCityUnmatched  = 10.0 // Ciudad presente pero no reconocida en el gazetteer
+	weightState          = 10.0
+	weightCountry        = 10.0
+	weightPostalCode     = 5.0
+	cityFuzzyMinNameSize = 5 // Longitud mínima para buscar por similitud
+	cityFuzzyMaxDistance = 1 // Errores de digitación tolerados en nombres cortos
+	cityFuzzyLongName    = 9 // A partir de esta longitud se toleran dos errores
+)
+
+// DefaultCountry es el país usado cuando la dirección no lo indica
+const DefaultCountry = "CO"
+
+// Address es una dirección tal como llega del canal de venta
+type Address struct {
+	Street     string
+	Street2    string
+	City       string
+	State      string
+	Country    string
+	PostalCode string
+}
+
+// Normalized es el resultado de normalizar una dirección contra el gazetteer
+type Normalized struct {
+	Street       string   `json:"street"`                // Calle con tipos de vía estandarizados (en minúsculas)
+	City         string   `json:"city"`                  // Nombre oficial de la ciudad (o el original si no se reconoce)
+	CityCode     string   `json:"city_code,omitempty"`   // Código oficial del municipio (DANE en Colombia)
+	State        string   `json:"state"`                 // Nombre oficial del departamento/estado
+	StateCode    string   `json:"state_code,omitempty"`  // Código oficial del departamento (DANE en Colombia)
+	Country      string   `json:"country"`               // País ISO 3166-1 alfa-2
+	PostalCode   string   `json:"postal_code,omitempty"` // Código postal sin espacios
+	CityMatched  bool     `json:"city_matched"`          // Si la ciudad se encontró en el gazetteer
+	Completeness float64  `json:"completeness"`          // Puntaje de completitud (0-100)
+	Missing      []string `json:"missing,omitempty"`     // Campos faltantes o incompletos
+	Key          string   `json:"key"`                   // Clave estable de la dirección para cache de geocodificación
+	lat, lng     float64
+}
+
+// Centroid retorna el centroide de la ciudad reconocida en el gazetteer
+func (n Normalized) Centroid() (lat, lng float64, ok bool) {
+	if !n.CityMatched {
+		return 0, 0, false
+	}
+	return n.lat, n.lng, true
+}
+
+// IsEmpty indica si la dirección no tiene calle ni ciudad
+func (a Address) IsEmpty() bool {
+	return strings.TrimSpace(a.Street) == "" && strings.TrimSpace(a.City) == ""
+}
+
+// Normalize normaliza ciudad, departamento y país contra el gazetteer de referencia,
+// estandariza los tipos de vía de la calle y calcula el puntaje de completitud.
+// "Bogota", "BOGOTÁ D.C." y "bogota dc" resuelven a la misma ciudad y código DANE.
+func Normalize(addr Address, defaultCountry string) Normalized {
+	result := Normalized{
+		Street:     normalizeStreet(addr.Street),
+		City:       strings.TrimSpace(addr.City),
+		State:      strings.TrimSpace(addr.State),
+		PostalCode: strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(addr.PostalCode), " ", "")),
+	}
+
+	countryGiven := strings.TrimSpace(addr.Country) != ""
+	result.Country = NormalizeCountry(addr.Country)
+	if result.Country == "" {
+		result.Country = NormalizeCountry(defaultCountry)
+	}
+	if result.Country == "" {
+		result.Country = DefaultCountry
+	}
+
+	if g, ok := gazetteers[result.Country]; ok {
+		stateCode := g.matchDepartment(addr.State)
+		if m, ok := g.matchCity(addr.City, stateCode); ok {
+			result.City = m.Name
+			result.CityCode = m.Code
+			result.CityMatched = true
+			result.lat, result.lng = m.Lat, m.Lng
+			// La ciudad define el departamento ("Bogotá, Cundinamarca" queda en Bogotá D.C.)
+			stateCode = m.DepartmentCode
+		}
+		if d, ok := g.departments[stateCode]; ok {
+			result.State = d.Name
+			result.StateCode = d.Code
+		}
+	}
+
+	result.Completeness, result.Missing = completeness(result, countryGiven)
+	result.Key = buildKey(result)
+	return result
+}
+
+// NormalizeCountry convierte el nombre o código de un país a ISO 3166-1 alfa-2.
+// Retorna cadena vacía si no se reconoce.
+func NormalizeCountry(country string) string {
+	key := normalize.Text(country)
+	if key == "" {
+		return ""
+	}
+	if iso, ok := countryAliases[key]; ok {
+		return iso
+	}
+	upper := strings.ToUpper(key)
+	if len(upper) == 2 {
+		return upper
+	}
+	return ""
+}
+
+// countryAliases mapea nombres y códigos alfa-3 frecuentes a ISO alfa-2
+var countryAliases = map[string]string{
+	"colombia": "CO", "col": "CO",
+	"mexico": "MX", "mex": "MX",
+	"peru": "PE", "per": "PE",
+	"ecuador": "EC", "ecu": "EC",
+	"chile": "CL", "chl": "CL",
+	"argentina": "AR", "arg": "AR",
+	"brasil": "BR", "brazil": "BR", "bra": "BR",
+	"venezuela": "VE", "ven": "VE",
+	"panama": "PA", "pan": "PA",
+	"costa rica": "CR", "cri": "CR",
+	"guatemala": "GT", "gtm": "GT",
+	"bolivia": "BO", "bol": "BO",
+	"paraguay": "PY", "pry": "PY",
+	"uruguay": "UY", "ury": "UY",
+	"republica dominicana": "DO", "dom": "DO",
+	"estados unidos": "US", "united states": "US", "usa": "US",
+	"espana": "ES", "spain": "ES", "esp": "ES",
+}
+
+// matchDepartment busca el departamento por nombre o código
+func (g *gazetteer) matchDepartment(state string) string {
+	key := normalize.Text(state)
+	if key == "" {
+		return ""
+	}
+	if code, ok := g.deptIndex[key]; ok {
+		return code
+	}
+	if _, ok := g.departments[key]; ok {
+		return key
+	}
+	if code, ok := g.deptIndex[trimDistrictSuffix(key)]; ok {
+		return code
+	}
+	return ""
+}
+
+// matchCity busca la ciudad por nombre exacto, por código oficial, sin sufijos
+// ("bogota dc", "medellin antioquia") y finalmente por similitud para tolerar errores de digitación.
+// Ante homónimos se prefiere el municipio del departamento indicado.
+func (g *gazetteer) matchCity(city, stateCode string) (Municipality, bool) {
+	key := normalize.Text(city)
+	if key == "" {
+		return Municipality{}, false
+	}
+	if m, ok := g.municipalities[key]; ok {
+		return m, true
+	}
+
+	candidates := []string{key, trimDistrictSuffix(key)}
+	// "Medellín, Antioquia" / "Cali - Valle": quedarse con la parte antes del separador
+	if i := strings.IndexAny(city, ",-/("); i > 0 {
+		candidates = append(candidates, normalize.Text(city[:i]))
+	}
+	for _, candidate := range candidates {
+		if codes, ok := g.cityIndex[candidate]; ok {
+			return g.pickCity(codes, stateCode), true
+		}
+	}
+
+	// Prefijo: "bogota cundinamarca colombia" -> "bogota"
+	tokens := strings.Fields(key)
+	for n := len(tokens) - 1; n >= 1; n-- {
+		if codes, ok := g.cityIndex[strings.Join(tokens[:n], " ")]; ok {
+			return g.pickCity(codes, stateCode), true
+		}
+	}
+
+	if len(key) < cityFuzzyMinNameSize {
+		return Municipality{}, false
+	}
+	maxDistance := cityFuzzyMaxDistance
+	if len(key) >= cityFuzzyLongName {
+		maxDistance++
+	}
+	// Se recorre el índice completo; ante empate gana el nombre menor para que el resultado sea estable
+	bestDistance, bestName := maxDistance+1, ""
+	for name := range g.cityIndex {
+		d := normalize.EditDistance(key, name)
+		if d < bestDistance || (d == bestDistance && name < bestName) {
+			bestDistance, bestName = d, name
+		}
+	}
+	if bestName != "" {
+		return g.pickCity(g.cityIndex[bestName], stateCode), true
+	}
+	return Municipality{}, false
+}
+
+// pickCity elige entre homónimos el municipio del departamento indicado, o el de mayor prioridad
+func (g *gazetteer) pickCity(codes []string, stateCode string) Municipality {
+	for _, code := range codes {
+		if m := g.municipalities[code]; m.DepartmentCode == stateCode {
+			return m
+		}
+	}
+	return g.municipalities[codes[0]]
+}
+
+// trimDistrictSuffix elimina sufijos de distrito como "d c", "dc" o "distrito capital"
+func trimDistrictSuffix(key string) string {
+	for _, suffix := range []string{" distrito capital", " d c", " dc", " distrito"} {
+		if strings.HasSuffix(key, suffix) {
+			return strings.TrimSuffix(key, suffix)
+		}
+	}
+	return key
+}
+
+// streetTypes estandariza las abreviaturas de tipo de vía
+var streetTypes = map[string]string{
+	"calle": "calle", "cll": "calle", "cl": "calle", "cal": "calle", "clle": "calle",
+	"carrera": "carrera", "cra": "carrera", "cr": "carrera", "kr": "carrera", "kra": "carrera", "crr": "carrera", "carr": "carrera",
+	"avenida": "avenida", "av": "avenida", "avda": "avenida", "ave": "avenida",
+	"diagonal": "diagonal", "dg": "diagonal", "diag": "diagonal",
+	"transversal": "transversal", "tv": "transversal", "tr": "transversal", "trans": "transversal", "transv": "transversal",
+	"circular": "circular", "cq": "circular", "circ": "circular",
+	"autopista": "autopista", "aut": "autopista", "autop": "autopista",
+	"kilometro": "kilometro", "km": "kilometro",
+}
+
+// streetUnitMarkers inician el detalle de la unidad (apartamento, torre, oficina), que pertenece
+// a la segunda línea de la dirección y no cambia las coordenadas
+var streetUnitMarkers = map[string]bool{
+	"apto": true, "apt": true, "apartamento": true, "ap": true, "int": true, "interior": true,
+	"torre": true, "casa": true, "oficina": true, "ofc": true, "piso": true, "bloque": true, "local": true,
+}
+
+// streetNumberMarkers son las palabras que preceden a la placa ("#", "No.", "Nro")
+var streetNumberMarkers = map[string]bool{"no": true, "nro": true, "num": true, "numero": true, "n": true}
+
+// normalizeStreet estandariza tipos de vía, elimina marcadores de número y el detalle de la unidad:
+// "Cra. 7 # 72-41 apto 301" y "carrera 7 no 72 41" quedan como "carrera 7 72 41"
+func normalizeStreet(street string) string {
+	tokens := strings.Fields(normalize.Text(splitDigitsAndLetters(street)))
+	out := make([]string, 0, len(tokens))
+	for i, t := range tokens {
+		// El detalle de la unidad solo se descarta después de la vía y la placa
+		if i >= 2 && streetUnitMarkers[t] {
+			break
+		}
+		if canonical, ok := streetTypes[t]; ok {
+			out = append(out, canonical)
+			continue
+		}
+		if streetNumberMarkers[t] {
+			continue
+		}
+		out = append(out, t)
+	}
+	return strings.Join(out, " ")
+}
+
+// splitDigitsAndLetters separa tipos de vía pegados al número ("Cl10" -> "Cl 10")
+// sin separar sufijos de placa ("10A" se conserva)
+func splitDigitsAndLetters(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if i > 0 && unicode.IsDigit(r) && unicode.IsLetter(runes[i-1]) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// hasStreetNumber indica si la calle tiene vía y placa (al menos dos grupos numéricos)
+func hasStreetNumber(street string) bool {
+	groups := 0
+	for _, t := range strings.Fields(street) {
+		if t != "" && unicode.IsDigit([]rune(t)[0]) {
+			groups++
+		}
+	}
+	return groups >= 2
+}
+
+// completeness calcula el puntaje de completitud (0-100) y los campos faltantes
+func completeness(n Normalized, countryGiven bool) (float64, []string) {
+	score := 0.0
+	var missing []string
+
+	if n.Street != "" {
+		score += weightStreet
+	} else {
+		missing = append(missing, FieldStreet)
+	}
+	if hasStreetNumber(n.Street) {
+		score += weightStreetNumber
+	} else {
+		missing = append(missing, FieldStreetNumber)
+	}
+	switch {
+	case n.CityMatched:
+		score += weightCity
+	case n.City != "":
+		score += weightCityUnmatched
+	default:
+		missing = append(missing, FieldCity)
+	}
+	if n.State != "" {
+		score += weightState
+	} else {
+		missing = append(missing, FieldState)
+	}
+	// El país se considera conocido si viene en la dirección o si la ciudad se reconoció en su gazetteer
+	if countryGiven || n.CityMatched {
+		score += weightCountry
+	} else {
+		missing = append(missing, FieldCountry)
+	}
+	if n.PostalCode != "" {
+		score += weightPostalCode
+	} else {
+		missing = append(missing, FieldPostalCode)
+	}
+	return score, missing
+}
+
+// buildKey construye la clave estable de la dirección: país, ciudad y calle normalizadas.
+// La segunda línea (apartamento, oficina) no afecta las coordenadas y no forma parte de la clave.
+func buildKey(n Normalized) string {
+	city := n.CityCode
+	if city == "" {
+		city = normalize.Text(n.City)
+	}
+	return strings.Join([]string{n.Country, city, n.Street}, "|")
+}
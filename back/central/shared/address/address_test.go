@@ -0,0 +1,144 @@
+package address
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeCity(t *testing.T) {
+	tests := []struct {
+		name      string
+		addr      Address
+		cityCode  string
+		city      string
+		stateCode string
+	}{
+		{name: "nombre oficial", addr: Address{City: "Bogotá D.C."}, cityCode: "11001", city: "Bogotá D.C.", stateCode: "11"},
+		{name: "sin tildes ni sufijo", addr: Address{City: "bogota dc"}, cityCode: "11001", city: "Bogotá D.C.", stateCode: "11"},
+		{name: "mayúsculas", addr: Address{City: "BOGOTÁ D.C."}, cityCode: "11001", city: "Bogotá D.C.", stateCode: "11"},
+		{name: "la ciudad define el departamento", addr: Address{City: "Bogotá", State: "Cundinamarca"}, cityCode: "11001", city: "Bogotá D.C.", stateCode: "11"},
+		{name: "departamento después del separador", addr: Address{City: "Medellín, Antioquia"}, cityCode: "05001", city: "Medellín", stateCode: "05"},
+		{name: "alias", addr: Address{City: "Santiago de Cali"}, cityCode: "76001", city: "Cali", stateCode: "76"},
+		{name: "error de digitación", addr: Address{City: "Barranqilla"}, cityCode: "08001", city: "Barranquilla", stateCode: "08"},
+		{name: "ciudad desconocida", addr: Address{City: "Gotham"}, city: "Gotham"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.addr, DefaultCountry)
+			if got.CityCode != tt.cityCode || got.City != tt.city || got.StateCode != tt.stateCode {
+				t.Errorf("Normalize(%+v) = city %q (%q) state %q, want %q (%q) state %q",
+					tt.addr, got.City, got.CityCode, got.StateCode, tt.city, tt.cityCode, tt.stateCode)
+			}
+			if got.CityMatched != (tt.cityCode != "") {
+				t.Errorf("CityMatched = %v, want %v", got.CityMatched, tt.cityCode != "")
+			}
+		})
+	}
+}
+
+func TestNormalizeStreet(t *testing.T) {
+	tests := []struct {
+		street string
+		want   string
+	}{
+		{street: "Cra. 7 # 72-41", want: "carrera 7 72 41"},
+		{street: "carrera 7 no 72 41", want: "carrera 7 72 41"},
+		{street: "Cra. 7 # 72-41 apto 301", want: "carrera 7 72 41"},
+		{street: "Cl10 # 43E-31", want: "calle 10 43e 31"},
+		{street: "AV 6N 23 50", want: "avenida 6n 23 50"},
+		{street: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeStreet(tt.street); got != tt.want {
+			t.Errorf("normalizeStreet(%q) = %q, want %q", tt.street, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	a := Normalize(Address{Street: "Cra. 7 # 72-41", City: "BOGOTÁ D.C."}, "CO")
+	b := Normalize(Address{Street: "carrera 7 no 72 41", Street2: "apto 301", City: "bogota", Country: "Colombia"}, "CO")
+	if a.Key != b.Key {
+		t.Errorf("las claves deberían coincidir: %q != %q", a.Key, b.Key)
+	}
+	if a.Key != "CO|11001|carrera 7 72 41" {
+		t.Errorf("Key = %q", a.Key)
+	}
+
+	c := Normalize(Address{Street: "Cra. 7 # 72-43", City: "Bogotá"}, "CO")
+	if a.Key == c.Key {
+		t.Errorf("placas distintas no deberían compartir clave: %q", c.Key)
+	}
+}
+
+func TestNormalizeCompleteness(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    Address
+		score   float64
+		missing []string
+	}{
+		{
+			name:  "completa",
+			addr:  Address{Street: "Calle 10 # 43E-31", City: "Medellín", Country: "CO", PostalCode: "050021"},
+			score: 100,
+		},
+		{
+			name:    "sin placa ni código postal",
+			addr:    Address{Street: "Calle 72", City: "Barranquilla"},
+			score:   weightStreet + weightCity + weightState + weightCountry,
+			missing: []string{FieldStreetNumber, FieldPostalCode},
+		},
+		{
+			name:    "ciudad no reconocida",
+			addr:    Address{Street: "Calle 1 # 2-3", City: "Gotham"},
+			score:   weightStreet + weightStreetNumber + weightCityUnmatched,
+			missing: []string{FieldState, FieldCountry, FieldPostalCode},
+		},
+		{
+			name:    "vacía",
+			addr:    Address{},
+			score:   0,
+			missing: []string{FieldStreet, FieldStreetNumber, FieldCity, FieldState, FieldCountry, FieldPostalCode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.addr, DefaultCountry)
+			if got.Completeness != tt.score {
+				t.Errorf("Completeness = %v, want %v", got.Completeness, tt.score)
+			}
+			if !reflect.DeepEqual(got.Missing, tt.missing) {
+				t.Errorf("Missing = %v, want %v", got.Missing, tt.missing)
+			}
+		})
+	}
+}
+
+func TestNormalizedCentroid(t *testing.T) {
+	lat, lng, ok := Normalize(Address{City: "Cali"}, "CO").Centroid()
+	if !ok || lat != 3.4516 || lng != -76.5320 {
+		t.Errorf("Centroid() = %v, %v, %v; want el centroide de Cali", lat, lng, ok)
+	}
+	if _, _, ok := Normalize(Address{City: "Gotham"}, "CO").Centroid(); ok {
+		t.Error("una ciudad no reconocida no debería tener centroide")
+	}
+}
+
+func TestNormalizeCountry(t *testing.T) {
+	tests := map[string]string{
+		"Colombia": "CO",
+		"co":       "CO",
+		" pe ":     "PE",
+		"":         "",
+		"Narnia":   "",
+	}
+	for input, want := range tests {
+		if got := NormalizeCountry(input); got != want {
+			t.Errorf("NormalizeCountry(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
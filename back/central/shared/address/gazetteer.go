@@ -0,0 +1,190 @@
+package address
+
+import "github.com/secamc93/probability/back/central/shared/normalize"
+
+// Department es una división administrativa de primer nivel (departamento/estado)
+type Department struct {
+	Code    string // Código oficial (DANE para Colombia)
+	Name    string
+	aliases []string
+}
+
+// Municipality es una ciudad o municipio del gazetteer con su centroide
+type Municipality struct {
+	Code           string // Código oficial (DANE para Colombia)
+	Name           string
+	DepartmentCode string
+	Lat            float64
+	Lng            float64
+	aliases        []string
+}
+
+// gazetteer es el diccionario de referencia de un país, indexado por nombre normalizado
+type gazetteer struct {
+	departments    map[string]Department
+	municipalities map[string]Municipality
+	deptIndex      map[string]string   // nombre normalizado -> código de departamento
+	cityIndex      map[string][]string // nombre normalizado -> códigos de municipio (en orden de prioridad)
+}
+
+// newGazetteer construye los índices por nombre normalizado.
+// Los municipios se registran en el orden recibido, que define la prioridad ante homónimos.
+func newGazetteer(departments []Department, municipalities []Municipality) *gazetteer {
+	g := &gazetteer{
+		departments:    make(map[string]Department, len(departments)),
+		municipalities: make(map[string]Municipality, len(municipalities)),
+		deptIndex:      make(map[string]string),
+		cityIndex:      make(map[string][]string),
+	}
+	for _, d := range departments {
+		g.departments[d.Code] = d
+		for _, name := range append([]string{d.Name}, d.aliases...) {
+			g.deptIndex[normalize.Text(name)] = d.Code
+		}
+	}
+	for _, m := range municipalities {
+		g.municipalities[m.Code] = m
+		for _, name := range append([]string{m.Name}, m.aliases...) {
+			key := normalize.Text(name)
+			g.cityIndex[key] = append(g.cityIndex[key], m.Code)
+		}
+	}
+	return g
+}
+
+// gazetteers contiene los diccionarios de referencia por país (ISO 3166-1 alfa-2)
+var gazetteers = map[string]*gazetteer{
+	"CO": newGazetteer(colombiaDepartments, colombiaMunicipalities),
+}
+
+// colombiaDepartments son los departamentos de Colombia con su código DANE
+var colombiaDepartments = []Department{
+	{Code: "05", Name: "Antioquia"},
+	{Code: "08", Name: "Atlántico"},
+	{Code: "11", Name: "Bogotá D.C.", aliases: []string{"Bogotá", "Bogota DC", "Bogotá Distrito Capital", "Distrito Capital", "Santa Fe de Bogotá"}},
+	{Code: "13", Name: "Bolívar"},
+	{Code: "15", Name: "Boyacá"},
+	{Code: "17", Name: "Caldas"},
+	{Code: "18", Name: "Caquetá"},
+	{Code: "19", Name: "Cauca"},
+	{Code: "20", Name: "Cesar"},
+	{Code: "23", Name: "Córdoba"},
+	{Code: "25", Name: "Cundinamarca"},
+	{Code: "27", Name: "Chocó"},
+	{Code: "41", Name: "Huila"},
+	{Code: "44", Name: "La Guajira", aliases: []string{"Guajira"}},
+	{Code: "47", Name: "Magdalena"},
+	{Code: "50", Name: "Meta"},
+	{Code: "52", Name: "Nariño"},
+	{Code: "54", Name: "Norte de Santander", aliases: []string{"Norte Santander", "N Santander"}},
+	{Code: "63", Name: "Quindío"},
+	{Code: "66", Name: "Risaralda"},
+	{Code: "68", Name: "Santander"},
+	{Code: "70", Name: "Sucre"},
+	{Code: "73", Name: "Tolima"},
+	{Code: "76", Name: "Valle del Cauca", aliases: []string{"Valle"}},
+	{Code: "81", Name: "Arauca"},
+	{Code: "85", Name: "Casanare"},
+	{Code: "86", Name: "Putumayo"},
+	{Code: "88", Name: "San Andrés, Providencia y Santa Catalina", aliases: []string{"San Andrés", "San Andrés y Providencia", "Archipiélago de San Andrés"}},
+	{Code: "91", Name: "Amazonas"},
+	{Code: "94", Name: "Guainía"},
+	{Code: "95", Name: "Guaviare"},
+	{Code: "97", Name: "Vaupés"},
+	{Code: "99", Name: "Vichada"},
+}
+
+// colombiaMunicipalities son las capitales departamentales y los municipios de mayor volumen
+// de envíos, con su código DANE y el centroide aproximado del casco urbano
+var colombiaMunicipalities = []Municipality{
+	// Capitales
+	{Code: "11001", Name: "Bogotá D.C.", DepartmentCode: "11", Lat: 4.7110, Lng: -74.0721, aliases: []string{"Bogotá", "Bogota DC", "Bogotá Distrito Capital", "Santa Fe de Bogotá", "Bogotá Cundinamarca"}},
+	{Code: "05001", Name: "Medellín", DepartmentCode: "05", Lat: 6.2442, Lng: -75.5812},
+	{Code: "76001", Name: "Cali", DepartmentCode: "76", Lat: 3.4516, Lng: -76.5320, aliases: []string{"Santiago de Cali"}},
+	{Code: "08001", Name: "Barranquilla", DepartmentCode: "08", Lat: 10.9685, Lng: -74.7813},
+	{Code: "13001", Name: "Cartagena", DepartmentCode: "13", Lat: 10.3910, Lng: -75.4794, aliases: []string{"Cartagena de Indias"}},
+	{Code: "68001", Name: "Bucaramanga", DepartmentCode: "68", Lat: 7.1193, Lng: -73.1227},
+	{Code: "54001", Name: "Cúcuta", DepartmentCode: "54", Lat: 7.8939, Lng: -72.5078, aliases: []string{"San José de Cúcuta"}},
+	{Code: "66001", Name: "Pereira", DepartmentCode: "66", Lat: 4.8133, Lng: -75.6961},
+	{Code: "17001", Name: "Manizales", DepartmentCode: "17", Lat: 5.0703, Lng: -75.5138},
+	{Code: "63001", Name: "Armenia", DepartmentCode: "63", Lat: 4.5339, Lng: -75.6811},
+	{Code: "73001", Name: "Ibagué", DepartmentCode: "73", Lat: 4.4389, Lng: -75.2322},
+	{Code: "47001", Name: "Santa Marta", DepartmentCode: "47", Lat: 11.2408, Lng: -74.1990},
+	{Code: "50001", Name: "Villavicencio", DepartmentCode: "50", Lat: 4.1420, Lng: -73.6266},
+	{Code: "41001", Name: "Neiva", DepartmentCode: "41", Lat: 2.9273, Lng: -75.2819},
+	{Code: "52001", Name: "Pasto", DepartmentCode: "52", Lat: 1.2136, Lng: -77.2811, aliases: []string{"San Juan de Pasto"}},
+	{Code: "20001", Name: "Valledupar", DepartmentCode: "20", Lat: 10.4631, Lng: -73.2532},
+	{Code: "23001", Name: "Montería", DepartmentCode: "23", Lat: 8.7479, Lng: -75.8814},
+	{Code: "70001", Name: "Sincelejo", DepartmentCode: "70", Lat: 9.3047, Lng: -75.3978},
+	{Code: "15001", Name: "Tunja", DepartmentCode: "15", Lat: 5.5353, Lng: -73.3678},
+	{Code: "19001", Name: "Popayán", DepartmentCode: "19", Lat: 2.4448, Lng: -76.6147},
+	{Code: "44001", Name: "Riohacha", DepartmentCode: "44", Lat: 11.5444, Lng: -72.9072},
+	{Code: "27001", Name: "Quibdó", DepartmentCode: "27", Lat: 5.6947, Lng: -76.6611},
+	{Code: "18001", Name: "Florencia", DepartmentCode: "18", Lat: 1.6144, Lng: -75.6062},
+	{Code: "81001", Name: "Arauca", DepartmentCode: "81", Lat: 7.0847, Lng: -70.7591},
+	{Code: "85001", Name: "Yopal", DepartmentCode: "85", Lat: 5.3378, Lng: -72.3959},
+	{Code: "86001", Name: "Mocoa", DepartmentCode: "86", Lat: 1.1522, Lng: -76.6466},
+	{Code: "88001", Name: "San Andrés", DepartmentCode: "88", Lat: 12.5847, Lng: -81.7006},
+	{Code: "91001", Name: "Leticia", DepartmentCode: "91", Lat: -4.2153, Lng: -69.9406},
+	{Code: "94001", Name: "Inírida", DepartmentCode: "94", Lat: 3.8653, Lng: -67.9239, aliases: []string{"Puerto Inírida"}},
+	{Code: "95001", Name: "San José del Guaviare", DepartmentCode: "95", Lat: 2.5729, Lng: -72.6459},
+	{Code: "97001", Name: "Mitú", DepartmentCode: "97", Lat: 1.2538, Lng: -70.2346},
+	{Code: "99001", Name: "Puerto Carreño", DepartmentCode: "99", Lat: 6.1890, Lng: -67.4859},
+
+	// Área metropolitana del Valle de Aburrá y Antioquia
+	{Code: "05088", Name: "Bello", DepartmentCode: "05", Lat: 6.3373, Lng: -75.5579},
+	{Code: "05360", Name: "Itagüí", DepartmentCode: "05", Lat: 6.1846, Lng: -75.5991},
+	{Code: "05266", Name: "Envigado", DepartmentCode: "05", Lat: 6.1759, Lng: -75.5917},
+	{Code: "05631", Name: "Sabaneta", DepartmentCode: "05", Lat: 6.1515, Lng: -75.6166},
+	{Code: "05380", Name: "La Estrella", DepartmentCode: "05", Lat: 6.1576, Lng: -75.6431},
+	{Code: "05615", Name: "Rionegro", DepartmentCode: "05", Lat: 6.1551, Lng: -75.3737},
+	{Code: "05045", Name: "Apartadó", DepartmentCode: "05", Lat: 7.8829, Lng: -76.6258},
+
+	// Sabana de Bogotá (Cundinamarca)
+	{Code: "25754", Name: "Soacha", DepartmentCode: "25", Lat: 4.5794, Lng: -74.2168},
+	{Code: "25175", Name: "Chía", DepartmentCode: "25", Lat: 4.8619, Lng: -74.0325},
+	{Code: "25899", Name: "Zipaquirá", DepartmentCode: "25", Lat: 5.0221, Lng: -74.0048},
+	{Code: "25269", Name: "Facatativá", DepartmentCode: "25", Lat: 4.8137, Lng: -74.3545},
+	{Code: "25290", Name: "Fusagasugá", DepartmentCode: "25", Lat: 4.3365, Lng: -74.3638},
+	{Code: "25307", Name: "Girardot", DepartmentCode: "25", Lat: 4.3032, Lng: -74.8031},
+	{Code: "25473", Name: "Mosquera", DepartmentCode: "25", Lat: 4.7059, Lng: -74.2302},
+	{Code: "25430", Name: "Madrid", DepartmentCode: "25", Lat: 4.7325, Lng: -74.2642},
+	{Code: "25286", Name: "Funza", DepartmentCode: "25", Lat: 4.7166, Lng: -74.2117},
+	{Code: "25126", Name: "Cajicá", DepartmentCode: "25", Lat: 4.9186, Lng: -74.0280},
+
+	// Área metropolitana de Barranquilla y Caribe
+	{Code: "08758", Name: "Soledad", DepartmentCode: "08", Lat: 10.9184, Lng: -74.7646},
+	{Code: "08433", Name: "Malambo", DepartmentCode: "08", Lat: 10.8597, Lng: -74.7739},
+	{Code: "08573", Name: "Puerto Colombia", DepartmentCode: "08", Lat: 10.9878, Lng: -74.9547},
+	{Code: "13430", Name: "Magangué", DepartmentCode: "13", Lat: 9.2414, Lng: -74.7547},
+	{Code: "13836", Name: "Turbaco", DepartmentCode: "13", Lat: 10.3319, Lng: -75.4142},
+	{Code: "47189", Name: "Ciénaga", DepartmentCode: "47", Lat: 11.0070, Lng: -74.2470},
+	{Code: "20011", Name: "Aguachica", DepartmentCode: "20", Lat: 8.3084, Lng: -73.6166},
+
+	// Valle del Cauca
+	{Code: "76520", Name: "Palmira", DepartmentCode: "76", Lat: 3.5394, Lng: -76.3036},
+	{Code: "76109", Name: "Buenaventura", DepartmentCode: "76", Lat: 3.8801, Lng: -77.0312},
+	{Code: "76834", Name: "Tuluá", DepartmentCode: "76", Lat: 4.0847, Lng: -76.1954},
+	{Code: "76364", Name: "Jamundí", DepartmentCode: "76", Lat: 3.2607, Lng: -76.5400},
+	{Code: "76892", Name: "Yumbo", DepartmentCode: "76", Lat: 3.5850, Lng: -76.4959},
+	{Code: "76147", Name: "Cartago", DepartmentCode: "76", Lat: 4.7464, Lng: -75.9117},
+	{Code: "76111", Name: "Guadalajara de Buga", DepartmentCode: "76", Lat: 3.9009, Lng: -76.2978, aliases: []string{"Buga"}},
+
+	// Santanderes
+	{Code: "68276", Name: "Floridablanca", DepartmentCode: "68", Lat: 7.0622, Lng: -73.0864},
+	{Code: "68307", Name: "Girón", DepartmentCode: "68", Lat: 7.0682, Lng: -73.1698, aliases: []string{"San Juan de Girón"}},
+	{Code: "68547", Name: "Piedecuesta", DepartmentCode: "68", Lat: 6.9870, Lng: -73.0497},
+	{Code: "54874", Name: "Villa del Rosario", DepartmentCode: "54", Lat: 7.8336, Lng: -72.4742},
+	{Code: "54498", Name: "Ocaña", DepartmentCode: "54", Lat: 8.2378, Lng: -73.3560},
+
+	// Eje cafetero, centro y sur
+	{Code: "66170", Name: "Dosquebradas", DepartmentCode: "66", Lat: 4.8392, Lng: -75.6673},
+	{Code: "63130", Name: "Calarcá", DepartmentCode: "63", Lat: 4.5293, Lng: -75.6431},
+	{Code: "15238", Name: "Duitama", DepartmentCode: "15", Lat: 5.8267, Lng: -73.0338},
+	{Code: "15759", Name: "Sogamoso", DepartmentCode: "15", Lat: 5.7147, Lng: -72.9339},
+	{Code: "73268", Name: "Espinal", DepartmentCode: "73", Lat: 4.1492, Lng: -74.8843, aliases: []string{"El Espinal"}},
+	{Code: "41551", Name: "Pitalito", DepartmentCode: "41", Lat: 1.8537, Lng: -76.0510},
+	{Code: "19698", Name: "Santander de Quilichao", DepartmentCode: "19", Lat: 3.0094, Lng: -76.4849},
+	{Code: "52356", Name: "Ipiales", DepartmentCode: "52", Lat: 0.8302, Lng: -77.6444},
+	{Code: "52835", Name: "Tumaco", DepartmentCode: "52", Lat: 1.8067, Lng: -78.7647, aliases: []string{"San Andrés de Tumaco"}},
+}
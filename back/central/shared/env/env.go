@@ -169,6 +169,17 @@ type Config struct {
 	WhatsAppToken      string `env:"WHATSAPP_TOKEN,required"`
 	WhatsAppPhoneNumID string `env:"WHATSAPP_PHONE_NUMBER_ID,required"`
 
+	// Normalización de teléfonos
+	DefaultPhoneCountry string `env:"DEFAULT_PHONE_COUNTRY"` // País ISO para números sin código de país (por defecto CO)
+
+	// Geocodificación de direcciones de envío
+	GeocoderProvider      string `env:"GEOCODER_PROVIDER"`        // none o file (por defecto none: se usa el centroide de la ciudad)
+	GeocoderFilePath      string `env:"GEOCODER_FILE_PATH"`       // Archivo JSON del geocoder offline
+	GeocoderCacheTTLHours string `env:"GEOCODER_CACHE_TTL_HOURS"` // Vigencia del cache por dirección (por defecto 720)
+
+	// Inventario
+	InventoryInsufficientStockPolicy string `env:"INVENTORY_INSUFFICIENT_STOCK_POLICY"` // flag o reject ante stock insuficiente sin backorder (por defecto flag)
+
 	// DynamoDB
 	DynamoRegion    string `env:"DYNAMO_REGION"`
 	DynamoAccessKey string `env:"DYNAMO_ACCESS_KEY"`
@@ -182,7 +193,14 @@ type Config struct {
 	RabbitMQPass  string `env:"RABBITMQ_PASS,required"`
 	RabbitMQVHost string `env:"RABBITMQ_VHOST,required"`
 
-	RedisOrderEventsChannel string `env:"REDIS_ORDER_EVENTS_CHANNEL,required"`
+	RedisOrderEventsChannel    string `env:"REDIS_ORDER_EVENTS_CHANNEL,required"`
+	RedisShipmentEventsChannel string `env:"REDIS_SHIPMENT_EVENTS_CHANNEL"` // Por defecto probability:shipments:events
+	RedisProductEventsChannel  string `env:"REDIS_PRODUCT_EVENTS_CHANNEL"`  // Por defecto probability:products:events
+
+	// Monitoreo de salud de integraciones
+	IntegrationHealthCheckIntervalMinutes string `env:"INTEGRATION_HEALTH_CHECK_INTERVAL_MINUTES"` // 0 desactiva el scheduler (por defecto 15)
+	IntegrationHealthFailureThreshold     string `env:"INTEGRATION_HEALTH_FAILURE_THRESHOLD"`      // Fallos consecutivos para marcar degradada (por defecto 3)
+	IntegrationHealthDeactivateThreshold  string `env:"INTEGRATION_HEALTH_DEACTIVATE_THRESHOLD"`   // Fallos consecutivos para desactivar (por defecto 0 = nunca)
 }
 
 func splitTag(tag string) []string {
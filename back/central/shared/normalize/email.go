@@ -0,0 +1,39 @@
+package normalize
+
+import "strings"
+
+// dominios cuyo proveedor ignora los puntos en la parte local del correo
+var dotInsensitiveDomains = map[string]string{
+	"gmail.com":      "gmail.com",
+	"googlemail.com": "gmail.com",
+}
+
+// Email retorna el email en minúsculas y sin espacios
+func Email(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// EmailAliasKey retorna una clave que agrupa los alias de un mismo buzón.
+// Elimina el sufijo "+etiqueta" de la parte local y, para proveedores como
+// Gmail, también los puntos. Retorna "" si el email no es válido.
+func EmailAliasKey(email string) string {
+	email = Email(email)
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return ""
+	}
+
+	local, domain := email[:at], email[at+1:]
+	if plus := strings.Index(local, "+"); plus >= 0 {
+		local = local[:plus]
+	}
+	if canonical, ok := dotInsensitiveDomains[domain]; ok {
+		local = strings.ReplaceAll(local, ".", "")
+		domain = canonical
+	}
+	if local == "" {
+		return ""
+	}
+
+	return local + "@" + domain
+}
@@ -0,0 +1,108 @@
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Text retorna el texto en minúsculas, sin tildes, sin signos de puntuación
+// y con los espacios colapsados. Se usa para comparar nombres y direcciones.
+func Text(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(t, s)
+	if err != nil {
+		stripped = s
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(stripped) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// NameSimilarity compara dos nombres y retorna un valor entre 0 y 1.
+// Usa la similitud de Jaccard entre tokens, de modo que "Pérez Juan" y
+// "juan perez" sean equivalentes, combinada con la distancia de edición
+// para tolerar errores de digitación.
+func NameSimilarity(a, b string) float64 {
+	a, b = Text(a), Text(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	tokensA := strings.Fields(a)
+	tokensB := strings.Fields(b)
+	setB := make(map[string]bool, len(tokensB))
+	for _, t := range tokensB {
+		setB[t] = true
+	}
+	union := make(map[string]bool, len(tokensA)+len(tokensB))
+	intersection := 0
+	for _, t := range tokensA {
+		if setB[t] && !union[t] {
+			intersection++
+		}
+		union[t] = true
+	}
+	for _, t := range tokensB {
+		union[t] = true
+	}
+	jaccard := float64(intersection) / float64(len(union))
+
+	maxLen := max(len([]rune(a)), len([]rune(b)))
+	edit := 1 - float64(levenshtein(a, b))/float64(maxLen)
+
+	return max(jaccard, edit)
+}
+
+// levenshtein calcula la distancia de edición entre dos cadenas
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// EditDistance retorna la distancia de edición entre dos textos normalizados con Text
+func EditDistance(a, b string) int {
+	return levenshtein(Text(a), Text(b))
+}
+
+// DocumentID retorna un documento de identidad en mayúsculas y sin separadores,
+// de modo que "1.023.456-7" y "10234567" coincidan
+func DocumentID(doc string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(doc) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
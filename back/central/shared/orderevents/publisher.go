@@ -1,33 +1,32 @@
-package redis
+package orderevents
 
 import (
 	"context"
 	"encoding/json"
 
-	"github.com/secamc93/probability/back/central/services/modules/orders/internal/domain"
 	"github.com/secamc93/probability/back/central/shared/log"
 	redisclient "github.com/secamc93/probability/back/central/shared/redis"
 )
 
-// OrderEventPublisher publica eventos de órdenes a Redis Pub/Sub
-type OrderEventPublisher struct {
+// Publisher publica eventos de órdenes a Redis Pub/Sub
+type Publisher struct {
 	redisClient redisclient.IRedis
 	logger      log.ILogger
 	channel     string
 }
 
-// NewOrderEventPublisher crea un nuevo publicador de eventos de órdenes
-func NewOrderEventPublisher(redisClient redisclient.IRedis, logger log.ILogger, channel string) domain.IOrderEventPublisher {
-	return &OrderEventPublisher{
+// NewPublisher crea un nuevo publicador de eventos de órdenes en el canal indicado
+func NewPublisher(redisClient redisclient.IRedis, logger log.ILogger, channel string) *Publisher {
+	return &Publisher{
 		redisClient: redisClient,
 		logger:      logger,
 		channel:     channel,
 	}
 }
 
-// PublishOrderEvent publica un evento de orden a Redis
-func (p *OrderEventPublisher) PublishOrderEvent(ctx context.Context, event *domain.OrderEvent) error {
-	// Serializar evento a JSON
+// PublishOrderEvent publica un evento de orden a Redis. Si Redis no está conectado el evento
+// se descarta con una advertencia.
+func (p *Publisher) PublishOrderEvent(ctx context.Context, event *OrderEvent) error {
 	eventJSON, err := json.Marshal(event)
 	if err != nil {
 		p.logger.Error(ctx).
@@ -38,8 +37,16 @@ func (p *OrderEventPublisher) PublishOrderEvent(ctx context.Context, event *doma
 		return err
 	}
 
-	// Publicar a Redis
-	if err := p.redisClient.Client(ctx).Publish(ctx, p.channel, eventJSON).Err(); err != nil {
+	client := p.redisClient.Client(ctx)
+	if client == nil {
+		p.logger.Warn(ctx).
+			Str("event_id", event.ID).
+			Str("event_type", string(event.Type)).
+			Msg("Redis no disponible, evento de orden no publicado")
+		return nil
+	}
+
+	if err := client.Publish(ctx, p.channel, eventJSON).Err(); err != nil {
 		p.logger.Error(ctx).
 			Err(err).
 			Str("event_id", event.ID).
@@ -58,4 +65,3 @@ func (p *OrderEventPublisher) PublishOrderEvent(ctx context.Context, event *doma
 
 	return nil
 }
-
@@ -0,0 +1,105 @@
+package paymentledger
+
+import (
+	"math"
+	"time"
+)
+
+// Estados del pago (deben coincidir con models.Payment.Status)
+const (
+	StatusPending   = "pending"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+	StatusRefunded  = "refunded"
+)
+
+// AmountTolerance es la diferencia máxima que se considera redondeo al comparar montos
+const AmountTolerance = 0.01
+
+// Payment es lo que el saldo de la orden necesita de cada pago
+type Payment struct {
+	PaymentMethodID uint
+	Amount          float64
+	Status          string
+	PaidAt          *time.Time
+	RefundAmount    *float64
+}
+
+// NetPaid retorna lo que el pago suma al saldo pagado de la orden: el monto de los pagos
+// completados (o reembolsados) menos lo reembolsado
+func (p Payment) NetPaid() float64 {
+	if p.Status != StatusCompleted && p.Status != StatusRefunded {
+		return 0
+	}
+	refunded := 0.0
+	if p.RefundAmount != nil {
+		refunded = *p.RefundAmount
+	}
+	return math.Max(p.Amount-refunded, 0)
+}
+
+// Summary es el saldo de una orden calculado con todos sus pagos
+type Summary struct {
+	TotalAmount     float64
+	PaidAmount      float64 // Pagos completados, netos de reembolsos
+	PendingAmount   float64 // Pagos registrados aún sin confirmar
+	RefundedAmount  float64 // Reembolsado de los pagos
+	BalanceDue      float64 // Lo que falta por pagar
+	IsPaid          bool
+	PaidAt          *time.Time // Último pago completado (nil si la orden no está pagada)
+	PaymentMethodID uint       // Método del pago principal (0 = sin pagos)
+	PaymentCount    int
+}
+
+// Summarize calcula el saldo de una orden con todos sus pagos. La orden queda pagada cuando los
+// pagos completados cubren el total; PaidAt es el último pago completado. El método de pago
+// principal es el del pago que más aporta (o, sin pagos completados, el del mayor pago no fallido).
+func Summarize(totalAmount float64, payments []Payment) Summary {
+	summary := Summary{
+		TotalAmount:  totalAmount,
+		PaymentCount: len(payments),
+	}
+
+	var primaryPaid, primaryAny float64
+	var paidMethod, anyMethod uint
+	for _, p := range payments {
+		net := p.NetPaid()
+		summary.PaidAmount += net
+		if p.RefundAmount != nil {
+			summary.RefundedAmount += *p.RefundAmount
+		}
+		if p.Status == StatusPending {
+			summary.PendingAmount += p.Amount
+		}
+
+		if net > 0 && p.PaidAt != nil && (summary.PaidAt == nil || p.PaidAt.After(*summary.PaidAt)) {
+			summary.PaidAt = p.PaidAt
+		}
+		if net > primaryPaid {
+			primaryPaid, paidMethod = net, p.PaymentMethodID
+		}
+		if p.Status != StatusFailed && (anyMethod == 0 || p.Amount > primaryAny) {
+			primaryAny, anyMethod = p.Amount, p.PaymentMethodID
+		}
+	}
+
+	summary.PaidAmount = RoundAmount(summary.PaidAmount)
+	summary.PendingAmount = RoundAmount(summary.PendingAmount)
+	summary.RefundedAmount = RoundAmount(summary.RefundedAmount)
+	summary.BalanceDue = RoundAmount(math.Max(totalAmount-summary.PaidAmount, 0))
+	summary.IsPaid = summary.PaidAmount > 0 && summary.BalanceDue < AmountTolerance
+	if !summary.IsPaid {
+		summary.PaidAt = nil
+	}
+
+	summary.PaymentMethodID = paidMethod
+	if summary.PaymentMethodID == 0 {
+		summary.PaymentMethodID = anyMethod
+	}
+	return summary
+}
+
+// RoundAmount redondea un monto a dos decimales
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
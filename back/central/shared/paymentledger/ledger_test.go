@@ -0,0 +1,142 @@
+package paymentledger
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPaymentNetPaid(t *testing.T) {
+	refund := func(amount float64) *float64 { return &amount }
+
+	tests := []struct {
+		name    string
+		payment Payment
+		want    float64
+	}{
+		{name: "completado", payment: Payment{Amount: 100, Status: StatusCompleted}, want: 100},
+		{name: "completado con reembolso parcial", payment: Payment{Amount: 100, Status: StatusCompleted, RefundAmount: refund(30)}, want: 70},
+		{name: "reembolsado por completo", payment: Payment{Amount: 100, Status: StatusRefunded, RefundAmount: refund(100)}, want: 0},
+		{name: "reembolso mayor al monto", payment: Payment{Amount: 100, Status: StatusRefunded, RefundAmount: refund(120)}, want: 0},
+		{name: "pendiente", payment: Payment{Amount: 100, Status: StatusPending}, want: 0},
+		{name: "fallido", payment: Payment{Amount: 100, Status: StatusFailed}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.payment.NetPaid(); got != tt.want {
+				t.Errorf("NetPaid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	day := func(d int) *time.Time {
+		at := time.Date(2026, 1, d, 12, 0, 0, 0, time.UTC)
+		return &at
+	}
+	refund := func(amount float64) *float64 { return &amount }
+
+	tests := []struct {
+		name     string
+		total    float64
+		payments []Payment
+		want     Summary
+	}{
+		{
+			name:  "sin pagos",
+			total: 100,
+			want:  Summary{TotalAmount: 100, BalanceDue: 100},
+		},
+		{
+			name:  "pago completo",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 100, Status: StatusCompleted, PaidAt: day(2)},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 100, IsPaid: true, PaidAt: day(2), PaymentMethodID: 1, PaymentCount: 1},
+		},
+		{
+			name:  "abonos: PaidAt es el último pago y el método principal el que más aporta",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 30, Status: StatusCompleted, PaidAt: day(5)},
+				{PaymentMethodID: 2, Amount: 70, Status: StatusCompleted, PaidAt: day(3)},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 100, IsPaid: true, PaidAt: day(5), PaymentMethodID: 2, PaymentCount: 2},
+		},
+		{
+			name:  "pago parcial con pendiente",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 40, Status: StatusCompleted, PaidAt: day(1)},
+				{PaymentMethodID: 2, Amount: 60, Status: StatusPending},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 40, PendingAmount: 60, BalanceDue: 60, PaymentMethodID: 1, PaymentCount: 2},
+		},
+		{
+			name:  "reembolso parcial deja saldo pendiente",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 100, Status: StatusCompleted, PaidAt: day(1), RefundAmount: refund(25)},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 75, RefundedAmount: 25, BalanceDue: 25, PaymentMethodID: 1, PaymentCount: 1},
+		},
+		{
+			name:  "sin pagos completados usa el mayor pago no fallido",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 3, Amount: 100, Status: StatusFailed},
+				{PaymentMethodID: 1, Amount: 20, Status: StatusPending},
+				{PaymentMethodID: 2, Amount: 50, Status: StatusPending},
+			},
+			want: Summary{TotalAmount: 100, PendingAmount: 70, BalanceDue: 100, PaymentMethodID: 2, PaymentCount: 3},
+		},
+		{
+			name:  "diferencia de redondeo se considera pagada",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 33.333, Status: StatusCompleted, PaidAt: day(1)},
+				{PaymentMethodID: 1, Amount: 33.333, Status: StatusCompleted, PaidAt: day(1)},
+				{PaymentMethodID: 1, Amount: 33.333, Status: StatusCompleted, PaidAt: day(1)},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 100, IsPaid: true, PaidAt: day(1), PaymentMethodID: 1, PaymentCount: 3},
+		},
+		{
+			name:  "pago mayor al total no deja saldo negativo",
+			total: 100,
+			payments: []Payment{
+				{PaymentMethodID: 1, Amount: 120, Status: StatusCompleted, PaidAt: day(1)},
+			},
+			want: Summary{TotalAmount: 100, PaidAmount: 120, IsPaid: true, PaidAt: day(1), PaymentMethodID: 1, PaymentCount: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Summarize(tt.total, tt.payments)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Summarize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundAmount(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   float64
+	}{
+		{amount: 19.899999999999999, want: 19.9},
+		{amount: 0.005, want: 0.01},
+		{amount: 10.004, want: 10},
+		{amount: -2.345, want: -2.35},
+	}
+
+	for _, tt := range tests {
+		if got := RoundAmount(tt.amount); got != tt.want {
+			t.Errorf("RoundAmount(%v) = %v, want %v", tt.amount, got, tt.want)
+		}
+	}
+}
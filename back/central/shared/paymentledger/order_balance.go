@@ -0,0 +1,64 @@
+package paymentledger
+
+import (
+	"time"
+
+	"github.com/secamc93/probability/back/migration/shared/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FromModels convierte los pagos persistidos a la entrada del cálculo de saldo
+func FromModels(payments []models.Payment) []Payment {
+	result := make([]Payment, len(payments))
+	for i, p := range payments {
+		result[i] = Payment{
+			PaymentMethodID: p.PaymentMethodID,
+			Amount:          p.Amount,
+			Status:          p.Status,
+			PaidAt:          p.PaidAt,
+			RefundAmount:    p.RefundAmount,
+		}
+	}
+	return result
+}
+
+// RecomputeOrder recalcula, dentro de la transacción tx, el saldo de la orden con todos sus pagos y
+// guarda en la orden is_paid, paid_at y el método de pago principal. Bloquea la orden para que dos
+// pagos o reembolsos simultáneos no se pisen. Si la orden ya estaba pagada y ningún pago trae fecha,
+// conserva su paid_at (o usa la hora actual). Retorna gorm.ErrRecordNotFound si la orden no existe.
+func RecomputeOrder(tx *gorm.DB, orderID string) (*Summary, error) {
+	var order models.Order
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "total_amount", "payment_method_id", "is_paid", "paid_at").
+		Where("id = ?", orderID).
+		First(&order).Error; err != nil {
+		return nil, err
+	}
+
+	var payments []models.Payment
+	if err := tx.Where("order_id = ?", orderID).Order("created_at ASC").Find(&payments).Error; err != nil {
+		return nil, err
+	}
+
+	summary := Summarize(order.TotalAmount, FromModels(payments))
+	if summary.IsPaid && summary.PaidAt == nil {
+		paidAt := time.Now()
+		if order.PaidAt != nil {
+			paidAt = *order.PaidAt
+		}
+		summary.PaidAt = &paidAt
+	}
+
+	updates := map[string]interface{}{
+		"is_paid": summary.IsPaid,
+		"paid_at": summary.PaidAt,
+	}
+	if summary.PaymentMethodID > 0 && summary.PaymentMethodID != order.PaymentMethodID {
+		updates["payment_method_id"] = summary.PaymentMethodID
+	}
+	if err := tx.Model(&models.Order{}).Where("id = ?", orderID).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
@@ -0,0 +1,133 @@
+package phone
+
+// lineRule clasifica un número nacional por su prefijo y, opcionalmente, su longitud
+type lineRule struct {
+	prefix string
+	length int // 0 = cualquier longitud válida
+}
+
+// countryMeta describe el plan de numeración de un país
+type countryMeta struct {
+	callingCode string
+	minLen      int    // Longitud mínima del número nacional (sin código de país ni prefijo troncal)
+	maxLen      int    // Longitud máxima del número nacional
+	trunk       string // Prefijo troncal usado al marcar dentro del país ("0" en muchos países)
+	mobile      []lineRule
+	landline    []lineRule
+}
+
+// countries es el plan de numeración por código ISO 3166-1 alfa-2.
+// Para los países sin reglas de móvil/fijo el tipo de línea queda como desconocido.
+var countries = map[string]countryMeta{
+	// Latinoamérica
+	"CO": {callingCode: "57", minLen: 10, maxLen: 10,
+		mobile:   []lineRule{{prefix: "3"}},
+		landline: []lineRule{{prefix: "60"}}},
+	"MX": {callingCode: "52", minLen: 10, maxLen: 10},
+	"PE": {callingCode: "51", minLen: 8, maxLen: 9, trunk: "0",
+		mobile:   []lineRule{{prefix: "9", length: 9}},
+		landline: []lineRule{{prefix: "1", length: 8}, {prefix: "4", length: 8}, {prefix: "5", length: 8}, {prefix: "6", length: 8}, {prefix: "7", length: 8}, {prefix: "8", length: 8}}},
+	"EC": {callingCode: "593", minLen: 8, maxLen: 9, trunk: "0",
+		mobile:   []lineRule{{prefix: "9", length: 9}},
+		landline: []lineRule{{prefix: "2", length: 8}, {prefix: "3", length: 8}, {prefix: "4", length: 8}, {prefix: "5", length: 8}, {prefix: "6", length: 8}, {prefix: "7", length: 8}}},
+	"CL": {callingCode: "56", minLen: 9, maxLen: 9,
+		mobile:   []lineRule{{prefix: "9"}},
+		landline: []lineRule{{prefix: "2"}, {prefix: "3"}, {prefix: "4"}, {prefix: "5"}, {prefix: "6"}, {prefix: "7"}}},
+	"AR": {callingCode: "54", minLen: 10, maxLen: 11, trunk: "0",
+		mobile: []lineRule{{prefix: "9", length: 11}}},
+	"BR": {callingCode: "55", minLen: 10, maxLen: 11, trunk: "0",
+		mobile:   []lineRule{{length: 11}},
+		landline: []lineRule{{length: 10}}},
+	"VE": {callingCode: "58", minLen: 10, maxLen: 10, trunk: "0",
+		mobile:   []lineRule{{prefix: "4"}},
+		landline: []lineRule{{prefix: "2"}}},
+	"PA": {callingCode: "507", minLen: 7, maxLen: 8,
+		mobile:   []lineRule{{prefix: "6", length: 8}},
+		landline: []lineRule{{length: 7}}},
+	"CR": {callingCode: "506", minLen: 8, maxLen: 8,
+		mobile:   []lineRule{{prefix: "5"}, {prefix: "6"}, {prefix: "7"}, {prefix: "8"}},
+		landline: []lineRule{{prefix: "2"}}},
+	"GT": {callingCode: "502", minLen: 8, maxLen: 8,
+		mobile:   []lineRule{{prefix: "3"}, {prefix: "4"}, {prefix: "5"}},
+		landline: []lineRule{{prefix: "2"}, {prefix: "6"}, {prefix: "7"}}},
+	"BO": {callingCode: "591", minLen: 8, maxLen: 8,
+		mobile:   []lineRule{{prefix: "6"}, {prefix: "7"}},
+		landline: []lineRule{{prefix: "2"}, {prefix: "3"}, {prefix: "4"}}},
+	"PY": {callingCode: "595", minLen: 9, maxLen: 9, trunk: "0",
+		mobile: []lineRule{{prefix: "9"}}},
+	"UY": {callingCode: "598", minLen: 8, maxLen: 8, trunk: "0",
+		mobile:   []lineRule{{prefix: "9"}},
+		landline: []lineRule{{prefix: "2"}, {prefix: "4"}}},
+	"CU": {callingCode: "53", minLen: 8, maxLen: 8},
+
+	// América del Norte
+	"US": {callingCode: "1", minLen: 10, maxLen: 10},
+	"CA": {callingCode: "1", minLen: 10, maxLen: 10},
+
+	// Europa
+	"ES": {callingCode: "34", minLen: 9, maxLen: 9,
+		mobile:   []lineRule{{prefix: "6"}, {prefix: "7"}},
+		landline: []lineRule{{prefix: "8"}, {prefix: "9"}}},
+	"GR": {callingCode: "30", minLen: 10, maxLen: 10},
+	"NL": {callingCode: "31", minLen: 9, maxLen: 9, trunk: "0"},
+	"BE": {callingCode: "32", minLen: 8, maxLen: 9, trunk: "0"},
+	"FR": {callingCode: "33", minLen: 9, maxLen: 9, trunk: "0",
+		mobile: []lineRule{{prefix: "6"}, {prefix: "7"}}},
+	"HU": {callingCode: "36", minLen: 8, maxLen: 9},
+	"IT": {callingCode: "39", minLen: 9, maxLen: 11},
+	"RO": {callingCode: "40", minLen: 9, maxLen: 9, trunk: "0"},
+	"CH": {callingCode: "41", minLen: 9, maxLen: 9, trunk: "0"},
+	"AT": {callingCode: "43", minLen: 10, maxLen: 13, trunk: "0"},
+	"GB": {callingCode: "44", minLen: 10, maxLen: 10, trunk: "0",
+		mobile: []lineRule{{prefix: "7"}}},
+	"DK": {callingCode: "45", minLen: 8, maxLen: 8},
+	"SE": {callingCode: "46", minLen: 8, maxLen: 9, trunk: "0"},
+	"NO": {callingCode: "47", minLen: 8, maxLen: 8},
+	"PL": {callingCode: "48", minLen: 9, maxLen: 9},
+	"DE": {callingCode: "49", minLen: 10, maxLen: 12, trunk: "0"},
+	"RU": {callingCode: "7", minLen: 10, maxLen: 10, trunk: "8"},
+
+	// Asia y Oceanía
+	"MY": {callingCode: "60", minLen: 8, maxLen: 10, trunk: "0"},
+	"AU": {callingCode: "61", minLen: 9, maxLen: 9, trunk: "0",
+		mobile: []lineRule{{prefix: "4"}}},
+	"ID": {callingCode: "62", minLen: 8, maxLen: 12, trunk: "0"},
+	"PH": {callingCode: "63", minLen: 10, maxLen: 10, trunk: "0"},
+	"NZ": {callingCode: "64", minLen: 8, maxLen: 9, trunk: "0"},
+	"SG": {callingCode: "65", minLen: 8, maxLen: 8},
+	"TH": {callingCode: "66", minLen: 8, maxLen: 9, trunk: "0"},
+	"JP": {callingCode: "81", minLen: 10, maxLen: 11, trunk: "0"},
+	"KR": {callingCode: "82", minLen: 9, maxLen: 10, trunk: "0"},
+	"VN": {callingCode: "84", minLen: 9, maxLen: 10, trunk: "0"},
+	"CN": {callingCode: "86", minLen: 11, maxLen: 11, trunk: "0"},
+	"TR": {callingCode: "90", minLen: 10, maxLen: 10, trunk: "0"},
+	"IN": {callingCode: "91", minLen: 10, maxLen: 10, trunk: "0"},
+	"PK": {callingCode: "92", minLen: 10, maxLen: 10, trunk: "0"},
+	"AF": {callingCode: "93", minLen: 8, maxLen: 9, trunk: "0"},
+	"LK": {callingCode: "94", minLen: 9, maxLen: 9, trunk: "0"},
+	"MM": {callingCode: "95", minLen: 8, maxLen: 10, trunk: "0"},
+	"IR": {callingCode: "98", minLen: 10, maxLen: 10, trunk: "0"},
+
+	// África
+	"EG": {callingCode: "20", minLen: 9, maxLen: 10, trunk: "0"},
+	"ZA": {callingCode: "27", minLen: 9, maxLen: 9, trunk: "0"},
+}
+
+// primaryCountryByCallingCode resuelve el país cuando varios comparten código (ej: +1 → US)
+var primaryCountryByCallingCode = map[string]string{
+	"1": "US",
+	"7": "RU",
+}
+
+// countryByCallingCode indexa los países por su código de llamada
+var countryByCallingCode = func() map[string]string {
+	index := make(map[string]string, len(countries))
+	for iso, meta := range countries {
+		if primary, ok := primaryCountryByCallingCode[meta.callingCode]; ok {
+			index[meta.callingCode] = primary
+			continue
+		}
+		index[meta.callingCode] = iso
+	}
+	return index
+}()
@@ -0,0 +1,234 @@
+package phone
+
+import (
+	"strings"
+)
+
+// LineType es el tipo de línea de un número telefónico
+type LineType string
+
+const (
+	LineTypeMobile   LineType = "mobile"
+	LineTypeLandline LineType = "landline"
+	LineTypeUnknown  LineType = "unknown"
+)
+
+// DefaultCountry es el país usado cuando el negocio no tiene uno configurado
+const DefaultCountry = "CO"
+
+// Motivos por los que un número se considera inválido
+const (
+	ReasonEmpty           = "empty"
+	ReasonUnknownCountry  = "unknown_country_code"
+	ReasonInvalidLength   = "invalid_length"
+	ReasonInvalidPrefix   = "invalid_prefix"
+	ReasonUnsupportedChar = "unsupported_characters"
+)
+
+// Number es el resultado de normalizar un teléfono
+type Number struct {
+	Raw         string   `json:"raw"`                    // Valor tal como llegó
+	E164        string   `json:"e164,omitempty"`         // Formato E.164 (ej: +573001234567), vacío si es inválido
+	Country     string   `json:"country,omitempty"`      // País ISO 3166-1 alfa-2
+	CallingCode string   `json:"calling_code,omitempty"` // Código de país sin "+"
+	National    string   `json:"national,omitempty"`     // Número nacional sin prefijo troncal
+	Type        LineType `json:"type"`                   // Tipo de línea
+	Valid       bool     `json:"valid"`                  // Si el número es válido para el país
+	Reason      string   `json:"reason,omitempty"`       // Motivo de invalidez
+}
+
+// IsMobile indica si el número es válido y no es una línea fija
+// (los números de tipo desconocido se consideran aptos para móvil/WhatsApp)
+func (n Number) IsMobile() bool {
+	return n.Valid && n.Type != LineTypeLandline
+}
+
+// Digits retorna el número E.164 sin el "+" (formato usado por la API de WhatsApp)
+func (n Number) Digits() string {
+	return strings.TrimPrefix(n.E164, "+")
+}
+
+// IsSupportedCountry indica si existe plan de numeración para el país
+func IsSupportedCountry(country string) bool {
+	_, ok := countries[strings.ToUpper(strings.TrimSpace(country))]
+	return ok
+}
+
+// Normalize convierte un teléfono a E.164 usando defaultCountry para los números
+// sin código de país. Acepta "+57 300 123 4567", "0057...", "573001234567" y "300 123 4567".
+func Normalize(raw, defaultCountry string) Number {
+	result := Number{Raw: raw, Type: LineTypeUnknown}
+
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		result.Reason = ReasonEmpty
+		return result
+	}
+
+	// Descartar extensiones ("ext", "x", "#") y quedarse con los dígitos
+	trimmed = cutExtension(trimmed)
+	international := strings.HasPrefix(trimmed, "+")
+	digits, ok := extractDigits(trimmed)
+	if !ok {
+		result.Reason = ReasonUnsupportedChar
+		return result
+	}
+	if !international && strings.HasPrefix(digits, "00") {
+		international = true
+		digits = digits[2:]
+	}
+	if digits == "" {
+		result.Reason = ReasonEmpty
+		return result
+	}
+
+	var country, national string
+	if international {
+		country, national = splitCallingCode(digits)
+		if country == "" {
+			result.Reason = ReasonUnknownCountry
+			return result
+		}
+	} else {
+		country = strings.ToUpper(strings.TrimSpace(defaultCountry))
+		if _, ok := countries[country]; !ok {
+			country = DefaultCountry
+		}
+		national = nationalFromLocal(digits, countries[country])
+	}
+
+	meta := countries[country]
+	national = adjustNational(country, national, meta)
+
+	result.Country = country
+	result.CallingCode = meta.callingCode
+	result.National = national
+
+	if len(national) < meta.minLen || len(national) > meta.maxLen {
+		result.Reason = ReasonInvalidLength
+		return result
+	}
+
+	lineType, valid := classify(national, meta)
+	if !valid {
+		result.Reason = ReasonInvalidPrefix
+		return result
+	}
+
+	result.Type = lineType
+	result.Valid = true
+	result.E164 = "+" + meta.callingCode + national
+	return result
+}
+
+// nationalFromLocal interpreta un número sin "+" en el contexto del país por defecto:
+// puede venir en formato nacional, con prefijo troncal o con el código de país sin "+"
+func nationalFromLocal(digits string, meta countryMeta) string {
+	if fitsLength(digits, meta) {
+		return digits
+	}
+	if meta.trunk != "" && strings.HasPrefix(digits, meta.trunk) {
+		if withoutTrunk := digits[len(meta.trunk):]; fitsLength(withoutTrunk, meta) {
+			return withoutTrunk
+		}
+	}
+	if strings.HasPrefix(digits, meta.callingCode) {
+		withoutCode := digits[len(meta.callingCode):]
+		if meta.trunk != "" && !fitsLength(withoutCode, meta) && strings.HasPrefix(withoutCode, meta.trunk) {
+			withoutCode = withoutCode[len(meta.trunk):]
+		}
+		if fitsLength(withoutCode, meta) {
+			return withoutCode
+		}
+	}
+	return digits
+}
+
+// adjustNational aplica particularidades de formato de algunos países
+func adjustNational(country, national string, meta countryMeta) string {
+	// Prefijo troncal escrito después del código de país: "+44 (0) 7911..."
+	if meta.trunk != "" && !fitsLength(national, meta) && strings.HasPrefix(national, meta.trunk) {
+		if withoutTrunk := national[len(meta.trunk):]; fitsLength(withoutTrunk, meta) {
+			national = withoutTrunk
+		}
+	}
+	// México: el "1" de móvil (+52 1 ...) dejó de usarse en 2019
+	if country == "MX" && len(national) == 11 && strings.HasPrefix(national, "1") {
+		national = national[1:]
+	}
+	return national
+}
+
+// classify determina el tipo de línea. Si el país tiene reglas y ninguna aplica, el número es inválido.
+func classify(national string, meta countryMeta) (LineType, bool) {
+	if len(meta.mobile) == 0 && len(meta.landline) == 0 {
+		return LineTypeUnknown, true
+	}
+	if matchesAny(national, meta.mobile) {
+		return LineTypeMobile, true
+	}
+	if matchesAny(national, meta.landline) {
+		return LineTypeLandline, true
+	}
+	// Sin reglas de fijo, lo que no es móvil conocido queda como desconocido
+	if len(meta.landline) == 0 {
+		return LineTypeUnknown, true
+	}
+	return LineTypeUnknown, false
+}
+
+func matchesAny(national string, rules []lineRule) bool {
+	for _, rule := range rules {
+		if rule.length != 0 && len(national) != rule.length {
+			continue
+		}
+		if strings.HasPrefix(national, rule.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCallingCode separa el código de país de un número internacional (1 a 3 dígitos)
+func splitCallingCode(digits string) (string, string) {
+	for size := 3; size >= 1; size-- {
+		if len(digits) <= size {
+			continue
+		}
+		if country, ok := countryByCallingCode[digits[:size]]; ok {
+			return country, digits[size:]
+		}
+	}
+	return "", digits
+}
+
+func fitsLength(national string, meta countryMeta) bool {
+	return len(national) >= meta.minLen && len(national) <= meta.maxLen
+}
+
+// extractDigits retorna los dígitos del número, aceptando solo separadores habituales
+func extractDigits(s string) (string, bool) {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+		case r == ' ', r == '-', r == '.', r == '(', r == ')', r == '/', r == '\u00a0':
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// cutExtension elimina extensiones telefónicas al final del número
+func cutExtension(s string) string {
+	lower := strings.ToLower(s)
+	for _, marker := range []string{"ext", "x", "#"} {
+		if i := strings.Index(lower, marker); i > 0 {
+			return strings.TrimSpace(s[:i])
+		}
+	}
+	return s
+}
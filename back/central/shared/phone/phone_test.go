@@ -0,0 +1,78 @@
+package phone
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		country  string
+		e164     string
+		lineType LineType
+		reason   string
+	}{
+		{name: "internacional con espacios", raw: "+57 300 123 4567", country: "CO", e164: "+573001234567", lineType: LineTypeMobile},
+		{name: "nacional con país por defecto", raw: "300 123 4567", country: "CO", e164: "+573001234567", lineType: LineTypeMobile},
+		{name: "prefijo 00", raw: "0057 300 123 4567", country: "CO", e164: "+573001234567", lineType: LineTypeMobile},
+		{name: "código de país sin +", raw: "573001234567", country: "CO", e164: "+573001234567", lineType: LineTypeMobile},
+		{name: "fijo colombiano", raw: "(601) 234 5678", country: "CO", e164: "+576012345678", lineType: LineTypeLandline},
+		{name: "país por defecto desconocido usa CO", raw: "3001234567", country: "ZZ", e164: "+573001234567", lineType: LineTypeMobile},
+		{name: "internacional ignora el país por defecto", raw: "+51 987 654 321", country: "CO", e164: "+51987654321", lineType: LineTypeMobile},
+		{name: "prefijo troncal nacional", raw: "0987654321", country: "EC", e164: "+593987654321", lineType: LineTypeMobile},
+		{name: "troncal después del código de país", raw: "+44 (0) 7911 123456", country: "CO", e164: "+447911123456", lineType: LineTypeMobile},
+		{name: "móvil mexicano con 1 antiguo", raw: "+52 1 55 1234 5678", country: "CO", e164: "+525512345678", lineType: LineTypeUnknown},
+		{name: "extensión descartada", raw: "+57 601 234 5678 ext 12", country: "CO", e164: "+576012345678", lineType: LineTypeLandline},
+		{name: "vacío", raw: "  ", country: "CO", lineType: LineTypeUnknown, reason: ReasonEmpty},
+		{name: "muy corto", raw: "12345", country: "CO", lineType: LineTypeUnknown, reason: ReasonInvalidLength},
+		{name: "prefijo inválido", raw: "5001234567", country: "CO", lineType: LineTypeUnknown, reason: ReasonInvalidPrefix},
+		{name: "código de país desconocido", raw: "+999 1234 5678", country: "CO", lineType: LineTypeUnknown, reason: ReasonUnknownCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.raw, tt.country)
+			if got.E164 != tt.e164 {
+				t.Errorf("E164 = %q, want %q", got.E164, tt.e164)
+			}
+			if got.Type != tt.lineType {
+				t.Errorf("Type = %q, want %q", got.Type, tt.lineType)
+			}
+			if got.Reason != tt.reason {
+				t.Errorf("Reason = %q, want %q", got.Reason, tt.reason)
+			}
+			if got.Valid != (tt.reason == "") {
+				t.Errorf("Valid = %v, want %v", got.Valid, tt.reason == "")
+			}
+			if got.Raw != tt.raw {
+				t.Errorf("Raw = %q, want %q", got.Raw, tt.raw)
+			}
+		})
+	}
+}
+
+func TestNumberIsMobile(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{raw: "+573001234567", want: true},
+		{raw: "+576012345678", want: false},
+		{raw: "+525512345678", want: true}, // Tipo desconocido: apto para WhatsApp
+		{raw: "12345", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.raw, "CO").IsMobile(); got != tt.want {
+			t.Errorf("IsMobile(%q) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestNumberDigits(t *testing.T) {
+	if got := Normalize("+57 300 123 4567", "CO").Digits(); got != "573001234567" {
+		t.Errorf("Digits() = %q, want %q", got, "573001234567")
+	}
+	if got := Normalize("12345", "CO").Digits(); got != "" {
+		t.Errorf("Digits() de un número inválido = %q, want vacío", got)
+	}
+}
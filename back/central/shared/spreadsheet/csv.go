@@ -0,0 +1,73 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// readCSV lee un CSV detectando el separador ("," o ";") a partir del encabezado
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	firstLine := data
+	if i := bytes.IndexByte(firstLine, '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	if strings.Count(string(firstLine), ";") > strings.Count(string(firstLine), ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	return rows, nil
+}
+
+// writeCSV escribe las filas con BOM UTF-8 para que Excel respete los acentos
+func writeCSV(w io.Writer, rows [][]string) error {
+	return NewCSVStream(w).WriteRows(rows)
+}
+
+// CSVStream escribe un CSV por bloques de filas (ej: una respuesta HTTP en streaming).
+// El BOM (para que Excel detecte UTF-8) se escribe antes del primer bloque.
+type CSVStream struct {
+	w       io.Writer
+	writer  *csv.Writer
+	started bool
+}
+
+// NewCSVStream crea un escritor de CSV por bloques
+func NewCSVStream(w io.Writer) *CSVStream {
+	return &CSVStream{w: w, writer: csv.NewWriter(w)}
+}
+
+// WriteRows escribe un bloque de filas y lo vacía al writer subyacente. Los valores que una hoja de
+// cálculo interpretaría como fórmula se escriben con ' al inicio.
+func (s *CSVStream) WriteRows(rows [][]string) error {
+	if !s.started {
+		if _, err := io.WriteString(s.w, "\ufeff"); err != nil {
+			return err
+		}
+		s.started = true
+	}
+	record := make([]string, 0)
+	for _, row := range rows {
+		record = record[:0]
+		for _, value := range row {
+			record = append(record, escapeFormula(value))
+		}
+		if err := s.writer.Write(record); err != nil {
+			return err
+		}
+	}
+	s.writer.Flush()
+	return s.writer.Error()
+}
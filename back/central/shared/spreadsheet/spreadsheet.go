@@ -0,0 +1,102 @@
+// Package spreadsheet lee y escribe hojas de cálculo simples (una hoja, filas de texto) en CSV y XLSX.
+// Cubre la importación y exportación masiva de datos; no soporta fórmulas, estilos ni varias hojas.
+package spreadsheet
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Formatos soportados
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// ErrUnsupportedFormat indica que el formato no es CSV ni XLSX
+var ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+
+// ErrInvalidFile indica que el archivo no se pudo leer en el formato indicado
+var ErrInvalidFile = errors.New("invalid spreadsheet file")
+
+// formulaPrefixes son los caracteres con los que Excel y LibreOffice interpretan una celda como fórmula
+const formulaPrefixes = "=+-@"
+
+// IsSupportedFormat indica si el formato es soportado
+func IsSupportedFormat(format string) bool {
+	return format == FormatCSV || format == FormatXLSX
+}
+
+// FormatFromFilename deduce el formato a partir de la extensión del archivo ("" si no es soportado)
+func FormatFromFilename(filename string) string {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), "."))
+	if IsSupportedFormat(format) {
+		return format
+	}
+	return ""
+}
+
+// ContentType retorna el content type del formato
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// Read lee todas las filas de la primera hoja del archivo. XLSX requiere io.ReaderAt y el tamaño,
+// por lo que el contenido se recibe completo en memoria.
+func Read(format string, data []byte) ([][]string, error) {
+	var rows [][]string
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(data)
+	case FormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Un archivo exportado se puede volver a importar: se quita el escape de fórmulas
+	for _, row := range rows {
+		for j, value := range row {
+			row[j] = unescapeFormula(value)
+		}
+	}
+	return rows, nil
+}
+
+// Write escribe las filas en el formato indicado
+func Write(format string, w io.Writer, rows [][]string) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, rows)
+	case FormatXLSX:
+		return writeXLSX(w, rows)
+	default:
+		return ErrUnsupportedFormat
+	}
+}
+
+// escapeFormula antepone ' a los valores que la hoja de cálculo interpretaría como fórmula
+// (inyección de fórmulas con datos ingresados por clientes o integraciones)
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// unescapeFormula quita el ' que escapeFormula antepuso
+func unescapeFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
@@ -0,0 +1,167 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// buildXLSX arma un XLSX en memoria con las partes indicadas (nombre -> contenido)
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestXLSXRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"sku", "name", "price", "notes"},
+		{"00123", "Camisa <azul> & \"roja\"", "19.90", ""},
+		nil,
+		{"", "", "", "  con espacios  "},
+		{"=HYPERLINK(\"http://x\")", "+57 300", "-5", "@user"},
+		{"ñandú", "línea\nnueva"},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(FormatXLSX, &buf, rows); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	got, err := Read(FormatXLSX, buf.Bytes())
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	want := [][]string{
+		{"sku", "name", "price", "notes"},
+		{"00123", "Camisa <azul> & \"roja\"", "19.90"},
+		nil,
+		{"", "", "", "  con espacios  "},
+		{"=HYPERLINK(\"http://x\")", "+57 300", "-5", "@user"},
+		{"ñandú", "línea\nnueva"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Read(Write(rows)) = %q, want %q", got, want)
+	}
+}
+
+func TestWriteXLSXEscapesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeXLSXSheet(&buf, [][]string{{"=1+1", "-5", "texto"}}); err != nil {
+		t.Fatalf("writeXLSXSheet: %v", err)
+	}
+	sheet := buf.String()
+	for _, want := range []string{`<c r="A1" t="inlineStr"><is><t xml:space="preserve">&#39;=1+1</t>`, `<c r="B1" t="inlineStr"><is><t xml:space="preserve">&#39;-5</t>`, `<t xml:space="preserve">texto</t>`} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet does not contain %q:\n%s", want, sheet)
+		}
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Datos" sheetId="1" r:id="rId7"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId7" Target="worksheets/datos.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>sku</t></si><si><r><t>Camisa </t></r><r><t>azul</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>hoja equivocada</t></is></c></row></sheetData></worksheet>`,
+		"xl/worksheets/datos.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>` +
+			`<row r="3"><c r="A3"><v>19.899999999999999</v></c><c r="B3" t="b"><v>1</v></c><c r="C3" t="b"><v>0</v></c><c r="D3" t="s"><v>9</v></c></row>` +
+			`<row r="4"><c t="str"><v>sin ref</v></c><c r="AB4" t="n"><v>00042</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+
+	got, err := readXLSX(data)
+	if err != nil {
+		t.Fatalf("readXLSX: %v", err)
+	}
+
+	row4 := make([]string, 28)
+	row4[0], row4[27] = "sin ref", "42"
+	want := [][]string{
+		{"sku", "", "Camisa azul"},
+		nil,
+		{"19.9", "true", "false", ""},
+		row4,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readXLSX() = %q, want %q", got, want)
+	}
+}
+
+func TestReadXLSXWithoutWorkbookUsesFirstSheet(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="B1" t="inlineStr"><is><t>valor</t></is></c></row></sheetData></worksheet>`,
+	})
+
+	got, err := readXLSX(data)
+	if err != nil {
+		t.Fatalf("readXLSX: %v", err)
+	}
+	if want := [][]string{{"", "valor"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("readXLSX() = %q, want %q", got, want)
+	}
+}
+
+func TestReadXLSXInvalidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "no es zip", data: []byte("sku,name\n1,camisa")},
+		{name: "zip sin hojas", data: buildXLSX(t, map[string]string{"docProps/app.xml": "<Properties/>"})},
+		{name: "hoja con XML inválido", data: buildXLSX(t, map[string]string{"xl/worksheets/sheet1.xml": "<worksheet><sheetData>"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := readXLSX(tt.data); !errors.Is(err, ErrInvalidFile) {
+				t.Errorf("err = %v, want ErrInvalidFile", err)
+			}
+		})
+	}
+}
+
+func TestColumnNameAndIndex(t *testing.T) {
+	tests := []struct {
+		col  int
+		name string
+	}{
+		{col: 0, name: "A"},
+		{col: 25, name: "Z"},
+		{col: 26, name: "AA"},
+		{col: 27, name: "AB"},
+		{col: 701, name: "ZZ"},
+		{col: 702, name: "AAA"},
+	}
+
+	for _, tt := range tests {
+		if got := columnName(tt.col); got != tt.name {
+			t.Errorf("columnName(%d) = %q, want %q", tt.col, got, tt.name)
+		}
+		if got := columnIndex(tt.name + "12"); got != tt.col {
+			t.Errorf("columnIndex(%q) = %d, want %d", tt.name+"12", got, tt.col)
+		}
+	}
+}
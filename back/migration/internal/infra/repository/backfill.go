@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// backfillData actualiza datos existentes cuando cambia su formato. Cada paso es idempotente.
+func (r *Repository) backfillData(ctx context.Context) error {
+	if err := r.backfillClientPhoneE164(ctx); err != nil {
+		return fmt.Errorf("failed to backfill client phone_e164: %w", err)
+	}
+	if err := r.backfillPhoneListEntries(ctx); err != nil {
+		return fmt.Errorf("failed to backfill phone list entries: %w", err)
+	}
+	return nil
+}
+
+// backfillClientPhoneE164 completa el teléfono E.164 de los clientes creados antes de que se normalizara,
+// para que la búsqueda por teléfono en la ingesta use solo la columna indexada. Toma el E.164 de la
+// orden más reciente del cliente cuyo teléfono tiene los mismos dígitos finales que el del cliente.
+func (r *Repository) backfillClientPhoneE164(ctx context.Context) error {
+	return r.db.Conn(ctx).Exec(`
+		UPDATE clients c
+		SET phone_e164 = src.customer_phone_e164
+		FROM (
+			SELECT DISTINCT ON (o.customer_id) o.customer_id, o.customer_phone_e164,
+				RIGHT(REGEXP_REPLACE(o.customer_phone, '[^0-9]', '', 'g'), 10) AS match_key
+			FROM orders o
+			WHERE o.customer_id IS NOT NULL AND o.customer_phone_e164 <> '' AND o.deleted_at IS NULL
+			ORDER BY o.customer_id, o.created_at DESC
+		) src
+		WHERE c.id = src.customer_id
+			AND COALESCE(c.phone_e164, '') = ''
+			AND c.phone <> ''
+			AND RIGHT(REGEXP_REPLACE(c.phone, '[^0-9]', '', 'g'), 10) = src.match_key`).Error
+}
+
+// backfillPhoneListEntries pasa las entradas de blocklist/allowlist por teléfono guardadas con sus
+// últimos diez dígitos al formato E.164 con el que ahora se comparan. Los números escritos con código
+// de país se convierten directamente; los nacionales toman el E.164 ya normalizado de alguna orden del
+// negocio con el mismo número. Las entradas sin ninguna de las dos referencias quedan sin cambios.
+func (r *Repository) backfillPhoneListEntries(ctx context.Context) error {
+	db := r.db.Conn(ctx)
+
+	if err := db.Exec(`
+		UPDATE customer_list_entries e
+		SET normalized_value = '+' || REGEXP_REPLACE(e.value, '[^0-9]', '', 'g'), updated_at = NOW()
+		WHERE e.match_type = 'phone'
+			AND e.deleted_at IS NULL
+			AND e.normalized_value NOT LIKE '+%'
+			AND e.value ~ '^\s*\+[0-9 ().-]+$'
+			AND NOT EXISTS (
+				SELECT 1 FROM customer_list_entries d
+				WHERE d.business_id = e.business_id AND d.match_type = 'phone' AND d.deleted_at IS NULL
+					AND d.normalized_value = '+' || REGEXP_REPLACE(e.value, '[^0-9]', '', 'g')
+			)`).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`
+		UPDATE customer_list_entries e
+		SET normalized_value = src.customer_phone_e164, updated_at = NOW()
+		FROM (
+			SELECT DISTINCT ON (o.business_id, match_key) o.business_id, o.customer_phone_e164,
+				RIGHT(REGEXP_REPLACE(o.customer_phone, '[^0-9]', '', 'g'), 10) AS match_key
+			FROM orders o
+			WHERE o.customer_phone_e164 <> '' AND o.business_id IS NOT NULL AND o.deleted_at IS NULL
+			ORDER BY o.business_id, match_key, o.created_at DESC
+		) src
+		WHERE e.match_type = 'phone'
+			AND e.deleted_at IS NULL
+			AND e.normalized_value NOT LIKE '+%'
+			AND src.business_id = e.business_id
+			AND src.match_key = e.normalized_value
+			AND NOT EXISTS (
+				SELECT 1 FROM customer_list_entries d
+				WHERE d.business_id = e.business_id AND d.match_type = 'phone' AND d.deleted_at IS NULL
+					AND d.normalized_value = src.customer_phone_e164
+			)`).Error
+}
@@ -44,17 +44,40 @@ func (r *Repository) Migrate(ctx context.Context) error {
 		// Integration Notification Configs (debe ir después de Integration)
 		&models.IntegrationNotificationConfig{},
 
+		// Integration Health Checks (debe ir después de Integration)
+		&models.IntegrationHealthCheck{},
+
+		// Integration Sync Schedules (debe ir después de Integration)
+		&models.IntegrationSyncSchedule{},
+
 		// Payment Methods
 		&models.PaymentMethod{},
 		&models.PaymentMethodMapping{},
 		&models.OrderStatusMapping{},
 		&models.Product{},
 
+		// Product Variants (debe ir después de Product e Integration)
+		&models.ProductVariant{},
+		&models.ProductVariantIntegration{},
+
+		// Product Bulk Jobs (importación/exportación masiva)
+		&models.ProductBulkJob{},
+
+		// Product Catalog Import Jobs (importación de catálogo desde integraciones)
+		&models.ProductCatalogImportJob{},
+
+		// Warehouses y Drivers (deben ir antes de Order)
+		&models.Warehouse{},
+		&models.Driver{},
+
 		// Orders
 		&models.Order{},
 		&models.OrderHistory{},
 		&models.OrderError{},
 
+		// Order Export Jobs (exportación asíncrona)
+		&models.OrderExportJob{},
+
 		// Order Channel Metadata
 		&models.OrderChannelMetadata{},
 
@@ -66,13 +89,41 @@ func (r *Repository) Migrate(ctx context.Context) error {
 
 		// Payments
 		&models.Payment{},
+		&models.PaymentRefund{},
+		&models.PaymentRefundItem{},
 
 		// Shipments
 		&models.Shipment{},
+		&models.ShipmentTrackingEvent{},
+
+		// COD Settlements (conciliación contra entrega, debe ir después de Order y Payment)
+		&models.CodSettlement{},
+		&models.CodSettlementItem{},
+
+		// Delivery Attempts (debe ir después de Shipment)
+		&models.DeliveryFailureReason{},
+		&models.DeliveryAttempt{},
+		&models.ProofOfDelivery{},
+
+		// Shopify Fulfillment Syncs (debe ir después de Shipment)
+		&models.ShopifyFulfillmentSync{},
+
+		// Client Merges (debe ir después de Client)
+		&models.ClientMerge{},
+
+		// Customer Blocklist / Allowlist
+		&models.CustomerListEntry{},
+
+		// Inventory Movements (debe ir después de Product y Order)
+		&models.InventoryMovement{},
 	); err != nil {
 		return err
 	}
 
+	if err := r.backfillData(ctx); err != nil {
+		return err
+	}
+
 	return r.seedInitialData(ctx)
 }
 
@@ -159,5 +210,24 @@ func (r *Repository) seedInitialData(ctx context.Context) error {
 		}
 	}
 
+	// 5. Seed catálogo global de motivos de falla de entrega
+	failureReasons := []models.DeliveryFailureReason{
+		{Code: "customer_absent", Name: "Cliente ausente", Description: "No había nadie para recibir el pedido"},
+		{Code: "refused", Name: "Pedido rechazado", Description: "El cliente no quiso recibir el pedido"},
+		{Code: "wrong_address", Name: "Dirección errada", Description: "La dirección no existe o está incompleta"},
+		{Code: "no_money", Name: "Sin dinero", Description: "El cliente no tenía el dinero para el pago contra entrega"},
+	}
+	for _, reason := range failureReasons {
+		var existing models.DeliveryFailureReason
+		if err := db.Where("code = ? AND business_id IS NULL", reason.Code).FirstOrCreate(&existing, models.DeliveryFailureReason{
+			Code:        reason.Code,
+			Name:        reason.Name,
+			Description: reason.Description,
+			IsActive:    true,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to seed delivery failure reason %s: %w", reason.Code, err)
+		}
+	}
+
 	return nil
 }
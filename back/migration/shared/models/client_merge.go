@@ -0,0 +1,41 @@
+package models
+
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	CLIENT MERGES - Auditoría de fusiones de clientes duplicados
+//
+// ───────────────────────────────────────────
+
+// ClientMerge registra la fusión de un cliente duplicado en otro.
+// El cliente fusionado queda eliminado (soft delete) y sus órdenes pasan al cliente que sobrevive.
+type ClientMerge struct {
+	gorm.Model
+
+	BusinessID       uint `gorm:"not null;index"`
+	SurvivorClientID uint `gorm:"not null;index"` // Cliente que conserva el historial
+	MergedClientID   uint `gorm:"not null;index"` // Cliente fusionado (eliminado)
+
+	// Motivos de coincidencia detectados: "dni", "email", "email_alias", "phone", "name_address"
+	MatchReasons datatypes.JSON `gorm:"type:jsonb"`
+	Score        float64        `gorm:"type:decimal(5,4)"` // Puntaje de similitud al momento de la fusión
+	Reason       string         `gorm:"size:500"`          // Motivo indicado por quien ejecuta la fusión
+
+	OrdersMoved int64 `gorm:"not null;default:0"` // Órdenes re-asignadas al cliente sobreviviente
+
+	// Snapshots de ambos clientes antes de la fusión
+	SurvivorSnapshot datatypes.JSON `gorm:"type:jsonb"`
+	MergedSnapshot   datatypes.JSON `gorm:"type:jsonb"`
+
+	// Relaciones
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ClientMerge) TableName() string {
+	return "client_merges"
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	COD SETTLEMENTS - Conciliación del dinero contra entrega
+//
+// ───────────────────────────────────────────
+
+// CodSettlement es la liquidación del dinero recaudado contra entrega por un conductor o una
+// transportadora: las órdenes entregadas que debe remitir, lo que declaró y la diferencia
+type CodSettlement struct {
+	gorm.Model
+
+	BusinessID uint `gorm:"not null;index"`
+
+	// Quién remite el dinero
+	PartyType   string `gorm:"size:20;not null;index"` // "driver" o "carrier"
+	DriverID    *uint  `gorm:"index"`
+	CarrierCode string `gorm:"size:50;index"`
+	PartyName   string `gorm:"size:255"` // Nombre del conductor o transportadora (desnormalizado)
+
+	// Órdenes entregadas en el periodo
+	PeriodFrom time.Time `gorm:"not null"`
+	PeriodTo   time.Time `gorm:"not null"`
+	Currency   string    `gorm:"size:10;not null"`
+
+	// Totales
+	ExpectedAmount  float64 `gorm:"type:decimal(14,2);not null;default:0"` // Suma del COD de las órdenes
+	DeclaredAmount  float64 `gorm:"type:decimal(14,2);not null;default:0"` // Suma de lo remitido
+	Variance        float64 `gorm:"type:decimal(14,2);not null;default:0"` // Declarado - esperado
+	OrderCount      int     `gorm:"default:0"`
+	ReconciledCount int     `gorm:"default:0"`
+	MismatchCount   int     `gorm:"default:0"` // Items con diferencia o líneas sin orden
+
+	Status   string `gorm:"size:20;not null;default:'open';index"` // "open", "reconciled", "closed"
+	ClosedAt *time.Time
+	Notes    *string `gorm:"type:text"`
+
+	CreatedBy *uint
+
+	// Relaciones
+	Business Business            `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Items    []CodSettlementItem `gorm:"foreignKey:SettlementID"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CodSettlement) TableName() string {
+	return "cod_settlements"
+}
+
+// CodSettlementItem es una orden de la liquidación o una línea remitida que no corresponde a
+// ninguna orden de la liquidación
+type CodSettlementItem struct {
+	gorm.Model
+
+	SettlementID uint    `gorm:"not null;index"`
+	OrderID      *string `gorm:"type:varchar(36);index"` // Nulo en líneas sin orden
+	OrderNumber  string  `gorm:"size:128"`
+
+	// Identificadores para cruzar con el extracto de la transportadora
+	GuideID        string `gorm:"size:128;index"`
+	TrackingNumber string `gorm:"size:128;index"`
+	Reference      string `gorm:"size:128"` // Referencia tal como vino en la remesa
+
+	ExpectedAmount float64  `gorm:"type:decimal(12,2);not null;default:0"`
+	DeclaredAmount *float64 `gorm:"type:decimal(12,2)"` // Nulo = aún no remitido
+	Variance       float64  `gorm:"type:decimal(12,2);not null;default:0"`
+
+	Status       string `gorm:"size:20;not null;default:'pending';index"` // "pending", "reconciled", "short", "over", "unmatched"
+	PaymentID    *uint  `gorm:"index"`                                    // Pago creado al conciliar
+	ReconciledAt *time.Time
+	Notes        string         `gorm:"size:512"`
+	Metadata     datatypes.JSON `gorm:"type:jsonb"` // Fila original del extracto
+
+	// Relaciones
+	Settlement CodSettlement `gorm:"foreignKey:SettlementID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CodSettlementItem) TableName() string {
+	return "cod_settlement_items"
+}
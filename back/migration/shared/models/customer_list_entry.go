@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	CUSTOMER LIST ENTRIES - Listas de bloqueo y confianza de clientes
+//
+// ───────────────────────────────────────────
+
+// CustomerListEntry es una entrada de la lista de bloqueo (blocklist) o de confianza (allowlist)
+// de un negocio. Se compara contra los datos del cliente al ingresar cada orden.
+type CustomerListEntry struct {
+	gorm.Model
+
+	BusinessID uint `gorm:"not null;index;uniqueIndex:idx_customer_list_entry,priority:1,where:deleted_at IS NULL"`
+
+	// Tipo de lista: "blocklist" o "allowlist". Un mismo valor solo puede estar en una de las dos
+	ListType string `gorm:"size:16;not null;index"`
+
+	// Campo comparado: "phone", "email", "dni" o "address"
+	MatchType string `gorm:"size:16;not null;uniqueIndex:idx_customer_list_entry,priority:2,where:deleted_at IS NULL"`
+
+	Value           string `gorm:"size:255;not null"`                                                                         // Valor tal como se registró
+	NormalizedValue string `gorm:"size:255;not null;uniqueIndex:idx_customer_list_entry,priority:3,where:deleted_at IS NULL"` // Valor normalizado usado para comparar
+
+	Reason    string     `gorm:"size:500"` // Motivo del bloqueo/confianza
+	ExpiresAt *time.Time `gorm:"index"`    // Vencimiento (null = no vence)
+	Source    string     `gorm:"size:32"`  // Origen: "manual" o "csv_import"
+	CreatedBy *uint      `gorm:"index"`    // Usuario que creó la entrada (opcional)
+
+	// Relaciones
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (CustomerListEntry) TableName() string {
+	return "customer_list_entries"
+}
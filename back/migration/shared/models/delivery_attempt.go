@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	DELIVERY ATTEMPTS - Intentos de entrega de última milla
+//
+// ───────────────────────────────────────────
+
+// DeliveryFailureReason es un motivo del catálogo de fallas de entrega.
+// Los motivos sin negocio son globales; cada negocio puede agregar los suyos.
+type DeliveryFailureReason struct {
+	gorm.Model
+
+	BusinessID  *uint  `gorm:"index;uniqueIndex:idx_delivery_failure_reason_code,priority:1"` // nil = motivo global
+	Code        string `gorm:"size:50;not null;uniqueIndex:idx_delivery_failure_reason_code,priority:2"`
+	Name        string `gorm:"size:128;not null"`
+	Description string `gorm:"type:text"`
+	IsActive    bool   `gorm:"default:true;index"`
+
+	// Relación
+	Business *Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (DeliveryFailureReason) TableName() string {
+	return "delivery_failure_reasons"
+}
+
+// DeliveryAttempt registra cada intento de entrega de un envío: quién, cuándo, dónde y con qué resultado
+type DeliveryAttempt struct {
+	gorm.Model
+
+	ShipmentID    uint   `gorm:"not null;index"`
+	OrderID       string `gorm:"type:varchar(36);not null;index"` // Desnormalizado para consultas por orden
+	AttemptNumber int    `gorm:"not null"`                        // 1 = primer intento
+
+	DriverID    *uint     `gorm:"index"`
+	DriverName  string    `gorm:"size:255"`
+	AttemptedAt time.Time `gorm:"not null;index"`
+	Latitude    *float64  `gorm:"type:decimal(10,8)"`
+	Longitude   *float64  `gorm:"type:decimal(11,8)"`
+
+	Outcome           string     `gorm:"size:20;not null;index"` // "delivered", "failed"
+	FailureReasonCode *string    `gorm:"size:50;index"`          // Código del catálogo delivery_failure_reasons
+	Notes             *string    `gorm:"type:text"`
+	RescheduledFor    *time.Time // Nueva fecha acordada tras el intento fallido
+	CreatedBy         *uint      `gorm:"index"`
+
+	// Relación
+	Shipment Shipment `gorm:"foreignKey:ShipmentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (DeliveryAttempt) TableName() string {
+	return "delivery_attempts"
+}
@@ -0,0 +1,44 @@
+package models
+
+import "gorm.io/gorm"
+
+// ───────────────────────────────────────────
+//
+//	DRIVERS - Conductores de última milla
+//
+// ───────────────────────────────────────────
+
+// Driver representa un conductor del negocio que entrega órdenes de última milla.
+// orders.driver_id y shipments.driver_id referencian esta tabla.
+type Driver struct {
+	gorm.Model
+
+	BusinessID uint  `gorm:"not null;index"`
+	UserID     *uint `gorm:"uniqueIndex"` // Cuenta de usuario con la que el conductor usa la app móvil
+
+	// Perfil
+	Name           string `gorm:"size:255;not null"`
+	Phone          string `gorm:"size:32"`
+	Email          string `gorm:"size:255"`
+	DocumentNumber string `gorm:"size:64"`
+
+	// Vehículo
+	VehicleType       string   `gorm:"size:20"` // "motorcycle", "car", "van", "truck", "bicycle"
+	VehiclePlate      string   `gorm:"size:20"`
+	VehicleCapacityKg *float64 `gorm:"type:decimal(10,2)"`
+
+	// Zona de reparto: código o nombre de la ciudad (se compara con la dirección de envío de la orden)
+	Zone string `gorm:"size:128;index"`
+
+	IsActive bool    `gorm:"default:true;index"`
+	Notes    *string `gorm:"type:text"`
+
+	// Relaciones
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User     *User    `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// TableName especifica el nombre de la tabla
+func (Driver) TableName() string {
+	return "drivers"
+}
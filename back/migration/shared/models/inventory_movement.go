@@ -0,0 +1,40 @@
+package models
+
+import "gorm.io/gorm"
+
+// ───────────────────────────────────────────
+//
+//	INVENTORY MOVEMENTS - Libro de movimientos de inventario
+//
+// ───────────────────────────────────────────
+
+// InventoryMovement registra un movimiento de inventario de un producto.
+// Tipos: "reserve" (orden creada), "commit" (orden enviada), "release" (orden cancelada).
+// Las cantidades son siempre positivas; el tipo indica el efecto sobre stock y reservas.
+type InventoryMovement struct {
+	gorm.Model
+
+	BusinessID uint    `gorm:"not null;index"`
+	ProductID  string  `gorm:"type:varchar(64);not null;index;index:idx_inventory_movement_order_product,priority:2"`
+	OrderID    *string `gorm:"type:varchar(36);index:idx_inventory_movement_order_product,priority:1"` // Orden que origina el movimiento
+	VariantID  *string `gorm:"type:varchar(64);index"`                                                 // Variante cuyo stock se mueve (NULL = stock del producto)
+
+	Type     string `gorm:"size:20;not null;index"` // reserve, commit, release
+	Quantity int    `gorm:"not null"`
+	Reason   string `gorm:"size:255"` // Motivo del movimiento (ej: "order_created", "shipment_shipped", "order_cancelled")
+
+	// Estado del producto antes y después del movimiento
+	StockBefore    int `gorm:"not null;default:0"`
+	StockAfter     int `gorm:"not null;default:0"`
+	ReservedBefore int `gorm:"not null;default:0"`
+	ReservedAfter  int `gorm:"not null;default:0"`
+
+	// Relaciones
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Product  Product  `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (InventoryMovement) TableName() string {
+	return "inventory_movements"
+}
@@ -62,6 +62,7 @@ type Business struct {
 	BusinessTypeID   uint   `gorm:"not null;index"`
 	ParentBusinessID *uint  `gorm:"index"` // ID del negocio padre (para jerarquía)
 	Timezone         string `gorm:"size:40;default:'America/Bogota'"`
+	DefaultCountry   string `gorm:"size:2;default:'CO'"` // País ISO 3166-1 alfa-2 para normalizar teléfonos sin código de país
 	Address          string `gorm:"size:255"`
 	Description      string `gorm:"size:500"`
 
@@ -222,10 +223,11 @@ type BusinessStaff struct {
 // ───────────────────────────────────────────
 type Client struct {
 	gorm.Model
-	BusinessID uint    `gorm:"not null;index;uniqueIndex:idx_business_client_email,priority:1"`
+	BusinessID uint    `gorm:"not null;index;uniqueIndex:idx_business_client_email,priority:1;index:idx_business_client_phone_e164,priority:1"`
 	Name       string  `gorm:"size:255;not null"`
 	Email      string  `gorm:"size:255;uniqueIndex:idx_business_client_email,priority:2"`
 	Phone      string  `gorm:"size:20"`
+	PhoneE164  string  `gorm:"size:20;index:idx_business_client_phone_e164,priority:2"` // Teléfono normalizado a E.164 (vacío si es inválido)
 	Dni        *string `gorm:"size:30;uniqueIndex:idx_business_client_dni,priority:2"`
 
 	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
@@ -288,12 +290,13 @@ type IntegrationType struct {
 	Category    string `gorm:"size:20;not null;index"`   // "internal" | "external"
 	IsActive    bool   `gorm:"default:true"`             // Si el tipo está activo y disponible
 
-	// Configuración requerida (JSON schema - define qué campos de config son necesarios)
-	// Ejemplo: {"required_fields": ["phone_number_id"], "optional_fields": ["webhook_url"]}
+	// Configuración requerida (JSON Schema - se valida al crear/actualizar integraciones)
+	// Ejemplo: {"type": "object", "required": ["store_name"], "properties": {"store_name": {"type": "string", "pattern": "^[a-z0-9-]+$"}}}
+	// Se acepta también el formato legacy: {"required_fields": ["phone_number_id"], "optional_fields": ["webhook_url"]}
 	ConfigSchema datatypes.JSON `gorm:"type:jsonb"`
 
-	// Credenciales requeridas (JSON schema - define qué credenciales son necesarias)
-	// Ejemplo: {"required_fields": ["access_token"], "optional_fields": ["refresh_token"]}
+	// Credenciales requeridas (JSON Schema - se valida al crear/actualizar integraciones)
+	// Ejemplo: {"type": "object", "required": ["access_token"], "properties": {"access_token": {"type": "string", "minLength": 10}}}
 	CredentialsSchema datatypes.JSON `gorm:"type:jsonb"`
 
 	// Instrucciones paso a paso para configurar la integración
@@ -335,6 +338,12 @@ type Integration struct {
 	IsActive  bool `gorm:"default:true;index"`
 	IsDefault bool `gorm:"default:false;index"` // Si es la integración por defecto para este tipo
 
+	// Salud (monitoreo periódico de la conexión)
+	// "unknown" | "healthy" | "degraded"
+	HealthStatus        string `gorm:"size:20;default:'unknown';index"`
+	LastHealthCheckAt   *time.Time
+	ConsecutiveFailures int `gorm:"default:0"`
+
 	// Configuración (JSON flexible - no contiene información sensible)
 	// Ejemplo WhatsApp: {"phone_number_id": "123", "webhook_url": "...", "template_language": "es"}
 	// Ejemplo Shopify: {"store_name": "mi-tienda", "api_version": "2024-01"}
@@ -361,6 +370,70 @@ func (Integration) TableName() string {
 	return "integrations"
 }
 
+// ───────────────────────────────────────────
+//
+//	INTEGRATION HEALTH CHECKS - Historial de chequeos de salud de integraciones
+//
+// ───────────────────────────────────────────
+type IntegrationHealthCheck struct {
+	gorm.Model
+
+	// Relación con Integration
+	IntegrationID uint        `gorm:"not null;index:idx_integration_health_check_integration_checked,priority:1"`
+	Integration   Integration `gorm:"foreignKey:IntegrationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	// Resultado del chequeo
+	// "ok" | "auth_failed" | "unreachable" | "error"
+	Status       string `gorm:"size:20;not null;index"`
+	LatencyMs    int64  `gorm:"default:0"`
+	ErrorMessage string `gorm:"size:1000"`
+
+	// Origen del chequeo: "scheduler" | "manual"
+	Trigger   string    `gorm:"size:20;not null;default:'scheduler'"`
+	CheckedAt time.Time `gorm:"not null;index:idx_integration_health_check_integration_checked,priority:2"`
+}
+
+// TableName especifica el nombre de la tabla para IntegrationHealthCheck
+func (IntegrationHealthCheck) TableName() string {
+	return "integration_health_checks"
+}
+
+// ───────────────────────────────────────────
+//
+//	INTEGRATION SYNC SCHEDULES - Programación de sincronización por instancia de integración
+//
+// ───────────────────────────────────────────
+type IntegrationSyncSchedule struct {
+	gorm.Model
+
+	// Relación con Integration (una programación por instancia)
+	IntegrationID uint        `gorm:"not null;uniqueIndex"`
+	Integration   Integration `gorm:"foreignKey:IntegrationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	// Expresión cron de 5 campos o descriptor
+	// Ejemplos: "*/15 * * * *", "0 */2 * * *", "@hourly", "@every 30m"
+	CronSpec  string     `gorm:"size:100;not null"`
+	IsPaused  bool       `gorm:"default:false;index"`
+	NextRunAt *time.Time `gorm:"index"`
+
+	// Última ejecución
+	LastRunAt          *time.Time
+	LastFinishedAt     *time.Time
+	LastStatus         string `gorm:"size:20"` // "running" | "success" | "failed"
+	LastError          string `gorm:"size:1000"`
+	LastTrigger        string `gorm:"size:20"` // "scheduler" | "manual"
+	LastItemsProcessed int    `gorm:"default:0"`
+	LastDurationMs     int64  `gorm:"default:0"`
+
+	// Cursor opaco definido por cada integración (p. ej. fecha de la última orden importada)
+	Cursor string `gorm:"size:500"`
+}
+
+// TableName especifica el nombre de la tabla para IntegrationSyncSchedule
+func (IntegrationSyncSchedule) TableName() string {
+	return "integration_sync_schedules"
+}
+
 // ───────────────────────────────────────────
 //
 //	INTEGRATION NOTIFICATION CONFIG - Configuraciones de notificaciones por integración
@@ -59,9 +59,13 @@ type Order struct {
 	CustomerID    *uint  `gorm:"index"`          // ID del cliente (referencia opcional)
 	CustomerName  string `gorm:"size:255"`       // Nombre completo
 	CustomerEmail string `gorm:"size:255;index"` // Email
-	CustomerPhone string `gorm:"size:32"`        // Teléfono
+	CustomerPhone string `gorm:"size:32"`        // Teléfono (tal como lo envía la plataforma)
 	CustomerDNI   string `gorm:"size:64"`        // DNI/Identificación
 
+	CustomerPhoneE164  string `gorm:"size:20;index"` // Teléfono normalizado a E.164 (vacío si es inválido)
+	CustomerPhoneValid *bool  // Si el teléfono es válido para el país (null = sin teléfono)
+	CustomerPhoneType  string `gorm:"size:16"` // "mobile", "landline" o "unknown"
+
 	// ============================================
 	// DIRECCIÓN DE ENVÍO (Desnormalizado)
 	// ============================================
@@ -73,6 +77,16 @@ type Order struct {
 	ShippingLat        *float64 // Latitud
 	ShippingLng        *float64 // Longitud
 
+	// Normalización y geocodificación de la dirección de envío
+	ShippingCityCode    string   `gorm:"size:10;index"`     // Código oficial del municipio (DANE en Colombia)
+	ShippingStateCode   string   `gorm:"size:10"`           // Código oficial del departamento (DANE en Colombia)
+	AddressCompleteness *float64 `gorm:"type:decimal(5,2)"` // Puntaje de completitud de la dirección (0-100)
+	GeocodePrecision    string   `gorm:"size:16"`           // Precisión de las coordenadas: address, street o city (centroide; no sirve para validar distancias ni ordenar paradas)
+	GeocodeSource       string   `gorm:"size:32"`           // Origen de las coordenadas: canal, geocoder o gazetteer
+
+	// Estado del inventario de la orden: reserved, backordered, insufficient, committed o released
+	InventoryStatus string `gorm:"size:20;index"`
+
 	// ============================================
 	// INFORMACIÓN DE PAGO
 	// ============================================
@@ -270,6 +284,10 @@ type OrderItem struct {
 	Order     Order   `gorm:"foreignKey:OrderID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Product   Product `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 
+	// Variante del catálogo resuelta al ingerir la orden (NULL si el producto no tiene variantes)
+	ProductVariantID *string        `gorm:"type:varchar(64);index"`
+	ProductVariant   ProductVariant `gorm:"foreignKey:ProductVariantID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+
 	// ============================================
 	// INFORMACIÓN ESPECÍFICA DE LA ORDEN
 	// (No está en products, es específica de esta venta)
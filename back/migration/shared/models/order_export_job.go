@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	ORDER EXPORT JOBS - Exportación asíncrona de órdenes
+//
+// ───────────────────────────────────────────
+
+// OrderExportJob es una exportación de órdenes (XLSX/CSV) procesada en background.
+// El archivo resultante se guarda en S3 y su URL queda en el trabajo.
+type OrderExportJob struct {
+	gorm.Model
+
+	BusinessID *uint  `gorm:"index"`                                    // Negocio filtrado (nil = todos)
+	Format     string `gorm:"size:8;not null"`                          // "csv" | "xlsx"
+	Status     string `gorm:"size:16;not null;index;default:'pending'"` // "pending" | "processing" | "completed" | "failed"
+
+	Columns   datatypes.JSON `gorm:"type:jsonb"`    // Columnas exportadas, en orden
+	ItemLines bool           `gorm:"default:false"` // Una fila por item de la orden
+	Filters   datatypes.JSON `gorm:"type:jsonb"`    // Filtros de ListOrders
+
+	ResultFileKey string `gorm:"size:500"`
+	ResultFileURL string `gorm:"size:1000"`
+
+	// Progreso
+	TotalOrders     int `gorm:"default:0"`
+	ProcessedOrders int `gorm:"default:0"`
+	RowCount        int `gorm:"default:0"` // Filas escritas (mayor a las órdenes con item_lines)
+
+	ErrorMessage string `gorm:"size:1000"`
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedBy  *uint `gorm:"index"`
+}
+
+// TableName especifica el nombre de la tabla
+func (OrderExportJob) TableName() string {
+	return "order_export_jobs"
+}
@@ -107,7 +107,7 @@ func (OrderStatusMapping) TableName() string {
 // Debe coincidir con las constantes en orders/domain/status.go
 func (m *OrderStatusMapping) IsValidMappedStatus() bool {
 	validStatuses := []string{
-		"pending", "processing", "shipped", "delivered",
+		"pending", "processing", "ready_to_ship", "shipped", "delivered",
 		"completed", "cancelled", "refunded", "failed", "on_hold",
 	}
 
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PAYMENT REFUNDS - Reembolsos de pagos de la orden
+//
+// ───────────────────────────────────────────
+
+// PaymentRefund es un reembolso total o parcial de un pago. payments.refund_amount guarda la
+// suma de los reembolsos del pago; esta tabla guarda el detalle de cada uno.
+type PaymentRefund struct {
+	gorm.Model
+
+	PaymentID  uint   `gorm:"not null;index"`
+	OrderID    string `gorm:"type:varchar(36);not null;index"` // UUID de la orden (desnormalizado)
+	BusinessID *uint  `gorm:"index"`
+
+	Amount   float64 `gorm:"type:decimal(12,2);not null"`
+	Currency string  `gorm:"size:10"`
+	Reason   string  `gorm:"size:32;not null;index"` // "customer_request", "damaged", "not_delivered", "duplicate", "fraud", "other"
+	Note     *string `gorm:"type:text"`
+
+	CreatedBy *uint
+
+	// Write-back a la plataforma de origen de la orden
+	WriteBackStatus  string     `gorm:"size:20;not null;default:'not_requested';index"` // "not_requested", "synced", "failed", "not_supported"
+	ExternalRefundID string     `gorm:"size:128"`                                       // ID del reembolso en la plataforma
+	WriteBackError   *string    `gorm:"type:text"`
+	WriteBackAt      *time.Time // Cuándo se intentó el write-back
+
+	// Relaciones
+	Payment Payment             `gorm:"foreignKey:PaymentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Items   []PaymentRefundItem `gorm:"foreignKey:RefundID"`
+}
+
+// TableName especifica el nombre de la tabla
+func (PaymentRefund) TableName() string {
+	return "payment_refunds"
+}
+
+// PaymentRefundItem es una línea de la orden incluida en el reembolso
+type PaymentRefundItem struct {
+	gorm.Model
+
+	RefundID    uint    `gorm:"not null;index"`
+	OrderItemID uint    `gorm:"not null;index"`
+	SKU         string  `gorm:"size:128"` // SKU de la línea (desnormalizado)
+	Quantity    int     `gorm:"not null"`
+	Amount      float64 `gorm:"type:decimal(12,2);not null"`
+
+	// Relaciones
+	Refund    PaymentRefund `gorm:"foreignKey:RefundID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	OrderItem OrderItem     `gorm:"foreignKey:OrderItemID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (PaymentRefundItem) TableName() string {
+	return "payment_refund_items"
+}
@@ -46,6 +46,9 @@ type Product struct {
 	AllowBackorder    bool `gorm:"default:false" json:"allow_backorder"` // Permitir venta sin stock
 	LowStockThreshold *int `json:"low_stock_threshold,omitempty"`        // Umbral de stock bajo
 
+	// Cantidad reservada por órdenes pendientes de envío (disponible = StockQuantity - ReservedQuantity)
+	ReservedQuantity int `gorm:"not null;default:0" json:"reserved_quantity"`
+
 	// Media
 	ImageURL string         `gorm:"size:500" json:"image_url"`           // URL de imagen principal
 	Images   datatypes.JSON `gorm:"type:jsonb" json:"images,omitempty"`  // Array de URLs de imágenes
@@ -72,9 +75,13 @@ type Product struct {
 	// Metadata
 	Metadata datatypes.JSON `gorm:"type:jsonb" json:"metadata,omitempty"` // Datos adicionales flexibles
 
+	// Ejes de opción de las variantes (ej: ["size", "color"]); vacío si el producto no tiene variantes
+	OptionAxes datatypes.JSON `gorm:"type:jsonb" json:"option_axes,omitempty"`
+
 	// Relaciones
 	Business                    Business                     `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	ProductBusinessIntegrations []ProductBusinessIntegration `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Variants                    []ProductVariant             `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
 
 // TableName especifica el nombre de la tabla
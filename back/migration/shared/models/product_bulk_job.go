@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT BULK JOBS - Importación y exportación masiva de productos
+//
+// ───────────────────────────────────────────
+
+// ProductBulkJob es un trabajo en background de importación (CSV/XLSX) o exportación de productos.
+// El archivo de origen y el archivo de resultado se guardan en S3.
+type ProductBulkJob struct {
+	gorm.Model
+
+	BusinessID uint   `gorm:"not null;index"`
+	Type       string `gorm:"size:16;not null;index"`                   // "import" | "export"
+	Format     string `gorm:"size:8;not null"`                          // "csv" | "xlsx"
+	Status     string `gorm:"size:16;not null;index;default:'pending'"` // "pending" | "processing" | "completed" | "failed"
+	DryRun     bool   `gorm:"default:false"`                            // Solo valida la importación, sin guardar
+
+	ColumnMapping datatypes.JSON `gorm:"type:jsonb"` // Importación: columna del archivo -> campo del producto
+	Filters       datatypes.JSON `gorm:"type:jsonb"` // Exportación: filtros del listado de productos
+
+	SourceFileName string `gorm:"size:255"` // Nombre original del archivo importado
+	SourceFileKey  string `gorm:"size:500"` // Llave del archivo importado en S3
+	ResultFileKey  string `gorm:"size:500"` // Llave del archivo de resultado (reporte de importación o exportación)
+	ResultFileURL  string `gorm:"size:1000"`
+
+	// Progreso
+	TotalRows     int `gorm:"default:0"`
+	ProcessedRows int `gorm:"default:0"`
+	CreatedCount  int `gorm:"default:0"`
+	UpdatedCount  int `gorm:"default:0"`
+	ErrorCount    int `gorm:"default:0"`
+
+	RowErrors    datatypes.JSON `gorm:"type:jsonb"` // Primeros errores por fila
+	ErrorMessage string         `gorm:"size:1000"`  // Error que hizo fallar el trabajo
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedBy  *uint `gorm:"index"`
+
+	// Relaciones
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ProductBulkJob) TableName() string {
+	return "product_bulk_jobs"
+}
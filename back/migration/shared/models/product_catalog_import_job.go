@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT CATALOG IMPORT JOBS - Importación de catálogo desde integraciones
+//
+// ───────────────────────────────────────────
+
+// ProductCatalogImportJob es una corrida en background de la importación del catálogo de una integración.
+// El reporte de la corrida (SKUs creados, actualizados y en conflicto) se guarda en Report.
+type ProductCatalogImportJob struct {
+	gorm.Model
+
+	BusinessID     uint   `gorm:"not null;index"`
+	IntegrationID  uint   `gorm:"not null;index"`
+	Status         string `gorm:"size:16;not null;index;default:'pending'"` // "pending" | "processing" | "completed" | "failed"
+	DownloadImages bool   `gorm:"default:true"`                             // Descargar las imágenes del catálogo a S3
+
+	// Progreso
+	Pages        int `gorm:"default:0"`
+	ProductsRead int `gorm:"default:0"`
+
+	Report       datatypes.JSON `gorm:"type:jsonb"` // Reporte de la corrida (CatalogImportResult)
+	ErrorMessage string         `gorm:"size:1000"`  // Error que hizo fallar el trabajo
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedBy  *uint `gorm:"index"`
+
+	// Relaciones
+	Business    Business    `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Integration Integration `gorm:"foreignKey:IntegrationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ProductCatalogImportJob) TableName() string {
+	return "product_catalog_import_jobs"
+}
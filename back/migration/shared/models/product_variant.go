@@ -0,0 +1,123 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PRODUCT VARIANTS - Variantes de producto (talla, color, ...)
+//
+// ───────────────────────────────────────────
+
+// ProductVariant representa una variante vendible de un producto, definida por los valores
+// de los ejes de opción del producto (ej: {"size": "M", "color": "Rojo"}).
+// REGLA DE NEGOCIO: el SKU de la variante es único dentro del negocio.
+// El stock de un producto con variantes se controla por variante; TrackInventory,
+// AllowBackorder y LowStockThreshold se heredan del producto.
+type ProductVariant struct {
+	// ID alfanumérico único (generado automáticamente)
+	ID        string     `gorm:"type:varchar(64);primaryKey" json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `gorm:"index" json:"deleted_at,omitempty"`
+
+	// Producto padre y negocio (desnormalizado para la unicidad del SKU)
+	ProductID  string `gorm:"type:varchar(64);not null;index"`
+	BusinessID uint   `gorm:"not null;index;uniqueIndex:idx_business_variant_sku,priority:1"`
+
+	// SKU único dentro del negocio
+	SKU string `gorm:"size:128;not null;uniqueIndex:idx_business_variant_sku,priority:2"`
+
+	// Identificación
+	Title      string         `gorm:"size:255" json:"title"`               // Título de la variante (ej: "M / Rojo")
+	Options    datatypes.JSON `gorm:"type:jsonb" json:"options,omitempty"` // Valores por eje de opción: {"size": "M", "color": "Rojo"}
+	Barcode    string         `gorm:"size:128;index" json:"barcode"`       // Código de barras (EAN/UPC)
+	ExternalID string         `gorm:"size:255;index" json:"external_id"`   // ID en sistemas externos
+	Position   int            `gorm:"default:0" json:"position"`           // Orden de presentación
+
+	// Pricing (nil = se usa el precio del producto)
+	Price          *float64 `gorm:"type:decimal(15,2)" json:"price,omitempty"`
+	CompareAtPrice *float64 `gorm:"type:decimal(15,2)" json:"compare_at_price,omitempty"`
+	CostPrice      *float64 `gorm:"type:decimal(15,2)" json:"cost_price,omitempty"`
+
+	// Inventory
+	StockQuantity    int `gorm:"default:0" json:"stock_quantity"`             // Cantidad en stock
+	ReservedQuantity int `gorm:"not null;default:0" json:"reserved_quantity"` // Reservado por órdenes pendientes de envío
+
+	// Peso (nil = se usa el peso del producto)
+	Weight *float64 `gorm:"type:decimal(10,3)" json:"weight,omitempty"`
+
+	// Estado
+	IsActive bool `gorm:"default:true;index" json:"is_active"`
+
+	// Metadata
+	Metadata datatypes.JSON `gorm:"type:jsonb" json:"metadata,omitempty"`
+
+	// Relaciones
+	Product      Product                     `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Business     Business                    `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Integrations []ProductVariantIntegration `gorm:"foreignKey:VariantID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ProductVariant) TableName() string {
+	return "product_variants"
+}
+
+// BeforeCreate genera el ID hash antes de crear la variante
+func (v *ProductVariant) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == "" {
+		v.ID = generateVariantID()
+	}
+	return nil
+}
+
+// generateVariantID genera un ID alfanumérico único para variantes
+// Formato: VAR_ + 12 caracteres aleatorios (letras y números)
+func generateVariantID() string {
+	b := make([]byte, 9)
+	rand.Read(b)
+
+	encoded := base64.RawURLEncoding.EncodeToString(b)
+	if len(encoded) > 12 {
+		encoded = encoded[:12]
+	}
+
+	return fmt.Sprintf("VAR_%s", encoded)
+}
+
+// ProductVariantIntegration relaciona una variante con su ID en una integración
+// (ej: el variant_id de Shopify). Es el equivalente por variante de ProductBusinessIntegration.
+type ProductVariantIntegration struct {
+	gorm.Model
+
+	VariantID string `gorm:"type:varchar(64);not null;index;uniqueIndex:idx_variant_integration,priority:1"`
+	ProductID string `gorm:"type:varchar(64);not null;index"`
+
+	// ID del negocio (desnormalizado; DEBE coincidir con el de la variante y la integración)
+	BusinessID uint `gorm:"not null;index"`
+
+	// ID de la integración asociada
+	IntegrationID uint `gorm:"not null;uniqueIndex:idx_variant_integration,priority:2;index:idx_variant_integration_external,priority:1"`
+
+	// ID de la variante en el sistema externo de la integración
+	ExternalVariantID string `gorm:"size:255;not null;index:idx_variant_integration_external,priority:2"`
+
+	// Relaciones
+	Variant     ProductVariant `gorm:"foreignKey:VariantID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Product     Product        `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Business    Business       `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Integration Integration    `gorm:"foreignKey:IntegrationID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ProductVariantIntegration) TableName() string {
+	return "product_variant_integrations"
+}
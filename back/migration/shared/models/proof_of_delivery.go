@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	PROOF OF DELIVERY - Evidencia de entrega del envío
+//
+// ───────────────────────────────────────────
+
+// ProofOfDelivery guarda la evidencia capturada por el conductor al entregar: foto, firma,
+// quién recibió y dónde estaba el dispositivo
+type ProofOfDelivery struct {
+	gorm.Model
+
+	ShipmentID uint   `gorm:"not null;uniqueIndex"`            // Una evidencia por envío
+	OrderID    string `gorm:"type:varchar(36);not null;index"` // Desnormalizado para consultas por orden
+	DriverID   *uint  `gorm:"index"`
+
+	PhotoPath     string `gorm:"size:512;not null"` // Path relativo en el almacenamiento
+	SignaturePath string `gorm:"size:512"`          // Path relativo en el almacenamiento (opcional)
+
+	ReceiverName     string `gorm:"size:255;not null"`
+	ReceiverDocument string `gorm:"size:50"`
+
+	// Geolocalización del dispositivo al entregar
+	Latitude   *float64  `gorm:"type:decimal(10,8)"`
+	Longitude  *float64  `gorm:"type:decimal(11,8)"`
+	CapturedAt time.Time `gorm:"not null;index"`
+
+	// Comparación con la dirección de la orden
+	DistanceMeters   *float64 `gorm:"type:decimal(12,2)"` // Distancia al punto de la dirección (nil si no hay coordenadas)
+	IsFarFromAddress bool     `gorm:"default:false;index"`
+
+	Notes *string `gorm:"type:text"`
+
+	// Relación
+	Shipment Shipment `gorm:"foreignKey:ShipmentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ProofOfDelivery) TableName() string {
+	return "proofs_of_delivery"
+}
@@ -27,14 +27,18 @@ type Shipment struct {
 	CarrierCode    *string `gorm:"size:50"`        // Código del transportista
 
 	// Información de guía
-	GuideID  *string `gorm:"size:128;index"` // ID de guía de envío
-	GuideURL *string `gorm:"size:512"`       // URL de la guía
+	GuideID          *string    `gorm:"size:128;index"` // ID de guía de envío
+	GuideURL         *string    `gorm:"size:512"`       // URL de la guía
+	GuideRequestedAt *time.Time // Generación de guía en curso (reserva para no comprar dos guías del mismo envío)
 
 	// Estado del envío
 	Status      string     `gorm:"size:64;not null;index;default:'pending'"` // "pending", "in_transit", "delivered", "failed"
 	ShippedAt   *time.Time `gorm:"index"`                                    // Cuándo se envió
 	DeliveredAt *time.Time // Cuándo se entregó
 
+	// Tracking con la transportadora
+	LastTrackedAt *time.Time `gorm:"index"` // Última consulta de tracking a la transportadora
+
 	// Información de dirección
 	ShippingAddressID *uint    // FK a addresses (opcional, puede usar la de la orden)
 	ShippingAddress   *Address `gorm:"foreignKey:ShippingAddressID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
@@ -57,6 +61,11 @@ type Shipment struct {
 	DriverName    string `gorm:"size:255"`      // Nombre del conductor
 	IsLastMile    bool   `gorm:"default:false"` // Si es última milla
 
+	// Intentos de entrega de última milla
+	FailedAttempts   int        `gorm:"default:0"` // Intentos fallidos registrados
+	EscalatedAt      *time.Time `gorm:"index"`     // Cuándo se escaló por fallas repetidas
+	EscalationReason *string    `gorm:"type:text"` // Motivo del escalamiento
+
 	// Información adicional
 	EstimatedDelivery *time.Time     `gorm:"index"`      // Entrega estimada
 	DeliveryNotes     *string        `gorm:"type:text"`  // Notas de entrega
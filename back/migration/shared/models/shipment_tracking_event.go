@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHIPMENT TRACKING EVENTS - Línea de tiempo del envío
+//
+// ───────────────────────────────────────────
+
+// ShipmentTrackingEvent representa un checkpoint reportado por la transportadora (webhook o consulta periódica)
+type ShipmentTrackingEvent struct {
+	gorm.Model
+
+	ShipmentID uint      `gorm:"not null;index;uniqueIndex:idx_shipment_tracking_event_dedupe,priority:1"`
+	OccurredAt time.Time `gorm:"not null;index;uniqueIndex:idx_shipment_tracking_event_dedupe,priority:2"` // Cuándo ocurrió según la transportadora
+
+	RawCode     string `gorm:"size:100;not null;uniqueIndex:idx_shipment_tracking_event_dedupe,priority:3"` // Código de estado original de la transportadora
+	Status      string `gorm:"size:64;not null;index"`                                                      // Estado normalizado: "pending", "in_transit", "out_for_delivery", "delivered", "failed", "returned", "unknown"
+	Location    string `gorm:"size:255"`                                                                    // Ciudad / centro de distribución
+	Description string `gorm:"type:text"`                                                                   // Descripción legible del checkpoint
+
+	CarrierCode string `gorm:"size:50;index"`    // Transportadora que reportó el evento
+	Source      string `gorm:"size:20;not null"` // "webhook", "polling"
+
+	// Relación
+	Shipment Shipment `gorm:"foreignKey:ShipmentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ShipmentTrackingEvent) TableName() string {
+	return "shipment_tracking_events"
+}
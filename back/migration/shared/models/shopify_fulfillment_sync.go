@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	SHOPIFY FULFILLMENT SYNCS - Write-back de envíos a Shopify
+//
+// ───────────────────────────────────────────
+
+// ShopifyFulfillmentSync registra el estado de la escritura de fulfillments/cancelaciones
+// hacia la tienda Shopify de origen de una orden. Los intentos fallidos quedan pendientes de reintento.
+type ShopifyFulfillmentSync struct {
+	gorm.Model
+
+	// Clave idempotente: "shipment:<id>" para envíos, "order:<id>:cancel" para cancelaciones de orden
+	SyncKey string `gorm:"size:100;not null;uniqueIndex"`
+
+	// Orden e integración de origen
+	BusinessID      *uint  `gorm:"index"`
+	IntegrationID   uint   `gorm:"not null;index"`
+	OrderID         string `gorm:"type:varchar(36);not null;index"` // UUID de la orden
+	ExternalOrderID string `gorm:"size:255;not null"`               // ID de la orden en Shopify
+	ShipmentID      *uint  `gorm:"index"`                           // Envío asociado (null para cancelación de orden)
+
+	// Acción a reflejar en Shopify: "fulfill", "cancel_fulfillment", "cancel_order"
+	Action string `gorm:"size:32;not null"`
+
+	// Información de tracking enviada
+	TrackingNumber string `gorm:"size:128"`
+	TrackingURL    string `gorm:"size:512"`
+	Carrier        string `gorm:"size:128"`
+
+	// ID del fulfillment creado en Shopify (para actualizar tracking o cancelar)
+	FulfillmentID string `gorm:"size:64"`
+
+	// Estado del write-back: "pending", "synced", "failed"
+	Status      string     `gorm:"size:20;not null;default:'pending';index"`
+	Attempts    int        `gorm:"not null;default:0"`
+	LastError   string     `gorm:"type:text"`
+	NextRetryAt *time.Time `gorm:"index"` // null = sin reintento programado
+	SyncedAt    *time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (ShopifyFulfillmentSync) TableName() string {
+	return "shopify_fulfillment_syncs"
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ───────────────────────────────────────────
+//
+//	WAREHOUSES - Almacenes / bodegas de despacho
+//
+// ───────────────────────────────────────────
+
+// Warehouse representa un almacén del negocio desde el que se preparan y despachan las órdenes.
+// orders.warehouse_id y shipments.warehouse_id referencian esta tabla.
+type Warehouse struct {
+	gorm.Model
+
+	BusinessID uint   `gorm:"not null;index;uniqueIndex:idx_business_warehouse_code,priority:1"`
+	Code       string `gorm:"size:50;not null;uniqueIndex:idx_business_warehouse_code,priority:2"` // Código único dentro del negocio
+	Name       string `gorm:"size:128;not null"`
+
+	// Dirección
+	Street     string   `gorm:"size:255"`
+	City       string   `gorm:"size:128"`
+	State      string   `gorm:"size:128"`
+	Country    string   `gorm:"size:128"`
+	PostalCode string   `gorm:"size:32"`
+	Lat        *float64 `gorm:"type:decimal(10,8)"`
+	Lng        *float64 `gorm:"type:decimal(11,8)"`
+
+	// Contacto
+	ContactName  string `gorm:"size:255"`
+	ContactPhone string `gorm:"size:32"`
+
+	// Horario de operación por día: [{"day": "monday", "opens": "08:00", "closes": "18:00"}]
+	OperatingHours datatypes.JSON `gorm:"type:jsonb"`
+
+	IsActive  bool `gorm:"default:true;index"`
+	IsDefault bool `gorm:"default:false"` // Almacén usado para las órdenes sin almacén asignado
+
+	// Relación
+	Business Business `gorm:"foreignKey:BusinessID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName especifica el nombre de la tabla
+func (Warehouse) TableName() string {
+	return "warehouses"
+}